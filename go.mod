@@ -2,6 +2,9 @@ module github.com/bodrovis/lokalise-glossary-guard-core
 
 go 1.26
 
-require golang.org/x/net v0.56.0
+require (
+	golang.org/x/net v0.56.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require golang.org/x/text v0.38.0 // indirect
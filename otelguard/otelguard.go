@@ -0,0 +1,189 @@
+// Package otelguard adds OpenTelemetry tracing to glossary validation.
+//
+// It lives in a separate module so the core stays free of the OpenTelemetry SDK.
+// Register Middleware once (or call Install) to get a span per check, and use
+// Validate instead of validator.Validate to group them under a parent run span.
+package otelguard
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+const (
+	// ScopeName is the instrumentation scope used for all spans.
+	ScopeName = "github.com/bodrovis/lokalise-glossary-guard-core/otelguard"
+
+	// RunSpanName is the name of the parent span created by Validate.
+	RunSpanName = "glossary.validate"
+	// CheckSpanPrefix prefixes per-check span names ("glossary.check <name>").
+	CheckSpanPrefix = "glossary.check "
+)
+
+// Attribute keys set on run and check spans.
+const (
+	AttrFilePath    = attribute.Key("glossary.file.path")
+	AttrLangs       = attribute.Key("glossary.langs")
+	AttrPass        = attribute.Key("glossary.summary.pass")
+	AttrWarn        = attribute.Key("glossary.summary.warn")
+	AttrFail        = attribute.Key("glossary.summary.fail")
+	AttrError       = attribute.Key("glossary.summary.error")
+	AttrInfo        = attribute.Key("glossary.summary.info")
+	AttrSkipped     = attribute.Key("glossary.summary.skipped")
+	AttrFixes       = attribute.Key("glossary.summary.applied_fixes")
+	AttrEarlyExit   = attribute.Key("glossary.early_exit")
+	AttrEarlyCheck  = attribute.Key("glossary.early_check")
+	AttrCheckName   = attribute.Key("glossary.check.name")
+	AttrPriority    = attribute.Key("glossary.check.priority")
+	AttrFailFast    = attribute.Key("glossary.check.fail_fast")
+	AttrStatus      = attribute.Key("glossary.check.status")
+	AttrFixChanged  = attribute.Key("glossary.fix.changed")
+	AttrFixNote     = attribute.Key("glossary.fix.note")
+	AttrFixMode     = attribute.Key("glossary.fix.mode")
+	AttrInputBytes  = attribute.Key("glossary.input.bytes")
+	AttrOutputBytes = attribute.Key("glossary.output.bytes")
+)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// Option customizes the tracer used by Middleware and Validate.
+type Option func(*config)
+
+// WithTracerProvider sets the provider to create the tracer from.
+// By default the global provider (otel.GetTracerProvider) is used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		if tp != nil {
+			c.provider = tp
+		}
+	}
+}
+
+func tracerFrom(opts []Option) trace.Tracer {
+	c := config{}
+	for _, o := range opts {
+		if o != nil {
+			o(&c)
+		}
+	}
+	if c.provider == nil {
+		c.provider = otel.GetTracerProvider()
+	}
+	return c.provider.Tracer(ScopeName)
+}
+
+// Middleware returns a validator middleware that wraps every check in its own span.
+// Check spans become children of whatever span is active in ctx (see Validate).
+func Middleware(opts ...Option) validator.Middleware {
+	tracer := tracerFrom(opts)
+
+	return func(next validator.Step) validator.Step {
+		return func(
+			ctx context.Context,
+			unit checks.CheckUnit,
+			a checks.Artifact,
+			ro checks.RunOptions,
+		) checks.CheckOutcome {
+			ctx, span := tracer.Start(ctx, CheckSpanPrefix+unit.Name(),
+				trace.WithAttributes(
+					AttrCheckName.String(unit.Name()),
+					AttrPriority.Int(unit.Priority()),
+					AttrFailFast.Bool(unit.FailFast()),
+					AttrFixMode.String(fixModeName(ro.FixMode)),
+					AttrInputBytes.Int(len(a.Data)),
+				),
+			)
+			defer span.End()
+
+			out := next(ctx, unit, a, ro)
+
+			span.SetAttributes(
+				AttrStatus.String(string(out.Result.Status)),
+				AttrFixChanged.Bool(out.Final.DidChange),
+			)
+			if out.Final.DidChange {
+				span.SetAttributes(AttrOutputBytes.Int(len(out.Final.Data)))
+			}
+			if out.Final.Note != "" {
+				span.SetAttributes(AttrFixNote.String(out.Final.Note))
+			}
+			if out.Result.Status == checks.Error {
+				span.SetStatus(codes.Error, out.Result.Message)
+			}
+
+			return out
+		}
+	}
+}
+
+// Install registers Middleware globally via validator.Use.
+func Install(opts ...Option) {
+	validator.Use(Middleware(opts...))
+}
+
+// Validate runs validator.Validate inside a parent run span carrying summary counters.
+// Per-check spans appear under it only if Middleware is installed.
+func Validate(
+	ctx context.Context,
+	filePath string,
+	data []byte,
+	langs []string,
+	ro checks.RunOptions,
+	opts ...Option,
+) (validator.Summary, error) {
+	ctx, span := tracerFrom(opts).Start(ctx, RunSpanName,
+		trace.WithAttributes(
+			AttrFilePath.String(filePath),
+			AttrLangs.StringSlice(langs),
+			AttrFixMode.String(fixModeName(ro.FixMode)),
+			AttrInputBytes.Int(len(data)),
+		),
+	)
+	defer span.End()
+
+	sum, err := validator.Validate(ctx, filePath, data, langs, ro)
+
+	span.SetAttributes(
+		AttrPass.Int(sum.Pass),
+		AttrWarn.Int(sum.Warn),
+		AttrFail.Int(sum.Fail),
+		AttrError.Int(sum.Error),
+		AttrInfo.Int(sum.Info),
+		AttrSkipped.Int(sum.Skipped),
+		AttrFixes.Bool(sum.AppliedFixes),
+		AttrEarlyExit.Bool(sum.EarlyExit),
+	)
+	if sum.EarlyExit {
+		span.SetAttributes(AttrEarlyCheck.String(sum.EarlyCheck))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return sum, err
+}
+
+func fixModeName(m checks.FixMode) string {
+	switch m {
+	case checks.FixNone:
+		return "none"
+	case checks.FixIfFailed:
+		return "if-failed"
+	case checks.FixIfNotPass:
+		return "if-not-pass"
+	case checks.FixAlways:
+		return "always"
+	default:
+		return "unknown"
+	}
+}
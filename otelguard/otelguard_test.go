@@ -0,0 +1,149 @@
+package otelguard_test
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/otelguard"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestValidate_CreatesRunAndCheckSpans(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	register(t, "alpha", 1, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, "alpha", "ok", a, "")
+	})
+	register(t, "beta", 2, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		final := checks.FixResult{Data: []byte("fixed!"), Path: a.Path, DidChange: true, Note: "rewrote"}
+		return checks.OutcomeWithFinal(checks.Warn, "beta", "fixed", final)
+	})
+	register(t, "gamma", 3, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Error, "gamma", "boom", a, "")
+	})
+
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	otelguard.Install(otelguard.WithTracerProvider(tp))
+
+	sum, err := otelguard.Validate(context.Background(), "file.csv", []byte("data"), []string{"en"},
+		checks.RunOptions{FixMode: checks.FixAlways}, otelguard.WithTracerProvider(tp))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Pass != 1 || sum.Warn != 1 || sum.Error != 1 {
+		t.Fatalf("counters mismatch: %+v", sum)
+	}
+
+	spans := rec.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("got %d spans, want 4", len(spans))
+	}
+
+	byName := map[string]sdktrace.ReadOnlySpan{}
+	for _, s := range spans {
+		byName[s.Name()] = s
+	}
+
+	run, ok := byName[otelguard.RunSpanName]
+	if !ok {
+		t.Fatalf("run span missing")
+	}
+	assertAttr(t, run.Attributes(), otelguard.AttrFilePath, attribute.StringValue("file.csv"))
+	assertAttr(t, run.Attributes(), otelguard.AttrWarn, attribute.IntValue(1))
+	assertAttr(t, run.Attributes(), otelguard.AttrFixes, attribute.BoolValue(true))
+
+	for _, name := range []string{"alpha", "beta", "gamma"} {
+		s, ok := byName[otelguard.CheckSpanPrefix+name]
+		if !ok {
+			t.Fatalf("span for %s missing", name)
+		}
+		if s.Parent().SpanID() != run.SpanContext().SpanID() {
+			t.Fatalf("span %s is not a child of the run span", name)
+		}
+		assertAttr(t, s.Attributes(), otelguard.AttrCheckName, attribute.StringValue(name))
+	}
+
+	beta := byName[otelguard.CheckSpanPrefix+"beta"]
+	assertAttr(t, beta.Attributes(), otelguard.AttrStatus, attribute.StringValue(string(checks.Warn)))
+	assertAttr(t, beta.Attributes(), otelguard.AttrFixChanged, attribute.BoolValue(true))
+	assertAttr(t, beta.Attributes(), otelguard.AttrFixNote, attribute.StringValue("rewrote"))
+	assertAttr(t, beta.Attributes(), otelguard.AttrOutputBytes, attribute.IntValue(6))
+
+	gamma := byName[otelguard.CheckSpanPrefix+"gamma"]
+	if gamma.Status().Code != codes.Error || gamma.Status().Description != "boom" {
+		t.Fatalf("gamma status = %+v, want Error/boom", gamma.Status())
+	}
+}
+
+func TestValidate_RecordsRunError(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	register(t, "any", 1, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, "any", "ok", a, "")
+	})
+
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := otelguard.Validate(ctx, "file.csv", []byte("x"), nil, checks.RunOptions{},
+		otelguard.WithTracerProvider(tp))
+	if err == nil {
+		t.Fatalf("expected context error")
+	}
+
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want only the run span", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Fatalf("run span status = %+v, want Error", spans[0].Status())
+	}
+	assertAttr(t, spans[0].Attributes(), otelguard.AttrEarlyExit, attribute.BoolValue(true))
+}
+
+func register(
+	t *testing.T,
+	name string,
+	prio int,
+	run func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome,
+) {
+	t.Helper()
+
+	unit, err := checks.NewCheckAdapter(name, run, checks.WithPriority(prio))
+	if err != nil {
+		t.Fatalf("NewCheckAdapter(%s): %v", name, err)
+	}
+	if _, err := checks.Register(unit); err != nil {
+		t.Fatalf("Register(%s): %v", name, err)
+	}
+}
+
+func assertAttr(t *testing.T, attrs []attribute.KeyValue, key attribute.Key, want attribute.Value) {
+	t.Helper()
+
+	for _, kv := range attrs {
+		if kv.Key == key {
+			if kv.Value != want {
+				t.Fatalf("%s = %v, want %v", key, kv.Value.Emit(), want.Emit())
+			}
+			return
+		}
+	}
+	t.Fatalf("attribute %s not found", key)
+}
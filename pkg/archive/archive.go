@@ -0,0 +1,205 @@
+// Package archive validates glossaries bundled in ZIP archives, such as Lokalise
+// project backups that keep glossary.csv next to translation memory and other assets.
+//
+// Validate locates the glossary entry by name pattern and runs the validator on it;
+// WriteFixed copies the archive with the fixed glossary in place of the original.
+package archive
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// DefaultPattern matches the glossary entry when Options.Pattern is empty.
+const DefaultPattern = "glossary*.csv"
+
+// DefaultMaxSize caps the uncompressed glossary size when Options.MaxSize is zero.
+const DefaultMaxSize = 256 << 20
+
+var (
+	// ErrNoGlossary is returned when no entry matches the pattern.
+	ErrNoGlossary = errors.New("archive: no glossary entry found")
+	// ErrAmbiguous is returned when several entries match the pattern.
+	ErrAmbiguous = errors.New("archive: several entries match the glossary pattern")
+	// ErrTooLarge is returned when the glossary entry exceeds Options.MaxSize.
+	ErrTooLarge = errors.New("archive: glossary entry is too large")
+)
+
+// Options tune Validate.
+type Options struct {
+	// Pattern is matched (path.Match, case-insensitive) against the base name of every
+	// file entry. Empty uses DefaultPattern.
+	Pattern string
+
+	// MaxSize caps the uncompressed size of the glossary entry. Zero uses DefaultMaxSize.
+	MaxSize int64
+
+	// Langs are the declared languages of the glossary.
+	Langs []string
+
+	// Run is passed to validator.Validate. Use FixMode to enable fixes.
+	Run checks.RunOptions
+}
+
+// Result is the outcome for the glossary entry of one archive.
+type Result struct {
+	// Entry is the name of the glossary entry inside the archive.
+	Entry string
+
+	// Summary is the validation summary; Summary.FinalPath is the entry name after fixes.
+	Summary validator.Summary
+}
+
+// Find returns the single file entry whose base name matches pattern.
+func Find(zr *zip.Reader, pattern string) (*zip.File, error) {
+	if pattern == "" {
+		pattern = DefaultPattern
+	}
+	pattern = strings.ToLower(pattern)
+
+	var found []*zip.File
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		ok, err := path.Match(pattern, strings.ToLower(path.Base(f.Name)))
+		if err != nil {
+			return nil, fmt.Errorf("archive: bad pattern %q: %w", pattern, err)
+		}
+		if ok {
+			found = append(found, f)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%w (pattern %q)", ErrNoGlossary, pattern)
+	case 1:
+		return found[0], nil
+	default:
+		names := make([]string, len(found))
+		for i, f := range found {
+			names[i] = f.Name
+		}
+		return nil, fmt.Errorf("%w: %s", ErrAmbiguous, strings.Join(names, ", "))
+	}
+}
+
+// Validate locates the glossary in zr and validates it with opts.Run.
+// A validator error is returned as is (a *validator.RunError) together with the result.
+func Validate(ctx context.Context, zr *zip.Reader, opts Options) (Result, error) {
+	f, err := Find(zr, opts.Pattern)
+	if err != nil {
+		return Result{}, err
+	}
+
+	data, err := readEntry(f, opts.maxSize())
+	if err != nil {
+		return Result{}, err
+	}
+
+	sum, err := validator.Validate(ctx, f.Name, data, opts.Langs, opts.Run)
+
+	return Result{Entry: f.Name, Summary: sum}, err
+}
+
+func (o Options) maxSize() int64 {
+	if o.MaxSize == 0 {
+		return DefaultMaxSize
+	}
+
+	return o.MaxSize
+}
+
+func readEntry(f *zip.File, maxSize int64) ([]byte, error) {
+	if maxSize > 0 && f.UncompressedSize64 > uint64(maxSize) {
+		return nil, fmt.Errorf("%w: %s is %d bytes", ErrTooLarge, f.Name, f.UncompressedSize64)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("archive: open %s: %w", f.Name, err)
+	}
+	defer rc.Close()
+
+	// The header size can lie; never read past the cap.
+	r := io.Reader(rc)
+	if maxSize > 0 {
+		r = io.LimitReader(rc, maxSize+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("archive: read %s: %w", f.Name, err)
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrTooLarge, f.Name, maxSize)
+	}
+
+	return data, nil
+}
+
+// WriteFixed writes a copy of zr to w in which the glossary entry of res holds
+// res.Summary.FinalData, under the fixed name when a check renamed the file.
+// Every other entry is copied without recompression; entry order is kept.
+func WriteFixed(w io.Writer, zr *zip.Reader, res Result) error {
+	zw := zip.NewWriter(w)
+
+	if zr.Comment != "" {
+		if err := zw.SetComment(zr.Comment); err != nil {
+			return err
+		}
+	}
+
+	replaced := false
+	for _, f := range zr.File {
+		if f.Name != res.Entry {
+			if err := zw.Copy(f); err != nil {
+				return fmt.Errorf("archive: copy %s: %w", f.Name, err)
+			}
+			continue
+		}
+
+		hdr := f.FileHeader
+		hdr.Name = fixedName(res)
+		hdr.Method = zip.Deflate
+		hdr.CRC32, hdr.CompressedSize64, hdr.UncompressedSize64 = 0, 0, 0
+
+		fw, err := zw.CreateHeader(&hdr)
+		if err != nil {
+			return fmt.Errorf("archive: write %s: %w", hdr.Name, err)
+		}
+		if _, err := fw.Write(res.Summary.FinalData); err != nil {
+			return fmt.Errorf("archive: write %s: %w", hdr.Name, err)
+		}
+		replaced = true
+	}
+
+	if !replaced {
+		return fmt.Errorf("%w: %s", ErrNoGlossary, res.Entry)
+	}
+
+	return zw.Close()
+}
+
+// fixedName keeps the entry's directory and takes the base name from FinalPath.
+func fixedName(res Result) string {
+	if res.Summary.FinalPath == "" || res.Summary.FinalPath == res.Entry {
+		return res.Entry
+	}
+
+	dir := path.Dir(res.Entry)
+	base := path.Base(res.Summary.FinalPath)
+	if dir == "." {
+		return base
+	}
+
+	return dir + "/" + base
+}
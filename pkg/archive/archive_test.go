@@ -0,0 +1,159 @@
+package archive_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/archive"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
+)
+
+func TestMain(m *testing.M) {
+	if err := checks.LoadAll(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+type entry struct {
+	name string
+	body string
+}
+
+func mkZip(t *testing.T, entries ...entry) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		w, err := zw.Create(e.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, e.body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.SetComment("backup"); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return zr
+}
+
+func readAll(t *testing.T, zr *zip.Reader) map[string]string {
+	t.Helper()
+
+	out := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		out[f.Name] = string(data)
+	}
+
+	return out
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	zr := mkZip(t,
+		entry{"backup/", ""},
+		entry{"backup/tm/en.tmx", "<tmx/>"},
+		entry{"backup/Glossary.CSV", "term\n"},
+	)
+
+	f, err := archive.Find(zr, "")
+	if err != nil || f.Name != "backup/Glossary.CSV" {
+		t.Fatalf("Find = %v, %v", f, err)
+	}
+
+	if _, err := archive.Find(zr, "terms*.csv"); !errors.Is(err, archive.ErrNoGlossary) {
+		t.Fatalf("err = %v, want ErrNoGlossary", err)
+	}
+
+	two := mkZip(t, entry{"a/glossary.csv", "term\n"}, entry{"b/glossary-old.csv", "term\n"})
+	if _, err := archive.Find(two, ""); !errors.Is(err, archive.ErrAmbiguous) {
+		t.Fatalf("err = %v, want ErrAmbiguous", err)
+	}
+}
+
+func TestValidateAndWriteFixed(t *testing.T) {
+	t.Parallel()
+
+	zr := mkZip(t,
+		entry{"backup/tm/en.tmx", "<tmx/>"},
+		entry{"backup/glossary.csv", "term;description;en\n\ncloud;storage;cloud\n"},
+		entry{"backup/readme.txt", "hello"},
+	)
+
+	res, err := archive.Validate(context.Background(), zr, archive.Options{
+		Langs: []string{"en"},
+		Run:   checks.RunOptions{FixMode: checks.FixIfFailed, RerunAfterFix: true},
+	})
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if res.Entry != "backup/glossary.csv" || !res.Summary.AppliedFixes {
+		t.Fatalf("unexpected result: entry=%q fixed=%v", res.Entry, res.Summary.AppliedFixes)
+	}
+
+	var out bytes.Buffer
+	if err := archive.WriteFixed(&out, zr, res); err != nil {
+		t.Fatalf("WriteFixed: %v", err)
+	}
+
+	fixed, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fixed.Comment != "backup" {
+		t.Fatalf("comment = %q", fixed.Comment)
+	}
+
+	got := readAll(t, fixed)
+	if got["backup/glossary.csv"] != string(res.Summary.FinalData) {
+		t.Fatalf("glossary = %q, want %q", got["backup/glossary.csv"], res.Summary.FinalData)
+	}
+	if bytes.Contains(res.Summary.FinalData, []byte("\n\n")) {
+		t.Fatalf("blank line not fixed: %q", res.Summary.FinalData)
+	}
+	if got["backup/tm/en.tmx"] != "<tmx/>" || got["backup/readme.txt"] != "hello" || len(got) != 3 {
+		t.Fatalf("other entries changed: %v", got)
+	}
+	if fixed.File[1].Name != "backup/glossary.csv" {
+		t.Fatalf("entry order changed: %s", fixed.File[1].Name)
+	}
+}
+
+func TestValidate_TooLarge(t *testing.T) {
+	t.Parallel()
+
+	zr := mkZip(t, entry{"glossary.csv", "term;description\nx;y\n"})
+
+	_, err := archive.Validate(context.Background(), zr, archive.Options{MaxSize: 5})
+	if !errors.Is(err, archive.ErrTooLarge) {
+		t.Fatalf("err = %v, want ErrTooLarge", err)
+	}
+}
@@ -0,0 +1,163 @@
+// Package audit writes a JSON Lines audit log of check executions.
+//
+// Register Middleware (or call Install) to append one line per executed check,
+// and use Validate instead of validator.Validate to tag the lines of one run
+// with a shared run id.
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Record is one audit line describing a single check execution.
+type Record struct {
+	Time        time.Time     `json:"time"`
+	RunID       string        `json:"run_id,omitempty"`
+	File        string        `json:"file,omitempty"`
+	Check       string        `json:"check"`
+	Status      checks.Status `json:"status"`
+	Message     string        `json:"message,omitempty"`
+	DurationMS  float64       `json:"duration_ms"`
+	FixApplied  bool          `json:"fix_applied"`
+	FixNote     string        `json:"fix_note,omitempty"`
+	BytesBefore int           `json:"bytes_before"`
+	BytesAfter  int           `json:"bytes_after"`
+}
+
+// Writer appends records to an io.Writer, one JSON object per line.
+// It is safe for concurrent use. The first write error is kept and
+// reported by Err; later records are dropped so the log is never torn.
+type Writer struct {
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+	now func() time.Time
+}
+
+// NewWriter returns a Writer appending to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w, now: time.Now}
+}
+
+// Write appends r as a single JSON line.
+func (w *Writer) Write(r Record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return w.err
+	}
+	if _, err := w.w.Write(line); err != nil {
+		w.err = err
+	}
+
+	return w.err
+}
+
+// Err returns the first error the underlying io.Writer reported, if any.
+func (w *Writer) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.err
+}
+
+type runIDKey struct{}
+
+// WithRunID returns a context whose check executions are logged under id.
+func WithRunID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, runIDKey{}, id)
+}
+
+// RunIDFrom returns the run id stored in ctx, if any.
+func RunIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(runIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// NewRunID returns a random 128-bit run id in hex.
+func NewRunID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// Middleware returns a validator middleware that logs every check execution to w.
+// The run id comes from ctx (see WithRunID and Validate); it is omitted when absent.
+func Middleware(w *Writer) validator.Middleware {
+	return func(next validator.Step) validator.Step {
+		return func(
+			ctx context.Context,
+			unit checks.CheckUnit,
+			a checks.Artifact,
+			ro checks.RunOptions,
+		) checks.CheckOutcome {
+			start := w.now()
+
+			out := next(ctx, unit, a, ro)
+
+			after := len(a.Data)
+			if out.Final.Data != nil {
+				after = len(out.Final.Data)
+			}
+			runID, _ := RunIDFrom(ctx)
+
+			// Audit failures must not change validation results; see Writer.Err.
+			_ = w.Write(Record{
+				Time:        start.UTC(),
+				RunID:       runID,
+				File:        a.Path,
+				Check:       unit.Name(),
+				Status:      out.Result.Status,
+				Message:     out.Result.Message,
+				DurationMS:  float64(w.now().Sub(start)) / float64(time.Millisecond),
+				FixApplied:  out.Final.DidChange,
+				FixNote:     out.Final.Note,
+				BytesBefore: len(a.Data),
+				BytesAfter:  after,
+			})
+
+			return out
+		}
+	}
+}
+
+// Install registers Middleware globally via validator.Use.
+func Install(w *Writer) {
+	validator.Use(Middleware(w))
+}
+
+// Validate runs validator.Validate under a fresh run id (unless ctx already has one)
+// and returns it along with the summary. Lines are written only if Middleware is installed.
+func Validate(
+	ctx context.Context,
+	filePath string,
+	data []byte,
+	langs []string,
+	ro checks.RunOptions,
+) (string, validator.Summary, error) {
+	runID, ok := RunIDFrom(ctx)
+	if !ok {
+		runID = NewRunID()
+		ctx = WithRunID(ctx, runID)
+	}
+
+	sum, err := validator.Validate(ctx, filePath, data, langs, ro)
+
+	return runID, sum, err
+}
@@ -0,0 +1,144 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/audit"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestValidate_WritesOneLinePerCheck(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	register(t, "alpha", 1, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, "alpha", "ok", a, "")
+	})
+	register(t, "beta", 2, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		final := checks.FixResult{Data: []byte("fixed!"), Path: a.Path, DidChange: true, Note: "rewrote"}
+		return checks.OutcomeWithFinal(checks.Warn, "beta", "fixed", final)
+	})
+
+	var buf bytes.Buffer
+	w := audit.NewWriter(&buf)
+	audit.Install(w)
+
+	runID, sum, err := audit.Validate(context.Background(), "file.csv", []byte("data"), nil,
+		checks.RunOptions{FixMode: checks.FixAlways})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if runID == "" || sum.Pass != 1 || sum.Warn != 1 {
+		t.Fatalf("runID=%q summary=%+v", runID, sum)
+	}
+	if w.Err() != nil {
+		t.Fatalf("unexpected writer error: %v", w.Err())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+
+	var recs []audit.Record
+	for _, line := range lines {
+		var r audit.Record
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("bad JSON line %q: %v", line, err)
+		}
+		recs = append(recs, r)
+	}
+
+	if recs[0].Check != "alpha" || recs[0].Status != checks.Pass || recs[0].FixApplied {
+		t.Fatalf("alpha record = %+v", recs[0])
+	}
+	if recs[0].BytesBefore != 4 || recs[0].BytesAfter != 4 {
+		t.Fatalf("alpha bytes = %d/%d, want 4/4", recs[0].BytesBefore, recs[0].BytesAfter)
+	}
+
+	beta := recs[1]
+	if beta.Check != "beta" || beta.Status != checks.Warn || !beta.FixApplied || beta.FixNote != "rewrote" {
+		t.Fatalf("beta record = %+v", beta)
+	}
+	if beta.BytesBefore != 4 || beta.BytesAfter != 6 {
+		t.Fatalf("beta bytes = %d/%d, want 4/6", beta.BytesBefore, beta.BytesAfter)
+	}
+
+	for _, r := range recs {
+		if r.RunID != runID || r.File != "file.csv" || r.Time.IsZero() || r.DurationMS < 0 {
+			t.Fatalf("record = %+v, want run id %q", r, runID)
+		}
+	}
+}
+
+func TestValidate_KeepsRunIDFromContext(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	register(t, "alpha", 1, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, "alpha", "ok", a, "")
+	})
+
+	var buf bytes.Buffer
+	audit.Install(audit.NewWriter(&buf))
+
+	ctx := audit.WithRunID(context.Background(), "job-42")
+	runID, _, err := audit.Validate(ctx, "file.csv", []byte("data"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if runID != "job-42" || !strings.Contains(buf.String(), `"run_id":"job-42"`) {
+		t.Fatalf("runID=%q log=%s", runID, buf.String())
+	}
+}
+
+type failingWriter struct{ calls int }
+
+func (f *failingWriter) Write([]byte) (int, error) {
+	f.calls++
+	return 0, errors.New("disk full")
+}
+
+func TestWriter_KeepsFirstErrorAndStopsWriting(t *testing.T) {
+	t.Parallel()
+
+	fw := &failingWriter{}
+	w := audit.NewWriter(fw)
+
+	if err := w.Write(audit.Record{Check: "a"}); err == nil {
+		t.Fatalf("expected write error")
+	}
+	if err := w.Write(audit.Record{Check: "b"}); err == nil || fw.calls != 1 {
+		t.Fatalf("expected sticky error without another write, calls=%d err=%v", fw.calls, err)
+	}
+	if w.Err() == nil || w.Err().Error() != "disk full" {
+		t.Fatalf("Err() = %v", w.Err())
+	}
+}
+
+func register(
+	t *testing.T,
+	name string,
+	prio int,
+	run func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome,
+) {
+	t.Helper()
+
+	ch, err := checks.NewCheckAdapter(name, run, checks.WithPriority(prio))
+	if err != nil {
+		t.Fatalf("NewCheckAdapter(%s): %v", name, err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("Register(%s): %v", name, err)
+	}
+}
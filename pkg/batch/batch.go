@@ -0,0 +1,320 @@
+// Package batch validates and fixes every glossary file under a directory tree.
+//
+// Files are processed by validator.ValidateAll's bounded worker pool with the same
+// options, and fixed data is written back atomically.
+package batch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/internal/atomicfile"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// DefaultGlob selects the files FixDir processes when no glob is given.
+const DefaultGlob = "*.csv"
+
+// ErrTargetExists is the FileResult.Err of a file whose fix renamed it onto a path that
+// already exists or that another file of the batch was renamed to. The file is left as is.
+var ErrTargetExists = errors.New("fix target already exists")
+
+// Options tune FixDir.
+type Options struct {
+	// Run is used for every file. Use FixMode to enable fixes.
+	Run checks.RunOptions
+
+	// Langs are the declared languages used for every file ResolveLangs has no answer for.
+	Langs []string
+
+	// ResolveLangs, when set, picks the declared languages of each file (e.g. from its
+	// name or a sidecar config). It is called once per file, from a single goroutine,
+	// in path order; a nil result falls back to Langs.
+	ResolveLangs LangsResolver
+
+	// Workers is the number of files processed concurrently (0: GOMAXPROCS).
+	Workers int
+
+	// DryRun validates and fixes in memory but never touches the files.
+	DryRun bool
+}
+
+// LangsResolver returns the declared languages for the file at path, or nil for the default.
+type LangsResolver func(path string) []string
+
+// FileResult is the outcome for one matched file.
+type FileResult struct {
+	// Path is the file path under root, as found by the walk.
+	Path string
+
+	// Langs are the declared languages the file was validated with.
+	Langs []string
+
+	Summary validator.Summary
+
+	// Written is set when fixed data was stored on disk (at Summary.FinalPath).
+	Written bool
+
+	// Err is the run error of the file (a *validator.RunError)
+	// or the I/O error that prevented reading or writing it.
+	Err error
+}
+
+// Result aggregates the per-file outcomes of FixDir.
+type Result struct {
+	// Files holds one entry per matched file, sorted by Path. Files reached after ctx
+	// was cancelled report the cancellation in Err.
+	Files []FileResult
+
+	// Pass, Warn, Fail, Error, Info and Skipped sum the check counters of every file.
+	Pass    int
+	Warn    int
+	Fail    int
+	Error   int
+	Info    int
+	Skipped int
+
+	// Written counts files whose fixed data was stored on disk.
+	Written int
+
+	// Failed counts files with a non-nil Err.
+	Failed int
+}
+
+// FixDir walks root, runs validation (and fixes, as opts.Run.FixMode allows) on every
+// regular file whose name matches glob, and writes fixed data back atomically.
+//
+// glob is matched with filepath.Match against the file name, or against the slash-separated
+// path relative to root when it contains a "/". Empty means DefaultGlob.
+//
+// A file is rewritten only when its run finished without error and fixes changed
+// its data or path; when a fix renamed it, the old file is removed after the new one is in place.
+// A rename never replaces an existing file: the target is created exclusively, so when it
+// exists (or another file of the batch claimed it first) the file fails with ErrTargetExists
+// and keeps its original name and content.
+// Per-file problems are reported in FileResult.Err. The returned error is reserved for
+// a bad glob, a failed walk, run options that fail to resolve (a *validator.RunError)
+// or a cancelled ctx; the partial Result is returned with it.
+func FixDir(ctx context.Context, root, glob string, opts Options) (Result, error) {
+	if glob == "" {
+		glob = DefaultGlob
+	}
+	if _, err := filepath.Match(glob, ""); err != nil {
+		return Result{}, err
+	}
+
+	paths, err := collectFiles(ctx, root, glob)
+	if err != nil {
+		return Result{}, err
+	}
+
+	inputs := make([]validator.ArtifactInput, len(paths))
+	for i, path := range paths {
+		inputs[i] = validator.ArtifactInput{
+			Path:  path,
+			Langs: resolveLangs(path, opts),
+			Load:  func() ([]byte, error) { return os.ReadFile(path) },
+		}
+	}
+
+	written := make([]bool, len(paths))
+	sum, err := validator.ValidateAll(ctx, inputs, validator.BatchOptions{
+		Run:     opts.Run,
+		Workers: opts.Workers,
+		Done: func(i int, in validator.ArtifactInput, f *validator.FileSummary) {
+			written[i], f.Err = storeFile(in, *f, opts.DryRun)
+		},
+	})
+
+	return result(sum, inputs, written), err
+}
+
+func collectFiles(ctx context.Context, root, glob string) ([]string, error) {
+	matchRel := strings.Contains(glob, "/")
+
+	var paths []string
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		name := d.Name()
+		if matchRel {
+			rel, relErr := filepath.Rel(root, path)
+			if relErr != nil {
+				return relErr
+			}
+			name = filepath.ToSlash(rel)
+		}
+
+		ok, matchErr := filepath.Match(glob, name)
+		if matchErr != nil {
+			return matchErr
+		}
+		if ok {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// resolveLangs asks opts.ResolveLangs for the languages of path, falling back to opts.Langs.
+func resolveLangs(path string, opts Options) []string {
+	if opts.ResolveLangs != nil {
+		if langs := opts.ResolveLangs(path); langs != nil {
+			return langs
+		}
+	}
+
+	return opts.Langs
+}
+
+// storeFile writes the fixes of a file that was validated without error back to disk.
+// It reports whether it wrote, and the run or I/O error of the file.
+func storeFile(in validator.ArtifactInput, f validator.FileSummary, dryRun bool) (bool, error) {
+	if f.Err != nil || dryRun || !needsWrite(in.Path, in.Data, f.Summary) {
+		return false, f.Err
+	}
+	if err := writeResult(in.Path, f.Summary); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func needsWrite(path string, data []byte, sum validator.Summary) bool {
+	if !sum.AppliedFixes {
+		return false
+	}
+
+	return finalPath(path, sum) != path || !bytes.Equal(sum.FinalData, data)
+}
+
+func finalPath(path string, sum validator.Summary) string {
+	if sum.FinalPath == "" {
+		return path
+	}
+
+	return sum.FinalPath
+}
+
+// writeResult stores the fixed data at the final path and removes the original
+// when a fix renamed the file. A renamed file's target is reserved with O_EXCL first,
+// so it never overwrites another file; on any failure the original is kept.
+func writeResult(path string, sum validator.Summary) error {
+	target := finalPath(path, sum)
+
+	mode := fs.FileMode(0o644)
+	info, err := os.Stat(path)
+	if err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	if target == path || sameFile(info, target) {
+		if err := atomicfile.Write(path, sum.FinalData, mode); err != nil {
+			return err
+		}
+		if target != path {
+			// Same file under another spelling (case-insensitive file system).
+			return os.Rename(path, target)
+		}
+		return nil
+	}
+
+	if err := reserve(target, mode); err != nil {
+		return err
+	}
+	if err := atomicfile.Write(target, sum.FinalData, mode); err != nil {
+		_ = os.Remove(target)
+		return err
+	}
+
+	return os.Remove(path)
+}
+
+// reserve creates an empty file at target, failing with ErrTargetExists when one is there.
+func reserve(target string, mode fs.FileMode) error {
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("%w: %s", ErrTargetExists, target)
+	}
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// sameFile reports whether target names the file described by info.
+func sameFile(info fs.FileInfo, target string) bool {
+	if info == nil {
+		return false
+	}
+	other, err := os.Stat(target)
+
+	return err == nil && os.SameFile(info, other)
+}
+
+// result turns the batch summary into FixDir's Result.
+func result(sum validator.BatchSummary, inputs []validator.ArtifactInput, written []bool) Result {
+	res := Result{
+		Pass:    sum.Pass,
+		Warn:    sum.Warn,
+		Fail:    sum.Fail,
+		Error:   sum.Error,
+		Info:    sum.Info,
+		Skipped: sum.Skipped,
+		Failed:  sum.Failed,
+	}
+
+	for i, f := range sum.Files {
+		res.Files = append(res.Files, FileResult{
+			Path:    f.Path,
+			Langs:   inputs[i].Langs,
+			Summary: f.Summary,
+			Written: written[i],
+			Err:     f.Err,
+		})
+		if written[i] {
+			res.Written++
+		}
+	}
+
+	return res
+}
+
+// Errors returns the per-file errors, each prefixed with its path, joined with errors.Join.
+// Nil when every file was processed without error.
+func (r Result) Errors() error {
+	var errs []error
+	for _, f := range r.Files {
+		if f.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Path, f.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,348 @@
+package batch_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/batch"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// registerReplaceCheck registers a check that fails on "bad" and fixes it to "good".
+func registerReplaceCheck(t *testing.T) {
+	t.Helper()
+
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	ch, err := checks.NewCheckAdapter("replace-bad", func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+		return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+			Name: "replace-bad",
+			Validate: func(_ context.Context, a checks.Artifact) checks.ValidationResult {
+				if bytes.Contains(a.Data, []byte("bad")) {
+					return checks.ValidationResult{OK: false, Msg: "contains bad"}
+				}
+				return checks.ValidationResult{OK: true, Msg: "ok"}
+			},
+			Fix: func(_ context.Context, a checks.Artifact) (checks.FixResult, error) {
+				return checks.FixResult{
+					Data:      bytes.ReplaceAll(a.Data, []byte("bad"), []byte("good")),
+					DidChange: true,
+				}, nil
+			},
+			PassMsg:          "ok",
+			FixedMsg:         "fixed",
+			StatusAfterFixed: checks.Pass,
+		})
+	}, checks.WithPriority(1))
+	if err != nil {
+		t.Fatalf("new check: %v", err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+}
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	return string(b)
+}
+
+func TestFixDir_FixesMatchingFilesAndAggregates(t *testing.T) {
+	registerReplaceCheck(t)
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.csv"), "term\nbad\n")
+	writeFile(t, filepath.Join(root, "nested", "deep", "b.csv"), "term\nfine\n")
+	writeFile(t, filepath.Join(root, "nested", "c.csv"), "bad;bad\n")
+	writeFile(t, filepath.Join(root, "notes.txt"), "bad\n")
+
+	res, err := batch.FixDir(context.Background(), root, "", batch.Options{
+		Run:     checks.RunOptions{FixMode: checks.FixIfFailed, RerunAfterFix: true},
+		Workers: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if len(res.Files) != 3 {
+		t.Fatalf("expected 3 matched files, got %d: %+v", len(res.Files), res.Files)
+	}
+	wantOrder := []string{
+		filepath.Join(root, "a.csv"),
+		filepath.Join(root, "nested", "c.csv"),
+		filepath.Join(root, "nested", "deep", "b.csv"),
+	}
+	for i, want := range wantOrder {
+		if res.Files[i].Path != want {
+			t.Fatalf("file %d = %q, want %q", i, res.Files[i].Path, want)
+		}
+	}
+
+	if res.Written != 2 || res.Pass != 3 || res.Failed != 0 {
+		t.Fatalf("aggregate mismatch: %+v", res)
+	}
+	if got := readFile(t, filepath.Join(root, "a.csv")); got != "term\ngood\n" {
+		t.Fatalf("a.csv = %q", got)
+	}
+	if got := readFile(t, filepath.Join(root, "nested", "c.csv")); got != "good;good\n" {
+		t.Fatalf("c.csv = %q", got)
+	}
+	if got := readFile(t, filepath.Join(root, "notes.txt")); got != "bad\n" {
+		t.Fatalf("notes.txt must stay untouched, got %q", got)
+	}
+
+	info, err := os.Stat(filepath.Join(root, "a.csv"))
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("file mode not preserved: %v", info.Mode().Perm())
+	}
+
+	leftovers, _ := filepath.Glob(filepath.Join(root, ".*.tmp-*"))
+	if len(leftovers) != 0 {
+		t.Fatalf("temp files left behind: %v", leftovers)
+	}
+}
+
+func TestFixDir_RelativeGlobAndDryRun(t *testing.T) {
+	registerReplaceCheck(t)
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.csv"), "bad\n")
+	writeFile(t, filepath.Join(root, "glossaries", "b.csv"), "bad\n")
+
+	res, err := batch.FixDir(context.Background(), root, "glossaries/*.csv", batch.Options{
+		Run:    checks.RunOptions{FixMode: checks.FixIfFailed},
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if len(res.Files) != 1 || res.Files[0].Path != filepath.Join(root, "glossaries", "b.csv") {
+		t.Fatalf("unexpected files: %+v", res.Files)
+	}
+	if res.Written != 0 || res.Files[0].Written {
+		t.Fatalf("dry run must not write: %+v", res)
+	}
+	if !res.Files[0].Summary.AppliedFixes || string(res.Files[0].Summary.FinalData) != "good\n" {
+		t.Fatalf("expected in-memory fix, got %+v", res.Files[0].Summary)
+	}
+	if got := readFile(t, filepath.Join(root, "glossaries", "b.csv")); got != "bad\n" {
+		t.Fatalf("file changed during dry run: %q", got)
+	}
+}
+
+func TestFixDir_RunErrorIsPerFile(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	ch, err := checks.NewCheckAdapter("always-error", func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Error, "always-error", "boom", a, "")
+	}, checks.WithPriority(1))
+	if err != nil {
+		t.Fatalf("new check: %v", err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.csv"), "x\n")
+
+	res, err := batch.FixDir(context.Background(), root, "*.csv", batch.Options{
+		Run: checks.RunOptions{HardFailOnErr: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if res.Failed != 1 || res.Error != 1 {
+		t.Fatalf("aggregate mismatch: %+v", res)
+	}
+
+	var runErr *validator.RunError
+	if !errors.As(res.Files[0].Err, &runErr) {
+		t.Fatalf("expected *validator.RunError, got %T %v", res.Files[0].Err, res.Files[0].Err)
+	}
+	if joined := res.Errors(); joined == nil || !errors.As(joined, &runErr) {
+		t.Fatalf("Errors() must wrap per-file errors, got %v", joined)
+	}
+}
+
+func TestFixDir_BadGlobAndCancelledContext(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.csv"), "x\n")
+
+	if _, err := batch.FixDir(context.Background(), root, "[", batch.Options{}); !errors.Is(err, filepath.ErrBadPattern) {
+		t.Fatalf("expected ErrBadPattern, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := batch.FixDir(ctx, root, "*.csv", batch.Options{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestFixDir_ResolveLangsPerFile(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	seen := make(chan string, 4)
+	ch, err := checks.NewCheckAdapter("record-langs", func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		seen <- filepath.Base(a.Path) + "=" + strings.Join(a.Langs, ",")
+		return checks.OutcomeKeep(checks.Pass, "record-langs", "ok", a, "")
+	}, checks.WithPriority(1))
+	if err != nil {
+		t.Fatalf("new check: %v", err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "terms_de-fr.csv"), "x\n")
+	writeFile(t, filepath.Join(root, "other.csv"), "x\n")
+
+	var calls []string
+	res, err := batch.FixDir(context.Background(), root, "", batch.Options{
+		Langs:   []string{"en"},
+		Workers: 2,
+		ResolveLangs: func(path string) []string {
+			calls = append(calls, filepath.Base(path)) // called from one goroutine
+			name := strings.TrimSuffix(filepath.Base(path), ".csv")
+			if _, list, ok := strings.Cut(name, "_"); ok {
+				return strings.Split(list, "-")
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	close(seen)
+
+	if want := []string{"other.csv", "terms_de-fr.csv"}; !slices.Equal(calls, want) {
+		t.Fatalf("resolver calls = %v, want %v", calls, want)
+	}
+
+	got := map[string]bool{}
+	for s := range seen {
+		got[s] = true
+	}
+	if !got["other.csv=en"] || !got["terms_de-fr.csv=de,fr"] {
+		t.Fatalf("checks saw langs %v", got)
+	}
+
+	if len(res.Files) != 2 || !slices.Equal(res.Files[0].Langs, []string{"en"}) || !slices.Equal(res.Files[1].Langs, []string{"de", "fr"}) {
+		t.Fatalf("unexpected FileResult langs: %+v", res.Files)
+	}
+}
+
+func TestFixDir_RenameNeverOverwrites(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	ch, err := checks.NewCheckAdapter("rename", func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+		return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+			Name: "rename",
+			Validate: func(_ context.Context, a checks.Artifact) checks.ValidationResult {
+				if filepath.Base(a.Path) == "glossary.csv" {
+					return checks.ValidationResult{OK: true, Msg: "ok"}
+				}
+				return checks.ValidationResult{OK: false, Msg: "bad name"}
+			},
+			Fix: func(_ context.Context, a checks.Artifact) (checks.FixResult, error) {
+				return checks.FixResult{
+					Data:      a.Data,
+					Path:      filepath.Join(filepath.Dir(a.Path), "glossary.csv"),
+					DidChange: true,
+				}, nil
+			},
+			PassMsg:          "ok",
+			FixedMsg:         "renamed",
+			StatusAfterFixed: checks.Pass,
+		})
+	}, checks.WithPriority(1))
+	if err != nil {
+		t.Fatalf("new check: %v", err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.csv"), "term\na\n")
+	writeFile(t, filepath.Join(root, "b.csv"), "term\nb\n")
+	writeFile(t, filepath.Join(root, "taken", "c.csv"), "term\nc\n")
+	writeFile(t, filepath.Join(root, "taken", "glossary.csv"), "term\nexisting\n")
+
+	res, err := batch.FixDir(context.Background(), root, "", batch.Options{
+		Run:     checks.RunOptions{FixMode: checks.FixIfFailed},
+		Workers: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if res.Written != 1 || res.Failed != 2 {
+		t.Fatalf("want one rename and two collisions, got %+v", res)
+	}
+	if got := readFile(t, filepath.Join(root, "taken", "glossary.csv")); got != "term\nexisting\n" {
+		t.Fatalf("existing target overwritten: %q", got)
+	}
+	if got := readFile(t, filepath.Join(root, "taken", "c.csv")); got != "term\nc\n" {
+		t.Fatalf("source of a refused rename must stay, got %q", got)
+	}
+
+	var kept string
+	for _, f := range res.Files {
+		if f.Err == nil {
+			continue
+		}
+		if !errors.Is(f.Err, batch.ErrTargetExists) {
+			t.Fatalf("%s: err = %v, want ErrTargetExists", f.Path, f.Err)
+		}
+		if f.Path != filepath.Join(root, "taken", "c.csv") {
+			kept = f.Path
+		}
+	}
+	if kept == "" {
+		t.Fatal("one of a.csv and b.csv must collide with the other")
+	}
+	if _, err := os.Stat(kept); err != nil {
+		t.Fatalf("source of a refused rename must stay: %v", err)
+	}
+	if got := readFile(t, filepath.Join(root, "glossary.csv")); got != "term\na\n" && got != "term\nb\n" {
+		t.Fatalf("glossary.csv = %q", got)
+	}
+}
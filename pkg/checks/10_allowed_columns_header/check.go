@@ -5,29 +5,32 @@ import (
 	"strings"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/header"
 )
 
 const checkName = "ensure-allowed-columns-header"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureAllowedColumnsHeader,
-		checks.WithPriority(10),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureAllowedColumnsHeader,
+			checks.WithPriority(checks.PrioStructural+70),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
 }
 
 func runEnsureAllowedColumnsHeader(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	preserveCase := opts.PreserveHeaderCase
+
 	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:             checkName,
-		Validate:         validateAllowedColumnsHeader,
-		Fix:              fixAllowedColumnsHeader,
+		Name:     checkName,
+		Validate: validateAllowedColumnsHeader,
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixAllowedColumnsHeader(ctx, a, preserveCase)
+		},
 		FailAs:           checks.Warn,
 		PassMsg:          "header columns are allowed",
 		FixedMsg:         "header columns normalized (unknown columns removed, missing language columns added)",
@@ -50,57 +53,25 @@ func validateAllowedColumnsHeader(ctx context.Context, a checks.Artifact) checks
 		}
 	}
 
-	header, res, ok := readAllowedColumnsHeader(ctx, data)
-	if !ok {
-		return res
-	}
-
-	report, err := inspectAllowedColumns(ctx, header, a.Langs)
+	ix, err := checks.LocaleIndexOf(ctx, a)
 	if err != nil {
-		return cancelledValidation(err)
-	}
-
-	return allowedColumnsValidationResult(report)
-}
-
-func readAllowedColumnsHeader(
-	ctx context.Context,
-	data []byte,
-) ([]string, checks.ValidationResult, bool) {
-	r := checks.NewSemicolonCSVReader(data)
-
-	for {
-		if err := ctx.Err(); err != nil {
-			return nil, cancelledValidation(err), false
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
 		}
 
-		rec, err := r.Read()
-		if err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil {
-				return nil, cancelledValidation(ctxErr), false
-			}
-
-			return nil, checks.ValidationResult{
-				OK:  false,
-				Msg: "cannot parse header with semicolon delimiter",
-				Err: err,
-			}, false
-		}
-
-		if !isBlankCSVRecord(rec) {
-			return rec, checks.ValidationResult{}, true
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse header with semicolon delimiter",
+			Err: err,
 		}
 	}
-}
 
-func isBlankCSVRecord(record []string) bool {
-	for _, col := range record {
-		if !checks.IsBlankUnicode([]byte(col)) {
-			return false
-		}
+	report, err := inspectAllowedColumns(ctx, ix, a.Langs)
+	if err != nil {
+		return cancelledValidation(err)
 	}
 
-	return true
+	return allowedColumnsValidationResult(report, a.Langs)
 }
 
 type allowedColumnsReport struct {
@@ -149,7 +120,7 @@ type languagePresence struct {
 
 func inspectAllowedColumns(
 	ctx context.Context,
-	cols []string,
+	ix *checks.LocaleIndex,
 	langs []string,
 ) (allowedColumnsReport, error) {
 	allowed := newAllowedLanguages(langs)
@@ -160,7 +131,7 @@ func inspectAllowedColumns(
 
 	seen := make(map[string]languagePresence, len(allowed.keys))
 
-	for _, col := range cols {
+	for _, col := range ix.Columns {
 		if err := ctx.Err(); err != nil {
 			return allowedColumnsReport{}, err
 		}
@@ -175,51 +146,40 @@ func inspectAllowedColumns(
 	return report, nil
 }
 
+// inspectAllowedColumn classifies one non-service column from the shared locale index.
 func inspectAllowedColumn(
 	report *allowedColumnsReport,
 	seen map[string]languagePresence,
 	allowed allowedLanguages,
-	col string,
+	col checks.LocaleColumn,
 ) {
-	colTrim := strings.TrimSpace(col)
-	if colTrim == "" {
-		return
-	}
-
-	colLower := strings.ToLower(colTrim)
-	if _, ok := checks.KnownHeaders[colLower]; ok {
-		return
-	}
-
-	langCol, isLangLike := parseLangColumn(colTrim)
-
 	if allowed.hasAny() {
-		if isLangLike {
-			if _, ok := allowed.set[langCol.key]; ok {
-				p := seen[langCol.key]
-				if langCol.description {
+		if col.LangLike {
+			if _, ok := allowed.set[col.Key]; ok {
+				p := seen[col.Key]
+				if col.Description {
 					p.description = true
 				} else {
 					p.value = true
 				}
-				seen[langCol.key] = p
+				seen[col.Key] = p
 				return
 			}
 
-			report.unexpectedLangs = appendLangIfMissing(report.unexpectedLangs, langCol.base)
+			report.unexpectedLangs = appendLangIfMissing(report.unexpectedLangs, col.Base)
 			return
 		}
 
-		report.unknownCols = appendStringIfMissingFold(report.unknownCols, colTrim)
+		report.unknownCols = appendStringIfMissingFold(report.unknownCols, col.Label)
 		return
 	}
 
-	if isLangLike {
-		report.detectedLangsNoConfig = appendLangIfMissing(report.detectedLangsNoConfig, langCol.base)
+	if col.LangLike {
+		report.detectedLangsNoConfig = appendLangIfMissing(report.detectedLangsNoConfig, col.Base)
 		return
 	}
 
-	report.unknownCols = appendStringIfMissingFold(report.unknownCols, colTrim)
+	report.unknownCols = appendStringIfMissingFold(report.unknownCols, col.Label)
 }
 
 type parsedLangColumn struct {
@@ -290,11 +250,14 @@ func missingDeclaredLanguageColumns(
 	return missing
 }
 
-func allowedColumnsValidationResult(report allowedColumnsReport) checks.ValidationResult {
+func allowedColumnsValidationResult(report allowedColumnsReport, langs []string) checks.ValidationResult {
 	if len(report.unknownCols) > 0 {
+		findings := unknownColumnFindings(report.unknownCols, langs)
+
 		return checks.ValidationResult{
-			OK:  false,
-			Msg: "header has unknown columns: " + strings.Join(report.unknownCols, ", "),
+			OK:       false,
+			Msg:      "header has unknown columns: " + describeUnknownColumns(findings),
+			Findings: findings,
 		}
 	}
 
@@ -334,6 +297,41 @@ func allowedColumnsValidationResult(report allowedColumnsReport) checks.Validati
 	}
 }
 
+// unknownColumnFindings locates each unknown column in the header row and attaches
+// the column it most likely meant (see header.Suggest), so a UI can offer a rename
+// instead of letting the fix drop the column.
+func unknownColumnFindings(cols []string, langs []string) []checks.Finding {
+	out := make([]checks.Finding, 0, len(cols))
+	for _, col := range cols {
+		f := checks.Finding{
+			Row:     1,
+			Column:  col,
+			Value:   col,
+			Message: "unknown column",
+		}
+		if s, ok := header.Suggest(col, langs); ok {
+			f.Suggestion = s.To
+			f.Message = "unknown column (" + s.Reason + " to " + s.To + ")"
+		}
+		out = append(out, f)
+	}
+
+	return out
+}
+
+func describeUnknownColumns(findings []checks.Finding) string {
+	parts := make([]string, 0, len(findings))
+	for _, f := range findings {
+		if f.Suggestion == "" {
+			parts = append(parts, f.Value)
+			continue
+		}
+		parts = append(parts, f.Value+" (did you mean "+f.Suggestion+"?)")
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 func cancelledValidation(err error) checks.ValidationResult {
 	return checks.ValidationResult{
 		OK:  false,
@@ -202,3 +202,36 @@ func TestValidateAllowedColumnsHeader_ContextCancelled(t *testing.T) {
 		t.Fatalf("expected cancellation message, got %q", res.Msg)
 	}
 }
+
+func TestValidateAllowedColumnsHeader_SuggestsRenames(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data:  []byte("term;dsecription;English;German description;wtff;en;en_description;de;de_description\n"),
+		Langs: []string{"en", "de"},
+	}
+
+	res := validateAllowedColumnsHeader(context.Background(), a)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	want := map[string]string{
+		"dsecription":        "description",
+		"English":            "en",
+		"German description": "de_description",
+		"wtff":               "",
+	}
+	if len(res.Findings) != len(want) {
+		t.Fatalf("expected %d findings, got %+v", len(want), res.Findings)
+	}
+	for _, f := range res.Findings {
+		if s, ok := want[f.Column]; !ok || f.Suggestion != s || f.Row != 1 {
+			t.Fatalf("unexpected finding: %+v", f)
+		}
+	}
+
+	if !strings.Contains(res.Msg, "dsecription (did you mean description?)") || !strings.Contains(res.Msg, ", wtff") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+}
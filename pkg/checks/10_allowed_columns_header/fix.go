@@ -11,7 +11,10 @@ import (
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
 )
 
-func fixAllowedColumnsHeader(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+// fixAllowedColumnsHeader drops unknown columns and appends missing declared languages.
+// Kept locale labels are normalized to lowercase keys unless preserveCase is set,
+// in which case they (and added languages) keep the spelling from the header or config.
+func fixAllowedColumnsHeader(ctx context.Context, a checks.Artifact, preserveCase bool) (checks.FixResult, error) {
 	if err := ctx.Err(); err != nil {
 		return checks.FixResult{}, err
 	}
@@ -24,7 +27,7 @@ func fixAllowedColumnsHeader(ctx context.Context, a checks.Artifact) (checks.Fix
 		return early.result, early.err
 	}
 
-	plan := buildAllowedColumnsPlan(source.header(), a.Langs)
+	plan := buildAllowedColumnsPlan(source.header(), a.Langs, preserveCase)
 	if plan.isNoOp(source.header()) {
 		return checks.FixResult{
 			Data:      a.Data,
@@ -218,7 +221,7 @@ type allowedColumn struct {
 	idx   int
 }
 
-func buildAllowedColumnsPlan(header []string, langs []string) allowedColumnsPlan {
+func buildAllowedColumnsPlan(header []string, langs []string, preserveCase bool) allowedColumnsPlan {
 	declared := newDeclaredLanguages(langs)
 
 	plan := allowedColumnsPlan{
@@ -228,7 +231,7 @@ func buildAllowedColumnsPlan(header []string, langs []string) allowedColumnsPlan
 	seenLang := make(map[string]langPresence, len(declared.order))
 
 	for idx, name := range header {
-		col, ok := allowedColumnFromHeader(name, idx, declared, seenLang)
+		col, ok := allowedColumnFromHeader(name, idx, declared, seenLang, preserveCase)
 		if !ok {
 			continue
 		}
@@ -237,7 +240,7 @@ func buildAllowedColumnsPlan(header []string, langs []string) allowedColumnsPlan
 	}
 
 	if declared.hasAny() {
-		plan.addMissingDeclaredLanguages(declared, seenLang)
+		plan.addMissingDeclaredLanguages(declared, seenLang, preserveCase)
 	}
 
 	return plan
@@ -262,13 +265,15 @@ func (p allowedColumnsPlan) isNoOp(header []string) bool {
 }
 
 type declaredLanguages struct {
-	order []string
-	set   map[string]struct{}
+	order   []string
+	set     map[string]struct{}
+	spelled map[string]string // key -> label as first written in the config
 }
 
 func newDeclaredLanguages(langs []string) declaredLanguages {
 	out := declaredLanguages{
-		set: make(map[string]struct{}, len(langs)),
+		set:     make(map[string]struct{}, len(langs)),
+		spelled: make(map[string]string, len(langs)),
 	}
 
 	for _, lang := range langs {
@@ -282,6 +287,7 @@ func newDeclaredLanguages(langs []string) declaredLanguages {
 		}
 
 		out.set[key] = struct{}{}
+		out.spelled[key] = strings.TrimSpace(lang)
 		out.order = append(out.order, key)
 	}
 
@@ -307,6 +313,7 @@ func allowedColumnFromHeader(
 	idx int,
 	declared declaredLanguages,
 	seen map[string]langPresence,
+	preserveCase bool,
 ) (allowedColumn, bool) {
 	normalized := normalizeHeaderName(name)
 
@@ -331,8 +338,13 @@ func allowedColumnFromHeader(
 	}
 	seen[langCol.key] = seenEntry
 
+	label := normalizedLangColumnLabel(langCol)
+	if preserveCase {
+		label = strings.TrimSpace(name)
+	}
+
 	return allowedColumn{
-		label: normalizedLangColumnLabel(langCol),
+		label: label,
 		idx:   idx,
 	}, true
 }
@@ -348,9 +360,15 @@ func normalizedLangColumnLabel(col parsedLangColumn) string {
 func (p *allowedColumnsPlan) addMissingDeclaredLanguages(
 	declared declaredLanguages,
 	seen map[string]langPresence,
+	preserveCase bool,
 ) {
-	for _, lang := range declared.order {
-		presence := seen[lang]
+	for _, key := range declared.order {
+		presence := seen[key]
+
+		lang := key
+		if preserveCase {
+			lang = declared.spelled[key]
+		}
 
 		if !presence.base {
 			p.keep = append(p.keep, allowedColumn{
@@ -139,7 +139,7 @@ func Test_fixAllowedColumnsHeader(t *testing.T) {
 				Langs: c.langs,
 			}
 
-			res, err := fixAllowedColumnsHeader(context.Background(), art)
+			res, err := fixAllowedColumnsHeader(context.Background(), art, false)
 			if err != nil {
 				t.Fatalf("unexpected err: %v", err)
 			}
@@ -215,3 +215,33 @@ func TestRunEnsureAllowedColumnsHeader_EndToEnd_FixesAndPasses(t *testing.T) {
 		t.Fatalf("wrong row after fix.\n got:  %q\n want: %q", gotRow, wantRow)
 	}
 }
+
+func Test_fixAllowedColumnsHeader_PreserveCase(t *testing.T) {
+	t.Parallel()
+
+	art := checks.Artifact{
+		Data:  []byte("term;description;pt-BR;pt-BR_Description;junk\nhi;desc;oi;;x\n"),
+		Langs: []string{"pt-BR", "zh-Hans"},
+	}
+
+	res, err := fixAllowedColumnsHeader(context.Background(), art, true)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !res.DidChange {
+		t.Fatalf("expected change")
+	}
+
+	want := "term;description;pt-BR;pt-BR_Description;zh-Hans;zh-Hans_description\nhi;desc;oi;;;\n"
+	if string(res.Data) != want {
+		t.Fatalf("data mismatch:\n got:  %q\n want: %q", res.Data, want)
+	}
+
+	res, err = fixAllowedColumnsHeader(context.Background(), art, false)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !strings.HasPrefix(string(res.Data), "term;description;pt_br;pt_br_description;zh_hans;zh_hans_description\n") {
+		t.Fatalf("default mode should normalize labels, got %q", res.Data)
+	}
+}
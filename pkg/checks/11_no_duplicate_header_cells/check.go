@@ -11,17 +11,15 @@ import (
 const checkName = "warn-duplicate-header-cells"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runWarnDuplicateHeaderCells,
-		checks.WithPriority(11),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnDuplicateHeaderCells,
+			checks.WithPriority(checks.PrioStructural+75),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
 }
 
 func runWarnDuplicateHeaderCells(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
@@ -51,7 +49,7 @@ func validateDuplicateHeaderCells(ctx context.Context, a checks.Artifact) checks
 		}
 	}
 
-	header, res, ok := readDuplicateHeader(ctx, data)
+	header, res, ok := readDuplicateHeader(ctx, a)
 	if !ok {
 		return res
 	}
@@ -76,9 +74,9 @@ func validateDuplicateHeaderCells(ctx context.Context, a checks.Artifact) checks
 
 func readDuplicateHeader(
 	ctx context.Context,
-	data []byte,
+	a checks.Artifact,
 ) ([]string, checks.ValidationResult, bool) {
-	r := checks.NewSemicolonCSVReader(data)
+	r := checks.TableReaderOf(ctx, a)
 
 	for {
 		if err := ctx.Err(); err != nil {
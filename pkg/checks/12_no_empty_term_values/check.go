@@ -18,30 +18,32 @@ const (
 )
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runNoEmptyTermValues,
-		checks.WithFailFast(),
-		checks.WithPriority(12),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runNoEmptyTermValues,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioContent),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
 }
 
 func runNoEmptyTermValues(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
 	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:     checkName,
-		Validate: validateNoEmptyTermValues,
-		Fix:      nil,
-		PassMsg:  "all rows have non-empty term",
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateNoEmptyTermValues(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "all rows have non-empty term",
 	})
 }
 
-func validateNoEmptyTermValues(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+func validateNoEmptyTermValues(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
 	if err := ctx.Err(); err != nil {
 		return cancelledValidation(err)
 	}
@@ -54,7 +56,7 @@ func validateNoEmptyTermValues(ctx context.Context, a checks.Artifact) checks.Va
 		}
 	}
 
-	r := checks.NewSemicolonCSVReader(data)
+	r := checks.TableReaderOf(ctx, a)
 
 	header, rowNum, res, ok := readTermHeader(ctx, r)
 	if !ok {
@@ -69,7 +71,7 @@ func validateNoEmptyTermValues(ctx context.Context, a checks.Artifact) checks.Va
 		}
 	}
 
-	badRows, err := findRowsWithEmptyTerm(ctx, r, rowNum, termCol)
+	badRows, err := findRowsWithEmptyTerm(ctx, r, rowNum, termCol, limit, stopAfter)
 	if err != nil {
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return cancelledValidation(ctxErr)
@@ -82,7 +84,7 @@ func validateNoEmptyTermValues(ctx context.Context, a checks.Artifact) checks.Va
 		}
 	}
 
-	if len(badRows) == 0 {
+	if badRows.Total() == 0 {
 		return checks.ValidationResult{
 			OK:  true,
 			Msg: "all rows have non-empty term",
@@ -90,8 +92,10 @@ func validateNoEmptyTermValues(ctx context.Context, a checks.Artifact) checks.Va
 	}
 
 	return checks.ValidationResult{
-		OK:  false,
-		Msg: emptyTermRowsMessage(badRows),
+		OK:        false,
+		Msg:       emptyTermRowsMessage(badRows),
+		Findings:  emptyTermFindings(badRows, termCol),
+		Truncated: badRows.Exhausted(),
 	}
 }
 
@@ -163,13 +167,15 @@ func findRowsWithEmptyTerm(
 	r csvReader,
 	rowNum int,
 	termCol int,
-) ([]int, error) {
-	var badRows []int
+	limit int,
+	stopAfter int,
+) (checks.Capped[int], error) {
+	badRows := checks.Capped[int]{Limit: limit, StopAfter: stopAfter}
 
 	for {
 		if rowNum%ctxCheckEveryRows == 0 {
 			if err := ctx.Err(); err != nil {
-				return nil, err
+				return badRows, err
 			}
 		}
 
@@ -180,10 +186,10 @@ func findRowsWithEmptyTerm(
 			}
 
 			if ctxErr := ctx.Err(); ctxErr != nil {
-				return nil, ctxErr
+				return badRows, ctxErr
 			}
 
-			return nil, err
+			return badRows, err
 		}
 
 		rowNum++
@@ -193,7 +199,10 @@ func findRowsWithEmptyTerm(
 		}
 
 		if hasEmptyTermValue(rec, termCol) {
-			badRows = append(badRows, rowNum)
+			badRows.Add(rowNum)
+			if badRows.Exhausted() {
+				return badRows, nil
+			}
 		}
 	}
 }
@@ -214,8 +223,8 @@ func cancelledValidation(err error) checks.ValidationResult {
 	}
 }
 
-func emptyTermRowsMessage(rows []int) string {
-	display := rows
+func emptyTermRowsMessage(rows checks.Capped[int]) string {
+	display := rows.Items
 	truncated := false
 
 	if len(display) > maxReportedRows {
@@ -235,15 +244,29 @@ func emptyTermRowsMessage(rows []int) string {
 	}
 
 	if truncated {
-		b.WriteString(" ... (total ")
-		b.WriteString(strconv.Itoa(len(rows)))
-		b.WriteString(")")
-		return b.String()
+		b.WriteString(" ...")
 	}
 
 	b.WriteString(" (total ")
-	b.WriteString(strconv.Itoa(len(rows)))
+	b.WriteString(strconv.Itoa(rows.Total()))
 	b.WriteString(")")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
 
 	return b.String()
 }
+
+func emptyTermFindings(rows checks.Capped[int], termCol int) []checks.Finding {
+	out := make([]checks.Finding, 0, len(rows.Items))
+	for _, row := range rows.Items {
+		out = append(out, checks.Finding{
+			Code:    "empty-term",
+			Row:     row,
+			Field:   termCol + 1,
+			Column:  "term",
+			Message: "empty term",
+		})
+	}
+
+	return out
+}
@@ -2,6 +2,7 @@ package no_empty_term_values
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -22,7 +23,7 @@ func TestValidateNoEmptyTermValues_AllGood(t *testing.T) {
 		Path: "ok.csv",
 	}
 
-	res := validateNoEmptyTermValues(ctx, a)
+	res := validateNoEmptyTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -57,7 +58,7 @@ func TestValidateNoEmptyTermValues_EmptyTerms_Fail(t *testing.T) {
 		Path: "empties.csv",
 	}
 
-	res := validateNoEmptyTermValues(ctx, a)
+	res := validateNoEmptyTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because there are empty term cells")
@@ -97,7 +98,7 @@ func TestValidateNoEmptyTermValues_TooMany_EarlyCut(t *testing.T) {
 		Path: "many.csv",
 	}
 
-	res := validateNoEmptyTermValues(ctx, a)
+	res := validateNoEmptyTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because all term values are empty")
@@ -127,7 +128,7 @@ func TestValidateNoEmptyTermValues_BlankContent_Pass(t *testing.T) {
 		Path: "blank.csv",
 	}
 
-	res := validateNoEmptyTermValues(ctx, a)
+	res := validateNoEmptyTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true with no header, got false (%q)", res.Msg)
@@ -189,7 +190,7 @@ func TestValidateNoEmptyTermValues_BOMBeforeTermHeader(t *testing.T) {
 	res := validateNoEmptyTermValues(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "bom.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because row 3 has empty term")
@@ -210,7 +211,7 @@ func TestValidateNoEmptyTermValues_NoTermColumn_Pass(t *testing.T) {
 	res := validateNoEmptyTermValues(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "no_term.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true when term column is absent, got Msg=%q Err=%v", res.Msg, res.Err)
@@ -234,7 +235,7 @@ func TestValidateNoEmptyTermValues_ShortRowMissingTermCell_Fail(t *testing.T) {
 	res := validateNoEmptyTermValues(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "short.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because row 3 has no term cell")
@@ -269,3 +270,72 @@ func countRowNumbersInMsg(msg string) int {
 	parts := strings.Split(listPart, ",")
 	return len(parts)
 }
+
+func TestValidateNoEmptyTermValues_CapsStoredRows(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\n"
+	for range 15 {
+		csv += ";desc\n"
+	}
+
+	res := validateNoEmptyTermValues(context.Background(), checks.Artifact{Data: []byte(csv)}, 12, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, "(total 15) and 3 more (not stored)") {
+		t.Fatalf("expected total and overflow in message, got %q", res.Msg)
+	}
+}
+
+func TestValidateNoEmptyTermValues_StopAfter_Truncated(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\n" + strings.Repeat(";desc\n", 100)
+
+	res := validateNoEmptyTermValues(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 5)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !res.Truncated || len(res.Findings) != 5 {
+		t.Fatalf("expected 5 findings and a truncated result, got %d findings (truncated=%v)", len(res.Findings), res.Truncated)
+	}
+	if !strings.Contains(res.Msg, "(total 5)") || !strings.Contains(res.Msg, "stopped after 5 findings") {
+		t.Fatalf("unexpected message %q", res.Msg)
+	}
+}
+
+func TestValidateNoEmptyTermValues_FindingPositions(t *testing.T) {
+	t.Parallel()
+
+	csv := "description;term\nd;x\nd; \n"
+	res := validateNoEmptyTermValues(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+
+	want := []checks.Finding{{Code: "empty-term", Row: 3, Field: 2, Column: "term", Message: "empty term"}}
+	if !reflect.DeepEqual(res.Findings, want) {
+		t.Fatalf("Findings = %+v, want %+v", res.Findings, want)
+	}
+}
+
+func TestRunNoEmptyTermValues_RowsCountCommentLines(t *testing.T) {
+	t.Parallel()
+
+	unit, err := checks.NewCheckAdapter(checkName, runNoEmptyTermValues)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	csv := "# exported\n# by tool\nterm;description\nhello;ok\n;bad\n"
+	out := unit.Run(context.Background(), checks.Artifact{Data: []byte(csv), Path: "f.csv"},
+		checks.RunOptions{CommentPrefix: "#"})
+
+	if out.Result.Status != checks.Fail {
+		t.Fatalf("expected FAIL, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !strings.Contains(out.Result.Message, "empty term in rows: 5") {
+		t.Fatalf("message must point at line 5 of the file, got %q", out.Result.Message)
+	}
+	if len(out.Result.Findings) != 1 || out.Result.Findings[0].Row != 5 {
+		t.Fatalf("Findings = %+v, want row 5", out.Result.Findings)
+	}
+}
@@ -16,25 +16,49 @@ const (
 	checkName         = "warn-duplicate-term-values"
 )
 
+// settingKeep selects which row of a duplicated term the fixer keeps (default keepFirst).
+const settingKeep = "keep"
+
+const (
+	keepFirst              = "first"               // the earliest row
+	keepLast               = "last"                // the latest row, usually the most recently edited
+	keepLongestDescription = "longest-description" // the row with the longest description (ties: earliest)
+)
+
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runWarnDuplicateTermValues,
-		checks.WithPriority(13),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnDuplicateTermValues,
+			checks.WithPriority(checks.PrioContent+5),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
 }
 
 func runWarnDuplicateTermValues(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	keep, ok := opts.Setting(checkName, settingKeep)
+	if !ok {
+		keep = keepFirst
+	}
+	keep = strings.ToLower(strings.TrimSpace(keep))
+
 	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:             checkName,
-		Validate:         validateWarnDuplicateTermValues,
-		Fix:              fixDuplicateTermValues,
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			if !validKeep(keep) {
+				return invalidKeepValidation(keep)
+			}
+
+			return validateWarnDuplicateTermValues(ctx, a, limit, stopAfter)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixDuplicateTermValues(ctx, a, keep)
+		},
 		PassMsg:          "no duplicate term values",
 		FixedMsg:         "removed duplicate term rows",
 		AppliedMsg:       "auto-fix applied: removed duplicate term rows",
@@ -47,7 +71,8 @@ func runWarnDuplicateTermValues(ctx context.Context, a checks.Artifact, opts che
 // validateWarnDuplicateTermValues scans the "term" column and warns if the same non-empty value appears in multiple rows.
 // Case-sensitive: "Apple" and "apple" are considered different terms.
 // We report up to 10 offending term groups in the message, each annotated with row numbers (1-based).
-func validateWarnDuplicateTermValues(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+// At most limit groups, and limit rows per group, are kept in memory.
+func validateWarnDuplicateTermValues(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
 	if err := ctx.Err(); err != nil {
 		return cancelledValidation(err)
 	}
@@ -60,7 +85,7 @@ func validateWarnDuplicateTermValues(ctx context.Context, a checks.Artifact) che
 		}
 	}
 
-	r := checks.NewSemicolonCSVReader(data)
+	r := checks.TableReaderOf(ctx, a)
 
 	header, rowNum, res, ok := readTermHeader(ctx, r)
 	if !ok {
@@ -75,7 +100,7 @@ func validateWarnDuplicateTermValues(ctx context.Context, a checks.Artifact) che
 		}
 	}
 
-	dups, err := findDuplicateTerms(ctx, r, rowNum, termCol)
+	dups, err := findDuplicateTerms(ctx, r, rowNum, termCol, limit, stopAfter)
 	if err != nil {
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return cancelledValidation(ctxErr)
@@ -88,7 +113,7 @@ func validateWarnDuplicateTermValues(ctx context.Context, a checks.Artifact) che
 		}
 	}
 
-	if len(dups) == 0 {
+	if dups.Total() == 0 {
 		return checks.ValidationResult{
 			OK:  true,
 			Msg: "no duplicate term values",
@@ -96,8 +121,10 @@ func validateWarnDuplicateTermValues(ctx context.Context, a checks.Artifact) che
 	}
 
 	return checks.ValidationResult{
-		OK:  false,
-		Msg: duplicateTermsMessage(dups),
+		OK:        false,
+		Msg:       duplicateTermsMessage(dups),
+		Findings:  duplicateTermFindings(dups, termCol),
+		Truncated: dups.Exhausted(),
 	}
 }
 
@@ -166,11 +193,11 @@ func normalizeHeaderCell(s string) string {
 
 type duplicateTermInfo struct {
 	term string
-	rows []int
+	rows checks.Capped[int]
 }
 
 type termRows struct {
-	rows     []int
+	rows     checks.Capped[int]
 	reported bool
 }
 
@@ -179,14 +206,16 @@ func findDuplicateTerms(
 	r csvReader,
 	rowNum int,
 	termCol int,
-) ([]duplicateTermInfo, error) {
+	limit int,
+	stopAfter int,
+) (checks.Capped[duplicateTermInfo], error) {
 	seen := make(map[string]*termRows)
-	var duplicateOrder []string
+	duplicateOrder := checks.Capped[string]{Limit: limit, StopAfter: stopAfter}
 
 	for {
 		if rowNum%ctxCheckEveryRows == 0 {
 			if err := ctx.Err(); err != nil {
-				return nil, err
+				return checks.Capped[duplicateTermInfo]{}, err
 			}
 		}
 
@@ -197,10 +226,10 @@ func findDuplicateTerms(
 			}
 
 			if ctxErr := ctx.Err(); ctxErr != nil {
-				return nil, ctxErr
+				return checks.Capped[duplicateTermInfo]{}, ctxErr
 			}
 
-			return nil, err
+			return checks.Capped[duplicateTermInfo]{}, err
 		}
 
 		rowNum++
@@ -216,22 +245,30 @@ func findDuplicateTerms(
 
 		entry := seen[term]
 		if entry == nil {
-			seen[term] = &termRows{
-				rows: []int{rowNum},
-			}
+			entry = &termRows{rows: checks.NewCapped[int](limit)}
+			entry.rows.Add(rowNum)
+			seen[term] = entry
 			continue
 		}
 
-		entry.rows = append(entry.rows, rowNum)
+		entry.rows.Add(rowNum)
 		if !entry.reported {
-			duplicateOrder = append(duplicateOrder, term)
+			duplicateOrder.Add(term)
+			if duplicateOrder.Exhausted() {
+				break
+			}
 			entry.reported = true
 		}
 	}
 
-	dups := make([]duplicateTermInfo, 0, len(duplicateOrder))
-	for _, term := range duplicateOrder {
-		dups = append(dups, duplicateTermInfo{
+	dups := checks.Capped[duplicateTermInfo]{
+		Items:     make([]duplicateTermInfo, 0, len(duplicateOrder.Items)),
+		Limit:     limit,
+		Overflow:  duplicateOrder.Overflow,
+		StopAfter: duplicateOrder.StopAfter,
+	}
+	for _, term := range duplicateOrder.Items {
+		dups.Items = append(dups.Items, duplicateTermInfo{
 			term: term,
 			rows: seen[term].rows,
 		})
@@ -253,8 +290,8 @@ func termValue(record []string, termCol int) (string, bool) {
 	return term, true
 }
 
-func duplicateTermsMessage(dups []duplicateTermInfo) string {
-	limit := len(dups)
+func duplicateTermsMessage(dups checks.Capped[duplicateTermInfo]) string {
+	limit := len(dups.Items)
 	if limit > maxReportedTerms {
 		limit = maxReportedTerms
 	}
@@ -263,11 +300,12 @@ func duplicateTermsMessage(dups []duplicateTermInfo) string {
 	b.WriteString("duplicate term values found: ")
 
 	for i := 0; i < limit; i++ {
-		dup := dups[i]
+		dup := dups.Items[i]
 
 		b.WriteString(strconv.Quote(dup.term))
 		b.WriteString(" (rows ")
-		b.WriteString(joinIntSlice(dup.rows, ", "))
+		b.WriteString(joinIntSlice(dup.rows.Items, ", "))
+		b.WriteString(checks.OverflowNote(dup.rows.Overflow))
 		b.WriteString(")")
 
 		if i != limit-1 {
@@ -275,20 +313,37 @@ func duplicateTermsMessage(dups []duplicateTermInfo) string {
 		}
 	}
 
-	if len(dups) > limit {
-		b.WriteString(" ... (total ")
-		b.WriteString(strconv.Itoa(len(dups)))
-		b.WriteString(" duplicate terms)")
-		return b.String()
+	if dups.Total() > limit {
+		b.WriteString(" ...")
 	}
 
 	b.WriteString(" (total ")
-	b.WriteString(strconv.Itoa(len(dups)))
+	b.WriteString(strconv.Itoa(dups.Total()))
 	b.WriteString(" duplicate terms)")
+	b.WriteString(checks.OverflowNote(dups.Overflow))
+	b.WriteString(dups.TruncatedNote())
 
 	return b.String()
 }
 
+func duplicateTermFindings(dups checks.Capped[duplicateTermInfo], termCol int) []checks.Finding {
+	var out []checks.Finding
+	for _, dup := range dups.Items {
+		for _, row := range dup.rows.Items {
+			out = append(out, checks.Finding{
+				Code:    "duplicate-term",
+				Row:     row,
+				Field:   termCol + 1,
+				Column:  "term",
+				Value:   dup.term,
+				Message: "duplicate term value",
+			})
+		}
+	}
+
+	return out
+}
+
 func joinIntSlice(nums []int, sep string) string {
 	if len(nums) == 0 {
 		return ""
@@ -312,3 +367,21 @@ func cancelledValidation(err error) checks.ValidationResult {
 		Err: err,
 	}
 }
+
+func validKeep(keep string) bool {
+	switch keep {
+	case keepFirst, keepLast, keepLongestDescription:
+		return true
+	default:
+		return false
+	}
+}
+
+func invalidKeepValidation(keep string) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK: false,
+		Msg: "invalid " + settingKeep + " " + strconv.Quote(keep) +
+			" (expected " + keepFirst + ", " + keepLast + " or " + keepLongestDescription + ")",
+		Err: errors.New("invalid duplicate term keep strategy"),
+	}
+}
@@ -25,7 +25,7 @@ func TestValidateWarnDuplicateTermValues_NoDuplicates_PASS(t *testing.T) {
 		Path: "nodup.csv",
 	}
 
-	res := validateWarnDuplicateTermValues(ctx, a)
+	res := validateWarnDuplicateTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -58,7 +58,7 @@ func TestValidateWarnDuplicateTermValues_WithDuplicates_Warn(t *testing.T) {
 		Path: "dups.csv",
 	}
 
-	res := validateWarnDuplicateTermValues(ctx, a)
+	res := validateWarnDuplicateTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because there are duplicate term values")
@@ -102,7 +102,7 @@ func TestValidateWarnDuplicateTermValues_ManyDifferentDuplicates_TruncateList(t
 		Path: "manydups.csv",
 	}
 
-	res := validateWarnDuplicateTermValues(ctx, a)
+	res := validateWarnDuplicateTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because we flooded duplicates")
@@ -143,7 +143,7 @@ func TestValidateWarnDuplicateTermValues_EmptyOrWhitespaceTerms_Ignored(t *testi
 		Path: "mix.csv",
 	}
 
-	res := validateWarnDuplicateTermValues(ctx, a)
+	res := validateWarnDuplicateTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because Apple repeats")
@@ -175,7 +175,7 @@ func TestValidateWarnDuplicateTermValues_NoTermColumn_PASS(t *testing.T) {
 		Path: "noterm.csv",
 	}
 
-	res := validateWarnDuplicateTermValues(ctx, a)
+	res := validateWarnDuplicateTermValues(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true when there's no term column, got false: %q", res.Msg)
@@ -280,7 +280,7 @@ func TestValidateWarnDuplicateTermValues_BOMBeforeHeader(t *testing.T) {
 	res := validateWarnDuplicateTermValues(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "bom.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected duplicate warning")
@@ -292,3 +292,36 @@ func TestValidateWarnDuplicateTermValues_BOMBeforeHeader(t *testing.T) {
 		t.Fatalf("expected message to include Apple, got %q", res.Msg)
 	}
 }
+
+func TestValidateWarnDuplicateTermValues_CapsStoredRows(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\n"
+	for range 6 {
+		csv += "apple;fruit\n"
+	}
+
+	res := validateWarnDuplicateTermValues(context.Background(), checks.Artifact{Data: []byte(csv)}, 4, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, `"apple" (rows 2, 3, 4, 5 and 2 more (not stored))`) {
+		t.Fatalf("expected capped rows in message, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnDuplicateTermValues_FindingPositions(t *testing.T) {
+	t.Parallel()
+
+	csv := "description;term\nd;apple\nd;apple\n"
+	res := validateWarnDuplicateTermValues(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+
+	if len(res.Findings) != 2 {
+		t.Fatalf("Findings = %+v, want 2", res.Findings)
+	}
+	for i, f := range res.Findings {
+		if f.Code != "duplicate-term" || f.Row != i+2 || f.Field != 2 || f.Column != "term" {
+			t.Fatalf("finding %d = %+v, want duplicate-term at row %d, field 2", i, f, i+2)
+		}
+	}
+}
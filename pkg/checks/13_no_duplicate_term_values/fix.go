@@ -8,11 +8,14 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
 )
 
-func fixDuplicateTermValues(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+// fixDuplicateTermValues removes all but one row of every duplicated term; keep picks
+// the survivor (see settingKeep), which stays where it was.
+func fixDuplicateTermValues(ctx context.Context, a checks.Artifact, keep string) (checks.FixResult, error) {
 	if err := ctx.Err(); err != nil {
 		return checks.FixResult{}, err
 	}
@@ -50,7 +53,7 @@ func fixDuplicateTermValues(ctx context.Context, a checks.Artifact) (checks.FixR
 		return checks.NoFix(a, "no 'term' column found")
 	}
 
-	plan := buildDuplicateTermFixPlan(records, termCol, parts.headerLineNo)
+	plan := buildDuplicateTermFixPlan(records, termCol, parts.headerLineNo, keep)
 	if !plan.hasDuplicates() {
 		return checks.FixResult{
 			Data:      a.Data,
@@ -76,7 +79,7 @@ func fixDuplicateTermValues(ctx context.Context, a checks.Artifact) (checks.FixR
 		Data:      out,
 		Path:      "",
 		DidChange: true,
-		Note:      duplicateTermFixNote(plan.removed),
+		Note:      duplicateTermFixNote(plan.removed, keep),
 	}, nil
 }
 
@@ -234,42 +237,56 @@ func buildDuplicateTermFixPlan(
 	records [][]string,
 	termCol int,
 	headerLineNo int,
+	keep string,
 ) duplicateTermFixPlan {
-	seen := make(map[string]struct{})
-	removedByTerm := make(map[string]*removedDuplicateTerm)
-	removedOrder := make([]string, 0)
-
-	out := make([][]string, 0, len(records))
-	out = append(out, records[0])
+	rowsByTerm := make(map[string][]int)
+	termOrder := make([]string, 0)
 
 	for i := 1; i < len(records); i++ {
-		rec := records[i]
-
-		term, ok := duplicateTermValue(rec, termCol)
+		term, ok := duplicateTermValue(records[i], termCol)
 		if !ok {
-			out = append(out, rec)
 			continue
 		}
 
-		if _, exists := seen[term]; !exists {
-			seen[term] = struct{}{}
-			out = append(out, rec)
+		if _, exists := rowsByTerm[term]; !exists {
+			termOrder = append(termOrder, term)
+		}
+		rowsByTerm[term] = append(rowsByTerm[term], i)
+	}
+
+	descCol := -1
+	if keep == keepLongestDescription {
+		descCol = findDescriptionColumn(records[0])
+	}
+
+	drop := make(map[int]struct{})
+	removed := make([]removedDuplicateTerm, 0)
+
+	for _, term := range termOrder {
+		rows := rowsByTerm[term]
+		if len(rows) < 2 {
 			continue
 		}
 
-		info := removedByTerm[term]
-		if info == nil {
-			info = &removedDuplicateTerm{term: term}
-			removedByTerm[term] = info
-			removedOrder = append(removedOrder, term)
+		kept := keptDuplicateRow(records, rows, keep, descCol)
+		info := removedDuplicateTerm{term: term}
+		for _, i := range rows {
+			if i == kept {
+				continue
+			}
+			drop[i] = struct{}{}
+			info.rows = append(info.rows, headerLineNo+i)
 		}
 
-		info.rows = append(info.rows, headerLineNo+i)
+		removed = append(removed, info)
 	}
 
-	removed := make([]removedDuplicateTerm, 0, len(removedOrder))
-	for _, term := range removedOrder {
-		removed = append(removed, *removedByTerm[term])
+	out := make([][]string, 0, len(records)-len(drop))
+	out = append(out, records[0])
+	for i := 1; i < len(records); i++ {
+		if _, dropped := drop[i]; !dropped {
+			out = append(out, records[i])
+		}
 	}
 
 	return duplicateTermFixPlan{
@@ -278,6 +295,39 @@ func buildDuplicateTermFixPlan(
 	}
 }
 
+// keptDuplicateRow picks the record index to keep out of rows (ascending) for keep.
+func keptDuplicateRow(records [][]string, rows []int, keep string, descCol int) int {
+	switch keep {
+	case keepLast:
+		return rows[len(rows)-1]
+	case keepLongestDescription:
+		best, bestLen := rows[0], -1
+		for _, i := range rows {
+			n := 0
+			if descCol >= 0 && descCol < len(records[i]) {
+				n = utf8.RuneCountInString(strings.TrimSpace(records[i][descCol]))
+			}
+			if n > bestLen {
+				best, bestLen = i, n
+			}
+		}
+
+		return best
+	default:
+		return rows[0]
+	}
+}
+
+func findDescriptionColumn(header []string) int {
+	for i, col := range header {
+		if normalizeHeaderCell(col) == "description" {
+			return i
+		}
+	}
+
+	return -1
+}
+
 func duplicateTermValue(record []string, termCol int) (string, bool) {
 	if termCol >= len(record) {
 		return "", false
@@ -291,7 +341,7 @@ func duplicateTermValue(record []string, termCol int) (string, bool) {
 	return term, true
 }
 
-func duplicateTermFixNote(removed []removedDuplicateTerm) string {
+func duplicateTermFixNote(removed []removedDuplicateTerm, keep string) string {
 	var b strings.Builder
 	b.WriteString("removed duplicate term rows for: ")
 
@@ -306,6 +356,16 @@ func duplicateTermFixNote(removed []removedDuplicateTerm) string {
 		b.WriteString(")")
 	}
 
+	b.WriteString("; kept ")
+	switch keep {
+	case keepLast:
+		b.WriteString("the last row")
+	case keepLongestDescription:
+		b.WriteString("the row with the longest description")
+	default:
+		b.WriteString("the first row")
+	}
+
 	return b.String()
 }
 
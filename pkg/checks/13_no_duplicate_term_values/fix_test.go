@@ -17,7 +17,7 @@ func TestFixDuplicateTermValues_NoContent_NoFix(t *testing.T) {
 		Path: "empty.csv",
 	}
 
-	fr, err := fixDuplicateTermValues(ctx, a)
+	fr, err := fixDuplicateTermValues(ctx, a, keepFirst)
 	if err == nil {
 		t.Fatalf("expected ErrNoFix, got nil")
 	}
@@ -46,7 +46,7 @@ func TestFixDuplicateTermValues_NoHeader_NoFix(t *testing.T) {
 		Path: "noheader.csv",
 	}
 
-	fr, err := fixDuplicateTermValues(ctx, a)
+	fr, err := fixDuplicateTermValues(ctx, a, keepFirst)
 	if err == nil {
 		t.Fatalf("expected ErrNoFix, got nil")
 	}
@@ -77,7 +77,7 @@ func TestFixDuplicateTermValues_NoDuplicates_NoChange(t *testing.T) {
 		Path: "nodup.csv",
 	}
 
-	fr, err := fixDuplicateTermValues(ctx, a)
+	fr, err := fixDuplicateTermValues(ctx, a, keepFirst)
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -126,7 +126,7 @@ func TestFixDuplicateTermValues_RemovesDuplicateRows_CaseSensitive(t *testing.T)
 		Path: "dups.csv",
 	}
 
-	fr, err := fixDuplicateTermValues(ctx, a)
+	fr, err := fixDuplicateTermValues(ctx, a, keepFirst)
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -242,3 +242,96 @@ func TestRunWarnDuplicateTermValues_EndToEnd_WithFixPolicy(t *testing.T) {
 		t.Fatalf("expected DidChange=true because we removed duplicate rows")
 	}
 }
+
+func TestFixDuplicateTermValues_KeepStrategies(t *testing.T) {
+	t.Parallel()
+
+	input := "" +
+		"term;description\n" +
+		"Apple;red\n" +
+		"Banana;yellow\n" +
+		"Apple;a sweet red fruit\n" +
+		"Apple;sweet\n"
+
+	tests := []struct {
+		keep string
+		want string
+		note string
+	}{
+		{
+			keep: keepFirst,
+			want: "term;description\nApple;red\nBanana;yellow\n",
+			note: `removed duplicate term rows for: "Apple" (rows 4, 5); kept the first row`,
+		},
+		{
+			keep: keepLast,
+			want: "term;description\nBanana;yellow\nApple;sweet\n",
+			note: `removed duplicate term rows for: "Apple" (rows 2, 4); kept the last row`,
+		},
+		{
+			keep: keepLongestDescription,
+			want: "term;description\nBanana;yellow\nApple;a sweet red fruit\n",
+			note: `removed duplicate term rows for: "Apple" (rows 2, 5); kept the row with the longest description`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.keep, func(t *testing.T) {
+			t.Parallel()
+
+			fr, err := fixDuplicateTermValues(context.Background(), checks.Artifact{Data: []byte(input)}, tc.keep)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if got := string(fr.Data); got != tc.want {
+				t.Fatalf("fixed content mismatch.\n got: %q\nwant: %q", got, tc.want)
+			}
+			if fr.Note != tc.note {
+				t.Fatalf("Note = %q, want %q", fr.Note, tc.note)
+			}
+		})
+	}
+}
+
+func TestFixDuplicateTermValues_LongestDescriptionTieKeepsFirst(t *testing.T) {
+	t.Parallel()
+
+	input := "term;en\nApple;apple\nApple;pomme\n"
+
+	fr, err := fixDuplicateTermValues(context.Background(), checks.Artifact{Data: []byte(input)}, keepLongestDescription)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if got := string(fr.Data); got != "term;en\nApple;apple\n" {
+		t.Fatalf("without a description column the first row must be kept, got %q", got)
+	}
+}
+
+func TestRunWarnDuplicateTermValues_KeepSetting(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;description\nApple;red\nApple;sweet\n")}
+
+	out := runWarnDuplicateTermValues(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixIfFailed,
+		RerunAfterFix: true,
+		Settings:      map[string]checks.CheckSettings{checkName: {settingKeep: "Last"}},
+	})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if got := string(out.Final.Data); got != "term;description\nApple;sweet\n" {
+		t.Fatalf("unexpected fixed data: %q", got)
+	}
+
+	out = runWarnDuplicateTermValues(context.Background(), a, checks.RunOptions{
+		FixMode:  checks.FixIfFailed,
+		Settings: map[string]checks.CheckSettings{checkName: {settingKeep: "newest"}},
+	})
+	if out.Result.Status != checks.Error || !strings.Contains(out.Result.Message, `invalid keep "newest"`) {
+		t.Fatalf("expected ERROR for an invalid keep strategy, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("an invalid keep strategy must not change the data")
+	}
+}
@@ -15,17 +15,15 @@ const checkName = "warn-orphan-locale-descriptions"
 const maxReportedOrphans = 10
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runWarnOrphanLocaleDescriptions,
-		checks.WithPriority(14),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnOrphanLocaleDescriptions,
+			checks.WithPriority(checks.PrioContent+15),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
 }
 
 func runWarnOrphanLocaleDescriptions(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
@@ -55,14 +53,27 @@ func validateWarnOrphanLocaleDescriptions(ctx context.Context, a checks.Artifact
 		}
 	}
 
-	r := checks.NewSemicolonCSVReader(data)
+	ix, err := checks.LocaleIndexOf(ctx, a)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return checks.ValidationResult{
+				OK:  true,
+				Msg: "no header line found (nothing to validate for orphan locale descriptions)",
+			}
+		}
 
-	header, res, ok := readOrphanLocaleHeader(ctx, r)
-	if !ok {
-		return res
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse header with semicolon delimiter",
+			Err: err,
+		}
 	}
 
-	orphans, err := findOrphanLocaleDescriptions(ctx, header)
+	orphans, err := findOrphanLocaleDescriptions(ctx, ix)
 	if err != nil {
 		return cancelledValidation(err)
 	}
@@ -80,89 +91,32 @@ func validateWarnOrphanLocaleDescriptions(ctx context.Context, a checks.Artifact
 	}
 }
 
-type csvReader interface {
-	Read() ([]string, error)
-}
-
-func readOrphanLocaleHeader(
-	ctx context.Context,
-	r csvReader,
-) ([]string, checks.ValidationResult, bool) {
-	for {
-		if err := ctx.Err(); err != nil {
-			return nil, cancelledValidation(err), false
-		}
-
-		rec, err := r.Read()
-		if err != nil {
-			if errors.Is(err, io.EOF) {
-				return nil, checks.ValidationResult{
-					OK:  true,
-					Msg: "no header line found (nothing to validate for orphan locale descriptions)",
-				}, false
-			}
-
-			return nil, checks.ValidationResult{
-				OK:  false,
-				Msg: "cannot parse header with semicolon delimiter",
-				Err: err,
-			}, false
-		}
-
-		if !isBlankCSVRecord(rec) {
-			return rec, checks.ValidationResult{}, true
-		}
-	}
-}
-
-func isBlankCSVRecord(record []string) bool {
-	for _, field := range record {
-		if !checks.IsBlankUnicode([]byte(field)) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func findOrphanLocaleDescriptions(ctx context.Context, header []string) ([]string, error) {
-	allCols := make(map[string]struct{}, len(header))
-	var candidateOrder []string
-	seenCandidates := make(map[string]struct{})
+// findOrphanLocaleDescriptions lists (lowercased, in header order) the bases of
+// "<base>_description" columns that have no "<base>" column.
+func findOrphanLocaleDescriptions(ctx context.Context, ix *checks.LocaleIndex) ([]string, error) {
+	orphans := make([]string, 0)
+	seen := make(map[string]struct{})
 
-	for _, col := range header {
+	for _, col := range ix.Columns {
 		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
 
-		name := normalizeHeaderCell(col)
-		if name == "" {
+		if !col.Description {
 			continue
 		}
 
-		allCols[name] = struct{}{}
-
-		base, ok := descriptionBase(name)
-		if !ok {
+		base := strings.ToLower(strings.TrimSpace(col.Base))
+		if base == "" {
 			continue
 		}
 
-		if _, seen := seenCandidates[base]; seen {
+		if _, dup := seen[base]; dup {
 			continue
 		}
+		seen[base] = struct{}{}
 
-		seenCandidates[base] = struct{}{}
-		candidateOrder = append(candidateOrder, base)
-	}
-
-	orphans := make([]string, 0)
-
-	for _, base := range candidateOrder {
-		if err := ctx.Err(); err != nil {
-			return nil, err
-		}
-
-		if _, ok := allCols[base]; !ok {
+		if !ix.HasColumn(base) {
 			orphans = append(orphans, base)
 		}
 	}
@@ -170,25 +124,6 @@ func findOrphanLocaleDescriptions(ctx context.Context, header []string) ([]strin
 	return orphans, nil
 }
 
-func normalizeHeaderCell(s string) string {
-	return strings.ToLower(strings.TrimSpace(s))
-}
-
-func descriptionBase(name string) (string, bool) {
-	if !strings.HasSuffix(name, "_description") {
-		return "", false
-	}
-
-	base := strings.TrimSuffix(name, "_description")
-	base = strings.TrimSpace(base)
-
-	if base == "" {
-		return "", false
-	}
-
-	return base, true
-}
-
 func orphanLocaleDescriptionsMessage(orphans []string) string {
 	display := orphans
 	truncated := false
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/flags"
 )
 
 const checkName = "no-invalid-flags"
@@ -17,31 +18,28 @@ const (
 	maxReportedFlagErrors = 10
 )
 
-var watchedCols = []string{
-	"casesensitive",
-	"translatable",
-	"forbidden",
-}
-
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runNoInvalidFlags,
-		checks.WithFailFast(),
-		checks.WithPriority(15),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runNoInvalidFlags,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioContent+30),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
 }
 
 func runNoInvalidFlags(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
 	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:             checkName,
-		Validate:         validateNoInvalidFlags,
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateNoInvalidFlags(ctx, a, limit, stopAfter)
+		},
 		Fix:              fixNoInvalidFlags,
 		PassMsg:          "all flag columns contain only yes/no",
 		FixedMsg:         "normalized flag columns to yes/no",
@@ -51,7 +49,7 @@ func runNoInvalidFlags(ctx context.Context, a checks.Artifact, opts checks.RunOp
 	})
 }
 
-func validateNoInvalidFlags(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+func validateNoInvalidFlags(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
 	if err := ctx.Err(); err != nil {
 		return cancelledValidation(err)
 	}
@@ -64,7 +62,7 @@ func validateNoInvalidFlags(ctx context.Context, a checks.Artifact) checks.Valid
 		}
 	}
 
-	r := checks.NewSemicolonCSVReader(data)
+	r := checks.TableReaderOf(ctx, a)
 
 	header, rowNum, res, ok := readFlagHeader(ctx, r)
 	if !ok {
@@ -79,7 +77,7 @@ func validateNoInvalidFlags(ctx context.Context, a checks.Artifact) checks.Valid
 		}
 	}
 
-	invalids, err := findInvalidFlagValues(ctx, r, rowNum, flagColumns)
+	invalids, err := findInvalidFlagValues(ctx, r, rowNum, flagColumns, limit, stopAfter)
 	if err != nil {
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return cancelledValidation(ctxErr)
@@ -92,7 +90,7 @@ func validateNoInvalidFlags(ctx context.Context, a checks.Artifact) checks.Valid
 		}
 	}
 
-	if len(invalids) == 0 {
+	if invalids.Total() == 0 {
 		return checks.ValidationResult{
 			OK:  true,
 			Msg: "all flag columns contain only yes/no",
@@ -100,8 +98,10 @@ func validateNoInvalidFlags(ctx context.Context, a checks.Artifact) checks.Valid
 	}
 
 	return checks.ValidationResult{
-		OK:  false,
-		Msg: invalidFlagsMessage(invalids),
+		OK:        false,
+		Msg:       invalidFlagsMessage(invalids),
+		Findings:  invalidFlagFindings(invalids),
+		Truncated: invalids.Exhausted(),
 	}
 }
 
@@ -160,18 +160,13 @@ type flagColumn struct {
 }
 
 func findFlagColumns(header []string) []flagColumn {
-	watched := make(map[string]struct{}, len(watchedCols))
-	for _, col := range watchedCols {
-		watched[col] = struct{}{}
-	}
-
-	cols := make([]flagColumn, 0, len(watchedCols))
+	cols := make([]flagColumn, 0)
 
 	for i, h := range header {
-		name := normalizeHeaderCell(h)
-		if _, ok := watched[name]; !ok {
+		if !flags.IsColumn(h) {
 			continue
 		}
+		name := normalizeHeaderCell(h)
 
 		cols = append(cols, flagColumn{
 			name: name,
@@ -188,6 +183,7 @@ func normalizeHeaderCell(s string) string {
 
 type invalidFlagValue struct {
 	colName string
+	colPos  int
 	value   string
 	rowNum  int
 }
@@ -197,27 +193,35 @@ func findInvalidFlagValues(
 	r csvReader,
 	rowNum int,
 	flagColumns []flagColumn,
-) ([]invalidFlagValue, error) {
-	var invalids []invalidFlagValue
+	limit int,
+	stopAfter int,
+) (checks.Capped[invalidFlagValue], error) {
+	invalids := checks.Capped[invalidFlagValue]{Limit: limit, StopAfter: stopAfter}
+
+	// A flag column becomes required only once some row actually sets it;
+	// columns nobody uses may stay blank (Lokalise applies its defaults).
+	used := make([]bool, len(flagColumns))
+	// Blank values past the cap are only counted, per column, so they can be dropped too.
+	overflowBlanks := make([]int, len(flagColumns))
 
 	for {
 		if rowNum%ctxCheckEveryRows == 0 {
 			if err := ctx.Err(); err != nil {
-				return nil, err
+				return checks.Capped[invalidFlagValue]{}, err
 			}
 		}
 
 		rec, err := r.Read()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
-				return invalids, nil
+				return dropUnusedBlankFlags(invalids, flagColumns, used, overflowBlanks), nil
 			}
 
 			if ctxErr := ctx.Err(); ctxErr != nil {
-				return nil, ctxErr
+				return checks.Capped[invalidFlagValue]{}, ctxErr
 			}
 
-			return nil, err
+			return checks.Capped[invalidFlagValue]{}, err
 		}
 
 		rowNum++
@@ -226,19 +230,59 @@ func findInvalidFlagValues(
 			continue
 		}
 
-		for _, col := range flagColumns {
+		for i, col := range flagColumns {
 			value := flagValue(rec, col.pos)
-			if isValidFlagValue(value) {
+			if value != "" {
+				used[i] = true
+			}
+			if flags.IsValid(value) {
 				continue
 			}
 
-			invalids = append(invalids, invalidFlagValue{
+			stored := invalids.Add(invalidFlagValue{
 				colName: col.name,
+				colPos:  col.pos,
 				value:   value,
 				rowNum:  rowNum,
 			})
+			if !stored && value == "" {
+				overflowBlanks[i]++
+			}
+			if invalids.Exhausted() {
+				return dropUnusedBlankFlags(invalids, flagColumns, used, overflowBlanks), nil
+			}
+		}
+	}
+}
+
+// dropUnusedBlankFlags removes blank-value reports for flag columns no row uses.
+func dropUnusedBlankFlags(
+	invalids checks.Capped[invalidFlagValue],
+	flagColumns []flagColumn,
+	used []bool,
+	overflowBlanks []int,
+) checks.Capped[invalidFlagValue] {
+	unused := make(map[int]struct{})
+	for i, col := range flagColumns {
+		if !used[i] {
+			unused[col.pos] = struct{}{}
+			invalids.Overflow -= overflowBlanks[i]
+		}
+	}
+	if len(unused) == 0 {
+		return invalids
+	}
+
+	out := invalids.Items[:0]
+	for _, inv := range invalids.Items {
+		if _, skip := unused[inv.colPos]; skip && inv.value == "" {
+			continue
 		}
+		out = append(out, inv)
 	}
+	invalids.Items = out
+
+	return invalids
 }
 
 func flagValue(record []string, pos int) string {
@@ -249,12 +293,8 @@ func flagValue(record []string, pos int) string {
 	return strings.TrimSpace(record[pos])
 }
 
-func isValidFlagValue(value string) bool {
-	return value == "yes" || value == "no"
-}
-
-func invalidFlagsMessage(invalids []invalidFlagValue) string {
-	limit := len(invalids)
+func invalidFlagsMessage(invalids checks.Capped[invalidFlagValue]) string {
+	limit := len(invalids.Items)
 	if limit > maxReportedFlagErrors {
 		limit = maxReportedFlagErrors
 	}
@@ -263,7 +303,7 @@ func invalidFlagsMessage(invalids []invalidFlagValue) string {
 	b.WriteString("invalid values in flag columns: ")
 
 	for i := 0; i < limit; i++ {
-		inv := invalids[i]
+		inv := invalids.Items[i]
 
 		b.WriteString(inv.colName)
 		b.WriteString("=")
@@ -277,20 +317,35 @@ func invalidFlagsMessage(invalids []invalidFlagValue) string {
 		}
 	}
 
-	if len(invalids) > limit {
-		b.WriteString(" ... (total ")
-		b.WriteString(strconv.Itoa(len(invalids)))
-		b.WriteString(" invalid values)")
-		return b.String()
+	if invalids.Total() > limit {
+		b.WriteString(" ...")
 	}
 
 	b.WriteString(" (total ")
-	b.WriteString(strconv.Itoa(len(invalids)))
+	b.WriteString(strconv.Itoa(invalids.Total()))
 	b.WriteString(" invalid values)")
+	b.WriteString(checks.OverflowNote(invalids.Overflow))
+	b.WriteString(invalids.TruncatedNote())
 
 	return b.String()
 }
 
+func invalidFlagFindings(invalids checks.Capped[invalidFlagValue]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(invalids.Items))
+	for _, inv := range invalids.Items {
+		out = append(out, checks.Finding{
+			Code:    "invalid-flag",
+			Row:     inv.rowNum,
+			Field:   inv.colPos + 1,
+			Column:  inv.colName,
+			Value:   inv.value,
+			Message: "flag must be yes or no",
+		})
+	}
+
+	return out
+}
+
 func cancelledValidation(err error) checks.ValidationResult {
 	return checks.ValidationResult{
 		OK:  false,
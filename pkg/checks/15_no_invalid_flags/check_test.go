@@ -23,7 +23,7 @@ func TestValidateNoInvalidFlags_NoFlagColumns_PASS(t *testing.T) {
 		Path: "noflags.csv",
 	}
 
-	res := validateNoInvalidFlags(ctx, a)
+	res := validateNoInvalidFlags(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -52,7 +52,7 @@ func TestValidateNoInvalidFlags_AllGoodYesNo_PASS(t *testing.T) {
 		Path: "clean.csv",
 	}
 
-	res := validateNoInvalidFlags(ctx, a)
+	res := validateNoInvalidFlags(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -81,7 +81,7 @@ func TestValidateNoInvalidFlags_InvalidValues_FAIL(t *testing.T) {
 		Path: "dirty.csv",
 	}
 
-	res := validateNoInvalidFlags(ctx, a)
+	res := validateNoInvalidFlags(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because flags have invalid values")
@@ -109,6 +109,14 @@ func TestValidateNoInvalidFlags_InvalidValues_FAIL(t *testing.T) {
 	if !strings.Contains(res.Msg, "total 3") {
 		t.Fatalf("expected message to mention total 3 invalid values, got: %q", res.Msg)
 	}
+
+	if len(res.Findings) != 3 {
+		t.Fatalf("expected 3 findings, got %+v", res.Findings)
+	}
+	if f := res.Findings[1]; f.Code != "invalid-flag" || f.Row != 3 || f.Field != 4 ||
+		f.Column != "translatable" || f.Value != "maybe" {
+		t.Fatalf("unexpected finding for row 3: %+v", f)
+	}
 }
 
 func TestValidateNoInvalidFlags_BlankRowsAreSkipped(t *testing.T) {
@@ -127,7 +135,7 @@ func TestValidateNoInvalidFlags_BlankRowsAreSkipped(t *testing.T) {
 		Path: "skipblank.csv",
 	}
 
-	res := validateNoInvalidFlags(ctx, a)
+	res := validateNoInvalidFlags(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because 'lol' is invalid")
@@ -160,7 +168,7 @@ func TestValidateNoInvalidFlags_PartialHeader_ONLYForbidden(t *testing.T) {
 		Path: "onlyforbidden.csv",
 	}
 
-	res := validateNoInvalidFlags(ctx, a)
+	res := validateNoInvalidFlags(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false due to empty forbidden")
@@ -177,6 +185,51 @@ func TestValidateNoInvalidFlags_PartialHeader_ONLYForbidden(t *testing.T) {
 	}
 }
 
+func TestValidateNoInvalidFlags_UnusedFlagColumnsMayStayBlank(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	csv := "" +
+		"term;description;casesensitive;translatable;forbidden\n" +
+		"apple;fruit;;;\n" +
+		"pear;fruit;;yes\n" +
+		"plum;fruit\n"
+
+	res := validateNoInvalidFlags(ctx, checks.Artifact{
+		Data: []byte(csv),
+		Path: "minimal.csv",
+	}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false: translatable is used by row 3, so rows 2 and 4 must set it")
+	}
+	if strings.Contains(res.Msg, "casesensitive") || strings.Contains(res.Msg, "forbidden") {
+		t.Fatalf("unused flag columns must not be reported, got: %q", res.Msg)
+	}
+	if !strings.Contains(res.Msg, `translatable="" (row 2)`) || !strings.Contains(res.Msg, `translatable="" (row 4)`) {
+		t.Fatalf("expected blank translatable rows 2 and 4 in message, got: %q", res.Msg)
+	}
+}
+
+func TestValidateNoInvalidFlags_AllFlagsUnused_PASS(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;casesensitive;translatable;forbidden\n" +
+		"apple;fruit;;;\n" +
+		"pear;fruit\n"
+
+	res := validateNoInvalidFlags(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+		Path: "minimal.csv",
+	}, checks.DefaultMaxFindings, 0)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true when no row uses flags, got: %q", res.Msg)
+	}
+}
+
 func TestValidateNoInvalidFlags_TruncatesAfter10(t *testing.T) {
 	t.Parallel()
 
@@ -196,7 +249,7 @@ func TestValidateNoInvalidFlags_TruncatesAfter10(t *testing.T) {
 		Path: "a_lot.csv",
 	}
 
-	res := validateNoInvalidFlags(ctx, a)
+	res := validateNoInvalidFlags(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because all values are invalid")
@@ -301,7 +354,7 @@ func TestValidateNoInvalidFlags_BOMBeforeHeader(t *testing.T) {
 	res := validateNoInvalidFlags(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "bom.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because YES is invalid")
@@ -313,3 +366,24 @@ func TestValidateNoInvalidFlags_BOMBeforeHeader(t *testing.T) {
 		t.Fatalf("expected invalid casesensitive value in message, got %q", res.Msg)
 	}
 }
+
+func TestValidateNoInvalidFlags_CapsStoredValues(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description;casesensitive;forbidden\n"
+	for range 5 {
+		csv += "apple;fruit;maybe;\n"
+	}
+
+	res := validateNoInvalidFlags(context.Background(), checks.Artifact{Data: []byte(csv)}, 3, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	// Blank "forbidden" values are ignored (nobody uses that column), stored or not.
+	if !strings.Contains(res.Msg, "(total 5 invalid values) and 3 more (not stored)") {
+		t.Fatalf("expected total and overflow in message, got %q", res.Msg)
+	}
+	if strings.Contains(res.Msg, `forbidden=""`) {
+		t.Fatalf("unused blank column must not be reported, got %q", res.Msg)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/flags"
 )
 
 type flagFixInput struct {
@@ -289,18 +290,13 @@ type flagFixColumn struct {
 }
 
 func flagFixColumns(header []string) []flagFixColumn {
-	watched := make(map[string]struct{}, len(watchedCols))
-	for _, col := range watchedCols {
-		watched[col] = struct{}{}
-	}
-
-	cols := make([]flagFixColumn, 0, len(watchedCols))
+	cols := make([]flagFixColumn, 0)
 
 	for i, h := range header {
-		name := strings.ToLower(strings.TrimSpace(h))
-		if _, ok := watched[name]; !ok {
+		if !flags.IsColumn(h) {
 			continue
 		}
+		name := strings.ToLower(strings.TrimSpace(h))
 
 		cols = append(cols, flagFixColumn{
 			name: name,
@@ -341,7 +337,7 @@ func normalizeFlagRecords(
 			}
 
 			orig := newRow[col.pos]
-			normalized := normalizeFlagValue(orig)
+			normalized := flags.Normalize(orig)
 
 			if normalized != orig {
 				newRow[col.pos] = normalized
@@ -355,22 +351,6 @@ func normalizeFlagRecords(
 	return out, changed, nil
 }
 
-func normalizeFlagValue(v string) string {
-	trimmed := strings.TrimSpace(v)
-	if trimmed == "" {
-		return v
-	}
-
-	switch strings.ToLower(trimmed) {
-	case "yes", "y", "true", "1":
-		return "yes"
-	case "no", "n", "false", "0":
-		return "no"
-	default:
-		return v
-	}
-}
-
 func flagFixIsBlankCSVRecord(record []string) bool {
 	for _, field := range record {
 		if !checks.IsBlankUnicode([]byte(field)) {
@@ -18,18 +18,15 @@ const (
 )
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runNoForbiddenNonTranslatableTerms,
-		checks.WithFailFast(),
-		checks.WithPriority(16),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runNoForbiddenNonTranslatableTerms,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioContent+35),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
 }
 
 func runNoForbiddenNonTranslatableTerms(
@@ -37,17 +34,24 @@ func runNoForbiddenNonTranslatableTerms(
 	a checks.Artifact,
 	opts checks.RunOptions,
 ) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
 	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:     checkName,
-		Validate: validateNoForbiddenNonTranslatableTerms,
-		Fix:      nil,
-		PassMsg:  "no forbidden non-translatable terms found",
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateNoForbiddenNonTranslatableTerms(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no forbidden non-translatable terms found",
 	})
 }
 
 func validateNoForbiddenNonTranslatableTerms(
 	ctx context.Context,
 	a checks.Artifact,
+	limit int,
+	stopAfter int,
 ) checks.ValidationResult {
 	if err := ctx.Err(); err != nil {
 		return cancelledValidation(err)
@@ -61,7 +65,7 @@ func validateNoForbiddenNonTranslatableTerms(
 		}
 	}
 
-	r := checks.NewSemicolonCSVReader(data)
+	r := checks.TableReaderOf(ctx, a)
 
 	header, rowNum, res, ok := readForbiddenNonTranslatableHeader(ctx, r)
 	if !ok {
@@ -76,7 +80,7 @@ func validateNoForbiddenNonTranslatableTerms(
 		}
 	}
 
-	badRows, err := findForbiddenNonTranslatableRows(ctx, r, rowNum, cols)
+	badRows, err := findForbiddenNonTranslatableRows(ctx, r, rowNum, cols, limit, stopAfter)
 	if err != nil {
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return cancelledValidation(ctxErr)
@@ -89,7 +93,7 @@ func validateNoForbiddenNonTranslatableTerms(
 		}
 	}
 
-	if len(badRows) == 0 {
+	if badRows.Total() == 0 {
 		return checks.ValidationResult{
 			OK:  true,
 			Msg: "no forbidden non-translatable terms found",
@@ -97,8 +101,10 @@ func validateNoForbiddenNonTranslatableTerms(
 	}
 
 	return checks.ValidationResult{
-		OK:  false,
-		Msg: forbiddenNonTranslatableMessage(badRows),
+		OK:        false,
+		Msg:       forbiddenNonTranslatableMessage(badRows),
+		Findings:  forbiddenNonTranslatableFindings(badRows),
+		Truncated: badRows.Exhausted(),
 	}
 }
 
@@ -196,13 +202,15 @@ func findForbiddenNonTranslatableRows(
 	r csvReader,
 	rowNum int,
 	cols forbiddenNonTranslatableColumns,
-) ([]forbiddenNonTranslatableRow, error) {
-	var badRows []forbiddenNonTranslatableRow
+	limit int,
+	stopAfter int,
+) (checks.Capped[forbiddenNonTranslatableRow], error) {
+	badRows := checks.Capped[forbiddenNonTranslatableRow]{Limit: limit, StopAfter: stopAfter}
 
 	for {
 		if rowNum%ctxCheckEveryRows == 0 {
 			if err := ctx.Err(); err != nil {
-				return nil, err
+				return checks.Capped[forbiddenNonTranslatableRow]{}, err
 			}
 		}
 
@@ -213,10 +221,10 @@ func findForbiddenNonTranslatableRows(
 			}
 
 			if ctxErr := ctx.Err(); ctxErr != nil {
-				return nil, ctxErr
+				return checks.Capped[forbiddenNonTranslatableRow]{}, ctxErr
 			}
 
-			return nil, err
+			return checks.Capped[forbiddenNonTranslatableRow]{}, err
 		}
 
 		rowNum++
@@ -229,10 +237,13 @@ func findForbiddenNonTranslatableRows(
 			continue
 		}
 
-		badRows = append(badRows, forbiddenNonTranslatableRow{
+		badRows.Add(forbiddenNonTranslatableRow{
 			rowNum: rowNum,
 			term:   recordValue(rec, cols.term),
 		})
+		if badRows.Exhausted() {
+			return badRows, nil
+		}
 	}
 }
 
@@ -252,8 +263,8 @@ func recordValue(record []string, pos int) string {
 	return strings.TrimSpace(record[pos])
 }
 
-func forbiddenNonTranslatableMessage(rows []forbiddenNonTranslatableRow) string {
-	limit := len(rows)
+func forbiddenNonTranslatableMessage(rows checks.Capped[forbiddenNonTranslatableRow]) string {
+	limit := len(rows.Items)
 	if limit > maxReportedRows {
 		limit = maxReportedRows
 	}
@@ -262,7 +273,7 @@ func forbiddenNonTranslatableMessage(rows []forbiddenNonTranslatableRow) string
 	b.WriteString("terms cannot be both forbidden and non-translatable: ")
 
 	for i := range limit {
-		row := rows[i]
+		row := rows.Items[i]
 
 		if row.term != "" {
 			b.WriteString("term=")
@@ -279,20 +290,33 @@ func forbiddenNonTranslatableMessage(rows []forbiddenNonTranslatableRow) string
 		}
 	}
 
-	if len(rows) > limit {
-		b.WriteString(" ... (total ")
-		b.WriteString(strconv.Itoa(len(rows)))
-		b.WriteString(" terms)")
-		return b.String()
+	if rows.Total() > limit {
+		b.WriteString(" ...")
 	}
 
 	b.WriteString(" (total ")
-	b.WriteString(strconv.Itoa(len(rows)))
+	b.WriteString(strconv.Itoa(rows.Total()))
 	b.WriteString(" terms)")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
 
 	return b.String()
 }
 
+func forbiddenNonTranslatableFindings(rows checks.Capped[forbiddenNonTranslatableRow]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(rows.Items))
+	for _, row := range rows.Items {
+		out = append(out, checks.Finding{
+			Row:     row.rowNum,
+			Column:  "term",
+			Value:   row.term,
+			Message: "term is both forbidden and non-translatable",
+		})
+	}
+
+	return out
+}
+
 func cancelledValidation(err error) checks.ValidationResult {
 	return checks.ValidationResult{
 		OK:  false,
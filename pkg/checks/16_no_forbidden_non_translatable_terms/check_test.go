@@ -25,7 +25,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_AllGood_PASS(t *testing.T) {
 		Path: "clean.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -54,7 +54,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_ForbiddenAndNonTranslatable_FAI
 		Path: "dirty.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because some terms are forbidden and non-translatable")
@@ -96,7 +96,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_ColumnsInDifferentOrder_FAIL(t
 		Path: "reordered.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because apple is forbidden and non-translatable")
@@ -130,7 +130,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_NoRelevantColumns_PASS(t *testi
 		Path: "no_flags.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -157,7 +157,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_OnlyTranslatableColumn_PASS(t *
 		Path: "only_translatable.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -184,7 +184,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_OnlyForbiddenColumn_PASS(t *tes
 		Path: "only_forbidden.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -213,7 +213,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_BlankRowsAreSkipped(t *testing.
 		Path: "skipblank.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because world is forbidden and non-translatable")
@@ -240,7 +240,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_EmptyContent_PASS(t *testing.T)
 		Path: "empty.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if !res.OK {
 		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
@@ -271,7 +271,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_TruncatesAfter10(t *testing.T)
 		Path: "a_lot.csv",
 	}
 
-	res := validateNoForbiddenNonTranslatableTerms(ctx, a)
+	res := validateNoForbiddenNonTranslatableTerms(ctx, a, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because all terms are forbidden and non-translatable")
@@ -347,7 +347,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_BOMBeforeHeader(t *testing.T) {
 	res := validateNoForbiddenNonTranslatableTerms(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "bom.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because foo is forbidden and non-translatable")
@@ -370,7 +370,7 @@ func TestValidateNoForbiddenNonTranslatableTerms_NoTermColumn_ReportsRowOnly(t *
 	res := validateNoForbiddenNonTranslatableTerms(context.Background(), checks.Artifact{
 		Data: []byte(csv),
 		Path: "noterm.csv",
-	})
+	}, checks.DefaultMaxFindings, 0)
 
 	if res.OK {
 		t.Fatalf("expected OK=false because row is forbidden and non-translatable")
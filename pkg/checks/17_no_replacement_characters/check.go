@@ -0,0 +1,252 @@
+package replacement_characters
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-replacement-characters"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+	replacementChar   = "\uFFFD"
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnReplacementCharacters,
+			checks.WithPriority(checks.PrioContent+40),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnReplacementCharacters — entry point for the check.
+// There is no auto-fix: the original characters were lost before the file reached us.
+func runWarnReplacementCharacters(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnReplacementCharacters(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no replacement characters (U+FFFD) found",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnReplacementCharacters scans every cell (header included) for U+FFFD.
+// A file can be perfectly valid UTF-8 and still carry these characters when an
+// earlier tool decoded it with the wrong charset, so we report where the data was lost.
+func validateWarnReplacementCharacters(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for replacement characters",
+		}
+	}
+
+	if !bytes.Contains(data, []byte(replacementChar)) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no replacement characters (U+FFFD) found",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	hits, err := findReplacementCharacters(ctx, r, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating replacement characters",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no replacement characters (U+FFFD) found",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       replacementCharactersMessage(hits),
+		Findings:  replacementCharacterFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+type replacementHit struct {
+	rowNum int
+	column string
+	count  int
+}
+
+// replacementHits keeps up to the findings limit of cells but counts every character.
+type replacementHits struct {
+	checks.Capped[replacementHit]
+	chars int
+}
+
+func findReplacementCharacters(ctx context.Context, r csvReader, limit, stopAfter int) (replacementHits, error) {
+	var (
+		hits   = replacementHits{Capped: checks.Capped[replacementHit]{Limit: limit, StopAfter: stopAfter}}
+		header []string
+		rowNum int
+	)
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return replacementHits{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return replacementHits{}, ctxErr
+			}
+
+			return replacementHits{}, err
+		}
+
+		rowNum++
+
+		if header == nil && !isBlankCSVRecord(rec) {
+			header = rec
+		}
+
+		for i, cell := range rec {
+			n := strings.Count(cell, replacementChar)
+			if n == 0 {
+				continue
+			}
+
+			hits.chars += n
+			hits.Add(replacementHit{
+				rowNum: rowNum,
+				column: columnLabel(header, i),
+				count:  n,
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// columnLabel names a column by its header cell, falling back to a 1-based position
+// when the header is missing, blank, or itself damaged.
+func columnLabel(header []string, pos int) string {
+	if pos < len(header) {
+		name := strings.TrimSpace(header[pos])
+		if name != "" && !strings.Contains(name, replacementChar) {
+			return name
+		}
+	}
+
+	return "#" + strconv.Itoa(pos+1)
+}
+
+func replacementCharactersMessage(hits replacementHits) string {
+	limit := len(hits.Items)
+	if limit > maxReportedCells {
+		limit = maxReportedCells
+	}
+
+	var b strings.Builder
+	b.WriteString("replacement characters (U+FFFD) found, data was likely lost in an earlier conversion: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString("row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(" column ")
+		b.WriteString(strconv.Quote(hit.column))
+		b.WriteString(" x")
+		b.WriteString(strconv.Itoa(hit.count))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.chars))
+	b.WriteString(" characters in ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func replacementCharacterFindings(hits replacementHits) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Message: "replacement characters (U+FFFD) x" + strconv.Itoa(hit.count),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
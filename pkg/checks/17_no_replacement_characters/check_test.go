@@ -0,0 +1,169 @@
+package replacement_characters
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnReplacementCharacters_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr\n" +
+		"apple;fruit;pomme\n" +
+		"café;drink place;café\n"
+
+	res := validateWarnReplacementCharacters(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+		Path: "ok.csv",
+	}, checks.DefaultMaxFindings, 0)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+}
+
+func TestValidateWarnReplacementCharacters_ReportsRowsAndColumns(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr\n" +
+		"apple;fruit;pomme\n" +
+		"caf�;drink place;caf��\n"
+
+	res := validateWarnReplacementCharacters(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+		Path: "lost.csv",
+	}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false when U+FFFD is present")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic WARN (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{
+		`row 3 column "term" x1`,
+		`row 3 column "fr" x2`,
+		"total 3 characters in 2 cells",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateWarnReplacementCharacters_DamagedHeader_UsesPosition(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;descr�ption\nhello;world\n"
+
+	res := validateWarnReplacementCharacters(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false for damaged header")
+	}
+	if !strings.Contains(res.Msg, `row 1 column "#2"`) {
+		t.Fatalf("expected positional column label, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnReplacementCharacters_Truncates(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\n"
+	for range 15 {
+		csv += "bad�;desc\n"
+	}
+
+	res := validateWarnReplacementCharacters(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if got := strings.Count(res.Msg, "row "); got != maxReportedCells {
+		t.Fatalf("expected %d listed cells, got %d in %q", maxReportedCells, got, res.Msg)
+	}
+	if !strings.Contains(res.Msg, "total 15 characters in 15 cells") {
+		t.Fatalf("expected total in message, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnReplacementCharacters_BlankContent_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnReplacementCharacters(context.Background(), checks.Artifact{
+		Data: []byte("\xEF\xBB\xBF \n\n"),
+	}, checks.DefaultMaxFindings, 0)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true for blank content, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnReplacementCharacters_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnReplacementCharacters(ctx, checks.Artifact{
+		Data: []byte("term\nx�\n"),
+	}, checks.DefaultMaxFindings, 0)
+
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
+
+func TestRunWarnReplacementCharacters_EndToEnd_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data: []byte("term;fr\nhello;bonjour �\n"),
+		Path: "lost.csv",
+	}
+
+	out := runWarnReplacementCharacters(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixAlways,
+		RerunAfterFix: true,
+	})
+
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("check has no fixer, DidChange must be false")
+	}
+	if string(out.Final.Data) != string(a.Data) {
+		t.Fatalf("data must be unchanged")
+	}
+}
+
+func TestValidateWarnReplacementCharacters_CapsStoredCells(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\n"
+	for range 15 {
+		csv += "bad��;desc\n"
+	}
+
+	res := validateWarnReplacementCharacters(context.Background(), checks.Artifact{Data: []byte(csv)}, 12, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, "(total 30 characters in 15 cells) and 3 more (not stored)") {
+		t.Fatalf("expected totals and overflow in message, got %q", res.Msg)
+	}
+}
@@ -0,0 +1,362 @@
+package non_breaking_spaces
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-non-breaking-spaces"
+
+// settingSkipDescriptions controls whether description columns are left untouched (default: true).
+const settingSkipDescriptions = "skip-descriptions"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnNonBreakingSpaces,
+			checks.WithPriority(checks.PrioContent+45),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type nbspConfig struct {
+	skipDescriptions bool
+	maxFindings      int
+	stopAfter        int
+}
+
+func configFrom(opts checks.RunOptions) nbspConfig {
+	return nbspConfig{
+		skipDescriptions: opts.SettingBool(checkName, settingSkipDescriptions, true),
+		maxFindings:      opts.FindingsLimit(),
+		stopAfter:        opts.StopAfter(),
+	}
+}
+
+func runWarnNonBreakingSpaces(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnNonBreakingSpaces(ctx, a, cfg)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixNonBreakingSpaces(ctx, a, cfg)
+		},
+		PassMsg:          "no non-breaking spaces in term or locale values",
+		FixedMsg:         "replaced non-breaking spaces with regular spaces",
+		AppliedMsg:       "auto-fix applied: replaced non-breaking spaces with regular spaces",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "non-breaking spaces are still present after fix",
+	})
+}
+
+// validateWarnNonBreakingSpaces reports NBSP-like characters inside term and locale values.
+// They look like ordinary spaces but never match source text that uses U+0020.
+func validateWarnNonBreakingSpaces(ctx context.Context, a checks.Artifact, cfg nbspConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for non-breaking spaces",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readNBSPHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := targetColumns(header, cfg)
+	if len(cols) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no term or locale columns found (skipping non-breaking space check)",
+		}
+	}
+
+	hits, err := findNonBreakingSpaces(ctx, r, rowNum, cols, cfg.maxFindings, cfg.stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating non-breaking spaces",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no non-breaking spaces in term or locale values",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       nonBreakingSpacesMessage(hits),
+		Findings:  nonBreakingSpaceFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readNBSPHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for non-breaking spaces)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type targetColumn struct {
+	name string
+	pos  int
+}
+
+// targetColumns picks the term column, locale value columns and (optionally) description columns.
+// Flag and tags columns are never touched.
+func targetColumns(header []string, cfg nbspConfig) []targetColumn {
+	var cols []targetColumn
+
+	for i, h := range header {
+		name := normalizeHeaderCell(h)
+		if name == "" {
+			continue
+		}
+
+		switch {
+		case name == "term":
+		case name == "description" || strings.HasSuffix(name, "_description"):
+			if cfg.skipDescriptions {
+				continue
+			}
+		default:
+			if _, known := checks.KnownHeaders[name]; known {
+				continue
+			}
+		}
+
+		cols = append(cols, targetColumn{
+			name: strings.TrimSpace(h),
+			pos:  i,
+		})
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// isNonBreakingSpace matches NO-BREAK SPACE, NARROW NO-BREAK SPACE and FIGURE SPACE.
+func isNonBreakingSpace(r rune) bool {
+	switch r {
+	case '\u00A0', '\u202F', '\u2007':
+		return true
+	default:
+		return false
+	}
+}
+
+func countNonBreakingSpaces(s string) int {
+	n := 0
+	for _, r := range s {
+		if isNonBreakingSpace(r) {
+			n++
+		}
+	}
+
+	return n
+}
+
+type nbspHit struct {
+	rowNum int
+	column string
+	count  int
+}
+
+// nbspHits keeps up to the findings limit of cells but counts every character.
+type nbspHits struct {
+	checks.Capped[nbspHit]
+	chars int
+}
+
+func findNonBreakingSpaces(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols []targetColumn,
+	limit int,
+	stopAfter int,
+) (nbspHits, error) {
+	hits := nbspHits{Capped: checks.Capped[nbspHit]{Limit: limit, StopAfter: stopAfter}}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nbspHits{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nbspHits{}, ctxErr
+			}
+
+			return nbspHits{}, err
+		}
+
+		rowNum++
+
+		for _, col := range cols {
+			if col.pos >= len(rec) {
+				continue
+			}
+
+			n := countNonBreakingSpaces(rec[col.pos])
+			if n == 0 {
+				continue
+			}
+
+			hits.chars += n
+			hits.Add(nbspHit{
+				rowNum: rowNum,
+				column: col.name,
+				count:  n,
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+func nonBreakingSpacesMessage(hits nbspHits) string {
+	limit := len(hits.Items)
+	if limit > maxReportedCells {
+		limit = maxReportedCells
+	}
+
+	var b strings.Builder
+	b.WriteString("non-breaking spaces found: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(") x")
+		b.WriteString(strconv.Itoa(hit.count))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.chars))
+	b.WriteString(" in ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func nonBreakingSpaceFindings(hits nbspHits) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Message: "non-breaking spaces x" + strconv.Itoa(hit.count),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,167 @@
+package non_breaking_spaces
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnNonBreakingSpaces_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr\n" +
+		"ice cream;cold dessert;glace\n"
+
+	res := validateWarnNonBreakingSpaces(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, nbspConfig{skipDescriptions: true})
+
+	if !res.OK {
+		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+}
+
+func TestValidateWarnNonBreakingSpaces_TermAndLocale_Warn(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr;casesensitive\n" +
+		"ice\u00a0cream;cold dessert;crème\u202fglacée;no\n" +
+		"apple;fruit;pomme;no\n"
+
+	res := validateWarnNonBreakingSpaces(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, nbspConfig{skipDescriptions: true})
+
+	if res.OK {
+		t.Fatalf("expected OK=false, NBSP present in term and fr")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+
+	for _, want := range []string{"term (row 2) x1", "fr (row 2) x1", "total 2 in 2 cells"} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateWarnNonBreakingSpaces_DescriptionsSkippedByDefault(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en;en_description\n" +
+		"cloud;remote\u00a0servers;cloud;hosted\u00a0compute\n"
+
+	a := checks.Artifact{Data: []byte(csv)}
+
+	res := validateWarnNonBreakingSpaces(context.Background(), a, configFrom(checks.RunOptions{}))
+	if !res.OK {
+		t.Fatalf("descriptions must be skipped by default, got %q", res.Msg)
+	}
+
+	res = validateWarnNonBreakingSpaces(context.Background(), a, configFrom(checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingSkipDescriptions: "false"},
+		},
+	}))
+	if res.OK {
+		t.Fatalf("expected description columns to be checked when skip-descriptions=false")
+	}
+	if !strings.Contains(res.Msg, "en_description (row 2)") || !strings.Contains(res.Msg, "description (row 2)") {
+		t.Fatalf("expected description columns in message, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnNonBreakingSpaces_NoHeader_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnNonBreakingSpaces(context.Background(), checks.Artifact{
+		Data: []byte("\n \n"),
+	}, nbspConfig{skipDescriptions: true})
+
+	if !res.OK {
+		t.Fatalf("expected OK=true for blank content, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnNonBreakingSpaces_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnNonBreakingSpaces(ctx, checks.Artifact{
+		Data: []byte("term\nice\u00a0cream\n"),
+	}, nbspConfig{})
+
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
+
+func TestRunWarnNonBreakingSpaces_NoFix_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data: []byte("term;fr\nice\u00a0cream;glace\n"),
+		Path: "nbsp.csv",
+	}
+
+	out := runWarnNonBreakingSpaces(context.Background(), a, checks.RunOptions{FixMode: checks.FixNone})
+
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("no fix requested, DidChange must be false")
+	}
+}
+
+func TestRunWarnNonBreakingSpaces_FixAndRerun_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data: []byte("term;fr\nice\u00a0cream;glace\n"),
+		Path: "nbsp.csv",
+	}
+
+	out := runWarnNonBreakingSpaces(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixIfNotPass,
+		RerunAfterFix: true,
+	})
+
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if got := string(out.Final.Data); got != "term;fr\nice cream;glace\n" {
+		t.Fatalf("unexpected fixed data: %q", got)
+	}
+}
+
+func TestValidateWarnNonBreakingSpaces_CapsStoredCells(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\n"
+	for range 5 {
+		csv += "a b;desc\n"
+	}
+
+	res := validateWarnNonBreakingSpaces(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, nbspConfig{skipDescriptions: true, maxFindings: 2})
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, "(total 5 in 5 cells) and 3 more (not stored)") {
+		t.Fatalf("expected totals and overflow in message, got %q", res.Msg)
+	}
+}
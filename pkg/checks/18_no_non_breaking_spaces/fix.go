@@ -0,0 +1,262 @@
+package non_breaking_spaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixNonBreakingSpaces replaces NBSP-like characters with U+0020 in the targeted columns.
+// The header row and untargeted columns are copied as-is.
+func fixNonBreakingSpaces(ctx context.Context, a checks.Artifact, cfg nbspConfig) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findNBSPFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readNBSPFixRecords(ctx, appendNBSPFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	cols := targetColumns(records[0], cfg)
+	replaced, err := replaceNonBreakingSpaces(ctx, records, cols)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if replaced == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no non-breaking spaces to replace",
+		}, nil
+	}
+
+	outTail, err := writeNBSPFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchNBSPFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "replaced " + strconv.Itoa(replaced) + " non-breaking spaces with regular spaces",
+	}, nil
+}
+
+// replaceNonBreakingSpaces rewrites records in place and returns the number of replaced characters.
+func replaceNonBreakingSpaces(
+	ctx context.Context,
+	records [][]string,
+	cols []targetColumn,
+) (int, error) {
+	replaced := 0
+
+	for i := 1; i < len(records); i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		row := records[i]
+
+		for _, col := range cols {
+			if col.pos >= len(row) {
+				continue
+			}
+
+			n := countNonBreakingSpaces(row[col.pos])
+			if n == 0 {
+				continue
+			}
+
+			row[col.pos] = strings.Map(func(r rune) rune {
+				if isNonBreakingSpace(r) {
+					return ' '
+				}
+				return r
+			}, row[col.pos])
+			replaced += n
+		}
+	}
+
+	return replaced, nil
+}
+
+type nbspFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findNBSPFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (nbspFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return nbspFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := nbspFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return nbspFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return nbspFixHeaderParts{}, false, nil
+}
+
+func nbspFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendNBSPFixHeaderAndRest(parts nbspFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readNBSPFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeNBSPFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchNBSPFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,98 @@
+package non_breaking_spaces
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixNonBreakingSpaces_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("  \n")}
+
+	fr, err := fixNonBreakingSpaces(context.Background(), a, nbspConfig{skipDescriptions: true})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixNonBreakingSpaces_ReplacesTargetColumnsOnly(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF\r\n" +
+		"term;description;fr;tags\r\n" +
+		"ice\u00a0cream;cold\u00a0dessert;crème\u202fglacée;a\u00a0b\r\n" +
+		"apple;fruit;pomme;x"
+
+	fr, err := fixNonBreakingSpaces(context.Background(), checks.Artifact{Data: []byte(in)}, nbspConfig{skipDescriptions: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF\r\n" +
+		"term;description;fr;tags\r\n" +
+		"ice cream;cold\u00a0dessert;crème glacée;a\u00a0b\r\n" +
+		"apple;fruit;pomme;x"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if !strings.Contains(fr.Note, "replaced 2 non-breaking spaces") {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixNonBreakingSpaces_IncludesDescriptionsWhenConfigured(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description\nice\u00a0cream;cold\u00a0dessert\n"
+
+	fr, err := fixNonBreakingSpaces(context.Background(), checks.Artifact{Data: []byte(in)}, nbspConfig{skipDescriptions: false})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := string(fr.Data); got != "term;description\nice cream;cold dessert\n" {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
+
+func TestFixNonBreakingSpaces_NothingToReplace_NoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description\nice cream;cold\u00a0dessert\n"
+
+	fr, err := fixNonBreakingSpaces(context.Background(), checks.Artifact{Data: []byte(in)}, nbspConfig{skipDescriptions: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("expected DidChange=false")
+	}
+	if string(fr.Data) != in {
+		t.Fatalf("data must be unchanged")
+	}
+}
+
+func TestFixNonBreakingSpaces_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixNonBreakingSpaces(ctx, checks.Artifact{Data: []byte("term\nx\n")}, nbspConfig{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,359 @@
+package term_overlaps
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-term-overlaps"
+
+// settingThreshold is the number of overlapping pairs tolerated before the check reports (default: 0).
+const settingThreshold = "threshold"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedPairs  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnTermOverlaps,
+			checks.WithOptIn(),
+			checks.WithPriority(checks.PrioSemantic+5),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnTermOverlaps — entry point for the check.
+// Informational only: overlaps are often intentional, so there is no auto-fix.
+func runWarnTermOverlaps(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	threshold := opts.SettingInt(checkName, settingThreshold, 0)
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnTermOverlaps(ctx, a, threshold, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no overlapping terms above threshold",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnTermOverlaps reports pairs where one term occurs as a whole-word sequence
+// inside another ("cloud" vs "cloud storage", "storage" vs "cloud storage").
+// Matching is case-insensitive; whitespace runs count as a single separator.
+func validateWarnTermOverlaps(ctx context.Context, a checks.Artifact, threshold, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for term overlaps",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTermHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	termCol := findTermColumn(header)
+	if termCol < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no 'term' column found (skipping term overlap check)",
+		}
+	}
+
+	terms, err := collectTerms(ctx, r, rowNum, termCol)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating term overlaps",
+			Err: err,
+		}
+	}
+
+	pairs, err := findOverlaps(ctx, terms, limit, stopAfter)
+	if err != nil {
+		return cancelledValidation(err)
+	}
+
+	if pairs.Total() <= threshold {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "overlapping term pairs: " + strconv.Itoa(pairs.Total()) + " (threshold " + strconv.Itoa(threshold) + ")",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       overlapsMessage(pairs),
+		Findings:  overlapFindings(pairs),
+		Truncated: pairs.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTermHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for term overlaps)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findTermColumn(header []string) int {
+	for i, col := range header {
+		if normalizeHeaderCell(col) == "term" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type termEntry struct {
+	term   string
+	key    string
+	words  []string
+	rowNum int
+}
+
+// collectTerms returns distinct terms in file order; the first occurrence wins.
+func collectTerms(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	termCol int,
+) ([]termEntry, error) {
+	var terms []termEntry
+	seen := make(map[string]struct{})
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return terms, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+
+			return nil, err
+		}
+
+		rowNum++
+
+		if termCol >= len(rec) {
+			continue
+		}
+
+		words := strings.Fields(strings.ToLower(rec[termCol]))
+		if len(words) == 0 {
+			continue
+		}
+
+		key := strings.Join(words, " ")
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		terms = append(terms, termEntry{
+			term:   strings.TrimSpace(rec[termCol]),
+			key:    key,
+			words:  words,
+			rowNum: rowNum,
+		})
+	}
+}
+
+type overlapPair struct {
+	inner termEntry
+	outer termEntry
+}
+
+// findOverlaps looks up every contiguous word sequence of each multi-word term in the term set.
+// Cost is quadratic in words per term, not in the number of terms.
+func findOverlaps(ctx context.Context, terms []termEntry, limit, stopAfter int) (checks.Capped[overlapPair], error) {
+	byKey := make(map[string]int, len(terms))
+	for i, t := range terms {
+		byKey[t.key] = i
+	}
+
+	pairs := checks.Capped[overlapPair]{Limit: limit, StopAfter: stopAfter}
+
+	for i, outer := range terms {
+		if i%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[overlapPair]{}, err
+			}
+		}
+
+		n := len(outer.words)
+		if n < 2 {
+			continue
+		}
+
+		reported := make(map[int]struct{})
+
+		for size := n - 1; size >= 1; size-- {
+			for start := 0; start+size <= n; start++ {
+				key := strings.Join(outer.words[start:start+size], " ")
+
+				idx, ok := byKey[key]
+				if !ok {
+					continue
+				}
+				if _, dup := reported[idx]; dup {
+					continue
+				}
+				reported[idx] = struct{}{}
+
+				pairs.Add(overlapPair{
+					inner: terms[idx],
+					outer: outer,
+				})
+				if pairs.Exhausted() {
+					return pairs, nil
+				}
+			}
+		}
+	}
+
+	return pairs, nil
+}
+
+func overlapsMessage(pairs checks.Capped[overlapPair]) string {
+	limit := len(pairs.Items)
+	if limit > maxReportedPairs {
+		limit = maxReportedPairs
+	}
+
+	var b strings.Builder
+	b.WriteString("terms overlapping other terms: ")
+
+	for i := range limit {
+		p := pairs.Items[i]
+
+		b.WriteString(strconv.Quote(p.inner.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(p.inner.rowNum))
+		b.WriteString(") in ")
+		b.WriteString(strconv.Quote(p.outer.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(p.outer.rowNum))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if pairs.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(pairs.Total()))
+	b.WriteString(" pairs)")
+	b.WriteString(checks.OverflowNote(pairs.Overflow))
+	b.WriteString(pairs.TruncatedNote())
+
+	return b.String()
+}
+
+// overlapFindings reports each pair on the row of the longer term.
+func overlapFindings(pairs checks.Capped[overlapPair]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(pairs.Items))
+	for _, p := range pairs.Items {
+		out = append(out, checks.Finding{
+			Row:     p.outer.rowNum,
+			Column:  "term",
+			Value:   p.outer.term,
+			Message: "contains term " + strconv.Quote(p.inner.term) + " (row " + strconv.Itoa(p.inner.rowNum) + ")",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
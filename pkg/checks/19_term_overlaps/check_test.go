@@ -0,0 +1,107 @@
+package term_overlaps
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnTermOverlaps_NoOverlaps_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description\n" +
+		"apple;fruit\n" +
+		"pear;fruit\n" +
+		"cloudy;weather\n" +
+		"cloud storage;service\n"
+
+	res := validateWarnTermOverlaps(context.Background(), checks.Artifact{Data: []byte(csv)}, 0, checks.DefaultMaxFindings, 0)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true (no whole-word overlaps), got %q", res.Msg)
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+}
+
+func TestValidateWarnTermOverlaps_PrefixAndInner_Warn(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description\n" +
+		"Cloud;service\n" +
+		"cloud  storage;service\n" +
+		"storage;disk\n" +
+		"cloud;dup ignored\n"
+
+	res := validateWarnTermOverlaps(context.Background(), checks.Artifact{Data: []byte(csv)}, 0, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false, overlaps are present")
+	}
+
+	for _, want := range []string{
+		`"Cloud" (row 2) in "cloud  storage" (row 3)`,
+		`"storage" (row 4) in "cloud  storage" (row 3)`,
+		"total 2 pairs",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateWarnTermOverlaps_Threshold(t *testing.T) {
+	t.Parallel()
+
+	csv := "term\ncloud\ncloud storage\n"
+
+	res := validateWarnTermOverlaps(context.Background(), checks.Artifact{Data: []byte(csv)}, 1, checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true when pairs do not exceed threshold, got %q", res.Msg)
+	}
+	if !strings.Contains(res.Msg, "overlapping term pairs: 1 (threshold 1)") {
+		t.Fatalf("unexpected pass message: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnTermOverlaps_NoTermColumn_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnTermOverlaps(context.Background(), checks.Artifact{Data: []byte("description\nx\n")}, 0, checks.DefaultMaxFindings, 0)
+	if !res.OK || !strings.Contains(res.Msg, "no 'term' column") {
+		t.Fatalf("expected skip pass, got OK=%v Msg=%q", res.OK, res.Msg)
+	}
+}
+
+func TestRunWarnTermOverlaps_OptIn(t *testing.T) {
+	t.Parallel()
+
+	unit, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check %q is not registered", checkName)
+	}
+
+	a := checks.Artifact{Data: []byte("term\ncloud\ncloud storage\n"), Path: "g.csv"}
+
+	out := unit.Run(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Skipped || !strings.Contains(out.Result.Message, "opt-in") {
+		t.Fatalf("expected disabled opt-in SKIPPED, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+
+	out = unit.Run(context.Background(), a, checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {checks.SettingEnabled: "yes"},
+		},
+	})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN when enabled, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("informational check must not change data")
+	}
+}
@@ -13,18 +13,16 @@ const checkName = "ensure-valid-extension"
 const expectedExt = ".csv"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureCSV,
-		checks.WithFailFast(),
-		checks.WithPriority(1),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureCSV,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
 }
 
 func runEnsureCSV(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
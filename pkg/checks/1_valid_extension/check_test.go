@@ -18,7 +18,7 @@ func TestEnsureValidExtension_Metadata(t *testing.T) {
 		checkName,
 		runEnsureCSV,
 		checks.WithFailFast(),
-		checks.WithPriority(1),
+		checks.WithPriority(checks.PrioStructural),
 	)
 	if err != nil {
 		t.Fatalf("NewCheckAdapter: %v", err)
@@ -37,7 +37,7 @@ func TestEnsureValidExtension_Metadata(t *testing.T) {
 	if !c.FailFast() {
 		t.Fatalf("FailFast() = false, want true")
 	}
-	if got, want := c.Priority(), 1; got != want {
+	if got, want := c.Priority(), checks.PrioStructural; got != want {
 		t.Fatalf("Priority() = %d, want %d", got, want)
 	}
 }
@@ -0,0 +1,407 @@
+package language_mismatch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-language-mismatch"
+
+const (
+	// settingSampleSize caps how many non-empty values are sampled per locale column (default: 200).
+	settingSampleSize = "sample-size"
+	// settingMinConfidence is the minimum share (percent) of the winning signal before we report (default: 80).
+	settingMinConfidence = "min-confidence"
+	// settingMinEvidence is the minimum number of signals a column needs before we judge it (default: 10).
+	settingMinEvidence = "min-evidence"
+)
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCols   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnLanguageMismatch,
+			checks.WithOptIn(),
+			checks.WithPriority(checks.PrioSemantic+15),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type detectConfig struct {
+	sampleSize    int
+	minConfidence float64
+	minEvidence   int
+}
+
+func configFrom(opts checks.RunOptions) detectConfig {
+	cfg := detectConfig{
+		sampleSize:    opts.SettingInt(checkName, settingSampleSize, 200),
+		minConfidence: float64(opts.SettingInt(checkName, settingMinConfidence, 80)) / 100,
+		minEvidence:   opts.SettingInt(checkName, settingMinEvidence, 10),
+	}
+
+	if cfg.sampleSize <= 0 {
+		cfg.sampleSize = 200
+	}
+
+	return cfg
+}
+
+// runWarnLanguageMismatch — entry point for the check.
+// Detection is heuristic, so this never fails the run and never fixes anything.
+func runWarnLanguageMismatch(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnLanguageMismatch(ctx, a, cfg)
+		},
+		Fix:     nil,
+		PassMsg: "locale columns look like their declared languages",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnLanguageMismatch samples each locale column and warns when the text
+// is confidently in another script or (for a few Latin languages) another language.
+func validateWarnLanguageMismatch(ctx context.Context, a checks.Artifact, cfg detectConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for language mismatch",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readLanguageHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := findLocaleColumns(header)
+	if len(cols) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no locale columns found (skipping language mismatch check)",
+		}
+	}
+
+	evidence, err := sampleLocaleColumns(ctx, r, rowNum, cols, cfg.sampleSize)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating language mismatch",
+			Err: err,
+		}
+	}
+
+	var mismatches []languageMismatch
+	for i, col := range cols {
+		if m, bad := judgeColumn(col, evidence[i], cfg); bad {
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	if len(mismatches) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "locale columns look like their declared languages",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: languageMismatchMessage(mismatches),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readLanguageHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for language mismatch)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type localeColumn struct {
+	label string
+	lang  string
+	pos   int
+}
+
+// findLocaleColumns returns translation value columns (not *_description) with their base language.
+func findLocaleColumns(header []string) []localeColumn {
+	var cols []localeColumn
+
+	for i, h := range header {
+		label := strings.TrimSpace(h)
+		name := strings.ToLower(label)
+
+		if _, known := checks.KnownHeaders[name]; known {
+			continue
+		}
+		if strings.HasSuffix(name, "_description") || !looksLikeLangCode(name) {
+			continue
+		}
+
+		base, _, _ := strings.Cut(strings.ReplaceAll(name, "-", "_"), "_")
+
+		cols = append(cols, localeColumn{
+			label: label,
+			lang:  base,
+			pos:   i,
+		})
+	}
+
+	return cols
+}
+
+func sampleLocaleColumns(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols []localeColumn,
+	sampleSize int,
+) ([]*columnEvidence, error) {
+	evidence := make([]*columnEvidence, len(cols))
+	sampled := make([]int, len(cols))
+	for i := range cols {
+		evidence[i] = newColumnEvidence()
+	}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		if !slices.ContainsFunc(sampled, func(n int) bool { return n < sampleSize }) {
+			return evidence, nil
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return evidence, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+
+			return nil, err
+		}
+
+		rowNum++
+
+		for i, col := range cols {
+			if sampled[i] >= sampleSize || col.pos >= len(rec) {
+				continue
+			}
+
+			value := strings.TrimSpace(rec[col.pos])
+			if value == "" {
+				continue
+			}
+
+			evidence[i].add(value)
+			sampled[i]++
+		}
+	}
+}
+
+type languageMismatch struct {
+	column     string
+	looksLike  string
+	confidence float64
+	signals    int
+}
+
+func judgeColumn(col localeColumn, ev *columnEvidence, cfg detectConfig) (languageMismatch, bool) {
+	dom, share, letters := ev.dominantScript()
+	if letters < cfg.minEvidence || share < cfg.minConfidence {
+		return languageMismatch{}, false
+	}
+
+	if !slices.Contains(expectedScripts(col.lang), dom) {
+		return languageMismatch{
+			column:     col.label,
+			looksLike:  string(dom) + " script",
+			confidence: share,
+			signals:    letters,
+		}, true
+	}
+
+	// Latin-language disambiguation only makes sense when we know the column's own profile.
+	if dom != scriptLatin {
+		return languageMismatch{}, false
+	}
+	if _, known := latinProfiles[col.lang]; !known {
+		return languageMismatch{}, false
+	}
+
+	lang, share, signals := ev.dominantLatinLanguage()
+	if signals < cfg.minEvidence || share < cfg.minConfidence || lang == col.lang {
+		return languageMismatch{}, false
+	}
+
+	return languageMismatch{
+		column:     col.label,
+		looksLike:  strconv.Quote(lang),
+		confidence: share,
+		signals:    signals,
+	}, true
+}
+
+func languageMismatchMessage(mismatches []languageMismatch) string {
+	limit := len(mismatches)
+	if limit > maxReportedCols {
+		limit = maxReportedCols
+	}
+
+	var b strings.Builder
+	b.WriteString("locale columns look like a different language: ")
+
+	for i := range limit {
+		m := mismatches[i]
+
+		b.WriteString(m.column)
+		b.WriteString(" looks like ")
+		b.WriteString(m.looksLike)
+		b.WriteString(" (confidence ")
+		b.WriteString(strconv.Itoa(int(m.confidence * 100)))
+		b.WriteString("%, ")
+		b.WriteString(strconv.Itoa(m.signals))
+		b.WriteString(" signals)")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if len(mismatches) > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(len(mismatches)))
+	b.WriteString(" columns)")
+
+	return b.String()
+}
+
+func looksLikeLangCode(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	s = strings.ReplaceAll(s, "-", "_")
+
+	parts := strings.Split(s, "_")
+
+	first := parts[0]
+	if len(first) < 2 || len(first) > 3 {
+		return false
+	}
+
+	for _, r := range first {
+		if !isASCIILetter(r) {
+			return false
+		}
+	}
+
+	for _, seg := range parts[1:] {
+		if seg == "" {
+			return false
+		}
+
+		for _, r := range seg {
+			if !isASCIILetter(r) && !isASCIIDigit(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,145 @@
+package language_mismatch
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+var testCfg = detectConfig{sampleSize: 200, minConfidence: 0.8, minEvidence: 10}
+
+func TestValidateWarnLanguageMismatch_MatchingColumns_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr;ru;de\n" +
+		"cloud storage;service;le stockage dans le nuage;облачное хранилище;der Speicher für die Cloud\n" +
+		"user account;login;le compte de l'utilisateur;учётная запись;das Konto für den Benutzer\n"
+
+	res := validateWarnLanguageMismatch(context.Background(), checks.Artifact{Data: []byte(csv)}, testCfg)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+}
+
+func TestValidateWarnLanguageMismatch_WrongScript_Warn(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;fr\n" +
+		"cloud storage;облачное хранилище\n" +
+		"user account;учётная запись\n"
+
+	res := validateWarnLanguageMismatch(context.Background(), checks.Artifact{Data: []byte(csv)}, testCfg)
+
+	if res.OK {
+		t.Fatalf("expected OK=false for Cyrillic text in fr column")
+	}
+	if !strings.Contains(res.Msg, "fr looks like Cyrillic script (confidence 100%") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnLanguageMismatch_GermanInFrenchColumn_Warn(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;fr\n" +
+		"storage;der Speicher für die Daten\n" +
+		"account;das Konto und die Größe\n" +
+		"login;Anmeldung mit dem Schlüssel\n" +
+		"user;der Benutzer und der Zugang\n"
+
+	res := validateWarnLanguageMismatch(context.Background(), checks.Artifact{Data: []byte(csv)}, testCfg)
+
+	if res.OK {
+		t.Fatalf("expected OK=false for German text in fr column")
+	}
+	if !strings.Contains(res.Msg, `fr looks like "de"`) {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnLanguageMismatch_NotEnoughEvidence_Pass(t *testing.T) {
+	t.Parallel()
+
+	// Single short words carry too little signal to judge.
+	csv := "term;fr\nok;der\n"
+
+	res := validateWarnLanguageMismatch(context.Background(), checks.Artifact{Data: []byte(csv)}, testCfg)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true when evidence is below the minimum, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnLanguageMismatch_UnknownLatinProfile_OnlyScriptChecked(t *testing.T) {
+	t.Parallel()
+
+	// "lv" has no Latin profile, so German-looking text is not judged; the script still matches.
+	csv := "term;lv\nstorage;der Speicher für die Daten und das Konto\n"
+
+	res := validateWarnLanguageMismatch(context.Background(), checks.Artifact{Data: []byte(csv)}, testCfg)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true for column without Latin profile, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnLanguageMismatch_NoLocaleColumns_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnLanguageMismatch(context.Background(), checks.Artifact{
+		Data: []byte("term;description\nx;y\n"),
+	}, testCfg)
+
+	if !res.OK || !strings.Contains(res.Msg, "no locale columns") {
+		t.Fatalf("expected skip pass, got OK=%v Msg=%q", res.OK, res.Msg)
+	}
+}
+
+func TestConfigFrom_Settings(t *testing.T) {
+	t.Parallel()
+
+	cfg := configFrom(checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {
+				settingSampleSize:    "5",
+				settingMinConfidence: "60",
+				settingMinEvidence:   "3",
+			},
+		},
+	})
+
+	if cfg.sampleSize != 5 || cfg.minConfidence != 0.6 || cfg.minEvidence != 3 {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+
+	if got := configFrom(checks.RunOptions{}); got != testCfg {
+		t.Fatalf("unexpected defaults: %+v", got)
+	}
+}
+
+func TestRunWarnLanguageMismatch_EndToEnd_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data: []byte("term;ja\nstorage;облачное хранилище\n"),
+		Path: "g.csv",
+	}
+
+	out := runWarnLanguageMismatch(context.Background(), a, checks.RunOptions{FixMode: checks.FixAlways})
+
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("detection check must not change data")
+	}
+}
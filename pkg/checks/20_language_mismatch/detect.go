@@ -0,0 +1,193 @@
+package language_mismatch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// script is a coarse writing-system bucket; enough to catch "Cyrillic text in the fr column".
+type script string
+
+const (
+	scriptLatin      script = "Latin"
+	scriptCyrillic   script = "Cyrillic"
+	scriptGreek      script = "Greek"
+	scriptArabic     script = "Arabic"
+	scriptHebrew     script = "Hebrew"
+	scriptHan        script = "Han"
+	scriptKana       script = "Kana"
+	scriptHangul     script = "Hangul"
+	scriptThai       script = "Thai"
+	scriptDevanagari script = "Devanagari"
+	scriptGeorgian   script = "Georgian"
+	scriptArmenian   script = "Armenian"
+)
+
+var scriptTables = []struct {
+	s     script
+	table *unicode.RangeTable
+}{
+	{scriptLatin, unicode.Latin},
+	{scriptCyrillic, unicode.Cyrillic},
+	{scriptGreek, unicode.Greek},
+	{scriptArabic, unicode.Arabic},
+	{scriptHebrew, unicode.Hebrew},
+	{scriptHan, unicode.Han},
+	{scriptKana, unicode.Hiragana},
+	{scriptKana, unicode.Katakana},
+	{scriptHangul, unicode.Hangul},
+	{scriptThai, unicode.Thai},
+	{scriptDevanagari, unicode.Devanagari},
+	{scriptGeorgian, unicode.Georgian},
+	{scriptArmenian, unicode.Armenian},
+}
+
+func scriptOf(r rune) (script, bool) {
+	for _, st := range scriptTables {
+		if unicode.Is(st.table, r) {
+			return st.s, true
+		}
+	}
+
+	return "", false
+}
+
+// nonLatinScripts lists languages whose text is expected outside the Latin script.
+// Languages not listed here are assumed to be written in Latin.
+var nonLatinScripts = map[string][]script{
+	"ru": {scriptCyrillic},
+	"uk": {scriptCyrillic},
+	"be": {scriptCyrillic},
+	"bg": {scriptCyrillic},
+	"mk": {scriptCyrillic},
+	"kk": {scriptCyrillic},
+	"ky": {scriptCyrillic},
+	"mn": {scriptCyrillic},
+	"sr": {scriptCyrillic, scriptLatin},
+	"el": {scriptGreek},
+	"ar": {scriptArabic},
+	"fa": {scriptArabic},
+	"ur": {scriptArabic},
+	"he": {scriptHebrew},
+	"zh": {scriptHan},
+	"ja": {scriptKana, scriptHan},
+	"ko": {scriptHangul, scriptHan},
+	"th": {scriptThai},
+	"hi": {scriptDevanagari},
+	"mr": {scriptDevanagari},
+	"ne": {scriptDevanagari},
+	"ka": {scriptGeorgian},
+	"hy": {scriptArmenian},
+}
+
+func expectedScripts(lang string) []script {
+	if s, ok := nonLatinScripts[lang]; ok {
+		return s
+	}
+
+	return []script{scriptLatin}
+}
+
+// latinProfile is a deliberately tiny fingerprint: frequent function words and
+// letters that are rare outside the language. Short glossary values rarely contain
+// either, which is why the check demands a minimum amount of evidence.
+type latinProfile struct {
+	stopwords map[string]struct{}
+	letters   string
+}
+
+func newProfile(words, letters string) latinProfile {
+	p := latinProfile{
+		stopwords: make(map[string]struct{}),
+		letters:   letters,
+	}
+	for _, w := range strings.Fields(words) {
+		p.stopwords[w] = struct{}{}
+	}
+
+	return p
+}
+
+var latinProfiles = map[string]latinProfile{
+	"en": newProfile("the and of to is for with on this that are by from or not your you", ""),
+	"de": newProfile("der die das und ist nicht mit ein eine für auf den dem von zu im sie wir ich", "äöüß"),
+	"fr": newProfile("le la les et est des un une pour dans du avec sur pas vous nous ce au aux", "éèêàçœùûîïëâ"),
+	"es": newProfile("el los las y es del un una para con por que se su al está", "ñáíóú¿¡"),
+	"it": newProfile("il lo gli e è di del della un una per con che non sono nel", "àèéìòù"),
+	"pt": newProfile("o os as e é do da dos das um uma para com não em que na", "ãõçáâêô"),
+	"nl": newProfile("de het een en van is niet met voor op dat die zijn te", ""),
+	"pl": newProfile("i w z na się nie do jest to że jak od dla", "ąćęłńśźż"),
+}
+
+// columnEvidence accumulates signals for a single locale column.
+type columnEvidence struct {
+	scripts map[script]int
+	langs   map[string]int
+}
+
+func newColumnEvidence() *columnEvidence {
+	return &columnEvidence{
+		scripts: make(map[script]int),
+		langs:   make(map[string]int),
+	}
+}
+
+func (e *columnEvidence) add(value string) {
+	for _, r := range value {
+		if s, ok := scriptOf(r); ok {
+			e.scripts[s]++
+		}
+	}
+
+	lower := strings.ToLower(value)
+
+	words := strings.FieldsFunc(lower, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, w := range words {
+		for lang, p := range latinProfiles {
+			if _, ok := p.stopwords[w]; ok {
+				e.langs[lang] += 2
+			}
+		}
+	}
+
+	for _, r := range lower {
+		for lang, p := range latinProfiles {
+			if p.letters != "" && strings.ContainsRune(p.letters, r) {
+				e.langs[lang]++
+			}
+		}
+	}
+}
+
+// dominantScript returns the most frequent script, its share, and the total letter count.
+func (e *columnEvidence) dominantScript() (script, float64, int) {
+	return dominant(e.scripts)
+}
+
+// dominantLatinLanguage returns the best-scoring Latin profile, its share, and total evidence.
+func (e *columnEvidence) dominantLatinLanguage() (string, float64, int) {
+	return dominant(e.langs)
+}
+
+func dominant[K ~string](counts map[K]int) (K, float64, int) {
+	var (
+		best      K
+		bestCount int
+		total     int
+	)
+
+	for k, n := range counts {
+		total += n
+		if n > bestCount || (n == bestCount && k < best) {
+			best, bestCount = k, n
+		}
+	}
+
+	if total == 0 {
+		return best, 0, 0
+	}
+
+	return best, float64(bestCount) / float64(total), total
+}
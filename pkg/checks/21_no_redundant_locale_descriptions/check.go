@@ -0,0 +1,322 @@
+package redundant_locale_descriptions
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-redundant-locale-descriptions"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnRedundantLocaleDescriptions,
+			checks.WithPriority(checks.PrioSemantic+20),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+func runWarnRedundantLocaleDescriptions(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnRedundantLocaleDescriptions(ctx, a, limit, stopAfter)
+		},
+		Fix:              fixRedundantLocaleDescriptions,
+		PassMsg:          "no locale descriptions duplicating the main description",
+		FixedMsg:         "blanked locale descriptions duplicating the main description",
+		AppliedMsg:       "auto-fix applied: blanked locale descriptions duplicating the main description",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "redundant locale descriptions remain after fix",
+	})
+}
+
+// validateWarnRedundantLocaleDescriptions warns about rows where a <locale>_description cell
+// is exactly the same (ignoring surrounding whitespace) as the main description cell.
+func validateWarnRedundantLocaleDescriptions(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for redundant locale descriptions",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readDescriptionHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols, ok := findDescriptionColumns(header)
+	if !ok {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no description and *_description columns found (skipping redundant description check)",
+		}
+	}
+
+	hits, err := findRedundantDescriptions(ctx, r, rowNum, cols, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating redundant locale descriptions",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no locale descriptions duplicating the main description",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       redundantDescriptionsMessage(hits),
+		Findings:  redundantDescriptionFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readDescriptionHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for redundant locale descriptions)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type localeDescriptionColumn struct {
+	name string
+	pos  int
+}
+
+type descriptionColumns struct {
+	description int
+	locales     []localeDescriptionColumn
+}
+
+func findDescriptionColumns(header []string) (descriptionColumns, bool) {
+	cols := descriptionColumns{description: -1}
+
+	for i, h := range header {
+		name := normalizeHeaderCell(h)
+
+		switch {
+		case name == "description":
+			if cols.description < 0 {
+				cols.description = i
+			}
+		case strings.HasSuffix(name, "_description") && name != "_description":
+			cols.locales = append(cols.locales, localeDescriptionColumn{
+				name: strings.TrimSpace(h),
+				pos:  i,
+			})
+		}
+	}
+
+	return cols, cols.description >= 0 && len(cols.locales) > 0
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// redundantColumns returns the positions of locale description cells that repeat the main description.
+func redundantColumns(record []string, cols descriptionColumns) []localeDescriptionColumn {
+	main := cellValue(record, cols.description)
+	if main == "" {
+		return nil
+	}
+
+	var out []localeDescriptionColumn
+	for _, col := range cols.locales {
+		if cellValue(record, col.pos) == main {
+			out = append(out, col)
+		}
+	}
+
+	return out
+}
+
+func cellValue(record []string, pos int) string {
+	if pos < 0 || pos >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[pos])
+}
+
+type redundantHit struct {
+	rowNum int
+	column string
+}
+
+func findRedundantDescriptions(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols descriptionColumns,
+	limit int,
+	stopAfter int,
+) (checks.Capped[redundantHit], error) {
+	hits := checks.Capped[redundantHit]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[redundantHit]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[redundantHit]{}, ctxErr
+			}
+
+			return checks.Capped[redundantHit]{}, err
+		}
+
+		rowNum++
+
+		for _, col := range redundantColumns(rec, cols) {
+			hits.Add(redundantHit{
+				rowNum: rowNum,
+				column: col.name,
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+func redundantDescriptionsMessage(hits checks.Capped[redundantHit]) string {
+	limit := len(hits.Items)
+	if limit > maxReportedCells {
+		limit = maxReportedCells
+	}
+
+	var b strings.Builder
+	b.WriteString("locale descriptions duplicate the main description: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func redundantDescriptionFindings(hits checks.Capped[redundantHit]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Message: "duplicates the main description",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
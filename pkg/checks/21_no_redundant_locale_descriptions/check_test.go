@@ -0,0 +1,113 @@
+package redundant_locale_descriptions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnRedundantLocaleDescriptions_Distinct_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en;en_description;fr;fr_description\n" +
+		"cloud;remote servers;cloud;hosted compute;nuage;serveurs distants\n" +
+		"apple;;apple;;pomme;\n"
+
+	res := validateWarnRedundantLocaleDescriptions(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+}
+
+func TestValidateWarnRedundantLocaleDescriptions_Duplicates_Warn(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en;en_description;fr;fr_description\n" +
+		"cloud;remote servers;cloud; remote servers ;nuage;serveurs distants\n" +
+		"apple;fruit;apple;fruit;pomme;fruit\n"
+
+	res := validateWarnRedundantLocaleDescriptions(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false, duplicates present")
+	}
+
+	for _, want := range []string{
+		"en_description (row 2)",
+		"en_description (row 3)",
+		"fr_description (row 3)",
+		"total 3 cells",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateWarnRedundantLocaleDescriptions_NoColumns_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnRedundantLocaleDescriptions(context.Background(), checks.Artifact{
+		Data: []byte("term;description;en\nx;y;z\n"),
+	}, checks.DefaultMaxFindings, 0)
+
+	if !res.OK || !strings.Contains(res.Msg, "skipping") {
+		t.Fatalf("expected skip pass, got OK=%v Msg=%q", res.OK, res.Msg)
+	}
+}
+
+func TestValidateWarnRedundantLocaleDescriptions_Blank_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnRedundantLocaleDescriptions(context.Background(), checks.Artifact{Data: []byte("\n\n")}, checks.DefaultMaxFindings, 0)
+
+	if !res.OK {
+		t.Fatalf("expected OK=true for blank content, got %q", res.Msg)
+	}
+}
+
+func TestRunWarnRedundantLocaleDescriptions_FixAndRerun_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data: []byte("term;description;en;en_description\ncloud;servers;cloud;servers\n"),
+		Path: "g.csv",
+	}
+
+	out := runWarnRedundantLocaleDescriptions(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixIfNotPass,
+		RerunAfterFix: true,
+	})
+
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if got := string(out.Final.Data); got != "term;description;en;en_description\ncloud;servers;cloud;\n" {
+		t.Fatalf("unexpected fixed data: %q", got)
+	}
+}
+
+func TestRunWarnRedundantLocaleDescriptions_NoFix_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{
+		Data: []byte("term;description;en;en_description\ncloud;servers;cloud;servers\n"),
+	}
+
+	out := runWarnRedundantLocaleDescriptions(context.Background(), a, checks.RunOptions{})
+
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("DidChange must be false without fix mode")
+	}
+}
@@ -0,0 +1,254 @@
+package redundant_locale_descriptions
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixRedundantLocaleDescriptions blanks <locale>_description cells that repeat the main description.
+// Columns are kept so the header shape does not change.
+func fixRedundantLocaleDescriptions(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findDescFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readDescFixRecords(ctx, appendDescFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	cols, ok := findDescriptionColumns(records[0])
+	if !ok {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no description and *_description columns found",
+		}, nil
+	}
+
+	blanked, err := blankRedundantDescriptions(ctx, records, cols)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if blanked == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no redundant locale descriptions to blank",
+		}, nil
+	}
+
+	outTail, err := writeDescFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchDescFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "blanked " + strconv.Itoa(blanked) + " locale descriptions duplicating the main description",
+	}, nil
+}
+
+// blankRedundantDescriptions rewrites records in place and returns the number of blanked cells.
+func blankRedundantDescriptions(
+	ctx context.Context,
+	records [][]string,
+	cols descriptionColumns,
+) (int, error) {
+	blanked := 0
+
+	for i := 1; i < len(records); i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		for _, col := range redundantColumns(records[i], cols) {
+			records[i][col.pos] = ""
+			blanked++
+		}
+	}
+
+	return blanked, nil
+}
+
+type descFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findDescFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (descFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return descFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := descFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return descFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return descFixHeaderParts{}, false, nil
+}
+
+func descFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendDescFixHeaderAndRest(parts descFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readDescFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeDescFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchDescFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,79 @@
+package redundant_locale_descriptions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixRedundantLocaleDescriptions_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixRedundantLocaleDescriptions(context.Background(), checks.Artifact{Data: []byte(" \n")})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixRedundantLocaleDescriptions_BlanksOnlyDuplicates(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF\r\n" +
+		"term;description;en;en_description;fr;fr_description\r\n" +
+		"cloud;servers;cloud;servers;nuage;serveurs\r\n" +
+		"apple;fruit;apple;fruit;pomme;fruit"
+
+	fr, err := fixRedundantLocaleDescriptions(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF\r\n" +
+		"term;description;en;en_description;fr;fr_description\r\n" +
+		"cloud;servers;cloud;;nuage;serveurs\r\n" +
+		"apple;fruit;apple;;pomme;"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if !strings.Contains(fr.Note, "blanked 3 locale descriptions") {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixRedundantLocaleDescriptions_NoColumns_NoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;en\ncloud;cloud\n"
+
+	fr, err := fixRedundantLocaleDescriptions(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected unchanged data, got DidChange=%v Data=%q", fr.DidChange, fr.Data)
+	}
+}
+
+func TestFixRedundantLocaleDescriptions_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixRedundantLocaleDescriptions(ctx, checks.Artifact{Data: []byte("term\nx\n")})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package comment_rows
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-comment-rows"
+
+const (
+	maxReportedLines = 10
+	maxQuotedRunes   = 40
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnCommentRows,
+			checks.WithPriority(checks.PrioSemantic+25),
+			checks.WithScope(checks.ScopeRows),
+			checks.WithComments(),
+		)
+	})
+}
+
+// runWarnCommentRows — entry point for the check.
+// Lokalise has no notion of comments, so every comment line would become a glossary row on upload.
+// There is no auto-fix: dropping the comments is the caller's decision.
+func runWarnCommentRows(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	prefix := opts.CommentPrefix
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnCommentRows(ctx, a, prefix)
+		},
+		Fix:     nil,
+		PassMsg: "no comment lines found",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnCommentRows reports lines starting with the configured comment prefix.
+// With no prefix configured there are no comments by definition.
+func validateWarnCommentRows(ctx context.Context, a checks.Artifact, prefix string) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "validation cancelled",
+			Err: err,
+		}
+	}
+
+	if prefix == "" {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "comment prefix is not configured",
+		}
+	}
+
+	lines := checks.FindCommentLines(a.Data, prefix)
+	if len(lines) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no comment lines found",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: commentRowsMessage(lines),
+	}
+}
+
+func commentRowsMessage(lines []checks.CommentLine) string {
+	limit := len(lines)
+	if limit > maxReportedLines {
+		limit = maxReportedLines
+	}
+
+	var b strings.Builder
+	b.WriteString("comment lines would be uploaded as glossary rows: ")
+
+	for i := range limit {
+		b.WriteString("line ")
+		b.WriteString(strconv.Itoa(lines[i].Line))
+		b.WriteString(" ")
+		b.WriteString(strconv.Quote(shorten(lines[i].Text)))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if len(lines) > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(len(lines)))
+	b.WriteString(" lines)")
+
+	return b.String()
+}
+
+func shorten(s string) string {
+	r := []rune(s)
+	if len(r) <= maxQuotedRunes {
+		return s
+	}
+	return string(r[:maxQuotedRunes]) + "…"
+}
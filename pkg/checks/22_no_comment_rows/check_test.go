@@ -0,0 +1,83 @@
+package comment_rows
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnCommentRows_NoPrefix_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnCommentRows(context.Background(), checks.Artifact{
+		Data: []byte("term;description\n# old;row\n"),
+	}, "")
+
+	if !res.OK {
+		t.Fatalf("expected OK=true without a prefix, got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnCommentRows_ReportsLines(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description\n" +
+		"# removed;old row\n" +
+		"apple;fruit\n" +
+		"\"multi\n# not a comment\";x\n" +
+		"#todo\n"
+
+	res := validateWarnCommentRows(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, "#")
+
+	if res.OK {
+		t.Fatalf("expected OK=false when comments are present")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic WARN (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{
+		`line 2 "# removed;old row"`,
+		`line 6 "#todo"`,
+		"(total 2 lines)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestRunWarnCommentRows_SeesRawComments(t *testing.T) {
+	t.Parallel()
+
+	data := "term;description\n# removed;old row\napple;fruit\n"
+
+	out := runWarnCommentRows(context.Background(), checks.Artifact{
+		Data: []byte(data),
+		Path: "c.csv",
+	}, checks.RunOptions{CommentPrefix: "#"})
+
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("Status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	if string(out.Final.Data) != data {
+		t.Fatalf("Final.Data changed: %q", string(out.Final.Data))
+	}
+}
+
+func TestValidateWarnCommentRows_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnCommentRows(ctx, checks.Artifact{Data: []byte("#x\n")}, "#")
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
@@ -0,0 +1,392 @@
+package tags_per_row
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "ensure-tags-policy"
+
+// Setting keys understood by this check. Without any of them the check is a no-op.
+const (
+	settingMinTags      = "min-tags"
+	settingMaxTags      = "max-tags"
+	settingRequiredTags = "required-tags"
+)
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureTagsPolicy,
+			checks.WithPriority(checks.PrioSemantic+35),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// tagsPolicy is the per-row tag contract read from the check settings.
+// Zero min/max and an empty required set mean "no constraint".
+type tagsPolicy struct {
+	min      int
+	max      int
+	required []string
+}
+
+func policyFrom(opts checks.RunOptions) tagsPolicy {
+	p := tagsPolicy{
+		min:      opts.SettingInt(checkName, settingMinTags, 0),
+		max:      opts.SettingInt(checkName, settingMaxTags, 0),
+		required: opts.SettingList(checkName, settingRequiredTags, nil),
+	}
+	if p.min < 0 {
+		p.min = 0
+	}
+	if p.max < 0 {
+		p.max = 0
+	}
+	return p
+}
+
+func (p tagsPolicy) active() bool {
+	return p.min > 0 || p.max > 0 || len(p.required) > 0
+}
+
+// runEnsureTagsPolicy — entry point for the check.
+// Tags drive reviewer routing in Lokalise, so a configured policy is enforced as FAIL.
+// There is no auto-fix: we cannot guess which tags a term should carry.
+func runEnsureTagsPolicy(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	policy := policyFrom(opts)
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateEnsureTagsPolicy(ctx, a, policy, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "all rows satisfy the tags policy",
+	})
+}
+
+func validateEnsureTagsPolicy(ctx context.Context, a checks.Artifact, policy tagsPolicy, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	if !policy.active() {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no tags policy configured",
+		}
+	}
+
+	if policy.max > 0 && policy.min > policy.max {
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "invalid tags policy: " + settingMinTags + " " + strconv.Itoa(policy.min) + " exceeds " + settingMaxTags + " " + strconv.Itoa(policy.max),
+			Err: errors.New("invalid tags policy"),
+		}
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for tags policy",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTagsHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := findTagsColumns(header)
+
+	bad, err := findTagsPolicyViolations(ctx, r, rowNum, cols, policy, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating tags policy",
+			Err: err,
+		}
+	}
+
+	if bad.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "all rows satisfy the tags policy",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       tagsPolicyMessage(bad, policy),
+		Findings:  tagsPolicyFindings(bad, policy),
+		Truncated: bad.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTagsHeader(ctx context.Context, r csvReader) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for tags policy)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type tagsColumns struct {
+	term int
+	tags int
+}
+
+// findTagsColumns locates the term and tags columns. A missing tags column
+// means every row carries zero tags.
+func findTagsColumns(header []string) tagsColumns {
+	cols := tagsColumns{term: -1, tags: -1}
+
+	for i, h := range header {
+		switch normalizeHeaderCell(h) {
+		case "term":
+			if cols.term < 0 {
+				cols.term = i
+			}
+		case "tags":
+			if cols.tags < 0 {
+				cols.tags = i
+			}
+		}
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type tagsViolation struct {
+	rowNum          int
+	term            string
+	count           int
+	tooFew          bool
+	tooMany         bool
+	missingRequired bool
+}
+
+func findTagsPolicyViolations(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols tagsColumns,
+	policy tagsPolicy,
+	limit int,
+	stopAfter int,
+) (checks.Capped[tagsViolation], error) {
+	required := make(map[string]struct{}, len(policy.required))
+	for _, t := range policy.required {
+		required[strings.ToLower(t)] = struct{}{}
+	}
+
+	bad := checks.Capped[tagsViolation]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[tagsViolation]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return bad, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[tagsViolation]{}, ctxErr
+			}
+
+			return checks.Capped[tagsViolation]{}, err
+		}
+
+		rowNum++
+
+		if isBlankCSVRecord(rec) {
+			continue
+		}
+
+		tags := checks.SplitTags(recordValue(rec, cols.tags))
+
+		v := tagsViolation{
+			rowNum:  rowNum,
+			term:    recordValue(rec, cols.term),
+			count:   len(tags),
+			tooFew:  len(tags) < policy.min,
+			tooMany: policy.max > 0 && len(tags) > policy.max,
+		}
+		if len(required) > 0 {
+			v.missingRequired = !hasAnyTag(tags, required)
+		}
+
+		if v.tooFew || v.tooMany || v.missingRequired {
+			bad.Add(v)
+			if bad.Exhausted() {
+				return bad, nil
+			}
+		}
+	}
+}
+
+func hasAnyTag(tags []string, required map[string]struct{}) bool {
+	for _, t := range tags {
+		if _, ok := required[strings.ToLower(t)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func recordValue(record []string, pos int) string {
+	if pos < 0 || pos >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[pos])
+}
+
+func tagsPolicyMessage(rows checks.Capped[tagsViolation], policy tagsPolicy) string {
+	limit := len(rows.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+	b.WriteString("rows violate the tags policy: ")
+
+	for i := range limit {
+		row := rows.Items[i]
+
+		if row.term != "" {
+			b.WriteString("term=")
+			b.WriteString(strconv.Quote(row.term))
+			b.WriteString(" ")
+		}
+
+		b.WriteString("(row ")
+		b.WriteString(strconv.Itoa(row.rowNum))
+		b.WriteString(") ")
+		b.WriteString(strings.Join(row.reasons(policy), ", "))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if rows.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(rows.Total()))
+	b.WriteString(" rows)")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
+
+	return b.String()
+}
+
+func tagsPolicyFindings(rows checks.Capped[tagsViolation], policy tagsPolicy) []checks.Finding {
+	out := make([]checks.Finding, 0, len(rows.Items))
+	for _, row := range rows.Items {
+		out = append(out, checks.Finding{
+			Row:     row.rowNum,
+			Column:  "tags",
+			Value:   row.term,
+			Message: strings.Join(row.reasons(policy), ", "),
+		})
+	}
+
+	return out
+}
+
+func (v tagsViolation) reasons(policy tagsPolicy) []string {
+	var out []string
+
+	if v.tooFew {
+		out = append(out, "has "+strconv.Itoa(v.count)+" tags, min "+strconv.Itoa(policy.min))
+	}
+	if v.tooMany {
+		out = append(out, "has "+strconv.Itoa(v.count)+" tags, max "+strconv.Itoa(policy.max))
+	}
+	if v.missingRequired {
+		out = append(out, "has none of the required tags ["+strings.Join(policy.required, ", ")+"]")
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,110 @@
+package tags_per_row
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const tagsCSV = "" +
+	"term;description;tags\n" +
+	"apple;fruit;food,ui\n" +
+	"pear;fruit;\n" +
+	"plum;fruit;a,b,c,d\n" +
+	"kiwi;fruit;legal, food ,food\n"
+
+func TestValidateEnsureTagsPolicy_NoPolicy_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureTagsPolicy(context.Background(), checks.Artifact{Data: []byte(tagsCSV)}, tagsPolicy{}, checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true without a policy, got %q", res.Msg)
+	}
+}
+
+func TestValidateEnsureTagsPolicy_MinMax(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureTagsPolicy(context.Background(), checks.Artifact{Data: []byte(tagsCSV)},
+		tagsPolicy{min: 1, max: 3}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic failure, got Err=%v", res.Err)
+	}
+	for _, want := range []string{
+		`term="pear" (row 3) has 0 tags, min 1`,
+		`term="plum" (row 4) has 4 tags, max 3`,
+		"(total 2 rows)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, "kiwi") {
+		t.Fatalf("duplicate tags must be counted once: %q", res.Msg)
+	}
+}
+
+func TestValidateEnsureTagsPolicy_RequiredTags(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureTagsPolicy(context.Background(), checks.Artifact{Data: []byte(tagsCSV)},
+		tagsPolicy{required: []string{"Food", "legal"}}, checks.DefaultMaxFindings, 0)
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	for _, want := range []string{
+		`term="pear" (row 3) has none of the required tags [Food, legal]`,
+		`term="plum" (row 4)`,
+		"(total 2 rows)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateEnsureTagsPolicy_MissingTagsColumn(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureTagsPolicy(context.Background(), checks.Artifact{
+		Data: []byte("term;description\napple;fruit\n"),
+	}, tagsPolicy{min: 1}, checks.DefaultMaxFindings, 0)
+
+	if res.OK || !strings.Contains(res.Msg, `term="apple" (row 2) has 0 tags, min 1`) {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+}
+
+func TestValidateEnsureTagsPolicy_InvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureTagsPolicy(context.Background(), checks.Artifact{Data: []byte(tagsCSV)},
+		tagsPolicy{min: 3, max: 1}, checks.DefaultMaxFindings, 0)
+
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected system error for min > max, got %+v", res)
+	}
+}
+
+func TestRunEnsureTagsPolicy_ReadsSettings(t *testing.T) {
+	t.Parallel()
+
+	out := runEnsureTagsPolicy(context.Background(), checks.Artifact{Data: []byte(tagsCSV), Path: "t.csv"},
+		checks.RunOptions{Settings: map[string]checks.CheckSettings{
+			checkName: {settingMaxTags: "2"},
+		}})
+
+	if out.Result.Status != checks.Fail {
+		t.Fatalf("Status = %s, want FAIL (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !strings.Contains(out.Result.Message, `term="plum"`) {
+		t.Fatalf("unexpected message %q", out.Result.Message)
+	}
+}
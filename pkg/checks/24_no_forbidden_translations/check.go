@@ -0,0 +1,354 @@
+package forbidden_translations
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-forbidden-translations"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnForbiddenTranslations,
+			checks.WithPriority(checks.PrioSemantic+40),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+func runWarnForbiddenTranslations(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnForbiddenTranslations(ctx, a, limit, stopAfter)
+		},
+		Fix:              fixForbiddenTranslations,
+		PassMsg:          "no translations on forbidden terms",
+		FixedMsg:         "cleared translations on forbidden terms",
+		AppliedMsg:       "auto-fix applied: cleared translations on forbidden terms",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "forbidden terms still have translations after fix",
+	})
+}
+
+// validateWarnForbiddenTranslations warns about rows marked forbidden=yes that still carry
+// locale values. Lokalise ignores those translations, so they usually signal a mix-up.
+func validateWarnForbiddenTranslations(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for forbidden term translations",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readForbiddenHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols, ok := findForbiddenColumns(header)
+	if !ok {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "forbidden or locale columns not found (skipping forbidden translations check)",
+		}
+	}
+
+	rows, err := findForbiddenTranslations(ctx, r, rowNum, cols, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating forbidden term translations",
+			Err: err,
+		}
+	}
+
+	if rows.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no translations on forbidden terms",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       forbiddenTranslationsMessage(rows),
+		Findings:  forbiddenTranslationFindings(rows),
+		Truncated: rows.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readForbiddenHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for forbidden term translations)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type localeColumn struct {
+	name string
+	pos  int
+}
+
+type forbiddenColumns struct {
+	term      int
+	forbidden int
+	locales   []localeColumn
+}
+
+// findForbiddenColumns locates the forbidden flag and locale value columns.
+// Service columns and *_description columns are not translations.
+func findForbiddenColumns(header []string) (forbiddenColumns, bool) {
+	cols := forbiddenColumns{term: -1, forbidden: -1}
+
+	for i, h := range header {
+		name := normalizeHeaderCell(h)
+
+		switch {
+		case name == "":
+			continue
+		case name == "term":
+			if cols.term < 0 {
+				cols.term = i
+			}
+		case name == "forbidden":
+			if cols.forbidden < 0 {
+				cols.forbidden = i
+			}
+		case strings.HasSuffix(name, "_description"):
+			continue
+		default:
+			if _, known := checks.KnownHeaders[name]; known {
+				continue
+			}
+
+			cols.locales = append(cols.locales, localeColumn{
+				name: strings.TrimSpace(h),
+				pos:  i,
+			})
+		}
+	}
+
+	return cols, cols.forbidden >= 0 && len(cols.locales) > 0
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// translatedColumns returns the non-empty locale cells of a forbidden row.
+func translatedColumns(record []string, cols forbiddenColumns) []localeColumn {
+	if !strings.EqualFold(cellValue(record, cols.forbidden), "yes") {
+		return nil
+	}
+
+	var out []localeColumn
+	for _, col := range cols.locales {
+		if cellValue(record, col.pos) != "" {
+			out = append(out, col)
+		}
+	}
+
+	return out
+}
+
+func cellValue(record []string, pos int) string {
+	if pos < 0 || pos >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[pos])
+}
+
+type forbiddenRow struct {
+	rowNum  int
+	term    string
+	locales []string
+}
+
+func findForbiddenTranslations(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols forbiddenColumns,
+	limit int,
+	stopAfter int,
+) (checks.Capped[forbiddenRow], error) {
+	rows := checks.Capped[forbiddenRow]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[forbiddenRow]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[forbiddenRow]{}, ctxErr
+			}
+
+			return checks.Capped[forbiddenRow]{}, err
+		}
+
+		rowNum++
+
+		translated := translatedColumns(rec, cols)
+		if len(translated) == 0 {
+			continue
+		}
+
+		row := forbiddenRow{
+			rowNum: rowNum,
+			term:   cellValue(rec, cols.term),
+		}
+		for _, col := range translated {
+			row.locales = append(row.locales, col.name)
+		}
+
+		rows.Add(row)
+		if rows.Exhausted() {
+			return rows, nil
+		}
+	}
+}
+
+func forbiddenTranslationsMessage(rows checks.Capped[forbiddenRow]) string {
+	limit := len(rows.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+	b.WriteString("forbidden terms have translations that Lokalise will ignore: ")
+
+	for i := range limit {
+		row := rows.Items[i]
+
+		if row.term != "" {
+			b.WriteString("term=")
+			b.WriteString(strconv.Quote(row.term))
+			b.WriteString(" ")
+		}
+
+		b.WriteString("(row ")
+		b.WriteString(strconv.Itoa(row.rowNum))
+		b.WriteString(") in ")
+		b.WriteString(strings.Join(row.locales, ", "))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if rows.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(rows.Total()))
+	b.WriteString(" terms)")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
+
+	return b.String()
+}
+
+func forbiddenTranslationFindings(rows checks.Capped[forbiddenRow]) []checks.Finding {
+	var out []checks.Finding
+	for _, row := range rows.Items {
+		for _, locale := range row.locales {
+			out = append(out, checks.Finding{
+				Row:     row.rowNum,
+				Column:  locale,
+				Value:   row.term,
+				Message: "forbidden term has a translation",
+			})
+		}
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,90 @@
+package forbidden_translations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnForbiddenTranslations_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;forbidden;en;fr;fr_description\n" +
+		"apple;fruit;no;apple;pomme;fruit\n" +
+		"badword;never use;yes;;;explain why\n"
+
+	res := validateWarnForbiddenTranslations(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true, got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnForbiddenTranslations_ReportsRows(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;forbidden;en;fr\n" +
+		"apple;fruit;no;apple;pomme\n" +
+		"badword;never use;YES;badword;\n" +
+		"worse;never;yes; ;pire\n"
+
+	res := validateWarnForbiddenTranslations(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic WARN (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{
+		`term="badword" (row 3) in en`,
+		`term="worse" (row 4) in fr`,
+		"(total 2 terms)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateWarnForbiddenTranslations_NoForbiddenColumn_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnForbiddenTranslations(context.Background(), checks.Artifact{
+		Data: []byte("term;description;en\napple;fruit;apple\n"),
+	}, checks.DefaultMaxFindings, 0)
+	if !res.OK || !strings.Contains(res.Msg, "skipping") {
+		t.Fatalf("expected skip pass, got %+v", res)
+	}
+}
+
+func TestRunWarnForbiddenTranslations_FixClears(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description;forbidden;en\nbad;x;yes;bad\n"
+
+	out := runWarnForbiddenTranslations(context.Background(), checks.Artifact{Data: []byte(in), Path: "f.csv"},
+		checks.RunOptions{FixMode: checks.FixIfNotPass, RerunAfterFix: true})
+
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("Status = %s, want PASS after fix (%s)", out.Result.Status, out.Result.Message)
+	}
+	if got := string(out.Final.Data); got != "term;description;forbidden;en\nbad;x;yes;\n" {
+		t.Fatalf("unexpected data %q", got)
+	}
+}
+
+func TestValidateWarnForbiddenTranslations_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnForbiddenTranslations(ctx, checks.Artifact{Data: []byte("term\nx\n")}, checks.DefaultMaxFindings, 0)
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
@@ -0,0 +1,254 @@
+package forbidden_translations
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixForbiddenTranslations clears locale cells on rows marked forbidden=yes.
+// Columns are kept so the header shape does not change; the term itself stays untouched.
+func fixForbiddenTranslations(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findForbFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readForbFixRecords(ctx, appendForbFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	cols, ok := findForbiddenColumns(records[0])
+	if !ok {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no forbidden or locale columns found",
+		}, nil
+	}
+
+	cleared, err := clearForbiddenTranslations(ctx, records, cols)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if cleared == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no translations on forbidden terms to clear",
+		}, nil
+	}
+
+	outTail, err := writeForbFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchForbFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "cleared " + strconv.Itoa(cleared) + " translations on forbidden terms",
+	}, nil
+}
+
+// clearForbiddenTranslations rewrites records in place and returns the number of cleared cells.
+func clearForbiddenTranslations(
+	ctx context.Context,
+	records [][]string,
+	cols forbiddenColumns,
+) (int, error) {
+	cleared := 0
+
+	for i := 1; i < len(records); i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		for _, col := range translatedColumns(records[i], cols) {
+			records[i][col.pos] = ""
+			cleared++
+		}
+	}
+
+	return cleared, nil
+}
+
+type forbFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findForbFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (forbFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return forbFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := forbFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return forbFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return forbFixHeaderParts{}, false, nil
+}
+
+func forbFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendForbFixHeaderAndRest(parts forbFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readForbFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeForbFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchForbFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,79 @@
+package forbidden_translations
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixForbiddenTranslations_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixForbiddenTranslations(context.Background(), checks.Artifact{Data: []byte(" \n")})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixForbiddenTranslations_ClearsOnlyForbiddenLocales(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF\r\n" +
+		"term;description;forbidden;en;en_description;fr\r\n" +
+		"apple;fruit;no;apple;fruit;pomme\r\n" +
+		"bad;never;yes;bad;keep me;mauvais"
+
+	fr, err := fixForbiddenTranslations(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF\r\n" +
+		"term;description;forbidden;en;en_description;fr\r\n" +
+		"apple;fruit;no;apple;fruit;pomme\r\n" +
+		"bad;never;yes;;keep me;"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if !strings.Contains(fr.Note, "cleared 2 translations") {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixForbiddenTranslations_NoColumns_NoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;en\nbad;bad\n"
+
+	fr, err := fixForbiddenTranslations(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected unchanged data, got DidChange=%v Data=%q", fr.DidChange, fr.Data)
+	}
+}
+
+func TestFixForbiddenTranslations_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixForbiddenTranslations(ctx, checks.Artifact{Data: []byte("term\nx\n")})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
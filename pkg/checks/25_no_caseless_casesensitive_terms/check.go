@@ -0,0 +1,313 @@
+package caseless_casesensitive_terms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-caseless-casesensitive-terms"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnCaselessCasesensitiveTerms,
+			checks.WithPriority(checks.PrioSemantic+45),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnCaselessCasesensitiveTerms — entry point for the check.
+// This is informational cleanup for legacy imports, so it only warns and has no auto-fix.
+func runWarnCaselessCasesensitiveTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnCaselessCasesensitiveTerms(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no case-sensitive terms without cased letters",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnCaselessCasesensitiveTerms flags rows with casesensitive=yes whose term has
+// no letter that has case (digits, symbols, or caseless scripts such as CJK),
+// where the flag cannot change matching.
+func validateWarnCaselessCasesensitiveTerms(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for case-sensitive terms",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readCaselessHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := findCaselessColumns(header)
+	if cols.term < 0 || cols.casesensitive < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "term or casesensitive column not found (skipping case-sensitive terms check)",
+		}
+	}
+
+	rows, err := findCaselessCasesensitiveRows(ctx, r, rowNum, cols, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating case-sensitive terms",
+			Err: err,
+		}
+	}
+
+	if rows.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no case-sensitive terms without cased letters",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       caselessMessage(rows),
+		Findings:  caselessFindings(rows),
+		Truncated: rows.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readCaselessHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for case-sensitive terms)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type caselessColumns struct {
+	term          int
+	casesensitive int
+}
+
+func findCaselessColumns(header []string) caselessColumns {
+	cols := caselessColumns{term: -1, casesensitive: -1}
+
+	for i, h := range header {
+		switch normalizeHeaderCell(h) {
+		case "term":
+			if cols.term < 0 {
+				cols.term = i
+			}
+		case "casesensitive":
+			if cols.casesensitive < 0 {
+				cols.casesensitive = i
+			}
+		}
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type caselessRow struct {
+	rowNum int
+	term   string
+}
+
+func findCaselessCasesensitiveRows(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols caselessColumns,
+	limit int,
+	stopAfter int,
+) (checks.Capped[caselessRow], error) {
+	rows := checks.Capped[caselessRow]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[caselessRow]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[caselessRow]{}, ctxErr
+			}
+
+			return checks.Capped[caselessRow]{}, err
+		}
+
+		rowNum++
+
+		if !strings.EqualFold(recordValue(rec, cols.casesensitive), "yes") {
+			continue
+		}
+
+		term := recordValue(rec, cols.term)
+		if term == "" || hasCasedLetter(term) {
+			continue
+		}
+
+		rows.Add(caselessRow{rowNum: rowNum, term: term})
+		if rows.Exhausted() {
+			return rows, nil
+		}
+	}
+}
+
+// hasCasedLetter reports whether s contains at least one rune with distinct upper/lower forms.
+func hasCasedLetter(s string) bool {
+	for _, r := range s {
+		if unicode.ToUpper(r) != r || unicode.ToLower(r) != r {
+			return true
+		}
+	}
+
+	return false
+}
+
+func recordValue(record []string, pos int) string {
+	if pos < 0 || pos >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[pos])
+}
+
+func caselessMessage(rows checks.Capped[caselessRow]) string {
+	limit := len(rows.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+	b.WriteString("casesensitive=yes has no effect on terms without cased letters: ")
+
+	for i := range limit {
+		row := rows.Items[i]
+
+		b.WriteString("term=")
+		b.WriteString(strconv.Quote(row.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(row.rowNum))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if rows.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(rows.Total()))
+	b.WriteString(" terms)")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
+
+	return b.String()
+}
+
+func caselessFindings(rows checks.Capped[caselessRow]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(rows.Items))
+	for _, row := range rows.Items {
+		out = append(out, checks.Finding{
+			Row:     row.rowNum,
+			Column:  "casesensitive",
+			Value:   row.term,
+			Message: "casesensitive=yes on a term without cased letters",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
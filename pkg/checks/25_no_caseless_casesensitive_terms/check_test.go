@@ -0,0 +1,82 @@
+package caseless_casesensitive_terms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnCaselessCasesensitiveTerms_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;casesensitive\n" +
+		"iPhone;device;yes\n" +
+		"404;not found;no\n" +
+		"Ω-3;omega;yes\n"
+
+	res := validateWarnCaselessCasesensitiveTerms(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true, got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnCaselessCasesensitiveTerms_ReportsRows(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;casesensitive\n" +
+		"404;not found;yes\n" +
+		"iPhone;device;yes\n" +
+		"東京;city;YES\n" +
+		"%%;percent;yes\n" +
+		";empty;yes\n"
+
+	res := validateWarnCaselessCasesensitiveTerms(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic WARN (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{
+		`term="404" (row 2)`,
+		`term="東京" (row 4)`,
+		`term="%%" (row 5)`,
+		"(total 3 terms)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateWarnCaselessCasesensitiveTerms_NoColumn_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnCaselessCasesensitiveTerms(context.Background(), checks.Artifact{
+		Data: []byte("term;description\n404;x\n"),
+	}, checks.DefaultMaxFindings, 0)
+	if !res.OK || !strings.Contains(res.Msg, "skipping") {
+		t.Fatalf("expected skip pass, got %+v", res)
+	}
+}
+
+func TestRunWarnCaselessCasesensitiveTerms_Warns(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description;casesensitive\n42;answer;yes\n"
+
+	out := runWarnCaselessCasesensitiveTerms(context.Background(), checks.Artifact{Data: []byte(in)},
+		checks.RunOptions{FixMode: checks.FixAlways})
+
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("Status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange || string(out.Final.Data) != in {
+		t.Fatalf("data must stay unchanged")
+	}
+}
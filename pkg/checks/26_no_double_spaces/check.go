@@ -0,0 +1,400 @@
+package double_spaces
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-double-spaces"
+
+// settingSkipDescriptions controls whether description columns are left untouched (default: true).
+const settingSkipDescriptions = "skip-descriptions"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnDoubleSpaces,
+			checks.WithPriority(checks.PrioSemantic+50),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type spacesConfig struct {
+	skipDescriptions bool
+	maxFindings      int
+	stopAfter        int
+}
+
+func configFrom(opts checks.RunOptions) spacesConfig {
+	return spacesConfig{
+		skipDescriptions: opts.SettingBool(checkName, settingSkipDescriptions, true),
+		maxFindings:      opts.FindingsLimit(),
+		stopAfter:        opts.StopAfter(),
+	}
+}
+
+func runWarnDoubleSpaces(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnDoubleSpaces(ctx, a, cfg)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixDoubleSpaces(ctx, a, cfg)
+		},
+		PassMsg:          "no repeated whitespace inside term or locale values",
+		FixedMsg:         "collapsed repeated whitespace inside cells",
+		AppliedMsg:       "auto-fix applied: collapsed repeated whitespace inside cells",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "repeated whitespace is still present after fix",
+	})
+}
+
+// validateWarnDoubleSpaces reports runs of two or more whitespace characters inside
+// term and locale values ("ice  cream"). Such terms never match source text.
+// Leading/trailing whitespace is not reported here.
+func validateWarnDoubleSpaces(ctx context.Context, a checks.Artifact, cfg spacesConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for repeated whitespace",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readSpacesHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := targetColumns(header, cfg)
+	if len(cols) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no term or locale columns found (skipping repeated whitespace check)",
+		}
+	}
+
+	hits, err := findDoubleSpaces(ctx, r, rowNum, cols, cfg.maxFindings, cfg.stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating repeated whitespace",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no repeated whitespace inside term or locale values",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       doubleSpacesMessage(hits),
+		Findings:  doubleSpacesFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readSpacesHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for repeated whitespace)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type targetColumn struct {
+	name string
+	pos  int
+}
+
+// targetColumns picks the term column, locale value columns and (optionally) description columns.
+// Flag and tags columns are never touched.
+func targetColumns(header []string, cfg spacesConfig) []targetColumn {
+	var cols []targetColumn
+
+	for i, h := range header {
+		name := normalizeHeaderCell(h)
+		if name == "" {
+			continue
+		}
+
+		switch {
+		case name == "term":
+		case name == "description" || strings.HasSuffix(name, "_description"):
+			if cfg.skipDescriptions {
+				continue
+			}
+		default:
+			if _, known := checks.KnownHeaders[name]; known {
+				continue
+			}
+		}
+
+		cols = append(cols, targetColumn{
+			name: strings.TrimSpace(h),
+			pos:  i,
+		})
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// collapseInnerWhitespace replaces every internal run of two or more whitespace
+// characters with a single space. Leading and trailing whitespace is kept as-is.
+// It returns the new value and the number of collapsed runs.
+func collapseInnerWhitespace(s string) (string, int) {
+	start := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsSpace(r) })
+	if start < 0 {
+		return s, 0
+	}
+	end := strings.LastIndexFunc(s, func(r rune) bool { return !unicode.IsSpace(r) })
+	_, size := utf8.DecodeRuneInString(s[end:])
+	end += size
+
+	inner := s[start:end]
+
+	var (
+		b     strings.Builder
+		runs  int
+		run   int
+		first rune
+	)
+	flush := func() {
+		switch {
+		case run >= 2:
+			b.WriteByte(' ')
+			runs++
+		case run == 1:
+			b.WriteRune(first)
+		}
+		run = 0
+	}
+
+	for _, r := range inner {
+		if unicode.IsSpace(r) {
+			if run == 0 {
+				first = r
+			}
+			run++
+			continue
+		}
+		flush()
+		b.WriteRune(r)
+	}
+	flush()
+
+	if runs == 0 {
+		return s, 0
+	}
+
+	return s[:start] + b.String() + s[end:], runs
+}
+
+func countDoubleSpaces(s string) int {
+	_, n := collapseInnerWhitespace(s)
+	return n
+}
+
+type spacesHit struct {
+	rowNum int
+	column string
+	count  int
+}
+
+// spacesHits keeps up to the findings limit of cells but counts every run.
+type spacesHits struct {
+	checks.Capped[spacesHit]
+	runs int
+}
+
+func findDoubleSpaces(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols []targetColumn,
+	limit int,
+	stopAfter int,
+) (spacesHits, error) {
+	hits := spacesHits{Capped: checks.Capped[spacesHit]{Limit: limit, StopAfter: stopAfter}}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return spacesHits{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return spacesHits{}, ctxErr
+			}
+
+			return spacesHits{}, err
+		}
+
+		rowNum++
+
+		for _, col := range cols {
+			if col.pos >= len(rec) {
+				continue
+			}
+
+			n := countDoubleSpaces(rec[col.pos])
+			if n == 0 {
+				continue
+			}
+
+			hits.runs += n
+			hits.Add(spacesHit{
+				rowNum: rowNum,
+				column: col.name,
+				count:  n,
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+func doubleSpacesMessage(hits spacesHits) string {
+	limit := len(hits.Items)
+	if limit > maxReportedCells {
+		limit = maxReportedCells
+	}
+
+	var b strings.Builder
+	b.WriteString("repeated whitespace inside cells: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(") x")
+		b.WriteString(strconv.Itoa(hit.count))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.runs))
+	b.WriteString(" runs in ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func doubleSpacesFindings(hits spacesHits) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Message: "repeated whitespace x" + strconv.Itoa(hit.count),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
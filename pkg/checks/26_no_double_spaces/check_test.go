@@ -0,0 +1,97 @@
+package double_spaces
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestCollapseInnerWhitespace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+		runs int
+	}{
+		{"ice cream", "ice cream", 0},
+		{"ice  cream", "ice cream", 1},
+		{" ice \t cream  and   more ", " ice cream and more ", 3},
+		{"a\tb", "a\tb", 0},
+		{"  ", "  ", 0},
+		{"", "", 0},
+		{"東京  タワー", "東京 タワー", 1},
+	}
+
+	for _, tt := range tests {
+		got, runs := collapseInnerWhitespace(tt.in)
+		if got != tt.want || runs != tt.runs {
+			t.Fatalf("collapseInnerWhitespace(%q) = %q, %d; want %q, %d", tt.in, got, runs, tt.want, tt.runs)
+		}
+	}
+}
+
+func TestValidateWarnDoubleSpaces_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en\n" +
+		"ice cream;a  dessert;ice cream\n" +
+		" padded ;x;y\n"
+
+	res := validateWarnDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(csv)}, spacesConfig{skipDescriptions: true})
+	if !res.OK {
+		t.Fatalf("expected OK=true, got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnDoubleSpaces_ReportsCells(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;tags;en;fr\n" +
+		"ice  cream;a  dessert;a  b;ice cream;glace   à  la crème\n"
+
+	res := validateWarnDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(csv)}, spacesConfig{skipDescriptions: true})
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic WARN (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{"term (row 2) x1", "fr (row 2) x2", "(total 3 runs in 2 cells)"} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, "description") || strings.Contains(res.Msg, "tags") {
+		t.Fatalf("descriptions and tags must be skipped, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnDoubleSpaces_DescriptionsWhenEnabled(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\napple;a  fruit\n"
+
+	res := validateWarnDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(csv)}, spacesConfig{skipDescriptions: false})
+	if res.OK || !strings.Contains(res.Msg, "description (row 2)") {
+		t.Fatalf("expected description to be reported, got %+v", res)
+	}
+}
+
+func TestRunWarnDoubleSpaces_ReadsSetting(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;description\napple;a  fruit\n"
+
+	out := runWarnDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{checkName: {settingSkipDescriptions: "no"}},
+	})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("Status = %s, want WARN (%s)", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,256 @@
+package double_spaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixDoubleSpaces collapses internal whitespace runs to a single space in the targeted columns.
+// The header row and untargeted columns are copied as-is.
+func fixDoubleSpaces(ctx context.Context, a checks.Artifact, cfg spacesConfig) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findSpacesFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readSpacesFixRecords(ctx, appendSpacesFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	cols := targetColumns(records[0], cfg)
+	collapsed, err := collapseDoubleSpaces(ctx, records, cols)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if collapsed == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no repeated whitespace to collapse",
+		}, nil
+	}
+
+	outTail, err := writeSpacesFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchSpacesFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "collapsed " + strconv.Itoa(collapsed) + " runs of repeated whitespace",
+	}, nil
+}
+
+// collapseDoubleSpaces rewrites records in place and returns the number of collapsed runs.
+func collapseDoubleSpaces(
+	ctx context.Context,
+	records [][]string,
+	cols []targetColumn,
+) (int, error) {
+	collapsed := 0
+
+	for i := 1; i < len(records); i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		row := records[i]
+
+		for _, col := range cols {
+			if col.pos >= len(row) {
+				continue
+			}
+
+			v, n := collapseInnerWhitespace(row[col.pos])
+			if n == 0 {
+				continue
+			}
+
+			row[col.pos] = v
+			collapsed += n
+		}
+	}
+
+	return collapsed, nil
+}
+
+type spacesFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findSpacesFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (spacesFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return spacesFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := spacesFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return spacesFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return spacesFixHeaderParts{}, false, nil
+}
+
+func spacesFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendSpacesFixHeaderAndRest(parts spacesFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readSpacesFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeSpacesFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchSpacesFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,79 @@
+package double_spaces
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixDoubleSpaces_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(" \n")}, spacesConfig{skipDescriptions: true})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixDoubleSpaces_CollapsesTargetedColumns(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF" +
+		"term;description;en\r\n" +
+		"ice  cream;a  dessert;ice   cream \r\n" +
+		"apple;fruit;apple"
+
+	fr, err := fixDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(in)}, spacesConfig{skipDescriptions: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF" +
+		"term;description;en\r\n" +
+		"ice cream;a  dessert;ice cream \r\n" +
+		"apple;fruit;apple"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if !strings.Contains(fr.Note, "collapsed 2 runs") {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixDoubleSpaces_NothingToCollapse(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description\napple;a  fruit\n"
+
+	fr, err := fixDoubleSpaces(context.Background(), checks.Artifact{Data: []byte(in)}, spacesConfig{skipDescriptions: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected unchanged data, got DidChange=%v Data=%q", fr.DidChange, fr.Data)
+	}
+}
+
+func TestFixDoubleSpaces_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixDoubleSpaces(ctx, checks.Artifact{Data: []byte("term\nx\n")}, spacesConfig{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,338 @@
+package trailing_term_punctuation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-trailing-term-punctuation"
+
+// settingCharacters lists the trailing characters to flag and strip (default ".:,").
+const settingCharacters = "characters"
+
+const defaultCharacters = ".:,"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnTrailingTermPunctuation,
+			checks.WithPriority(checks.PrioSemantic+55),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+			checks.WithOptIn(),
+		)
+	})
+}
+
+type punctConfig struct {
+	characters  string
+	maxFindings int
+	stopAfter   int
+}
+
+func configFrom(opts checks.RunOptions) punctConfig {
+	chars, ok := opts.Setting(checkName, settingCharacters)
+	if !ok {
+		chars = defaultCharacters
+	}
+
+	return punctConfig{
+		characters:  chars,
+		maxFindings: opts.FindingsLimit(),
+		stopAfter:   opts.StopAfter(),
+	}
+}
+
+// runWarnTrailingTermPunctuation — entry point for the check.
+// Opt-in because some terms legitimately end with punctuation ("etc.", "Inc.").
+func runWarnTrailingTermPunctuation(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnTrailingTermPunctuation(ctx, a, cfg)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixTrailingTermPunctuation(ctx, a, cfg)
+		},
+		PassMsg:          "no terms end with trailing punctuation",
+		FixedMsg:         "stripped trailing punctuation from terms",
+		AppliedMsg:       "auto-fix applied: stripped trailing punctuation from terms",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "terms still end with trailing punctuation after fix",
+	})
+}
+
+// validateWarnTrailingTermPunctuation flags terms ending with one of the configured characters.
+// These are usually copy-paste slips and keep the term from matching source text.
+func validateWarnTrailingTermPunctuation(ctx context.Context, a checks.Artifact, cfg punctConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	if cfg.characters == "" {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no trailing punctuation characters configured",
+		}
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for trailing term punctuation",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readPunctHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	termCol := findTermColumn(header)
+	if termCol < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "term column not found (skipping trailing punctuation check)",
+		}
+	}
+
+	changes, err := findTrailingPunctuation(ctx, r, rowNum, termCol, cfg)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating trailing term punctuation",
+			Err: err,
+		}
+	}
+
+	if changes.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no terms end with trailing punctuation",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       "terms end with trailing punctuation: " + termChangesList(changes),
+		Findings:  termChangeFindings(changes),
+		Truncated: changes.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readPunctHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for trailing term punctuation)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findTermColumn(header []string) int {
+	for i, h := range header {
+		if normalizeHeaderCell(h) == "term" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// strippedTerm returns the term without trailing configured characters.
+// ok is false when nothing would change or the term would become empty.
+func strippedTerm(term string, cfg punctConfig) (string, bool) {
+	trimmed := strings.TrimSpace(term)
+	if trimmed == "" {
+		return term, false
+	}
+
+	out := strings.TrimRightFunc(strings.TrimRight(trimmed, cfg.characters), isSpace)
+	if out == "" || out == trimmed {
+		return term, false
+	}
+
+	return out, true
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+type termChange struct {
+	rowNum int
+	from   string
+	to     string
+}
+
+func findTrailingPunctuation(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	termCol int,
+	cfg punctConfig,
+) (checks.Capped[termChange], error) {
+	changes := checks.Capped[termChange]{Limit: cfg.maxFindings, StopAfter: cfg.stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[termChange]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return changes, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[termChange]{}, ctxErr
+			}
+
+			return checks.Capped[termChange]{}, err
+		}
+
+		rowNum++
+
+		if termCol >= len(rec) {
+			continue
+		}
+
+		if to, ok := strippedTerm(rec[termCol], cfg); ok {
+			changes.Add(termChange{
+				rowNum: rowNum,
+				from:   strings.TrimSpace(rec[termCol]),
+				to:     to,
+			})
+			if changes.Exhausted() {
+				return changes, nil
+			}
+		}
+	}
+}
+
+// termChangesList renders `"Cloud." -> "Cloud" (row 3)` items with the usual truncation.
+func termChangesList(changes checks.Capped[termChange]) string {
+	limit := len(changes.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+
+	for i := range limit {
+		c := changes.Items[i]
+
+		b.WriteString(strconv.Quote(c.from))
+		b.WriteString(" -> ")
+		b.WriteString(strconv.Quote(c.to))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(c.rowNum))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if changes.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(changes.Total()))
+	b.WriteString(" terms)")
+	b.WriteString(checks.OverflowNote(changes.Overflow))
+	b.WriteString(changes.TruncatedNote())
+
+	return b.String()
+}
+
+func termChangeFindings(changes checks.Capped[termChange]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(changes.Items))
+	for _, c := range changes.Items {
+		out = append(out, checks.Finding{
+			Row:     c.rowNum,
+			Column:  "term",
+			Value:   c.from,
+			Message: "trailing punctuation (expected " + strconv.Quote(c.to) + ")",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
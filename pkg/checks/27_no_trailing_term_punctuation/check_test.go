@@ -0,0 +1,133 @@
+package trailing_term_punctuation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnTrailingTermPunctuation_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr\n" +
+		"apple;fruit.;pomme.\n" +
+		"e.g cloud;service;nuage\n"
+
+	res := validateWarnTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, configFrom(checks.RunOptions{}))
+
+	if !res.OK {
+		t.Fatalf("expected OK=true, got false with Msg=%q", res.Msg)
+	}
+	if res.Err != nil {
+		t.Fatalf("expected Err=nil, got %v", res.Err)
+	}
+}
+
+func TestValidateWarnTrailingTermPunctuation_ReportsTerms(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description\n" +
+		"Cloud.;service\n" +
+		"apple;fruit\n" +
+		"Settings:;menu\n" +
+		"Inc.,;company\n" +
+		"...;ellipsis\n"
+
+	res := validateWarnTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, configFrom(checks.RunOptions{}))
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic WARN (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{
+		`"Cloud." -> "Cloud" (row 2)`,
+		`"Settings:" -> "Settings" (row 4)`,
+		`"Inc.," -> "Inc" (row 5)`,
+		"(total 3 terms)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, `"..."`) {
+		t.Fatalf("punctuation-only terms must not be reported, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnTrailingTermPunctuation_CustomCharacters(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingCharacters: "!"},
+		},
+	}
+
+	csv := "term\nCloud.\nWow!\n"
+
+	res := validateWarnTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte(csv),
+	}, configFrom(opts))
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, `"Wow!" -> "Wow"`) || strings.Contains(res.Msg, "Cloud") {
+		t.Fatalf("expected only the configured character to be flagged, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnTrailingTermPunctuation_NoTermColumn_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte("description;fr\nfruit.;pomme.\n"),
+	}, configFrom(checks.RunOptions{}))
+
+	if !res.OK {
+		t.Fatalf("expected OK=true without term column, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnTrailingTermPunctuation_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnTrailingTermPunctuation(ctx, checks.Artifact{
+		Data: []byte("term\nCloud.\n"),
+	}, configFrom(checks.RunOptions{}))
+
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
+
+func TestRunWarnTrailingTermPunctuation_OptIn_DisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	ch, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check %q is not registered", checkName)
+	}
+
+	out := ch.Run(context.Background(), checks.Artifact{
+		Data: []byte("term\nCloud.\n"),
+	}, checks.RunOptions{})
+
+	if out.Result.Status != checks.Skipped {
+		t.Fatalf("expected disabled opt-in check to pass, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,289 @@
+package trailing_term_punctuation
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixTrailingTermPunctuation strips the configured trailing characters from the term column.
+// Every change is listed in the note so reviewers can see exactly what was rewritten.
+func fixTrailingTermPunctuation(ctx context.Context, a checks.Artifact, cfg punctConfig) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	if cfg.characters == "" {
+		return checks.NoFix(a, "no trailing punctuation characters configured")
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findPunctFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readPunctFixRecords(ctx, appendPunctFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	termCol := findTermColumn(records[0])
+	if termCol < 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "term column not found",
+		}, nil
+	}
+
+	changes, err := stripTrailingPunctuation(ctx, records, termCol, cfg, parts.before)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if changes.Total() == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no trailing punctuation to strip",
+		}, nil
+	}
+
+	outTail, err := writePunctFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchPunctFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "stripped trailing punctuation: " + termChangesList(changes),
+	}, nil
+}
+
+// stripTrailingPunctuation rewrites every record in place; the returned changes are capped for reporting.
+// Row numbers match the validator: CSV records counted from the top of the file.
+func stripTrailingPunctuation(
+	ctx context.Context,
+	records [][]string,
+	termCol int,
+	cfg punctConfig,
+	before []byte,
+) (checks.Capped[termChange], error) {
+	changes := checks.NewCapped[termChange](cfg.maxFindings)
+
+	offset := 1 + countPunctFixRecordsBefore(before)
+
+	for i := 1; i < len(records); i++ {
+		if err := ctx.Err(); err != nil {
+			return checks.Capped[termChange]{}, err
+		}
+
+		row := records[i]
+		if termCol >= len(row) {
+			continue
+		}
+
+		to, ok := strippedTerm(row[termCol], cfg)
+		if !ok {
+			continue
+		}
+
+		changes.Add(termChange{
+			rowNum: i + offset,
+			from:   strings.TrimSpace(row[termCol]),
+			to:     to,
+		})
+		row[termCol] = to
+	}
+
+	return changes, nil
+}
+
+// countPunctFixRecordsBefore counts whitespace-only lines above the header.
+// The CSV reader skips empty lines but still returns these as records.
+func countPunctFixRecordsBefore(before []byte) int {
+	n := 0
+
+	for _, line := range bytes.Split(before, []byte("\n")) {
+		if len(punctFixTrimTrailingCR(line)) > 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
+type punctFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findPunctFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (punctFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return punctFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := punctFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return punctFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return punctFixHeaderParts{}, false, nil
+}
+
+func punctFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendPunctFixHeaderAndRest(parts punctFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readPunctFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writePunctFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchPunctFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,93 @@
+package trailing_term_punctuation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixTrailingTermPunctuation_StripsAndReports(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\n" +
+		"term;description;fr\r\n" +
+		"Cloud.;service.;nuage.\r\n" +
+		"apple;fruit;pomme\r\n" +
+		"Settings: ;menu;paramètres\r\n"
+
+	res, err := fixTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte(in),
+	}, configFrom(checks.RunOptions{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.DidChange {
+		t.Fatalf("expected DidChange=true, note=%q", res.Note)
+	}
+
+	want := "" +
+		"\n" +
+		"term;description;fr\r\n" +
+		"Cloud;service.;nuage.\r\n" +
+		"apple;fruit;pomme\r\n" +
+		"Settings;menu;paramètres\r\n"
+
+	if got := string(res.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+
+	for _, w := range []string{
+		`"Cloud." -> "Cloud" (row 2)`,
+		`"Settings:" -> "Settings" (row 4)`,
+		"(total 2 terms)",
+	} {
+		if !strings.Contains(res.Note, w) {
+			t.Fatalf("expected %q in note, got %q", w, res.Note)
+		}
+	}
+}
+
+func TestFixTrailingTermPunctuation_NothingToStrip(t *testing.T) {
+	t.Parallel()
+
+	in := "term;fr\napple;pomme.\n"
+
+	res, err := fixTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte(in),
+	}, configFrom(checks.RunOptions{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.DidChange {
+		t.Fatalf("expected DidChange=false")
+	}
+	if string(res.Data) != in {
+		t.Fatalf("data must be unchanged")
+	}
+}
+
+func TestRunWarnTrailingTermPunctuation_Enabled_FixesToPass(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		FixMode:       checks.FixAlways,
+		RerunAfterFix: true,
+		Settings: map[string]checks.CheckSettings{
+			checkName: {checks.SettingEnabled: "true"},
+		},
+	}
+
+	out := runWarnTrailingTermPunctuation(context.Background(), checks.Artifact{
+		Data: []byte("term;fr\nCloud.;nuage\n"),
+	}, opts)
+
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !out.Final.DidChange || string(out.Final.Data) != "term;fr\nCloud;nuage\n" {
+		t.Fatalf("unexpected final data %q", out.Final.Data)
+	}
+}
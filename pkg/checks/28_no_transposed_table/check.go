@@ -0,0 +1,235 @@
+package transposed_table
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "ensure-not-transposed"
+
+const ctxCheckEveryRows = 1 << 12
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureNotTransposed,
+			checks.WithFailFast(),
+			// Runs before the separator check:
+			// a transposed table has to be turned back before any header check runs.
+			checks.WithPriority(checks.PrioStructural+40),
+			checks.WithDestructiveFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
+}
+
+// runEnsureNotTransposed — entry point for the check.
+// The fixer rewrites the whole table, so it only runs with RunOptions.AllowDestructive.
+func runEnsureNotTransposed(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateNotTransposed,
+		Fix:              fixTransposedTable,
+		Destructive:      true,
+		PassMsg:          "table is not transposed",
+		FixedMsg:         "transposed table turned back (terms as rows)",
+		AppliedMsg:       "auto-fix applied: transposed table turned back",
+		StillBadMsg:      "table still looks transposed after fix",
+		StatusAfterFixed: checks.Pass,
+	})
+}
+
+// validateNotTransposed detects tables exported with terms as columns:
+// the first row reads like terms while the first column reads like glossary headers.
+func validateNotTransposed(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to check for transposition",
+		}
+	}
+
+	records, err := readTransposeRecords(ctx, a)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		// Malformed CSV is reported by the parsing checks; nothing to judge here.
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "cannot parse CSV with semicolon delimiter (skipping transposition check)",
+		}
+	}
+
+	if !looksTransposed(records) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "table is not transposed",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK: false,
+		Msg: "table looks transposed: first column holds headers (" +
+			strings.Join(firstColumnHeaders(records), ", ") +
+			") and first row holds terms; expected terms as rows",
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+// readTransposeRecords returns all non-blank records of a (BOM stripped).
+func readTransposeRecords(ctx context.Context, a checks.Artifact) ([][]string, error) {
+	var (
+		r       csvReader = checks.TableReaderOf(ctx, a)
+		records [][]string
+		rowNum  int
+	)
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			records = append(records, rec)
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// looksTransposed applies the heuristic:
+//   - the top-left cell is "term";
+//   - no other cell of the first row is a known glossary header;
+//   - the first column contains "description" and mostly known headers or locale columns.
+func looksTransposed(records [][]string) bool {
+	if len(records) < 2 || len(records[0]) == 0 {
+		return false
+	}
+
+	header := records[0]
+	if normalizeHeaderCell(header[0]) != "term" {
+		return false
+	}
+
+	for _, cell := range header[1:] {
+		if _, known := checks.KnownHeaders[normalizeHeaderCell(cell)]; known {
+			return false
+		}
+	}
+
+	labels := 0
+	hasDescription := false
+
+	for _, rec := range records[1:] {
+		name := normalizeHeaderCell(rec[0])
+		if name == "description" {
+			hasDescription = true
+		}
+		if isHeaderLabel(name) {
+			labels++
+		}
+	}
+
+	return hasDescription && labels*2 > len(records)-1
+}
+
+// isHeaderLabel reports whether name reads like a glossary column: a known header,
+// a locale code or a locale description column.
+func isHeaderLabel(name string) bool {
+	if _, known := checks.KnownHeaders[name]; known {
+		return true
+	}
+
+	return looksLikeLangCode(strings.TrimSuffix(name, "_description"))
+}
+
+// looksLikeLangCode accepts "en", "pt_BR", "zh-Hant"-like codes.
+func looksLikeLangCode(s string) bool {
+	parts := strings.Split(strings.ReplaceAll(s, "-", "_"), "_")
+
+	first := parts[0]
+	if len(first) < 2 || len(first) > 3 {
+		return false
+	}
+
+	for _, r := range first {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+
+	for _, seg := range parts[1:] {
+		if seg == "" || len(seg) > 4 {
+			return false
+		}
+
+		for _, r := range seg {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func firstColumnHeaders(records [][]string) []string {
+	var out []string
+
+	for _, rec := range records[1:] {
+		name := normalizeHeaderCell(rec[0])
+		if _, known := checks.KnownHeaders[name]; known {
+			out = append(out, name)
+		}
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,93 @@
+package transposed_table
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const transposedCSV = "" +
+	"term;apple;cloud\n" +
+	"description;fruit;service\n" +
+	"casesensitive;no;yes\n" +
+	"en;apple;cloud\n" +
+	"fr;pomme;nuage\n"
+
+func TestValidateNotTransposed_NormalTable_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en;fr\n" +
+		"description;a word;description;description\n" +
+		"tags;labels;tags;étiquettes\n"
+
+	res := validateNotTransposed(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+}
+
+func TestValidateNotTransposed_Transposed_Fail(t *testing.T) {
+	t.Parallel()
+
+	res := validateNotTransposed(context.Background(), checks.Artifact{Data: []byte(transposedCSV)})
+	if res.OK {
+		t.Fatalf("expected OK=false for transposed table")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic FAIL (Err=nil), got %v", res.Err)
+	}
+	if !strings.Contains(res.Msg, "description, casesensitive") {
+		t.Fatalf("expected detected headers in message, got %q", res.Msg)
+	}
+}
+
+func TestValidateNotTransposed_TermsThatLookLikeHeaders_Pass(t *testing.T) {
+	t.Parallel()
+
+	// Only one header-like value in the first column: not enough to call it transposed.
+	csv := "term;en\ndescription;description\napple;apple\ncloud;cloud\n"
+
+	res := validateNotTransposed(context.Background(), checks.Artifact{Data: []byte(csv)})
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+}
+
+func TestValidateNotTransposed_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateNotTransposed(ctx, checks.Artifact{Data: []byte(transposedCSV)})
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
+
+func TestRunEnsureNotTransposed_FixRequiresAllowDestructive(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte(transposedCSV), Path: "t.csv"}
+	opts := checks.RunOptions{FixMode: checks.FixAlways, RerunAfterFix: true}
+
+	out := runEnsureNotTransposed(context.Background(), a, opts)
+	if out.Result.Status != checks.Fail {
+		t.Fatalf("expected FAIL without AllowDestructive, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange || string(out.Final.Data) != transposedCSV {
+		t.Fatalf("data must be untouched without AllowDestructive")
+	}
+
+	opts.AllowDestructive = true
+	out = runEnsureNotTransposed(context.Background(), a, opts)
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !out.Final.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+}
@@ -0,0 +1,134 @@
+package transposed_table
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixTransposedTable swaps rows and columns so terms become rows again.
+// Blank records are dropped and short rows are padded with empty cells;
+// the BOM, line endings and final newline are preserved.
+func fixTransposedTable(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	records, err := readTransposeRecords(ctx, a)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if !looksTransposed(records) {
+		return checks.NoFix(a, "table does not look transposed")
+	}
+
+	out := transposeRecords(records)
+
+	tail, err := writeTransposeFixRecords(ctx, out, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	data := make([]byte, 0, len(bom)+len(tail))
+	data = append(data, bom...)
+	data = append(data, tail...)
+
+	return checks.FixResult{
+		Data:      data,
+		Path:      "",
+		DidChange: true,
+		Note: "transposed " + strconv.Itoa(len(records)) + " rows into " +
+			strconv.Itoa(len(out)) + " rows (terms as rows)",
+	}, nil
+}
+
+func transposeRecords(records [][]string) [][]string {
+	width := 0
+	for _, rec := range records {
+		width = max(width, len(rec))
+	}
+
+	out := make([][]string, width)
+	for col := range width {
+		row := make([]string, len(records))
+		for i, rec := range records {
+			if col < len(rec) {
+				row[i] = rec[col]
+			}
+		}
+		out[col] = row
+	}
+
+	return out
+}
+
+func writeTransposeFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
@@ -0,0 +1,54 @@
+package transposed_table
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixTransposedTable_TurnsTableBack(t *testing.T) {
+	t.Parallel()
+
+	in := "\xEF\xBB\xBF" +
+		"term;apple;cloud\r\n" +
+		"description;fruit;service\r\n" +
+		"\r\n" +
+		"en;apple\r\n" +
+		"fr;pomme;nuage\r\n"
+
+	res, err := fixTransposedTable(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !res.DidChange {
+		t.Fatalf("expected DidChange=true, note=%q", res.Note)
+	}
+
+	want := "\xEF\xBB\xBF" +
+		"term;description;en;fr\r\n" +
+		"apple;fruit;apple;pomme\r\n" +
+		"cloud;service;;nuage\r\n"
+
+	if got := string(res.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if res.Note != "transposed 4 rows into 3 rows (terms as rows)" {
+		t.Fatalf("unexpected note %q", res.Note)
+	}
+}
+
+func TestFixTransposedTable_NotTransposed_NoFix(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description\napple;fruit\n"
+
+	res, err := fixTransposedTable(context.Background(), checks.Artifact{Data: []byte(in)})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if res.DidChange || string(res.Data) != in {
+		t.Fatalf("data must be unchanged")
+	}
+}
@@ -0,0 +1,334 @@
+package lokalise_compat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "lokalise-compat"
+
+// settingMaxTermLength overrides the maximum term length (default: the API limit).
+const settingMaxTermLength = "max-term-length"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runLokaliseCompat,
+			checks.WithPriority(checks.PrioSemantic+60),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type compatConfig struct {
+	maxTermLength int
+	maxFindings   int
+	stopAfter     int
+}
+
+func configFrom(opts checks.RunOptions) compatConfig {
+	cfg := compatConfig{
+		maxTermLength: opts.SettingInt(checkName, settingMaxTermLength, defaultMaxTermLength),
+		maxFindings:   opts.FindingsLimit(),
+		stopAfter:     opts.StopAfter(),
+	}
+	if cfg.maxTermLength <= 0 {
+		cfg.maxTermLength = defaultMaxTermLength
+	}
+
+	return cfg
+}
+
+// runLokaliseCompat — entry point for the check.
+// Rows breaking these rules are rejected by the server on upload, hence FAIL.
+// There is no auto-fix: shortening or rewriting a term is an editorial decision.
+func runLokaliseCompat(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateLokaliseCompat(ctx, a, cfg)
+		},
+		Fix:     nil,
+		PassMsg: "all rows satisfy Lokalise glossary constraints",
+	})
+}
+
+// validateLokaliseCompat applies the server-side rules from rules.go to every row:
+// reserved characters in terms and maximum term length.
+func validateLokaliseCompat(ctx context.Context, a checks.Artifact, cfg compatConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for Lokalise compatibility",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readCompatHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := findCompatColumns(header)
+	if cols.term < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no 'term' column found (skipping Lokalise compatibility check)",
+		}
+	}
+
+	rows, err := findCompatViolations(ctx, r, rowNum, cols, cfg)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating Lokalise compatibility",
+			Err: err,
+		}
+	}
+
+	if rows.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "all rows satisfy Lokalise glossary constraints",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       compatMessage(rows),
+		Findings:  compatFindings(rows),
+		Truncated: rows.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readCompatHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for Lokalise compatibility)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type compatColumns struct {
+	term int
+}
+
+func findCompatColumns(header []string) compatColumns {
+	cols := compatColumns{term: -1}
+
+	for i, h := range header {
+		if normalizeHeaderCell(h) == "term" {
+			cols.term = i
+			break
+		}
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type compatViolation struct {
+	rowNum  int
+	term    string
+	reasons []string
+}
+
+func findCompatViolations(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols compatColumns,
+	cfg compatConfig,
+) (checks.Capped[compatViolation], error) {
+	rows := checks.Capped[compatViolation]{Limit: cfg.maxFindings, StopAfter: cfg.stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[compatViolation]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[compatViolation]{}, ctxErr
+			}
+
+			return checks.Capped[compatViolation]{}, err
+		}
+
+		rowNum++
+
+		if isBlankCSVRecord(rec) {
+			continue
+		}
+
+		// Keep the raw term: reserved characters may sit at the edges.
+		term := ""
+		if cols.term < len(rec) {
+			term = rec[cols.term]
+		}
+
+		if reasons := rowViolations(term, cfg); len(reasons) > 0 {
+			rows.Add(compatViolation{
+				rowNum:  rowNum,
+				term:    strings.TrimSpace(term),
+				reasons: reasons,
+			})
+			if rows.Exhausted() {
+				return rows, nil
+			}
+		}
+	}
+}
+
+func rowViolations(term string, cfg compatConfig) []string {
+	var reasons []string
+
+	if strings.ContainsFunc(term, isReservedTermRune) {
+		reasons = append(reasons, "reserved characters in term")
+	}
+
+	if n := utf8.RuneCountInString(strings.TrimSpace(term)); n > cfg.maxTermLength {
+		reasons = append(reasons,
+			"term too long ("+strconv.Itoa(n)+" > "+strconv.Itoa(cfg.maxTermLength)+" characters)")
+	}
+
+	return reasons
+}
+
+func compatMessage(rows checks.Capped[compatViolation]) string {
+	limit := len(rows.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+	b.WriteString("rows would be rejected by Lokalise: ")
+
+	for i := range limit {
+		row := rows.Items[i]
+
+		if row.term != "" {
+			b.WriteString("term=")
+			b.WriteString(strconv.Quote(row.term))
+			b.WriteString(" ")
+		}
+
+		b.WriteString("(row ")
+		b.WriteString(strconv.Itoa(row.rowNum))
+		b.WriteString(") ")
+		b.WriteString(strings.Join(row.reasons, ", "))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if rows.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(rows.Total()))
+	b.WriteString(" rows)")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
+
+	return b.String()
+}
+
+func compatFindings(rows checks.Capped[compatViolation]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(rows.Items))
+	for _, row := range rows.Items {
+		out = append(out, checks.Finding{
+			Row:     row.rowNum,
+			Column:  "term",
+			Value:   row.term,
+			Message: strings.Join(row.reasons, ", "),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
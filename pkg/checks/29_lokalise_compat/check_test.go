@@ -0,0 +1,116 @@
+package lokalise_compat
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateLokaliseCompat_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;translatable;forbidden\n" +
+		"apple;fruit;yes;no\n" +
+		"Lokalise;brand;no;no\n"
+
+	res := validateLokaliseCompat(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(checks.RunOptions{}))
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+}
+
+func TestValidateLokaliseCompat_ReportsEveryRule(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;translatable;forbidden\n" +
+		"bell\a;desc;yes;no\n" +
+		strings.Repeat("x", defaultMaxTermLength+1) + ";desc;yes;no\n" +
+		"brand;desc;no;yes\n"
+
+	res := validateLokaliseCompat(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(checks.RunOptions{}))
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if res.Err != nil {
+		t.Fatalf("expected semantic FAIL (Err=nil), got %v", res.Err)
+	}
+
+	for _, want := range []string{
+		"(row 2) reserved characters in term",
+		"(row 3) term too long (256 > 255 characters)",
+		"(total 2 rows)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+}
+
+func TestValidateLokaliseCompat_LeavesLineBreaksAndTabsToOtherChecks(t *testing.T) {
+	t.Parallel()
+
+	// Line breaks belong to warn-multiline-terms (which fixes them) and forbidden
+	// non-translatable terms to no-forbidden-non-translatable-terms.
+	csv := "" +
+		"term;translatable;forbidden\n" +
+		"\"multi\nline\";yes;no\n" +
+		"\"para\u2029graph\";yes;no\n" +
+		"tab\tbed;yes;no\n" +
+		"brand;no;yes\n"
+
+	res := validateLokaliseCompat(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(checks.RunOptions{}))
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+}
+
+func TestValidateLokaliseCompat_MaxTermLengthSetting(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingMaxTermLength: "5"},
+		},
+	}
+
+	res := validateLokaliseCompat(context.Background(), checks.Artifact{
+		Data: []byte("term\napple\nbanana\n"),
+	}, configFrom(opts))
+
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, `term="banana" (row 3) term too long (6 > 5 characters)`) || strings.Contains(res.Msg, "apple") {
+		t.Fatalf("unexpected message %q", res.Msg)
+	}
+}
+
+func TestValidateLokaliseCompat_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateLokaliseCompat(ctx, checks.Artifact{Data: []byte("term\nx\n")}, configFrom(checks.RunOptions{}))
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
+
+func TestRunLokaliseCompat_EndToEnd_Fail(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term\nbell\a\n")}
+
+	out := runLokaliseCompat(context.Background(), a, checks.RunOptions{FixMode: checks.FixAlways})
+	if out.Result.Status != checks.Fail {
+		t.Fatalf("expected FAIL, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("check has no fixer, DidChange must be false")
+	}
+}
@@ -0,0 +1,23 @@
+package lokalise_compat
+
+import "unicode"
+
+// Server-side glossary constraints mirrored from the Lokalise glossary API.
+// Update this file together with the API so local validation rejects
+// exactly what the server would.
+const (
+	// defaultMaxTermLength is the longest term (in characters) the API accepts.
+	defaultMaxTermLength = 255
+)
+
+// isReservedTermRune reports characters the API refuses inside a term: control characters.
+// Tabs count as whitespace, and line breaks (including the Unicode line and paragraph
+// separators) are left to warn-multiline-terms, which can fix them.
+func isReservedTermRune(r rune) bool {
+	switch r {
+	case '\t', '\n', '\r':
+		return false
+	}
+
+	return unicode.IsControl(r)
+}
@@ -6,6 +6,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/encoding"
 )
 
 const (
@@ -13,31 +14,44 @@ const (
 	checkEveryByte = 1 << 16
 )
 
+// settingSampleThresholdMB switches charset detection to sampling for files of at least
+// this many megabytes. 0 always scans the whole file.
+const settingSampleThresholdMB = "sample-threshold-mb"
+
+const defaultSampleThresholdMB = encoding.DefaultSampleThreshold >> 20
+
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runUTF8Check,
-		checks.WithFailFast(),
-		checks.WithPriority(2),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runUTF8Check,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+10),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
 }
 
 func runUTF8Check(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
-	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:             checkName,
-		Validate:         validateUTF8,
-		Fix:              fixUTF8,
+	sampleThreshold := opts.SettingInt(checkName, settingSampleThresholdMB, defaultSampleThresholdMB) << 20
+
+	out := checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateUTF8,
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixUTF8(ctx, a, sampleThreshold)
+		},
 		PassMsg:          "file encoding is valid UTF-8",
 		FixedMsg:         "encoding fixed to valid UTF-8",
 		AppliedMsg:       "auto-fix applied",
 		StatusAfterFixed: checks.Pass,
 	})
+
+	if ctx.Err() == nil {
+		out.Detection = encodingDetection(a.Data, sampleThreshold)
+	}
+	return out
 }
 
 func validateUTF8(ctx context.Context, a checks.Artifact) checks.ValidationResult {
@@ -18,7 +18,7 @@ func TestEnsureUTF8_Metadata(t *testing.T) {
 	if !c.FailFast() {
 		t.Fatalf("FailFast() = false, want true")
 	}
-	if got, want := c.Priority(), 2; got != want {
+	if got, want := c.Priority(), checks.PrioStructural+10; got != want {
 		t.Fatalf("Priority() = %d, want %d", got, want)
 	}
 }
@@ -232,3 +232,36 @@ func lookupUTF8Check(t *testing.T) checks.CheckUnit {
 
 	return c
 }
+
+func TestEnsureUTF8_Run_Detection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     []byte
+		encoding string
+		path     string
+		bom      string
+	}{
+		{"plain utf8", []byte("term;description\n"), "UTF-8", "valid UTF-8", ""},
+		{"utf8 bom", append([]byte{0xEF, 0xBB, 0xBF}, "term\n"...), "UTF-8", "BOM", "UTF-8"},
+		{"utf16le bom", []byte{0xFF, 0xFE, 't', 0, 'e', 0}, "UTF-16LE", "BOM", "UTF-16LE"},
+		{"latin1", []byte("term\ncaf\xe9\n"), "windows-1252", "charset detection", ""},
+	}
+
+	for _, tt := range tests {
+		out := runUTF8Check(context.Background(), checks.Artifact{Data: tt.data}, checks.RunOptions{})
+		d := out.Detection
+		if d == nil {
+			t.Fatalf("%s: expected detection", tt.name)
+		}
+		if d.Encoding != tt.encoding || d.EncodingPath != tt.path || d.BOM != tt.bom {
+			t.Fatalf("%s: detection = %+v", tt.name, *d)
+		}
+	}
+
+	out := runUTF8Check(context.Background(), checks.Artifact{}, checks.RunOptions{})
+	if out.Detection != nil {
+		t.Fatalf("empty file must not produce a detection, got %+v", *out.Detection)
+	}
+}
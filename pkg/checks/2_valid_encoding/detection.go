@@ -0,0 +1,22 @@
+package valid_encoding
+
+import (
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/encoding"
+)
+
+// encodingDetection explains how the source encoding was decided, following
+// the same path the fixer takes: BOM, valid UTF-8, UTF-16 heuristic, charset sniffing.
+// It returns nil for empty input.
+func encodingDetection(data []byte, sampleThreshold int) *checks.Detection {
+	d, ok := encoding.Detect(data, encoding.Options{SampleThreshold: sampleThreshold})
+	if !ok {
+		return nil
+	}
+
+	return &checks.Detection{
+		Encoding:     d.Encoding,
+		EncodingPath: d.Path,
+		BOM:          d.BOM,
+	}
+}
@@ -12,12 +12,14 @@ import (
 // CP1251 encoded "Привет"
 var cp1251Privet = []byte{0xCF, 0xF0, 0xE8, 0xE2, 0xE5, 0xF2}
 
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 func hasUTF8BOM(b []byte) bool { return bytes.HasPrefix(b, utf8BOM) }
 
 func Test_fixUTF8_AlreadyUTF8(t *testing.T) {
 	data := []byte("hello, мир")
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error for valid UTF-8: %v", err)
 	}
@@ -38,7 +40,7 @@ func Test_fixUTF8_AlreadyUTF8(t *testing.T) {
 func Test_fixUTF8_StripsUTF8BOM(t *testing.T) {
 	data := append(append([]byte{}, utf8BOM...), []byte("with bom")...)
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -54,7 +56,7 @@ func Test_fixUTF8_StripsUTF8BOM(t *testing.T) {
 }
 
 func Test_fixUTF8_ConvertsNonUTF8(t *testing.T) {
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: cp1251Privet})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: cp1251Privet}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -76,7 +78,7 @@ func Test_fixUTF8_FallbackDecoderProducesValidUTF8(t *testing.T) {
 	// invalid UTF-8 byte sequence; decoder should still produce valid UTF-8 output
 	broken := []byte{0xC3, 0x28}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: broken})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: broken}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error for broken input: %v", err)
 	}
@@ -95,7 +97,7 @@ func Test_fixUTF8_UTF16LE_WithBOM(t *testing.T) {
 	// BOM FF FE + "Hi\n" in UTF-16LE (H=0x0048, i=0x0069, \n=0x000A)
 	data := []byte{0xFF, 0xFE, 0x48, 0x00, 0x69, 0x00, 0x0A, 0x00}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -117,7 +119,7 @@ func Test_fixUTF8_UTF16BE_WithBOM(t *testing.T) {
 	// BOM FE FF + "Hi" in UTF-16BE (H=0x0048, i=0x0069)
 	data := []byte{0xFE, 0xFF, 0x00, 0x48, 0x00, 0x69}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -139,7 +141,7 @@ func Test_fixUTF8_UTF16LE_NoBOM_Heuristic(t *testing.T) {
 	// "Hi" in UTF-16LE, no BOM: 48 00 69 00
 	data := []byte{0x48, 0x00, 0x69, 0x00}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -165,7 +167,7 @@ func Test_fixUTF8_UTF32LE_WithBOM(t *testing.T) {
 		0x0A, 0x00, 0x00, 0x00,
 	}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -191,7 +193,7 @@ func Test_fixUTF8_UTF32BE_WithBOM(t *testing.T) {
 		0x00, 0x00, 0x00, 0x69,
 	}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -210,7 +212,7 @@ func Test_fixUTF8_UTF32BE_WithBOM(t *testing.T) {
 }
 
 func Test_fixUTF8_Empty_NoOp(t *testing.T) {
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: nil})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: nil}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -230,7 +232,7 @@ func Test_fixUTF8_UTF16BE_NoBOM_Heuristic(t *testing.T) {
 	// "Hi" in UTF-16BE, no BOM: 00 48 00 69
 	data := []byte{0x00, 0x48, 0x00, 0x69}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -252,7 +254,7 @@ func Test_fixUTF8_UTF16LE_WithBOM_OddLengthPads(t *testing.T) {
 	// BOM FF FE + incomplete UTF-16LE unit for 'H': 48
 	data := []byte{0xFF, 0xFE, 0x48}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -271,7 +273,7 @@ func Test_fixUTF8_UTF32LE_WithBOM_PadsPartialRune(t *testing.T) {
 	// BOM FF FE 00 00 + partial UTF-32LE for 'H': 48
 	data := []byte{0xFF, 0xFE, 0x00, 0x00, 0x48}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -293,7 +295,7 @@ func Test_fixUTF8_UTF32BE_InvalidRuneUsesReplacement(t *testing.T) {
 		0x00, 0x11, 0x00, 0x00,
 	}
 
-	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data})
+	fr, err := fixUTF8(context.Background(), checks.Artifact{Data: data}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -309,7 +311,7 @@ func Test_fixUTF8_ContextCancelled(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	fr, err := fixUTF8(ctx, checks.Artifact{Data: []byte("hello")})
+	fr, err := fixUTF8(ctx, checks.Artifact{Data: []byte("hello")}, 0)
 	if err == nil {
 		t.Fatalf("expected context error, got nil")
 	}
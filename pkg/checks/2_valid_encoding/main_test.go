@@ -0,0 +1,16 @@
+package valid_encoding
+
+import (
+	"os"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// TestMain registers the check: importing a check package only provides it.
+func TestMain(m *testing.M) {
+	if err := checks.LoadAll(); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
@@ -0,0 +1,181 @@
+package declared_langs
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-declared-langs"
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnDeclaredLangs,
+			checks.WithPriority(checks.PrioStructural+5),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
+}
+
+// runWarnDeclaredLangs — entry point for the check.
+// It inspects Artifact.Langs, not the file: duplicated or malformed entries otherwise
+// surface later as confusing missing/unexpected-language results.
+// The fix hands a cleaned list to the following checks; the file is left untouched.
+func runWarnDeclaredLangs(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateDeclaredLangs,
+		Fix:              fixDeclaredLangs,
+		PassMsg:          "declared languages are valid and unique",
+		FixedMsg:         "normalized declared languages",
+		AppliedMsg:       "auto-fix applied: normalized declared languages",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "declared languages are still invalid after fix",
+	})
+}
+
+func validateDeclaredLangs(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "validation cancelled",
+			Err: err,
+		}
+	}
+
+	if len(a.Langs) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no declared languages",
+		}
+	}
+
+	report := inspectLangs(a.Langs)
+	if len(report.duplicates) == 0 && len(report.invalid) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "declared languages are valid and unique",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: report.message(),
+	}
+}
+
+// langsReport is the outcome of cleaning a declared language list.
+type langsReport struct {
+	langs      []string // kept entries, trimmed, first spelling wins, input order
+	duplicates []string // entries dropped because an equivalent code came earlier
+	invalid    []string // entries dropped because they are not language codes
+}
+
+// inspectLangs trims entries, rejects anything that does not look like a language code
+// and drops duplicates. "en-US", "en_us" and " EN_US " are the same language.
+func inspectLangs(langs []string) langsReport {
+	var report langsReport
+	seen := make(map[string]struct{}, len(langs))
+
+	for _, raw := range langs {
+		lang := strings.TrimSpace(raw)
+		if !looksLikeLangCode(lang) {
+			report.invalid = append(report.invalid, raw)
+			continue
+		}
+
+		key := normalizeLangKey(lang)
+		if _, dup := seen[key]; dup {
+			report.duplicates = append(report.duplicates, raw)
+			continue
+		}
+		seen[key] = struct{}{}
+
+		report.langs = append(report.langs, lang)
+	}
+
+	return report
+}
+
+func (r langsReport) message() string {
+	var parts []string
+
+	if len(r.duplicates) > 0 {
+		parts = append(parts, "duplicate declared languages: "+quoteList(r.duplicates))
+	}
+	if len(r.invalid) > 0 {
+		parts = append(parts, "invalid declared languages: "+quoteList(r.invalid))
+	}
+
+	return strings.Join(parts, " ; ")
+}
+
+func quoteList(items []string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = strconv.Quote(item)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func normalizeLangKey(lang string) string {
+	lang = strings.TrimSpace(lang)
+	if lang == "" {
+		return ""
+	}
+
+	lang = strings.ReplaceAll(lang, "-", "_")
+	return strings.ToLower(lang)
+}
+
+// looksLikeLangCode accepts a 2–3 letter base with optional letter/digit segments
+// separated by "_" or "-" ("en", "pt_BR", "zh-Hant-TW").
+func looksLikeLangCode(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	s = strings.ReplaceAll(s, "-", "_")
+
+	parts := strings.Split(s, "_")
+
+	first := parts[0]
+	if len(first) < 2 || len(first) > 3 {
+		return false
+	}
+
+	for _, r := range first {
+		if !isASCIILetter(r) {
+			return false
+		}
+	}
+
+	for _, seg := range parts[1:] {
+		if seg == "" {
+			return false
+		}
+
+		for _, r := range seg {
+			if !isASCIILetter(r) && !isASCIIDigit(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,86 @@
+package declared_langs
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestInspectLangs(t *testing.T) {
+	t.Parallel()
+
+	report := inspectLangs([]string{" en ", "fr", "EN", "pt-BR", "pt_br", "", "english", "de_", "x1"})
+
+	if want := []string{"en", "fr", "pt-BR"}; !reflect.DeepEqual(report.langs, want) {
+		t.Fatalf("langs: want %v, got %v", want, report.langs)
+	}
+	if want := []string{"EN", "pt_br"}; !reflect.DeepEqual(report.duplicates, want) {
+		t.Fatalf("duplicates: want %v, got %v", want, report.duplicates)
+	}
+	if want := []string{"", "english", "de_", "x1"}; !reflect.DeepEqual(report.invalid, want) {
+		t.Fatalf("invalid: want %v, got %v", want, report.invalid)
+	}
+}
+
+func TestValidateDeclaredLangs(t *testing.T) {
+	t.Parallel()
+
+	res := validateDeclaredLangs(context.Background(), checks.Artifact{Langs: []string{"en", "fr_FR"}})
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %q", res.Msg)
+	}
+
+	res = validateDeclaredLangs(context.Background(), checks.Artifact{})
+	if !res.OK {
+		t.Fatalf("expected OK=true for no langs, got %q", res.Msg)
+	}
+
+	res = validateDeclaredLangs(context.Background(), checks.Artifact{Langs: []string{"en", "en", "??"}})
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	want := `duplicate declared languages: "en" ; invalid declared languages: "??"`
+	if res.Msg != want {
+		t.Fatalf("want %q, got %q", want, res.Msg)
+	}
+}
+
+func TestRunWarnDeclaredLangs_NoFix_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term\n"), Langs: []string{"en", "EN"}}
+
+	out := runWarnDeclaredLangs(context.Background(), a, checks.RunOptions{FixMode: checks.FixNone})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.Langs != nil {
+		t.Fatalf("langs must be kept without fix, got %v", out.Final.Langs)
+	}
+}
+
+func TestRunWarnDeclaredLangs_Fix_PropagatesLangs(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term\n"), Path: "g.csv", Langs: []string{"en", "EN", "bad lang", "fr"}}
+
+	out := runWarnDeclaredLangs(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixIfNotPass,
+		RerunAfterFix: true,
+	})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if want := []string{"en", "fr"}; !reflect.DeepEqual(out.Final.Langs, want) {
+		t.Fatalf("want langs %v, got %v", want, out.Final.Langs)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("file must be untouched")
+	}
+	if !strings.Contains(out.Final.Note, "dropped 1 duplicate and rejected 1 invalid") {
+		t.Fatalf("unexpected note %q", out.Final.Note)
+	}
+}
@@ -0,0 +1,52 @@
+package declared_langs
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixDeclaredLangs replaces the declared language list with its cleaned version.
+// Data and Path are kept; only Langs is propagated to the following checks.
+func fixDeclaredLangs(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	if len(a.Langs) == 0 {
+		return checks.NoFix(a, "no declared languages")
+	}
+
+	report := inspectLangs(a.Langs)
+	if len(report.duplicates) == 0 && len(report.invalid) == 0 {
+		return checks.NoFix(a, "declared languages already clean")
+	}
+
+	langs := report.langs
+	if langs == nil {
+		langs = []string{}
+	}
+
+	return checks.FixResult{
+		Data:      a.Data,
+		Path:      "",
+		DidChange: false,
+		Note:      fixNote(report),
+		Langs:     langs,
+	}, nil
+}
+
+func fixNote(report langsReport) string {
+	var parts []string
+
+	if n := len(report.duplicates); n > 0 {
+		parts = append(parts, "dropped "+strconv.Itoa(n)+" duplicate")
+	}
+	if n := len(report.invalid); n > 0 {
+		parts = append(parts, "rejected "+strconv.Itoa(n)+" invalid")
+	}
+
+	return strings.Join(parts, " and ") + " declared languages; using [" + strings.Join(report.langs, ", ") + "]"
+}
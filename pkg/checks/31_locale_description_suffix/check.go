@@ -0,0 +1,222 @@
+package locale_description_suffix
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-locale-description-suffix"
+
+const descriptionSuffix = "description"
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnLocaleDescriptionSuffix,
+			checks.WithPriority(checks.PrioStructural+60),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
+}
+
+// runWarnLocaleDescriptionSuffix — entry point for the check.
+// It runs before allowed-columns and orphan-description checks so they see canonical labels.
+// With RunOptions.PreserveHeaderCase the suffix keeps its spelling ("pt-BR_Description" passes);
+// only the separator and outer whitespace are normalized.
+func runWarnLocaleDescriptionSuffix(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	preserveCase := opts.PreserveHeaderCase
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateLocaleDescriptionSuffix(ctx, a, preserveCase)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixLocaleDescriptionSuffix(ctx, a, preserveCase)
+		},
+		FailAs:           checks.Warn,
+		PassMsg:          "locale description columns use the canonical <locale>_description suffix",
+		FixedMsg:         "normalized locale description column labels",
+		AppliedMsg:       "auto-fix applied: normalized locale description column labels",
+		StatusAfterFixed: checks.Pass,
+		StillBadMsg:      "locale description column labels are still not canonical after fix",
+	})
+}
+
+// validateLocaleDescriptionSuffix reports header labels that mean "<locale>_description"
+// but spell the suffix differently: spaces around the separator, "-" or a space instead of "_",
+// different case or outer whitespace ("en _description", "en_Description ", "pt-BR - description").
+func validateLocaleDescriptionSuffix(ctx context.Context, a checks.Artifact, preserveCase bool) checks.ValidationResult {
+	header, res, ok := readHeader(ctx, a)
+	if !ok {
+		return res
+	}
+
+	variants, err := findSuffixVariants(ctx, header, preserveCase)
+	if err != nil {
+		return cancelledValidation(err)
+	}
+
+	if len(variants) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "locale description columns use the canonical <locale>_description suffix",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: suffixVariantsMessage(variants),
+	}
+}
+
+func readHeader(ctx context.Context, a checks.Artifact) ([]string, checks.ValidationResult, bool) {
+	r, res, ok := checks.NewSemicolonCSVReaderWithCtx(
+		ctx,
+		a,
+		"cannot check header: no usable content",
+	)
+	if !ok {
+		return nil, res, false
+	}
+
+	header, err := r.Read()
+	if err != nil || len(header) == 0 {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, cancelledValidation(ctxErr), false
+		}
+
+		return nil, checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse header with semicolon delimiter",
+			Err: err,
+		}, false
+	}
+
+	return header, checks.ValidationResult{}, true
+}
+
+type suffixVariant struct {
+	pos       int
+	label     string
+	canonical string
+}
+
+func findSuffixVariants(ctx context.Context, header []string, preserveCase bool) ([]suffixVariant, error) {
+	var variants []suffixVariant
+
+	for i, col := range header {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		canonical, ok := canonicalDescriptionLabel(col, preserveCase)
+		if !ok || canonical == col {
+			continue
+		}
+
+		variants = append(variants, suffixVariant{
+			pos:       i,
+			label:     col,
+			canonical: canonical,
+		})
+	}
+
+	return variants, nil
+}
+
+// canonicalDescriptionLabel recognizes "<locale><sep>description" with any case and a
+// separator made of spaces, "_" or "-", and returns "<locale>_description".
+// The locale part is kept as spelled; only the suffix is normalized,
+// and with preserveCase the suffix keeps its original case too.
+func canonicalDescriptionLabel(col string, preserveCase bool) (string, bool) {
+	trimmed := strings.TrimSpace(col)
+	if len(trimmed) <= len(descriptionSuffix) {
+		return "", false
+	}
+
+	cut := len(trimmed) - len(descriptionSuffix)
+	if !strings.EqualFold(trimmed[cut:], descriptionSuffix) {
+		return "", false
+	}
+
+	prefix := trimmed[:cut]
+	base := strings.TrimRight(prefix, " \t_-")
+	if base == prefix {
+		return "", false // "endescription": no separator at all
+	}
+
+	if !looksLikeLangCode(base) {
+		return "", false
+	}
+
+	if preserveCase {
+		return base + "_" + trimmed[cut:], true
+	}
+
+	return base + "_" + descriptionSuffix, true
+}
+
+func suffixVariantsMessage(variants []suffixVariant) string {
+	parts := make([]string, 0, len(variants))
+	for _, v := range variants {
+		parts = append(parts, strconv.Quote(v.label)+" -> "+strconv.Quote(v.canonical)+
+			" (column "+strconv.Itoa(v.pos+1)+")")
+	}
+
+	return "locale description columns with a non-canonical suffix: " + strings.Join(parts, ", ")
+}
+
+func looksLikeLangCode(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	parts := strings.Split(strings.ReplaceAll(s, "-", "_"), "_")
+
+	first := parts[0]
+	if len(first) < 2 || len(first) > 3 {
+		return false
+	}
+
+	for _, r := range first {
+		if !isASCIILetter(r) {
+			return false
+		}
+	}
+
+	for _, seg := range parts[1:] {
+		if seg == "" {
+			return false
+		}
+
+		for _, r := range seg {
+			if !isASCIILetter(r) && !isASCIIDigit(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,148 @@
+package locale_description_suffix
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestCanonicalDescriptionLabel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"en_description", "en_description", true},
+		{"en _description", "en_description", true},
+		{"en_Description ", "en_description", true},
+		{" EN - DESCRIPTION", "EN_description", true},
+		{"pt-BR description", "pt-BR_description", true},
+		{"zh_Hans__description", "zh_Hans_description", true},
+		{"endescription", "", false},
+		{"description", "", false},
+		{"_description", "", false},
+		{"term description", "", false},
+		{"short description", "", false},
+	}
+
+	for _, tc := range cases {
+		got, ok := canonicalDescriptionLabel(tc.in, false)
+		if ok != tc.ok || got != tc.want {
+			t.Fatalf("canonicalDescriptionLabel(%q) = %q, %v; want %q, %v", tc.in, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestValidateLocaleDescriptionSuffix(t *testing.T) {
+	t.Parallel()
+
+	t.Run("canonical labels -> OK", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;description;en;en_description;de;de_description\nx;y;a;b;c;d\n")}
+		res := validateLocaleDescriptionSuffix(context.Background(), a, false)
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+
+	t.Run("variants -> not OK, each listed with canonical form", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;en;en _description;de;de_Description \nx;a;b;c;d\n")}
+		res := validateLocaleDescriptionSuffix(context.Background(), a, false)
+		if res.OK {
+			t.Fatalf("expected OK=false")
+		}
+		if res.Err != nil {
+			t.Fatalf("did not expect Err, got %v", res.Err)
+		}
+		for _, want := range []string{`"en _description" -> "en_description" (column 3)`, `"de_Description " -> "de_description" (column 5)`} {
+			if !strings.Contains(res.Msg, want) {
+				t.Fatalf("expected message to contain %q, got %q", want, res.Msg)
+			}
+		}
+	})
+
+	t.Run("unrelated description-like headers are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;Description;product description\nx;y;z\n")}
+		res := validateLocaleDescriptionSuffix(context.Background(), a, false)
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		res := validateLocaleDescriptionSuffix(ctx, checks.Artifact{Data: []byte("term;en _description\n")}, false)
+		if res.OK || res.Err == nil {
+			t.Fatalf("expected cancelled validation, got %+v", res)
+		}
+	})
+}
+
+func TestRunWarnLocaleDescriptionSuffix_EndToEnd(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no fix -> Warn", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;en;en-Description\nx;a;b\n"), Path: "gloss.csv"}
+		out := runWarnLocaleDescriptionSuffix(context.Background(), a, checks.RunOptions{FixMode: checks.FixNone})
+		if out.Result.Status != checks.Warn {
+			t.Fatalf("expected Warn, got %s (%s)", out.Result.Status, out.Result.Message)
+		}
+	})
+
+	t.Run("fix -> Pass with canonical header", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;en;en-Description\nx;a;b\n"), Path: "gloss.csv"}
+		out := runWarnLocaleDescriptionSuffix(context.Background(), a, checks.RunOptions{
+			FixMode:       checks.FixIfFailed,
+			RerunAfterFix: true,
+		})
+		if out.Result.Status != checks.Pass {
+			t.Fatalf("expected Pass, got %s (%s)", out.Result.Status, out.Result.Message)
+		}
+		if want := "term;en;en_description\nx;a;b\n"; string(out.Final.Data) != want {
+			t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", out.Final.Data, want)
+		}
+	})
+}
+
+func TestCanonicalDescriptionLabel_PreserveCase(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{"pt-BR_Description", "pt-BR_Description"},
+		{"pt-BR _Description ", "pt-BR_Description"},
+		{"EN - DESCRIPTION", "EN_DESCRIPTION"},
+		{"en description", "en_description"},
+	}
+
+	for _, tc := range cases {
+		got, ok := canonicalDescriptionLabel(tc.in, true)
+		if !ok || got != tc.want {
+			t.Fatalf("canonicalDescriptionLabel(%q, true) = %q, %v; want %q", tc.in, got, ok, tc.want)
+		}
+	}
+
+	res := validateLocaleDescriptionSuffix(context.Background(), checks.Artifact{Data: []byte("term;pt-BR;pt-BR_Description\n")}, true)
+	if !res.OK {
+		t.Fatalf("case-only variant must pass when preserving case, got %q", res.Msg)
+	}
+}
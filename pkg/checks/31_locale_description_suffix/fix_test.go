@@ -0,0 +1,94 @@
+package locale_description_suffix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixLocaleDescriptionSuffix_AlreadyCanonical(t *testing.T) {
+	t.Parallel()
+
+	in := "term;en;en_description\nx;a;b\n"
+	fr, err := fixLocaleDescriptionSuffix(context.Background(), checks.Artifact{Data: []byte(in)}, false)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("expected DidChange=false")
+	}
+	if string(fr.Data) != in {
+		t.Fatalf("data should remain unchanged, got %q", fr.Data)
+	}
+}
+
+func TestFixLocaleDescriptionSuffix_RewritesOnlyVariants(t *testing.T) {
+	t.Parallel()
+
+	in := "term;Description;en;en _description;pt-BR - DESCRIPTION;notes\nEn _description;x;a;b;c;d\n"
+	fr, err := fixLocaleDescriptionSuffix(context.Background(), checks.Artifact{Data: []byte(in)}, false)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "term;Description;en;en_description;pt-BR_description;notes\nEn _description;x;a;b;c;d\n"
+	if got := string(fr.Data); got != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", got, want)
+	}
+}
+
+func TestFixLocaleDescriptionSuffix_PreservesBOMCRLFAndLeadingBlankLines(t *testing.T) {
+	t.Parallel()
+
+	const bom = "\xEF\xBB\xBF"
+
+	in := bom + "\r\nterm;en;en_Description \r\nx;a;b\r\n"
+	fr, err := fixLocaleDescriptionSuffix(context.Background(), checks.Artifact{Data: []byte(in)}, false)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	want := bom + "\r\nterm;en;en_description\r\nx;a;b\r\n"
+	if got := string(fr.Data); got != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", got, want)
+	}
+}
+
+func TestFixLocaleDescriptionSuffix_PreservesNoFinalNewline(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixLocaleDescriptionSuffix(context.Background(), checks.Artifact{Data: []byte("term;en;en Description")}, false)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if want := "term;en;en_description"; string(fr.Data) != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", fr.Data, want)
+	}
+}
+
+func TestFixLocaleDescriptionSuffix_Empty(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixLocaleDescriptionSuffix(context.Background(), checks.Artifact{Data: []byte("  \n")}, false)
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got fr=%+v err=%v", fr, err)
+	}
+}
+
+func TestFixLocaleDescriptionSuffix_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixLocaleDescriptionSuffix(ctx, checks.Artifact{Data: []byte("term;en _description\n")}, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+package locale_description_policy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "ensure-locale-description-policy"
+
+// settingPolicy selects the project policy for <locale>_description columns.
+// Without it the check is a no-op.
+const settingPolicy = "policy"
+
+const (
+	policyRequire = "require" // every locale column has a sibling <locale>_description
+	policyStrip   = "strip"   // no <locale>_description columns at all
+)
+
+const maxReportedColumns = 10
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureLocaleDescriptionPolicy,
+			checks.WithPriority(checks.PrioContent+20),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
+}
+
+// runEnsureLocaleDescriptionPolicy — entry point for the check.
+// Unlike allowed-columns, which pairs only declared languages, this applies to every
+// locale column present in the file. It runs after orphan descriptions got their base columns.
+func runEnsureLocaleDescriptionPolicy(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	policy, _ := opts.Setting(checkName, settingPolicy)
+	policy = strings.ToLower(policy)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateLocaleDescriptionPolicy(ctx, a, policy)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixLocaleDescriptionPolicy(ctx, a, policy)
+		},
+		PassMsg:          "locale description columns follow the configured policy",
+		FixedMsg:         "applied locale description column policy",
+		AppliedMsg:       "auto-fix applied: locale description column policy",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "locale description columns still violate the policy after fix",
+	})
+}
+
+func validateLocaleDescriptionPolicy(ctx context.Context, a checks.Artifact, policy string) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	switch policy {
+	case "":
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no locale description policy configured",
+		}
+	case policyRequire, policyStrip:
+	default:
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "invalid " + settingPolicy + " " + strconv.Quote(policy) + " (expected " + policyRequire + " or " + policyStrip + ")",
+			Err: errors.New("invalid locale description policy"),
+		}
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for locale description policy",
+		}
+	}
+
+	ix, err := checks.LocaleIndexOf(ctx, a)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return checks.ValidationResult{
+				OK:  true,
+				Msg: "no header line found (nothing to validate for locale description policy)",
+			}
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse header with semicolon delimiter",
+			Err: err,
+		}
+	}
+
+	if policy == policyStrip {
+		present := descriptionColumns(ix)
+		if len(present) == 0 {
+			return checks.ValidationResult{
+				OK:  true,
+				Msg: "no locale description columns (policy " + policyStrip + ")",
+			}
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: columnsMessage("locale description columns not allowed by policy "+policyStrip+": ", labels(present)),
+		}
+	}
+
+	missing := missingDescriptionColumns(ix)
+	if len(missing) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "every locale column has a *_description column (policy " + policyRequire + ")",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: columnsMessage("locale columns without a *_description column: ", labels(missing)),
+	}
+}
+
+// descriptionColumns lists every <locale>_description column in header order.
+func descriptionColumns(ix *checks.LocaleIndex) []checks.LocaleColumn {
+	var out []checks.LocaleColumn
+	for _, col := range ix.Columns {
+		if col.Description {
+			out = append(out, col)
+		}
+	}
+
+	return out
+}
+
+// missingDescriptionColumns lists language-like base columns that have no
+// "<base>_description" sibling anywhere in the header.
+func missingDescriptionColumns(ix *checks.LocaleIndex) []checks.LocaleColumn {
+	var out []checks.LocaleColumn
+	for _, col := range ix.Columns {
+		if col.Description || !col.LangLike {
+			continue
+		}
+		if ix.HasColumn(col.Label + "_description") {
+			continue
+		}
+
+		out = append(out, col)
+	}
+
+	return out
+}
+
+func labels(cols []checks.LocaleColumn) []string {
+	out := make([]string, 0, len(cols))
+	for _, col := range cols {
+		out = append(out, col.Label)
+	}
+
+	return out
+}
+
+func columnsMessage(prefix string, names []string) string {
+	display := names
+	if len(display) > maxReportedColumns {
+		display = display[:maxReportedColumns]
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteString(strings.Join(display, ", "))
+
+	if len(names) > len(display) {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(len(names)))
+	b.WriteString(")")
+
+	return b.String()
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,115 @@
+package locale_description_policy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateLocaleDescriptionPolicy(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("term;description;en;en_description;de;fr\nx;y;a;b;c;d\n")
+
+	t.Run("no policy -> OK", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: data}, "")
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+
+	t.Run("invalid policy -> Err", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: data}, "sometimes")
+		if res.OK || res.Err == nil {
+			t.Fatalf("expected OK=false with Err, got %+v", res)
+		}
+	})
+
+	t.Run("require lists locale columns without descriptions", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: data}, policyRequire)
+		if res.OK {
+			t.Fatalf("expected OK=false")
+		}
+		if !strings.Contains(res.Msg, "de, fr (total 2)") {
+			t.Fatalf("unexpected message: %q", res.Msg)
+		}
+	})
+
+	t.Run("require satisfied", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;description;en;EN_Description\nx;y;a;b\n")}
+		res := validateLocaleDescriptionPolicy(context.Background(), a, policyRequire)
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+
+	t.Run("strip lists description columns, keeps main description", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: data}, policyStrip)
+		if res.OK {
+			t.Fatalf("expected OK=false")
+		}
+		if !strings.Contains(res.Msg, "en_description (total 1)") {
+			t.Fatalf("unexpected message: %q", res.Msg)
+		}
+	})
+
+	t.Run("strip satisfied", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;description;en\nx;y;a\n")}
+		res := validateLocaleDescriptionPolicy(context.Background(), a, policyStrip)
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+}
+
+func TestRunEnsureLocaleDescriptionPolicy_EndToEnd(t *testing.T) {
+	t.Parallel()
+
+	settings := func(policy string) map[string]checks.CheckSettings {
+		return map[string]checks.CheckSettings{checkName: {settingPolicy: policy}}
+	}
+
+	t.Run("require without fix -> Warn", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;description;en\nx;y;a\n"), Path: "gloss.csv"}
+		out := runEnsureLocaleDescriptionPolicy(context.Background(), a, checks.RunOptions{
+			FixMode:  checks.FixNone,
+			Settings: settings("Require"),
+		})
+		if out.Result.Status != checks.Warn {
+			t.Fatalf("expected Warn, got %s (%s)", out.Result.Status, out.Result.Message)
+		}
+	})
+
+	t.Run("strip with fix -> Pass", func(t *testing.T) {
+		t.Parallel()
+
+		a := checks.Artifact{Data: []byte("term;description;en;en_description\nx;y;a;b\n"), Path: "gloss.csv"}
+		out := runEnsureLocaleDescriptionPolicy(context.Background(), a, checks.RunOptions{
+			FixMode:       checks.FixIfFailed,
+			RerunAfterFix: true,
+			Settings:      settings(policyStrip),
+		})
+		if out.Result.Status != checks.Pass {
+			t.Fatalf("expected Pass, got %s (%s)", out.Result.Status, out.Result.Message)
+		}
+		if want := "term;description;en\nx;y;a\n"; string(out.Final.Data) != want {
+			t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", out.Final.Data, want)
+		}
+	})
+}
@@ -0,0 +1,331 @@
+package locale_description_policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixLocaleDescriptionPolicy rewrites the whole table: policy "require" inserts an empty
+// "<locale>_description" column right after each locale column lacking one,
+// policy "strip" drops every <locale>_description column together with its cells.
+func fixLocaleDescriptionPolicy(ctx context.Context, a checks.Artifact, policy string) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	if policy != policyRequire && policy != policyStrip {
+		return checks.NoFix(a, "no valid locale description policy configured")
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findPolicyFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readPolicyFixRecords(ctx, appendPolicyFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || policyFixIsBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	plan := buildPolicyFixPlan(records[0], policy)
+	if len(plan.changed) == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "locale description columns already follow policy " + policy,
+		}, nil
+	}
+
+	outRecs, err := applyPolicyFixPlan(ctx, records, plan)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+
+	outTail, err := writePolicyFixRecords(ctx, outRecs, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchPolicyFix(bom, parts.before, outTail)
+
+	note := "added locale description columns: "
+	if policy == policyStrip {
+		note = "removed locale description columns: "
+	}
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      note + strings.Join(plan.changed, ", "),
+	}, nil
+}
+
+type policyFixColumn struct {
+	label  string
+	srcIdx int // -1 for an inserted, empty column
+}
+
+type policyFixPlan struct {
+	columns []policyFixColumn
+	changed []string
+}
+
+func buildPolicyFixPlan(header []string, policy string) policyFixPlan {
+	ix := checks.BuildLocaleIndex(header)
+
+	var affected []checks.LocaleColumn
+	if policy == policyStrip {
+		affected = descriptionColumns(ix)
+	} else {
+		affected = missingDescriptionColumns(ix)
+	}
+
+	byPos := make(map[int]struct{}, len(affected))
+	plan := policyFixPlan{
+		columns: make([]policyFixColumn, 0, len(header)+len(affected)),
+		changed: make([]string, 0, len(affected)),
+	}
+	for _, col := range affected {
+		byPos[col.Pos] = struct{}{}
+	}
+
+	for idx, cell := range header {
+		_, hit := byPos[idx]
+
+		if hit && policy == policyStrip {
+			plan.changed = append(plan.changed, strings.TrimSpace(cell))
+			continue
+		}
+
+		plan.columns = append(plan.columns, policyFixColumn{
+			label:  cell,
+			srcIdx: idx,
+		})
+
+		if hit {
+			label := strings.TrimSpace(cell) + "_description"
+			plan.columns = append(plan.columns, policyFixColumn{
+				label:  label,
+				srcIdx: -1,
+			})
+			plan.changed = append(plan.changed, label)
+		}
+	}
+
+	return plan
+}
+
+func applyPolicyFixPlan(
+	ctx context.Context,
+	records [][]string,
+	plan policyFixPlan,
+) ([][]string, error) {
+	out := make([][]string, len(records))
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		row := records[i]
+		newRow := make([]string, len(plan.columns))
+
+		for j, col := range plan.columns {
+			if i == 0 {
+				newRow[j] = col.label
+				continue
+			}
+
+			if col.srcIdx >= 0 && col.srcIdx < len(row) {
+				newRow[j] = row[col.srcIdx]
+			}
+		}
+
+		out[i] = newRow
+	}
+
+	return out, nil
+}
+
+type policyFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findPolicyFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (policyFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return policyFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := policyFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return policyFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return policyFixHeaderParts{}, false, nil
+}
+
+func policyFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendPolicyFixHeaderAndRest(parts policyFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readPolicyFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writePolicyFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func policyFixIsBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func stitchPolicyFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
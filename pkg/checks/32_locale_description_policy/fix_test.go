@@ -0,0 +1,80 @@
+package locale_description_policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixLocaleDescriptionPolicy_RequireInsertsAfterBase(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description;en;de;de_description;fr\nx;y;a;b;c;d\nshort;z\n"
+	fr, err := fixLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: []byte(in)}, policyRequire)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "term;description;en;en_description;de;de_description;fr;fr_description\n" +
+		"x;y;a;;b;c;d;\n" +
+		"short;z;;;;;;\n"
+	if got := string(fr.Data); got != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", got, want)
+	}
+}
+
+func TestFixLocaleDescriptionPolicy_StripRemovesColumnsAndCells(t *testing.T) {
+	t.Parallel()
+
+	const bom = "\xEF\xBB\xBF"
+
+	in := bom + "\r\nterm;description;en;en_description;de_description\r\nx;y;a;b;c\r\n"
+	fr, err := fixLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: []byte(in)}, policyStrip)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	want := bom + "\r\nterm;description;en\r\nx;y;a\r\n"
+	if got := string(fr.Data); got != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", got, want)
+	}
+}
+
+func TestFixLocaleDescriptionPolicy_NoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description;en;en_description"
+	fr, err := fixLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: []byte(in)}, policyRequire)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected untouched data, got %+v", fr)
+	}
+}
+
+func TestFixLocaleDescriptionPolicy_NoPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := fixLocaleDescriptionPolicy(context.Background(), checks.Artifact{Data: []byte("term;en\n")}, "")
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+}
+
+func TestFixLocaleDescriptionPolicy_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixLocaleDescriptionPolicy(ctx, checks.Artifact{Data: []byte("term;en\n")}, policyRequire)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
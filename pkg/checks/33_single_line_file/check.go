@@ -0,0 +1,76 @@
+package single_line_file
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-single-line-file"
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnSingleLineFile,
+			checks.WithPriority(checks.PrioStructural+30),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
+}
+
+// runWarnSingleLineFile — entry point for the check.
+// It runs right before ensure-at-least-two-lines so the likely cause is reported first.
+// There is no auto-fix: we cannot guess where the rows were meant to end.
+func runWarnSingleLineFile(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:     checkName,
+		Validate: validateSingleLineFile,
+		PassMsg:  "file contains line breaks",
+		FailAs:   checks.Warn,
+	})
+}
+
+// validateSingleLineFile warns when non-blank content has no "\n" at all.
+// Bare "\r" separators (classic Mac) are called out, since they are the usual cause.
+func validateSingleLineFile(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := bytes.TrimSpace(checks.StripUTF8BOM(a.Data))
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for line breaks",
+		}
+	}
+
+	if bytes.IndexByte(data, '\n') >= 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "file contains line breaks",
+		}
+	}
+
+	msg := "file contains a single line — missing line breaks? (" + strconv.Itoa(len(data)) + " bytes, no \\n found"
+	if n := bytes.Count(data, []byte("\r")); n > 0 {
+		msg += "; " + strconv.Itoa(n) + " bare \\r separators look like old Mac line endings"
+	}
+	msg += ")"
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: msg,
+	}
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,67 @@
+package single_line_file
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateSingleLineFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("multiple lines -> OK", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateSingleLineFile(context.Background(), checks.Artifact{Data: []byte("term;description\nx;y\n")})
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+
+	t.Run("trailing newline only -> single line", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateSingleLineFile(context.Background(), checks.Artifact{Data: []byte("term;description\n")})
+		if res.OK {
+			t.Fatalf("expected OK=false")
+		}
+		if !strings.Contains(res.Msg, "missing line breaks?") {
+			t.Fatalf("unexpected message: %q", res.Msg)
+		}
+	})
+
+	t.Run("bare CR separators are called out", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateSingleLineFile(context.Background(), checks.Artifact{Data: []byte("term;description\rx;y\rz;w")})
+		if res.OK {
+			t.Fatalf("expected OK=false")
+		}
+		if !strings.Contains(res.Msg, "2 bare \\r separators") {
+			t.Fatalf("unexpected message: %q", res.Msg)
+		}
+	})
+
+	t.Run("blank -> OK", func(t *testing.T) {
+		t.Parallel()
+
+		res := validateSingleLineFile(context.Background(), checks.Artifact{Data: []byte("  ")})
+		if !res.OK {
+			t.Fatalf("expected OK=true, got %+v", res)
+		}
+	})
+}
+
+func TestRunWarnSingleLineFile_HugeLine(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte("term;"), 4<<20) // 20 MB without a single newline
+
+	out := runWarnSingleLineFile(context.Background(), checks.Artifact{Data: data, Path: "gloss.csv"}, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected Warn, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,390 @@
+package known_tags
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "ensure-known-tags"
+
+// Setting keys understood by this check. Without allowed-tags the check is a no-op.
+const (
+	settingAllowedTags = "allowed-tags" // comma-separated project tag registry
+	settingTagAliases  = "tag-aliases"  // comma-separated "alias=tag" pairs applied by the fix
+)
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureKnownTags,
+			checks.WithPriority(checks.PrioSemantic+30),
+			checks.WithLossyFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// tagRegistry is the set of allowed tags plus an alias table, read from the check settings.
+// Keys are lowercased; values keep the registry spelling.
+type tagRegistry struct {
+	allowed map[string]string
+	aliases map[string]string
+}
+
+func registryFrom(opts checks.RunOptions) tagRegistry {
+	reg := tagRegistry{
+		allowed: make(map[string]string),
+		aliases: make(map[string]string),
+	}
+
+	for _, t := range opts.SettingList(checkName, settingAllowedTags, nil) {
+		reg.allowed[strings.ToLower(t)] = t
+	}
+
+	for _, pair := range opts.SettingList(checkName, settingTagAliases, nil) {
+		alias, target, ok := strings.Cut(pair, "=")
+		alias = strings.TrimSpace(alias)
+		target = strings.TrimSpace(target)
+		if !ok || alias == "" || target == "" {
+			continue
+		}
+
+		// aliases pointing outside the registry would only trade one unknown tag for another
+		if canonical, known := reg.allowed[strings.ToLower(target)]; known {
+			reg.aliases[strings.ToLower(alias)] = canonical
+		}
+	}
+
+	return reg
+}
+
+func (r tagRegistry) active() bool {
+	return len(r.allowed) > 0
+}
+
+func (r tagRegistry) known(tag string) bool {
+	_, ok := r.allowed[strings.ToLower(tag)]
+	return ok
+}
+
+// resolve maps a tag to its registry spelling, through the alias table if needed.
+func (r tagRegistry) resolve(tag string) (string, bool) {
+	key := strings.ToLower(tag)
+	if canonical, ok := r.allowed[key]; ok {
+		return canonical, true
+	}
+	if canonical, ok := r.aliases[key]; ok {
+		return canonical, true
+	}
+
+	return "", false
+}
+
+// runEnsureKnownTags — entry point for the check.
+// It runs before the tags policy check so the policy sees cleaned-up tags.
+func runEnsureKnownTags(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	reg := registryFrom(opts)
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateEnsureKnownTags(ctx, a, reg, limit, stopAfter)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixUnknownTags(ctx, a, reg)
+		},
+		PassMsg:          "all tags are in the project tag registry",
+		FixedMsg:         "mapped aliased tags and removed unknown tags",
+		AppliedMsg:       "auto-fix applied: mapped aliased tags and removed unknown tags",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "unknown tags remain after fix",
+	})
+}
+
+// validateEnsureKnownTags reports rows whose tags column holds tags missing from the registry.
+// Matching is case-insensitive.
+func validateEnsureKnownTags(ctx context.Context, a checks.Artifact, reg tagRegistry, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	if !reg.active() {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no tag registry configured",
+		}
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for unknown tags",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTagsHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := findTagsColumns(header)
+	if cols.tags < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no 'tags' column found (skipping unknown tags check)",
+		}
+	}
+
+	bad, err := findUnknownTags(ctx, r, rowNum, cols, reg, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating tags",
+			Err: err,
+		}
+	}
+
+	if bad.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "all tags are in the project tag registry",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       unknownTagsMessage(bad),
+		Findings:  unknownTagsFindings(bad),
+		Truncated: bad.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTagsHeader(ctx context.Context, r csvReader) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for unknown tags)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type tagsColumns struct {
+	term int
+	tags int
+}
+
+func findTagsColumns(header []string) tagsColumns {
+	cols := tagsColumns{term: -1, tags: -1}
+
+	for i, h := range header {
+		switch normalizeHeaderCell(h) {
+		case "term":
+			if cols.term < 0 {
+				cols.term = i
+			}
+		case "tags":
+			if cols.tags < 0 {
+				cols.tags = i
+			}
+		}
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type unknownTagsRow struct {
+	rowNum  int
+	term    string
+	unknown []string
+}
+
+func findUnknownTags(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols tagsColumns,
+	reg tagRegistry,
+	limit int,
+	stopAfter int,
+) (checks.Capped[unknownTagsRow], error) {
+	bad := checks.Capped[unknownTagsRow]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[unknownTagsRow]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return bad, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[unknownTagsRow]{}, ctxErr
+			}
+
+			return checks.Capped[unknownTagsRow]{}, err
+		}
+
+		rowNum++
+
+		if isBlankCSVRecord(rec) {
+			continue
+		}
+
+		var unknown []string
+		for _, tag := range checks.SplitTags(recordValue(rec, cols.tags)) {
+			if !reg.known(tag) {
+				unknown = append(unknown, tag)
+			}
+		}
+		if len(unknown) == 0 {
+			continue
+		}
+
+		bad.Add(unknownTagsRow{
+			rowNum:  rowNum,
+			term:    recordValue(rec, cols.term),
+			unknown: unknown,
+		})
+		if bad.Exhausted() {
+			return bad, nil
+		}
+	}
+}
+
+func recordValue(record []string, pos int) string {
+	if pos < 0 || pos >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[pos])
+}
+
+func unknownTagsMessage(rows checks.Capped[unknownTagsRow]) string {
+	limit := len(rows.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+	b.WriteString("rows with tags missing from the tag registry: ")
+
+	for i := range limit {
+		row := rows.Items[i]
+
+		if row.term != "" {
+			b.WriteString("term=")
+			b.WriteString(strconv.Quote(row.term))
+			b.WriteString(" ")
+		}
+
+		b.WriteString("(row ")
+		b.WriteString(strconv.Itoa(row.rowNum))
+		b.WriteString(") ")
+		b.WriteString(strings.Join(row.unknown, ", "))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if rows.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(rows.Total()))
+	b.WriteString(" rows)")
+	b.WriteString(checks.OverflowNote(rows.Overflow))
+	b.WriteString(rows.TruncatedNote())
+
+	return b.String()
+}
+
+func unknownTagsFindings(rows checks.Capped[unknownTagsRow]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(rows.Items))
+	for _, row := range rows.Items {
+		out = append(out, checks.Finding{
+			Row:     row.rowNum,
+			Column:  "tags",
+			Value:   strings.Join(row.unknown, ","),
+			Message: "unknown tags: " + strings.Join(row.unknown, ", "),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
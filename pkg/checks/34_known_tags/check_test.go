@@ -0,0 +1,112 @@
+package known_tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const tagsCSV = "" +
+	"term;description;tags\n" +
+	"apple;fruit;food,UI\n" +
+	"pear;fruit;\n" +
+	"plum;fruit;food,snacks,misc\n" +
+	"kiwi;fruit;legal\n"
+
+func registryOf(allowed, aliases string) tagRegistry {
+	return registryFrom(checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		checkName: {settingAllowedTags: allowed, settingTagAliases: aliases},
+	}})
+}
+
+func TestRegistryFrom(t *testing.T) {
+	t.Parallel()
+
+	reg := registryOf("food, ui ,Legal", "snacks=Food, x=unknown, broken, =ui")
+
+	if !reg.known("FOOD") || !reg.known("legal") || reg.known("snacks") {
+		t.Fatalf("unexpected allowed set: %+v", reg.allowed)
+	}
+	if got, ok := reg.resolve("Snacks"); !ok || got != "food" {
+		t.Fatalf("resolve(Snacks) = %q, %v", got, ok)
+	}
+	if _, ok := reg.resolve("x"); ok {
+		t.Fatalf("aliases to tags outside the registry must be ignored")
+	}
+	if len(reg.aliases) != 1 {
+		t.Fatalf("unexpected aliases: %+v", reg.aliases)
+	}
+}
+
+func TestValidateEnsureKnownTags_NoRegistry_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureKnownTags(context.Background(), checks.Artifact{Data: []byte(tagsCSV)}, registryOf("", ""), checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true without a registry, got %q", res.Msg)
+	}
+}
+
+func TestValidateEnsureKnownTags_ReportsUnknownTags(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureKnownTags(context.Background(), checks.Artifact{Data: []byte(tagsCSV)}, registryOf("food,ui,legal", ""), checks.DefaultMaxFindings, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, `term="plum" (row 4) snacks, misc`) || !strings.Contains(res.Msg, "(total 1 rows)") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+	if len(res.Findings) != 1 || res.Findings[0].Row != 4 || res.Findings[0].Column != "tags" {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateEnsureKnownTags_NoTagsColumn(t *testing.T) {
+	t.Parallel()
+
+	res := validateEnsureKnownTags(context.Background(), checks.Artifact{Data: []byte("term;description\nx;y\n")}, registryOf("food", ""), checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true without a tags column, got %q", res.Msg)
+	}
+}
+
+func TestValidateEnsureKnownTags_StopAfter(t *testing.T) {
+	t.Parallel()
+
+	data := "term;tags\na;x\nb;y\nc;z\n"
+	res := validateEnsureKnownTags(context.Background(), checks.Artifact{Data: []byte(data)}, registryOf("food", ""), checks.DefaultMaxFindings, 2)
+	if res.OK || !res.Truncated || len(res.Findings) != 2 {
+		t.Fatalf("expected 2 truncated findings, got %+v", res)
+	}
+}
+
+func TestRunEnsureKnownTags_EndToEnd(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte(tagsCSV), Path: "gloss.csv"}
+	opts := checks.RunOptions{
+		FixMode:       checks.FixIfFailed,
+		RerunAfterFix: true,
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingAllowedTags: "food,ui,legal", settingTagAliases: "snacks=food"},
+		},
+	}
+
+	out := runEnsureKnownTags(context.Background(), a, opts)
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected Pass after fix, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+
+	want := "" +
+		"term;description;tags\n" +
+		"apple;fruit;food,UI\n" +
+		"pear;fruit;\n" +
+		"plum;fruit;food\n" +
+		"kiwi;fruit;legal\n"
+	if string(out.Final.Data) != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", out.Final.Data, want)
+	}
+}
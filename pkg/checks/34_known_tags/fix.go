@@ -0,0 +1,313 @@
+package known_tags
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixUnknownTags rewrites tags cells that hold unknown tags: aliased tags are mapped to
+// their registry tag, the rest of the unknown ones are dropped. Cells with only known
+// tags are left exactly as they are.
+func fixUnknownTags(ctx context.Context, a checks.Artifact, reg tagRegistry) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	if !reg.active() {
+		return checks.NoFix(a, "no tag registry configured")
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findTagsFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readTagsFixRecords(ctx, appendTagsFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	tagsCol := findTagsColumns(records[0]).tags
+	if tagsCol < 0 {
+		return checks.NoFix(a, "no 'tags' column found")
+	}
+
+	stats, err := cleanTagsColumn(ctx, records, tagsCol, reg)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if stats.cells == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no unknown tags to fix",
+		}, nil
+	}
+
+	outTail, err := writeTagsFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchTagsFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note: "mapped " + strconv.Itoa(stats.mapped) + " aliased and removed " +
+			strconv.Itoa(stats.removed) + " unknown tags in " + strconv.Itoa(stats.cells) + " rows",
+	}, nil
+}
+
+type tagsFixStats struct {
+	cells   int
+	mapped  int
+	removed int
+}
+
+// cleanTagsColumn rewrites records in place.
+func cleanTagsColumn(ctx context.Context, records [][]string, col int, reg tagRegistry) (tagsFixStats, error) {
+	var stats tagsFixStats
+
+	for i := 1; i < len(records); i++ {
+		if i%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return tagsFixStats{}, err
+			}
+		}
+
+		row := records[i]
+		if col >= len(row) {
+			continue
+		}
+
+		cleaned, mapped, removed := cleanTagsCell(row[col], reg)
+		if mapped == 0 && removed == 0 {
+			continue
+		}
+
+		row[col] = cleaned
+		stats.cells++
+		stats.mapped += mapped
+		stats.removed += removed
+	}
+
+	return stats, nil
+}
+
+// cleanTagsCell keeps known tags as spelled, maps aliases and drops the rest.
+// Duplicates produced by mapping are removed; order follows first appearance.
+func cleanTagsCell(cell string, reg tagRegistry) (string, int, int) {
+	tags := checks.SplitTags(cell)
+
+	hasUnknown := false
+	for _, t := range tags {
+		if !reg.known(t) {
+			hasUnknown = true
+			break
+		}
+	}
+	if !hasUnknown {
+		return cell, 0, 0
+	}
+
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	mapped, removed := 0, 0
+
+	for _, t := range tags {
+		value := t
+		if !reg.known(t) {
+			canonical, ok := reg.resolve(t)
+			if !ok {
+				removed++
+				continue
+			}
+			value = canonical
+			mapped++
+		}
+
+		key := strings.ToLower(value)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, value)
+	}
+
+	return strings.Join(out, ","), mapped, removed
+}
+
+type tagsFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findTagsFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (tagsFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return tagsFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := tagsFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return tagsFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return tagsFixHeaderParts{}, false, nil
+}
+
+func tagsFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendTagsFixHeaderAndRest(parts tagsFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readTagsFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeTagsFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchTagsFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
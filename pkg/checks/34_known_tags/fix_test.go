@@ -0,0 +1,84 @@
+package known_tags
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestCleanTagsCell(t *testing.T) {
+	t.Parallel()
+
+	reg := registryOf("food,legal", "snacks=Food,law=legal")
+
+	cases := []struct {
+		in      string
+		want    string
+		mapped  int
+		removed int
+	}{
+		{"food, Legal", "food, Legal", 0, 0},
+		{"food,misc", "food", 0, 1},
+		{"snacks,law,misc", "food,legal", 2, 1},
+		{"food,snacks", "food", 1, 0},
+		{"misc", "", 0, 1},
+	}
+
+	for _, tc := range cases {
+		got, mapped, removed := cleanTagsCell(tc.in, reg)
+		if got != tc.want || mapped != tc.mapped || removed != tc.removed {
+			t.Fatalf("cleanTagsCell(%q) = %q, %d, %d; want %q, %d, %d", tc.in, got, mapped, removed, tc.want, tc.mapped, tc.removed)
+		}
+	}
+}
+
+func TestFixUnknownTags_PreservesBOMAndCRLF(t *testing.T) {
+	t.Parallel()
+
+	const bom = "\xEF\xBB\xBF"
+
+	in := bom + "term;tags\r\nx;food,misc\r\ny;food\r\n"
+	fr, err := fixUnknownTags(context.Background(), checks.Artifact{Data: []byte(in)}, registryOf("food", ""))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := bom + "term;tags\r\nx;food\r\ny;food\r\n"
+	if string(fr.Data) != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", fr.Data, want)
+	}
+}
+
+func TestFixUnknownTags_NoRegistryOrNoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;tags\nx;food\n"
+
+	if _, err := fixUnknownTags(context.Background(), checks.Artifact{Data: []byte(in)}, registryOf("", "")); !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix without a registry, got %v", err)
+	}
+
+	fr, err := fixUnknownTags(context.Background(), checks.Artifact{Data: []byte(in)}, registryOf("food", ""))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected untouched data, got %+v", fr)
+	}
+}
+
+func TestFixUnknownTags_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := fixUnknownTags(ctx, checks.Artifact{Data: []byte("term;tags\nx;y\n")}, registryOf("food", "")); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
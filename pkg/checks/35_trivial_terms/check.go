@@ -0,0 +1,388 @@
+package trivial_terms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-trivial-terms"
+
+// Setting keys understood by this check.
+const (
+	// settingMinLength is the minimum term length in characters (default: 2).
+	settingMinLength = "min-length"
+	// settingSourceLang picks the stopword list (default: first declared language, then "en").
+	settingSourceLang = "source-lang"
+	// settingStopwordsPrefix + lang ("stopwords-en") replaces the built-in list for that language.
+	settingStopwordsPrefix = "stopwords-"
+)
+
+const (
+	defaultMinLength  = 2
+	defaultSourceLang = "en"
+)
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedTerms  = 10
+)
+
+// defaultStopwords are deliberately short: only words that are never glossary terms on their own.
+var defaultStopwords = map[string][]string{
+	"en": {"a", "an", "the", "and", "or", "of", "to", "in", "on", "at", "for", "by", "with", "is", "it", "this", "that"},
+	"de": {"der", "die", "das", "ein", "eine", "und", "oder", "von", "zu", "in", "mit", "für", "ist"},
+	"fr": {"le", "la", "les", "un", "une", "des", "et", "ou", "de", "du", "à", "en", "pour", "est"},
+	"es": {"el", "la", "los", "las", "un", "una", "y", "o", "de", "del", "a", "en", "para", "es"},
+}
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnTrivialTerms,
+			checks.WithOptIn(),
+			checks.WithPriority(checks.PrioSemantic+10),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnTrivialTerms — entry point for the check.
+// Informational only: there is no auto-fix, a trivial term has to be removed or reworded by hand.
+func runWarnTrivialTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnTrivialTerms(ctx, a, rulesFrom(opts, a.Langs), limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no single-character or stopword-only terms",
+		FailAs:  checks.Warn,
+	})
+}
+
+// trivialRules holds the minimum length and the stopword set (lowercased) of the source language.
+type trivialRules struct {
+	minLength int
+	lang      string
+	stopwords map[string]struct{}
+}
+
+func rulesFrom(opts checks.RunOptions, langs []string) trivialRules {
+	lang := defaultSourceLang
+	if len(langs) > 0 && strings.TrimSpace(langs[0]) != "" {
+		lang = langs[0]
+	}
+	if v, ok := opts.Setting(checkName, settingSourceLang); ok && v != "" {
+		lang = v
+	}
+	lang = baseLang(lang)
+
+	words := opts.SettingList(checkName, settingStopwordsPrefix+lang, defaultStopwords[lang])
+
+	rules := trivialRules{
+		minLength: opts.SettingInt(checkName, settingMinLength, defaultMinLength),
+		lang:      lang,
+		stopwords: make(map[string]struct{}, len(words)),
+	}
+	for _, w := range words {
+		rules.stopwords[strings.ToLower(w)] = struct{}{}
+	}
+
+	return rules
+}
+
+// baseLang reduces "en_US" / "pt-BR" to the lowercased language part.
+func baseLang(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "_-"); i > 0 {
+		lang = lang[:i]
+	}
+
+	return lang
+}
+
+// validateWarnTrivialTerms reports terms shorter than the minimum length and terms made
+// only of stopwords ("the", "a of"). Such terms match almost any source text.
+func validateWarnTrivialTerms(ctx context.Context, a checks.Artifact, rules trivialRules, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for trivial terms",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTermHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	termCol := findTermColumn(header)
+	if termCol < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no 'term' column found (skipping trivial terms check)",
+		}
+	}
+
+	bad, err := findTrivialTerms(ctx, r, rowNum, termCol, rules, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating trivial terms",
+			Err: err,
+		}
+	}
+
+	if bad.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no single-character or stopword-only terms",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       trivialTermsMessage(bad, rules),
+		Findings:  trivialTermsFindings(bad, rules),
+		Truncated: bad.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTermHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for trivial terms)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findTermColumn(header []string) int {
+	for i, col := range header {
+		if normalizeHeaderCell(col) == "term" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type trivialTerm struct {
+	rowNum    int
+	term      string
+	tooShort  bool
+	stopwords bool
+}
+
+func findTrivialTerms(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	termCol int,
+	rules trivialRules,
+	limit int,
+	stopAfter int,
+) (checks.Capped[trivialTerm], error) {
+	bad := checks.Capped[trivialTerm]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[trivialTerm]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return bad, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[trivialTerm]{}, ctxErr
+			}
+
+			return checks.Capped[trivialTerm]{}, err
+		}
+
+		rowNum++
+
+		if termCol >= len(rec) {
+			continue
+		}
+
+		term := strings.TrimSpace(rec[termCol])
+		if term == "" {
+			continue // empty terms are reported by ensure-no-empty-term-values
+		}
+
+		hit := trivialTerm{
+			rowNum:    rowNum,
+			term:      term,
+			tooShort:  utf8.RuneCountInString(term) < rules.minLength,
+			stopwords: onlyStopwords(term, rules.stopwords),
+		}
+		if !hit.tooShort && !hit.stopwords {
+			continue
+		}
+
+		bad.Add(hit)
+		if bad.Exhausted() {
+			return bad, nil
+		}
+	}
+}
+
+// onlyStopwords reports whether every word of term is a stopword.
+// Punctuation around words is ignored ("the." counts as "the").
+func onlyStopwords(term string, stopwords map[string]struct{}) bool {
+	if len(stopwords) == 0 {
+		return false
+	}
+
+	words := strings.Fields(strings.ToLower(term))
+	if len(words) == 0 {
+		return false
+	}
+
+	for _, w := range words {
+		w = strings.TrimFunc(w, unicode.IsPunct)
+		if _, ok := stopwords[w]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t trivialTerm) reason(rules trivialRules) string {
+	if t.stopwords {
+		return "only " + rules.lang + " stopwords"
+	}
+
+	return "shorter than " + strconv.Itoa(rules.minLength) + " characters"
+}
+
+func trivialTermsMessage(terms checks.Capped[trivialTerm], rules trivialRules) string {
+	limit := len(terms.Items)
+	if limit > maxReportedTerms {
+		limit = maxReportedTerms
+	}
+
+	var b strings.Builder
+	b.WriteString("trivial terms: ")
+
+	for i := range limit {
+		t := terms.Items[i]
+
+		b.WriteString(strconv.Quote(t.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(t.rowNum))
+		b.WriteString(") ")
+		b.WriteString(t.reason(rules))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if terms.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(terms.Total()))
+	b.WriteString(" terms)")
+	b.WriteString(checks.OverflowNote(terms.Overflow))
+	b.WriteString(terms.TruncatedNote())
+
+	return b.String()
+}
+
+func trivialTermsFindings(terms checks.Capped[trivialTerm], rules trivialRules) []checks.Finding {
+	out := make([]checks.Finding, 0, len(terms.Items))
+	for _, t := range terms.Items {
+		out = append(out, checks.Finding{
+			Row:     t.rowNum,
+			Column:  "term",
+			Value:   t.term,
+			Message: "term is " + t.reason(rules),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,103 @@
+package trivial_terms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const termsCSV = "" +
+	"term;description\n" +
+	"a;article\n" +
+	"The;article\n" +
+	"of the;phrase\n" +
+	"cloud;noun\n" +
+	"the cloud;phrase\n" +
+	"X;letter\n"
+
+func TestRulesFrom(t *testing.T) {
+	t.Parallel()
+
+	rules := rulesFrom(checks.RunOptions{}, nil)
+	if rules.lang != "en" || rules.minLength != 2 {
+		t.Fatalf("unexpected defaults: %+v", rules)
+	}
+	if _, ok := rules.stopwords["the"]; !ok {
+		t.Fatalf("expected built-in English stopwords")
+	}
+
+	rules = rulesFrom(checks.RunOptions{}, []string{"de_DE", "en"})
+	if rules.lang != "de" {
+		t.Fatalf("expected source language from the first declared language, got %q", rules.lang)
+	}
+
+	rules = rulesFrom(checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		checkName: {settingSourceLang: "pt-BR", "stopwords-pt": "o, a, de", settingMinLength: "3"},
+	}}, []string{"en"})
+	if rules.lang != "pt" || rules.minLength != 3 || len(rules.stopwords) != 3 {
+		t.Fatalf("unexpected configured rules: %+v", rules)
+	}
+}
+
+func TestValidateWarnTrivialTerms(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnTrivialTerms(context.Background(), checks.Artifact{Data: []byte(termsCSV)},
+		rulesFrom(checks.RunOptions{}, nil), checks.DefaultMaxFindings, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{
+		`"a" (row 2) only en stopwords`,
+		`"The" (row 3) only en stopwords`,
+		`"of the" (row 4) only en stopwords`,
+		`"X" (row 7) shorter than 2 characters`,
+		"(total 4 terms)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, "cloud") {
+		t.Fatalf("terms with real words must not be reported: %q", res.Msg)
+	}
+	if len(res.Findings) != 4 || res.Findings[3].Row != 7 {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateWarnTrivialTerms_EmptyStopwordListOnlyChecksLength(t *testing.T) {
+	t.Parallel()
+
+	rules := rulesFrom(checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		checkName: {"stopwords-en": ""},
+	}}, nil)
+
+	res := validateWarnTrivialTerms(context.Background(), checks.Artifact{Data: []byte(termsCSV)}, rules, checks.DefaultMaxFindings, 0)
+	if res.OK || !strings.Contains(res.Msg, "(total 2 terms)") {
+		t.Fatalf("expected only the two single-character terms, got %q", res.Msg)
+	}
+}
+
+func TestRunWarnTrivialTerms_OptIn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte(termsCSV), Path: "gloss.csv"}
+
+	out := runWarnTrivialTerms(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected Warn from the raw run func, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+
+	unit, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check is not registered")
+	}
+	out = unit.Run(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Skipped || !strings.Contains(out.Result.Message, "opt-in") {
+		t.Fatalf("expected the registered check to be disabled by default, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+}
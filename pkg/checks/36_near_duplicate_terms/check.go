@@ -0,0 +1,358 @@
+package near_duplicate_terms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-near-duplicate-terms"
+
+// Setting keys understood by this check.
+const (
+	// settingMaxDistance is the largest edit distance reported (default: 1, capped at maxAllowedDistance).
+	settingMaxDistance = "max-distance"
+	// settingMinLength skips terms shorter than this many characters (default: 4), so "cat"/"car" stay quiet.
+	settingMinLength = "min-length"
+	// settingMaxComparisons bounds the number of candidate pairs compared (default: 250,000,000; 0 = unlimited).
+	settingMaxComparisons = "max-comparisons"
+)
+
+const (
+	defaultMaxDistance    = 1
+	maxAllowedDistance    = 3
+	defaultMinLength      = 4
+	defaultMaxComparisons = 250_000_000
+)
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedPairs  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnNearDuplicateTerms,
+			checks.WithOptIn(),
+			checks.WithPriority(checks.PrioSemantic),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnNearDuplicateTerms — entry point for the check.
+// Informational only: "color"/"colour" may both be wanted, so there is no auto-fix.
+func runWarnNearDuplicateTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnNearDuplicateTerms(ctx, a, cfg)
+		},
+		Fix:     nil,
+		PassMsg: "no near-duplicate terms",
+		FailAs:  checks.Warn,
+	})
+}
+
+type config struct {
+	maxDistance    int
+	minLength      int
+	maxComparisons int
+	limit          int
+	stopAfter      int
+}
+
+func configFrom(opts checks.RunOptions) config {
+	cfg := config{
+		maxDistance:    opts.SettingInt(checkName, settingMaxDistance, defaultMaxDistance),
+		minLength:      opts.SettingInt(checkName, settingMinLength, defaultMinLength),
+		maxComparisons: opts.SettingInt(checkName, settingMaxComparisons, defaultMaxComparisons),
+		limit:          opts.FindingsLimit(),
+		stopAfter:      opts.StopAfter(),
+	}
+
+	cfg.maxDistance = max(1, min(cfg.maxDistance, maxAllowedDistance))
+	cfg.minLength = max(1, cfg.minLength)
+	cfg.maxComparisons = max(0, cfg.maxComparisons)
+
+	return cfg
+}
+
+// validateWarnNearDuplicateTerms reports pairs of distinct terms within a small edit distance
+// ("color"/"colour", typos). Comparison is case-insensitive with whitespace runs collapsed;
+// exact duplicates are left to ensure-no-duplicate-term-values.
+func validateWarnNearDuplicateTerms(ctx context.Context, a checks.Artifact, cfg config) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for near-duplicate terms",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTermHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	termCol := findTermColumn(header)
+	if termCol < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no 'term' column found (skipping near-duplicate terms check)",
+		}
+	}
+
+	terms, err := collectTerms(ctx, r, rowNum, termCol, cfg.minLength)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating near-duplicate terms",
+			Err: err,
+		}
+	}
+
+	scan, err := findNearDuplicates(ctx, terms, cfg)
+	if err != nil {
+		return cancelledValidation(err)
+	}
+
+	if scan.pairs.Total() == 0 {
+		msg := "no near-duplicate terms"
+		if scan.budgetHit {
+			msg += budgetNote(cfg.maxComparisons)
+		}
+
+		return checks.ValidationResult{
+			OK:        true,
+			Msg:       msg,
+			Truncated: scan.budgetHit,
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       nearDuplicatesMessage(scan, cfg),
+		Findings:  nearDuplicateFindings(scan.pairs),
+		Truncated: scan.pairs.Exhausted() || scan.budgetHit,
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTermHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for near-duplicate terms)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findTermColumn(header []string) int {
+	for i, col := range header {
+		if normalizeHeaderCell(col) == "term" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type termEntry struct {
+	term   string
+	runes  []rune
+	mask   uint64
+	rowNum int
+}
+
+// collectTerms returns distinct normalized terms of at least minLength runes in file order;
+// the first occurrence wins.
+func collectTerms(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	termCol int,
+	minLength int,
+) ([]termEntry, error) {
+	var terms []termEntry
+	seen := make(map[string]struct{})
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return terms, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+
+			return nil, err
+		}
+
+		rowNum++
+
+		if termCol >= len(rec) {
+			continue
+		}
+
+		key := strings.Join(strings.Fields(strings.ToLower(rec[termCol])), " ")
+		runes := []rune(key)
+		if len(runes) < minLength {
+			continue
+		}
+
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		terms = append(terms, termEntry{
+			term:   strings.TrimSpace(rec[termCol]),
+			runes:  runes,
+			mask:   runeMask(runes),
+			rowNum: rowNum,
+		})
+	}
+}
+
+func budgetNote(maxComparisons int) string {
+	return " (comparison budget of " + strconv.Itoa(maxComparisons) + " pairs reached; raise " + settingMaxComparisons + " to scan further)"
+}
+
+func nearDuplicatesMessage(scan nearScan, cfg config) string {
+	pairs := scan.pairs
+
+	limit := len(pairs.Items)
+	if limit > maxReportedPairs {
+		limit = maxReportedPairs
+	}
+
+	var b strings.Builder
+	b.WriteString("near-duplicate terms (edit distance ≤ ")
+	b.WriteString(strconv.Itoa(cfg.maxDistance))
+	b.WriteString("): ")
+
+	for i := range limit {
+		p := pairs.Items[i]
+
+		b.WriteString(strconv.Quote(p.first.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(p.first.rowNum))
+		b.WriteString(") ~ ")
+		b.WriteString(strconv.Quote(p.second.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(p.second.rowNum))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if pairs.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(pairs.Total()))
+	b.WriteString(" pairs)")
+	b.WriteString(checks.OverflowNote(pairs.Overflow))
+	b.WriteString(pairs.TruncatedNote())
+	if scan.budgetHit {
+		b.WriteString(budgetNote(cfg.maxComparisons))
+	}
+
+	return b.String()
+}
+
+// nearDuplicateFindings reports each pair on the row of the later term.
+func nearDuplicateFindings(pairs checks.Capped[nearPair]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(pairs.Items))
+	for _, p := range pairs.Items {
+		out = append(out, checks.Finding{
+			Row:     p.second.rowNum,
+			Column:  "term",
+			Value:   p.second.term,
+			Message: "close to term " + strconv.Quote(p.first.term) + " (row " + strconv.Itoa(p.first.rowNum) + ", edit distance " + strconv.Itoa(p.distance) + ")",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,207 @@
+package near_duplicate_terms
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func naiveLevenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+
+	return prev[len(b)]
+}
+
+func TestBoundedLevenshtein_MatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(1, 2))
+	alphabet := []rune("abcé")
+
+	randWord := func() []rune {
+		w := make([]rune, 1+rng.IntN(8))
+		for i := range w {
+			w[i] = alphabet[rng.IntN(len(alphabet))]
+		}
+		return w
+	}
+
+	var prev, cur []int
+	for range 5000 {
+		a, b := randWord(), randWord()
+		want := naiveLevenshtein(a, b)
+
+		for k := 1; k <= maxAllowedDistance; k++ {
+			var d int
+			var ok bool
+			d, ok, prev, cur = boundedLevenshtein(a, b, k, prev, cur)
+
+			if ok != (want <= k) || (ok && d != want) {
+				t.Fatalf("%q/%q k=%d: got (%d, %v), naive distance %d", string(a), string(b), k, d, ok, want)
+			}
+			if ok && maskDistance(runeMask(a), runeMask(b)) > d {
+				t.Fatalf("%q/%q: mask bound exceeds distance %d", string(a), string(b), d)
+			}
+		}
+	}
+}
+
+func TestConfigFrom(t *testing.T) {
+	t.Parallel()
+
+	cfg := configFrom(checks.RunOptions{})
+	if cfg.maxDistance != 1 || cfg.minLength != 4 || cfg.maxComparisons != defaultMaxComparisons {
+		t.Fatalf("unexpected defaults: %+v", cfg)
+	}
+
+	cfg = configFrom(checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		checkName: {settingMaxDistance: "9", settingMinLength: "0", settingMaxComparisons: "-1"},
+	}})
+	if cfg.maxDistance != maxAllowedDistance || cfg.minLength != 1 || cfg.maxComparisons != 0 {
+		t.Fatalf("expected clamped settings, got %+v", cfg)
+	}
+}
+
+func TestValidateWarnNearDuplicateTerms(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description\n" +
+		"color;US\n" +
+		"Cloud storage;noun\n" +
+		"colour;UK\n" +
+		"cat;animal\n" +
+		"car;vehicle\n" +
+		"cloud  storage;same term, extra space\n" +
+		"clouds storage;typo\n" +
+		"COLOR;case only\n"
+
+	res := validateWarnNearDuplicateTerms(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(checks.RunOptions{}))
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{
+		`"color" (row 2) ~ "colour" (row 4)`,
+		`"Cloud storage" (row 3) ~ "clouds storage" (row 8)`,
+		"(total 2 pairs)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, `"cat"`) {
+		t.Fatalf("terms shorter than min-length must be skipped: %q", res.Msg)
+	}
+
+	if len(res.Findings) != 2 || res.Findings[0].Row != 4 || !strings.Contains(res.Findings[0].Message, "edit distance 1") {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateWarnNearDuplicateTerms_ComparisonBudget(t *testing.T) {
+	t.Parallel()
+
+	csv := "term\nalpha\nalphb\nalphc\nalphd\n"
+
+	cfg := configFrom(checks.RunOptions{})
+	cfg.maxComparisons = 2
+
+	res := validateWarnNearDuplicateTerms(context.Background(), checks.Artifact{Data: []byte(csv)}, cfg)
+	if res.OK || !res.Truncated {
+		t.Fatalf("expected truncated warning, got %+v", res)
+	}
+	if !strings.Contains(res.Msg, "(total 2 pairs)") || !strings.Contains(res.Msg, "comparison budget of 2 pairs reached") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnNearDuplicateTerms_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnNearDuplicateTerms(ctx, checks.Artifact{Data: []byte("term\ncolor\ncolour\n")}, configFrom(checks.RunOptions{}))
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancellation error, got %+v", res)
+	}
+}
+
+func TestValidateWarnNearDuplicateTerms_LargeFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("large input")
+	}
+	t.Parallel()
+
+	rng := rand.New(rand.NewPCG(3, 4))
+
+	var b strings.Builder
+	b.WriteString("term\n")
+	letters := []rune("abcdefghijklmnopqrstuvwxyz")
+	for range 50_000 {
+		words := 1 + rng.IntN(3)
+		for w := range words {
+			if w > 0 {
+				b.WriteByte(' ')
+			}
+			for range 3 + rng.IntN(8) {
+				b.WriteRune(letters[rng.IntN(len(letters))])
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	start := time.Now()
+	res := validateWarnNearDuplicateTerms(context.Background(), checks.Artifact{Data: []byte(b.String())}, configFrom(checks.RunOptions{}))
+	if res.Err != nil {
+		t.Fatalf("unexpected error: %v", res.Err)
+	}
+	if strings.Contains(res.Msg, "comparison budget") {
+		t.Fatalf("realistic input should fit the default budget: %q", res.Msg)
+	}
+	t.Logf("50k terms: %s, %s", time.Since(start), res.Msg[:min(len(res.Msg), 80)])
+}
+
+func TestRunWarnNearDuplicateTerms_OptIn(t *testing.T) {
+	t.Parallel()
+
+	unit, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check is not registered")
+	}
+
+	a := checks.Artifact{Data: []byte("term\ncolor\ncolour\n"), Path: "gloss.csv"}
+
+	out := unit.Run(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Skipped {
+		t.Fatalf("expected opt-in check to be skipped by default, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+
+	out = unit.Run(context.Background(), a, checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		checkName: {checks.SettingEnabled: "true"},
+	}})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected Warn when enabled, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+}
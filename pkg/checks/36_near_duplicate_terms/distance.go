@@ -0,0 +1,204 @@
+package near_duplicate_terms
+
+import (
+	"context"
+	"math/bits"
+	"slices"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+type nearPair struct {
+	first    termEntry
+	second   termEntry
+	distance int
+}
+
+type nearScan struct {
+	pairs       checks.Capped[nearPair]
+	comparisons int
+	budgetHit   bool
+}
+
+// lengthBucket holds the terms of one rune length in file order; masks[i] belongs to idx[i].
+type lengthBucket struct {
+	idx   []int
+	masks []uint64
+}
+
+// findNearDuplicates compares each term only with terms whose length differs by at most
+// maxDistance (length buckets), drops pairs whose rune masks already prove a larger
+// distance, and verifies the rest with a banded Levenshtein that stops once the band
+// exceeds maxDistance. Pairs are reported in file order of the earlier term.
+func findNearDuplicates(ctx context.Context, terms []termEntry, cfg config) (nearScan, error) {
+	buckets := make(map[int]*lengthBucket)
+	for i, t := range terms {
+		b := buckets[len(t.runes)]
+		if b == nil {
+			b = &lengthBucket{}
+			buckets[len(t.runes)] = b
+		}
+		b.idx = append(b.idx, i)
+		b.masks = append(b.masks, t.mask)
+	}
+
+	scan := nearScan{pairs: checks.Capped[nearPair]{Limit: cfg.limit, StopAfter: cfg.stopAfter}}
+
+	prev := make([]int, 0, 64)
+	cur := make([]int, 0, 64)
+
+	for i := range terms {
+		// one bucket scan can be thousands of pairs, so check on every term
+		if err := ctx.Err(); err != nil {
+			return nearScan{}, err
+		}
+
+		a := &terms[i]
+		var hits []nearPair
+
+		for l := len(a.runes) - cfg.maxDistance; l <= len(a.runes)+cfg.maxDistance; l++ {
+			bucket := buckets[l]
+			if bucket == nil {
+				continue
+			}
+
+			// only look at later terms so each pair is compared once
+			start, _ := slices.BinarySearch(bucket.idx, i+1)
+			masks := bucket.masks[start:]
+
+			if cfg.maxComparisons > 0 {
+				left := cfg.maxComparisons - scan.comparisons
+				if len(masks) > left {
+					masks = masks[:left]
+					scan.budgetHit = true
+				}
+			}
+
+			for n, m := range masks {
+				if maskDistance(a.mask, m) > cfg.maxDistance {
+					continue
+				}
+
+				b := &terms[bucket.idx[start+n]]
+
+				var d int
+				var ok bool
+				d, ok, prev, cur = boundedLevenshtein(a.runes, b.runes, cfg.maxDistance, prev, cur)
+				if !ok {
+					continue
+				}
+
+				hits = append(hits, nearPair{first: *a, second: *b, distance: d})
+			}
+			scan.comparisons += len(masks)
+
+			if scan.budgetHit {
+				break
+			}
+		}
+
+		// buckets are visited by length; report partners in file order
+		slices.SortFunc(hits, func(x, y nearPair) int { return x.second.rowNum - y.second.rowNum })
+		if addPairs(&scan.pairs, hits) || scan.budgetHit {
+			return scan, nil
+		}
+	}
+
+	return scan, nil
+}
+
+// addPairs stores hits and reports whether StopAfter was reached.
+func addPairs(pairs *checks.Capped[nearPair], hits []nearPair) bool {
+	for _, h := range hits {
+		pairs.Add(h)
+		if pairs.Exhausted() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runeMask maps every rune to one of 64 bits.
+func runeMask(runes []rune) uint64 {
+	var m uint64
+	for _, r := range runes {
+		m |= 1 << (uint32(r) % 64)
+	}
+
+	return m
+}
+
+// maskDistance is a lower bound of the edit distance: every bit set on one side only
+// stands for at least one rune the other term lacks, and each such rune costs an edit.
+// Bit collisions can only lower the bound, never raise it.
+func maskDistance(a, b uint64) int {
+	return max(bits.OnesCount64(a&^b), bits.OnesCount64(b&^a))
+}
+
+// boundedLevenshtein returns the edit distance of a and b if it is at most k.
+// Only cells within k of the diagonal are computed, and the scan stops as soon as
+// a whole row exceeds k. prev and cur are scratch rows reused between calls.
+func boundedLevenshtein(a, b []rune, k int, prev, cur []int) (int, bool, []int, []int) {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	n, m := len(a), len(b)
+	if m-n > k {
+		return 0, false, prev, cur
+	}
+
+	inf := k + 1
+
+	prev = slices.Grow(prev[:0], m+2)[:m+2]
+	cur = slices.Grow(cur[:0], m+2)[:m+2]
+
+	for j := range prev {
+		prev[j] = inf
+		if j <= k && j <= m {
+			prev[j] = j
+		}
+	}
+
+	for i := 1; i <= n; i++ {
+		lo := max(1, i-k)
+		hi := min(m, i+k)
+
+		if lo == 1 {
+			cur[0] = min(i, inf)
+		} else {
+			cur[lo-1] = inf
+		}
+		cur[hi+1] = inf
+
+		rowMin := cur[lo-1]
+
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			v := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if v > inf {
+				v = inf
+			}
+
+			cur[j] = v
+			rowMin = min(rowMin, v)
+		}
+
+		if rowMin > k {
+			return 0, false, prev, cur
+		}
+
+		prev, cur = cur, prev
+	}
+
+	if d := prev[m]; d <= k {
+		return d, true, prev, cur
+	}
+
+	return 0, false, prev, cur
+}
@@ -0,0 +1,349 @@
+package duplicate_translation_rows
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-duplicate-translation-rows"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnDuplicateTranslationRows,
+			checks.WithPriority(checks.PrioContent+10),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnDuplicateTranslationRows — entry point for the check.
+// Informational only: which of the two rows is the mis-keyed one needs a human, so there is no auto-fix.
+func runWarnDuplicateTranslationRows(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnDuplicateTranslationRows(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no rows with different terms but identical translations",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnDuplicateTranslationRows reports rows whose terms differ but whose translations
+// are identical in every locale column, which usually means a duplicate entered under another key.
+// Rows take part only when all translation cells are filled; cells are compared case-insensitively
+// with whitespace runs collapsed. Rows with the same term are left to ensure-no-duplicate-term-values.
+func validateWarnDuplicateTranslationRows(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for duplicate translation rows",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTranslationHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols, ok := findTranslationColumns(header)
+	if !ok {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "need a 'term' column and at least two translation columns (skipping duplicate translation rows check)",
+		}
+	}
+
+	dups, err := findDuplicateTranslationRows(ctx, r, rowNum, cols, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating duplicate translation rows",
+			Err: err,
+		}
+	}
+
+	if dups.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no rows with different terms but identical translations",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       duplicateRowsMessage(dups),
+		Findings:  duplicateRowFindings(dups),
+		Truncated: dups.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTranslationHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for duplicate translation rows)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type translationColumns struct {
+	term    int
+	locales []int
+}
+
+// findTranslationColumns picks the term column and every language-like, non-description locale column.
+// A single translation column is not enough: synonyms legitimately share one translation.
+func findTranslationColumns(header []string) (translationColumns, bool) {
+	cols := translationColumns{term: -1}
+
+	for i, h := range header {
+		if normalizeHeaderCell(h) == "term" {
+			cols.term = i
+			break
+		}
+	}
+
+	for _, col := range checks.BuildLocaleIndex(header).Columns {
+		if col.LangLike && !col.Description {
+			cols.locales = append(cols.locales, col.Pos)
+		}
+	}
+
+	return cols, cols.term >= 0 && len(cols.locales) >= 2
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+type rowRef struct {
+	rowNum int
+	term   string
+	key    string // normalized term
+}
+
+type duplicateRow struct {
+	row      rowRef
+	original rowRef
+}
+
+func findDuplicateTranslationRows(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols translationColumns,
+	limit int,
+	stopAfter int,
+) (checks.Capped[duplicateRow], error) {
+	dups := checks.Capped[duplicateRow]{Limit: limit, StopAfter: stopAfter}
+	seen := make(map[[sha256.Size]byte]rowRef)
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[duplicateRow]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return dups, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[duplicateRow]{}, ctxErr
+			}
+
+			return checks.Capped[duplicateRow]{}, err
+		}
+
+		rowNum++
+
+		sum, ok := translationsHash(rec, cols.locales)
+		if !ok {
+			continue
+		}
+
+		term := strings.TrimSpace(recordValue(rec, cols.term))
+		ref := rowRef{rowNum: rowNum, term: term, key: normalizeCell(term)}
+
+		first, dup := seen[sum]
+		if !dup {
+			seen[sum] = ref
+			continue
+		}
+		if first.key == ref.key {
+			continue // same term twice: reported by ensure-no-duplicate-term-values
+		}
+
+		dups.Add(duplicateRow{row: ref, original: first})
+		if dups.Exhausted() {
+			return dups, nil
+		}
+	}
+}
+
+// translationsHash hashes the normalized translation cells of a record.
+// ok is false when any translation cell is empty.
+func translationsHash(record []string, locales []int) ([sha256.Size]byte, bool) {
+	h := sha256.New()
+
+	for _, pos := range locales {
+		v := normalizeCell(recordValue(record, pos))
+		if v == "" {
+			return [sha256.Size]byte{}, false
+		}
+
+		h.Write([]byte(v))
+		h.Write([]byte{0}) // cell separator: "ab","c" must not equal "a","bc"
+	}
+
+	var sum [sha256.Size]byte
+	h.Sum(sum[:0])
+
+	return sum, true
+}
+
+func normalizeCell(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+func recordValue(record []string, idx int) string {
+	if idx < 0 || idx >= len(record) {
+		return ""
+	}
+
+	return record[idx]
+}
+
+func duplicateRowsMessage(dups checks.Capped[duplicateRow]) string {
+	limit := len(dups.Items)
+	if limit > maxReportedRows {
+		limit = maxReportedRows
+	}
+
+	var b strings.Builder
+	b.WriteString("rows with identical translations but different terms: ")
+
+	for i := range limit {
+		d := dups.Items[i]
+
+		b.WriteString(strconv.Quote(d.row.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(d.row.rowNum))
+		b.WriteString(") = ")
+		b.WriteString(strconv.Quote(d.original.term))
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(d.original.rowNum))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if dups.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(dups.Total()))
+	b.WriteString(" rows)")
+	b.WriteString(checks.OverflowNote(dups.Overflow))
+	b.WriteString(dups.TruncatedNote())
+
+	return b.String()
+}
+
+// duplicateRowFindings reports each duplicate on the later row.
+func duplicateRowFindings(dups checks.Capped[duplicateRow]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(dups.Items))
+	for _, d := range dups.Items {
+		out = append(out, checks.Finding{
+			Row:     d.row.rowNum,
+			Column:  "term",
+			Value:   d.row.term,
+			Message: "same translations as term " + strconv.Quote(d.original.term) + " (row " + strconv.Itoa(d.original.rowNum) + ")",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
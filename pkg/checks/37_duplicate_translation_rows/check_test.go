@@ -0,0 +1,81 @@
+package duplicate_translation_rows
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateWarnDuplicateTranslationRows(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en;de;de_description;fr\n" +
+		"sign in;action;Sign in;Anmelden;verb;Se connecter\n" +
+		"log in;action;Sign  in;anmelden;other note;Se connecter\n" +
+		"sign out;action;Sign out;Abmelden;;Se déconnecter\n" +
+		"Sign In;dup term;Sign in;Anmelden;;Se connecter\n" +
+		"draft;untranslated;Draft;;;\n" +
+		"outline;untranslated;Draft;;;\n" +
+		"signin;typo;Sign in;Anmelden;;Se connecter\n"
+
+	res := validateWarnDuplicateTranslationRows(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{
+		`"log in" (row 3) = "sign in" (row 2)`,
+		`"signin" (row 8) = "sign in" (row 2)`,
+		"(total 2 rows)",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, "Sign In") || strings.Contains(res.Msg, "outline") {
+		t.Fatalf("same-term and partially translated rows must be skipped: %q", res.Msg)
+	}
+
+	if len(res.Findings) != 2 || res.Findings[0].Row != 3 || res.Findings[1].Row != 8 {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateWarnDuplicateTranslationRows_CellBoundaries(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;en;de\nab;ab;c\na;a;bc\n"
+
+	res := validateWarnDuplicateTranslationRows(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if !res.OK {
+		t.Fatalf("cells must not run into each other: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnDuplicateTranslationRows_SingleLocaleSkipped(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;en\ncar;car\nautomobile;car\n"
+
+	res := validateWarnDuplicateTranslationRows(context.Background(), checks.Artifact{Data: []byte(csv)}, checks.DefaultMaxFindings, 0)
+	if !res.OK || !strings.Contains(res.Msg, "at least two translation columns") {
+		t.Fatalf("expected skip for a single translation column, got %+v", res)
+	}
+}
+
+func TestRunWarnDuplicateTranslationRows_Warns(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;en;de\na;x;y\nb;x;y\n"), Path: "gloss.csv"}
+
+	out := runWarnDuplicateTranslationRows(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected Warn, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("check must not change data")
+	}
+}
@@ -0,0 +1,318 @@
+package empty_locale_columns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-empty-locale-columns"
+
+const (
+	ctxCheckEveryRows  = 1 << 12
+	maxReportedColumns = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnEmptyLocaleColumns,
+			checks.WithPriority(checks.PrioContent+50),
+			checks.WithDestructiveFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnEmptyLocaleColumns — entry point for the check.
+// The fixer drops columns, so it only runs with RunOptions.AllowDestructive.
+func runWarnEmptyLocaleColumns(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name:             checkName,
+		Validate:         validateWarnEmptyLocaleColumns,
+		Fix:              fixEmptyLocaleColumns,
+		Destructive:      true,
+		PassMsg:          "every locale column has data",
+		FixedMsg:         "dropped empty locale columns",
+		AppliedMsg:       "auto-fix applied: dropped empty locale columns",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "empty locale columns remain after fix (their description columns have data)",
+	})
+}
+
+// validateWarnEmptyLocaleColumns reports locale columns ("fr", not "fr_description")
+// that are blank in every data row: dead weight, or a locale the export dropped.
+// Columns of declared languages (a.Langs) are expected even while empty and never reported.
+func validateWarnEmptyLocaleColumns(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for empty locale columns",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, res, ok := readLocaleHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	pairs := localePairs(checks.BuildLocaleIndex(header), a.Langs)
+	if len(pairs) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no locale columns found (skipping empty locale columns check)",
+		}
+	}
+
+	fill, rows, err := scanLocaleFill(ctx, r, pairs)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating empty locale columns",
+			Err: err,
+		}
+	}
+
+	if rows == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no data rows (skipping empty locale columns check)",
+		}
+	}
+
+	var empty []localePair
+	for i, p := range pairs {
+		if !fill[i].value {
+			empty = append(empty, p)
+		}
+	}
+
+	if len(empty) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "every locale column has data",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:       false,
+		Msg:      emptyColumnsMessage(empty, rows),
+		Findings: emptyColumnFindings(empty),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readLocaleHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, checks.ValidationResult, bool) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for empty locale columns)",
+				}, false
+			}
+
+			return nil, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		if !isBlankCSVRecord(rec) {
+			return rec, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// localePair is a locale value column and its "<locale>_description" sibling, if any.
+type localePair struct {
+	label       string
+	value       int
+	description int // -1 when there is no description column
+}
+
+// localePairs returns the language-like value columns of the header in order,
+// each with the first description column of the same locale. Columns of the
+// declared langs are left out.
+func localePairs(ix *checks.LocaleIndex, langs []string) []localePair {
+	declared := make(map[string]struct{}, len(langs))
+	for _, lang := range langs {
+		declared[strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "-", "_"))] = struct{}{}
+	}
+
+	descByKey := make(map[string]int)
+	for _, col := range ix.Columns {
+		if col.Description {
+			if _, dup := descByKey[col.Key]; !dup {
+				descByKey[col.Key] = col.Pos
+			}
+		}
+	}
+
+	var out []localePair
+	for _, col := range ix.Columns {
+		if col.Description || !col.LangLike {
+			continue
+		}
+		if _, ok := declared[col.Key]; ok {
+			continue
+		}
+
+		desc, ok := descByKey[col.Key]
+		if !ok {
+			desc = -1
+		}
+
+		out = append(out, localePair{label: col.Label, value: col.Pos, description: desc})
+	}
+
+	return out
+}
+
+type pairFill struct {
+	value       bool
+	description bool
+}
+
+// scanLocaleFill reports, per pair, whether any data row has a non-blank cell,
+// plus the number of non-blank data rows.
+func scanLocaleFill(ctx context.Context, r csvReader, pairs []localePair) ([]pairFill, int, error) {
+	fill := make([]pairFill, len(pairs))
+	rows := 0
+
+	for n := 0; ; n++ {
+		if n%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return fill, rows, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, 0, ctxErr
+			}
+
+			return nil, 0, err
+		}
+
+		if isBlankCSVRecord(rec) {
+			continue
+		}
+		rows++
+
+		for i, p := range pairs {
+			if !fill[i].value && !isBlankCell(recordValue(rec, p.value)) {
+				fill[i].value = true
+			}
+			if !fill[i].description && p.description >= 0 && !isBlankCell(recordValue(rec, p.description)) {
+				fill[i].description = true
+			}
+		}
+	}
+}
+
+func isBlankCell(s string) bool {
+	return checks.IsBlankUnicode([]byte(s))
+}
+
+func recordValue(record []string, idx int) string {
+	if idx < 0 || idx >= len(record) {
+		return ""
+	}
+
+	return record[idx]
+}
+
+func emptyColumnsMessage(empty []localePair, rows int) string {
+	limit := len(empty)
+	if limit > maxReportedColumns {
+		limit = maxReportedColumns
+	}
+
+	var b strings.Builder
+	b.WriteString("locale columns without any data: ")
+
+	for i := range limit {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.Quote(empty[i].label))
+	}
+
+	if len(empty) > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(len(empty)))
+	b.WriteString(" columns, ")
+	b.WriteString(strconv.Itoa(rows))
+	b.WriteString(" data rows)")
+
+	return b.String()
+}
+
+// emptyColumnFindings reports each column without a row: the whole column is the problem.
+func emptyColumnFindings(empty []localePair) []checks.Finding {
+	out := make([]checks.Finding, 0, len(empty))
+	for _, p := range empty {
+		out = append(out, checks.Finding{
+			Column:  p.label,
+			Message: "locale column has no data in any row",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
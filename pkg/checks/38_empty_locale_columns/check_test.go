@@ -0,0 +1,91 @@
+package empty_locale_columns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const sparseCSV = "" +
+	"term;description;en;fr;fr_description;de;de_description;it\n" +
+	"cloud;noun;cloud;;;Wolke;;\n" +
+	"server;noun;server; ;;Server;Rechner;\n" +
+	"disk;noun;disk;;;;;\n"
+
+func TestValidateWarnEmptyLocaleColumns(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnEmptyLocaleColumns(context.Background(), checks.Artifact{Data: []byte(sparseCSV)})
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	want := `locale columns without any data: "fr", "it" (total 2 columns, 3 data rows)`
+	if res.Msg != want {
+		t.Fatalf("message mismatch:\n got:  %q\n want: %q", res.Msg, want)
+	}
+	if len(res.Findings) != 2 || res.Findings[0].Column != "fr" || res.Findings[0].Row != 0 {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateWarnEmptyLocaleColumns_Skips(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"header only":       "term;en;fr\n",
+		"no locale columns": "term;description\ncloud;noun\n",
+		"all filled":        "term;en;fr\ncloud;cloud;nuage\n",
+	}
+
+	for name, in := range cases {
+		res := validateWarnEmptyLocaleColumns(context.Background(), checks.Artifact{Data: []byte(in)})
+		if !res.OK {
+			t.Fatalf("%s: expected OK=true, got %q", name, res.Msg)
+		}
+	}
+}
+
+func TestWarnEmptyLocaleColumns_KeepsDeclaredLangs(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte(sparseCSV), Langs: []string{"en", "FR"}}
+
+	res := validateWarnEmptyLocaleColumns(context.Background(), a)
+	want := `locale columns without any data: "it" (total 1 columns, 3 data rows)`
+	if res.OK || res.Msg != want {
+		t.Fatalf("got OK=%v %q, want %q", res.OK, res.Msg, want)
+	}
+
+	fr, err := fixEmptyLocaleColumns(context.Background(), a)
+	if err != nil {
+		t.Fatalf("fix: %v", err)
+	}
+	if !fr.DidChange || !strings.HasPrefix(string(fr.Data), "term;description;en;fr;fr_description;de;de_description\n") {
+		t.Fatalf("declared fr must be kept, got %q", fr.Data)
+	}
+}
+
+func TestRunWarnEmptyLocaleColumns_FixNeedsAllowDestructive(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte(sparseCSV), Path: "gloss.csv"}
+
+	out := runWarnEmptyLocaleColumns(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed})
+	if out.Result.Status != checks.Warn || out.Final.DidChange {
+		t.Fatalf("expected Warn without changes, got %s (%s) changed=%v", out.Result.Status, out.Result.Message, out.Final.DidChange)
+	}
+	if !strings.Contains(out.Final.Note, "destructive auto-fix skipped") {
+		t.Fatalf("expected skipped destructive fix in note, got %q", out.Final.Note)
+	}
+
+	out = runWarnEmptyLocaleColumns(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed, AllowDestructive: true})
+	if !out.Final.DidChange {
+		t.Fatalf("expected the fix to run with AllowDestructive, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if !strings.HasPrefix(string(out.Final.Data), "term;description;en;de;de_description\n") {
+		t.Fatalf("unexpected fixed data: %q", out.Final.Data)
+	}
+}
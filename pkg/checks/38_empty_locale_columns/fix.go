@@ -0,0 +1,303 @@
+package empty_locale_columns
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixEmptyLocaleColumns drops every locale column that is blank in all data rows,
+// together with its "<locale>_description" column. A pair whose description still
+// has data is kept whole: the description would otherwise become an orphan. Columns
+// of declared languages (a.Langs) are kept even when empty.
+func fixEmptyLocaleColumns(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findEmptyLocaleFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readEmptyLocaleFixRecords(ctx, appendEmptyLocaleFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	drop, labels := droppableColumns(records, a.Langs)
+	if len(drop) == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no fully empty locale columns to drop",
+		}, nil
+	}
+
+	outRecs, err := dropEmptyLocaleColumns(ctx, records, drop)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+
+	outTail, err := writeEmptyLocaleFixRecords(ctx, outRecs, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchEmptyLocaleFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "removed empty locale columns: " + strings.Join(labels, ", "),
+	}, nil
+}
+
+// droppableColumns returns the positions to drop and their header labels, in header order.
+// Nothing is dropped when the table has no data rows.
+func droppableColumns(records [][]string, langs []string) (map[int]struct{}, []string) {
+	header := records[0]
+	pairs := localePairs(checks.BuildLocaleIndex(header), langs)
+
+	fill := make([]pairFill, len(pairs))
+	rows := 0
+
+	for _, rec := range records[1:] {
+		if isBlankCSVRecord(rec) {
+			continue
+		}
+		rows++
+
+		for i, p := range pairs {
+			if !isBlankCell(recordValue(rec, p.value)) {
+				fill[i].value = true
+			}
+			if p.description >= 0 && !isBlankCell(recordValue(rec, p.description)) {
+				fill[i].description = true
+			}
+		}
+	}
+
+	drop := make(map[int]struct{})
+	if rows == 0 {
+		return drop, nil
+	}
+
+	for i, p := range pairs {
+		if fill[i].value || fill[i].description {
+			continue
+		}
+
+		drop[p.value] = struct{}{}
+		if p.description >= 0 {
+			drop[p.description] = struct{}{}
+		}
+	}
+
+	var labels []string
+	for pos, cell := range header {
+		if _, ok := drop[pos]; ok {
+			labels = append(labels, strings.TrimSpace(cell))
+		}
+	}
+
+	return drop, labels
+}
+
+func dropEmptyLocaleColumns(
+	ctx context.Context,
+	records [][]string,
+	drop map[int]struct{},
+) ([][]string, error) {
+	out := make([][]string, len(records))
+
+	for i, row := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		newRow := make([]string, 0, len(row))
+		for j, cell := range row {
+			if _, ok := drop[j]; ok {
+				continue
+			}
+			newRow = append(newRow, cell)
+		}
+
+		out[i] = newRow
+	}
+
+	return out, nil
+}
+
+type emptyLocaleFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findEmptyLocaleFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (emptyLocaleFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return emptyLocaleFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := emptyLocaleFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return emptyLocaleFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return emptyLocaleFixHeaderParts{}, false, nil
+}
+
+func emptyLocaleFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendEmptyLocaleFixHeaderAndRest(parts emptyLocaleFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readEmptyLocaleFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeEmptyLocaleFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchEmptyLocaleFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,59 @@
+package empty_locale_columns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixEmptyLocaleColumns_DropsFullyEmptyPairs(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixEmptyLocaleColumns(context.Background(), checks.Artifact{Data: []byte(sparseCSV)})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.DidChange || fr.Note != "removed empty locale columns: fr, fr_description, it" {
+		t.Fatalf("unexpected result: changed=%v note=%q", fr.DidChange, fr.Note)
+	}
+
+	want := "" +
+		"term;description;en;de;de_description\n" +
+		"cloud;noun;cloud;Wolke;\n" +
+		"server;noun;server;Server;Rechner\n" +
+		"disk;noun;disk;;\n"
+	if got := string(fr.Data); got != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", got, want)
+	}
+}
+
+func TestFixEmptyLocaleColumns_KeepsPairWithDescription(t *testing.T) {
+	t.Parallel()
+
+	const bom = "\xEF\xBB\xBF"
+
+	in := bom + "term;fr;fr_description;es\r\nx;;note;\r\n"
+	fr, err := fixEmptyLocaleColumns(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	want := bom + "term;fr;fr_description\r\nx;;note\r\n"
+	if got := string(fr.Data); got != want {
+		t.Fatalf("fixed data mismatch:\n got:  %q\n want: %q", got, want)
+	}
+}
+
+func TestFixEmptyLocaleColumns_NoDataRowsNoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;en;fr\n"
+	fr, err := fixEmptyLocaleColumns(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected no change, got %q", fr.Data)
+	}
+}
@@ -0,0 +1,244 @@
+package file_naming
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-file-naming"
+
+// Setting keys understood by this check.
+const (
+	// settingPattern is the file name convention, e.g. "glossary_<project>_<lang-list>.csv".
+	// Without it only the lowercase / no-spaces rules apply.
+	settingPattern = "pattern"
+	// settingProject fills the <project> placeholder; without it any lowercase slug matches.
+	settingProject = "project"
+)
+
+// Placeholders understood in settingPattern.
+const (
+	placeholderProject  = "<project>"
+	placeholderLangList = "<lang-list>"
+)
+
+const (
+	anyProject  = `[a-z0-9]+(?:-[a-z0-9]+)*`
+	anyLang     = `[a-z]{2,3}(?:_[a-z0-9]+)*`
+	anyLangList = anyLang + `(?:-` + anyLang + `)*`
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnFileNaming,
+			checks.WithOptIn(),
+			checks.WithPriority(checks.PrioStructural+80),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
+}
+
+// runWarnFileNaming — entry point for the check.
+// Only Path is inspected; the fixer proposes a new Path and never touches Data.
+func runWarnFileNaming(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	pattern, _ := opts.Setting(checkName, settingPattern)
+	project, _ := opts.Setting(checkName, settingProject)
+
+	conv := namingConvention{
+		pattern: strings.TrimSpace(pattern),
+		project: strings.ToLower(strings.TrimSpace(project)),
+	}
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateFileNaming(ctx, a, conv)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixFileNaming(ctx, a, conv)
+		},
+		PassMsg:          "file name follows the naming convention",
+		FixedMsg:         "renamed file to follow the naming convention",
+		AppliedMsg:       "auto-fix applied: proposed a conventional file name",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "file name still breaks the naming convention after fix",
+	})
+}
+
+// namingConvention is the configured pattern plus the values known for its placeholders.
+type namingConvention struct {
+	pattern string
+	project string
+}
+
+// langList is the <lang-list> value for langs: lowercased codes with "_" inside
+// ("pt-BR" -> "pt_br") joined with "-", in declared order.
+func langList(langs []string) string {
+	parts := make([]string, 0, len(langs))
+	for _, lang := range langs {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" {
+			continue
+		}
+
+		parts = append(parts, strings.ReplaceAll(lang, "-", "_"))
+	}
+
+	return strings.Join(parts, "-")
+}
+
+// compile turns the pattern into an anchored regexp. Placeholders with a known
+// value match exactly that value; the others match any well-formed value.
+func (c namingConvention) compile(langs []string) (*regexp.Regexp, error) {
+	if c.pattern == "" {
+		return nil, nil
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+
+	rest := c.pattern
+	for rest != "" {
+		open := strings.IndexByte(rest, '<')
+		if open < 0 {
+			b.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+
+		b.WriteString(regexp.QuoteMeta(rest[:open]))
+
+		end := strings.IndexByte(rest[open:], '>')
+		if end < 0 {
+			return nil, errors.New("unterminated placeholder in naming pattern")
+		}
+
+		switch ph := rest[open : open+end+1]; ph {
+		case placeholderProject:
+			b.WriteString(orAny(regexp.QuoteMeta(c.project), c.project != "", anyProject))
+		case placeholderLangList:
+			list := langList(langs)
+			b.WriteString(orAny(regexp.QuoteMeta(list), list != "", anyLangList))
+		default:
+			return nil, errors.New("unknown placeholder " + strconv.Quote(ph) + " in naming pattern")
+		}
+
+		rest = rest[open+end+1:]
+	}
+
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
+
+func orAny(exact string, known bool, fallback string) string {
+	if known {
+		return exact
+	}
+
+	return fallback
+}
+
+// render fills the pattern. ok is false when a placeholder has no known value.
+func (c namingConvention) render(langs []string) (string, bool) {
+	if c.pattern == "" {
+		return "", false
+	}
+
+	out := c.pattern
+
+	if strings.Contains(out, placeholderProject) {
+		if c.project == "" {
+			return "", false
+		}
+		out = strings.ReplaceAll(out, placeholderProject, c.project)
+	}
+
+	if strings.Contains(out, placeholderLangList) {
+		list := langList(langs)
+		if list == "" {
+			return "", false
+		}
+		out = strings.ReplaceAll(out, placeholderLangList, list)
+	}
+
+	return out, true
+}
+
+func validateFileNaming(ctx context.Context, a checks.Artifact, conv namingConvention) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	re, err := conv.compile(a.Langs)
+	if err != nil {
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "invalid " + settingPattern + " " + strconv.Quote(conv.pattern) + ": " + err.Error(),
+			Err: err,
+		}
+	}
+
+	_, base := splitPath(strings.TrimSpace(a.Path))
+	if base == "" {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no file path provided (nothing to check for naming)",
+		}
+	}
+
+	problems := namingProblems(base, conv, re)
+	if len(problems) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "file name follows the naming convention",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "file name " + strconv.Quote(base) + " breaks the naming convention: " + strings.Join(problems, ", "),
+	}
+}
+
+func namingProblems(base string, conv namingConvention, re *regexp.Regexp) []string {
+	var problems []string
+
+	if strings.ToLower(base) != base {
+		problems = append(problems, "not lowercase")
+	}
+
+	if strings.ContainsFunc(base, unicode.IsSpace) {
+		problems = append(problems, "contains spaces")
+	}
+
+	if re != nil && !re.MatchString(base) {
+		problems = append(problems, "does not match "+strconv.Quote(conv.pattern))
+	}
+
+	return problems
+}
+
+// splitPath splits after the last "/" or "\" so Windows-style paths keep their directory.
+func splitPath(path string) (dir, base string) {
+	i := strings.LastIndexAny(path, `/\`)
+
+	return path[:i+1], path[i+1:]
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,94 @@
+package file_naming
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateFileNaming_DefaultRules(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		path string
+		ok   bool
+		msg  string
+	}{
+		{"", true, "no file path provided (nothing to check for naming)"},
+		{"exports/glossary.csv", true, "file name follows the naming convention"},
+		{"Exports Dir/glossary.csv", true, "file name follows the naming convention"},
+		{`C:\Users\Me\My Glossary.CSV`, false, `file name "My Glossary.CSV" breaks the naming convention: not lowercase, contains spaces`},
+	}
+
+	for _, tc := range cases {
+		res := validateFileNaming(context.Background(), checks.Artifact{Path: tc.path}, namingConvention{})
+		if res.OK != tc.ok || res.Msg != tc.msg {
+			t.Fatalf("%q: got ok=%v msg=%q, want ok=%v msg=%q", tc.path, res.OK, res.Msg, tc.ok, tc.msg)
+		}
+	}
+}
+
+func TestValidateFileNaming_Pattern(t *testing.T) {
+	t.Parallel()
+
+	generic := namingConvention{pattern: "glossary_<project>_<lang-list>.csv"}
+	pinned := namingConvention{pattern: "glossary_<project>_<lang-list>.csv", project: "acme"}
+	langs := []string{"en", "pt-BR"}
+
+	cases := []struct {
+		name  string
+		conv  namingConvention
+		langs []string
+		path  string
+		ok    bool
+	}{
+		{"generic match", generic, nil, "glossary_web-app_en-de_at.csv", true},
+		{"generic miss", generic, nil, "terms_web-app.csv", false},
+		{"pinned project", pinned, langs, "glossary_acme_en-pt_br.csv", true},
+		{"other project", pinned, langs, "glossary_other_en-pt_br.csv", false},
+		{"other languages", pinned, langs, "glossary_acme_en.csv", false},
+	}
+
+	for _, tc := range cases {
+		res := validateFileNaming(context.Background(), checks.Artifact{Path: tc.path, Langs: tc.langs}, tc.conv)
+		if res.OK != tc.ok {
+			t.Fatalf("%s: got ok=%v (%q), want %v", tc.name, res.OK, res.Msg, tc.ok)
+		}
+	}
+}
+
+func TestValidateFileNaming_InvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	for _, pattern := range []string{"glossary_<team>.csv", "glossary_<project.csv"} {
+		res := validateFileNaming(context.Background(), checks.Artifact{Path: "glossary.csv"}, namingConvention{pattern: pattern})
+		if res.OK || res.Err == nil {
+			t.Fatalf("%q: expected a config error, got ok=%v msg=%q", pattern, res.OK, res.Msg)
+		}
+	}
+}
+
+func TestRunWarnFileNaming_OptInAndSettings(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		FixMode:       checks.FixIfFailed,
+		RerunAfterFix: true,
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingPattern: "glossary_<project>_<lang-list>.csv", settingProject: "Acme"},
+		},
+	}
+	a := checks.Artifact{Path: "out/Glossary Final.csv", Data: []byte("term;en\n"), Langs: []string{"en", "de"}}
+
+	out := runWarnFileNaming(context.Background(), a, opts)
+	if out.Result.Status != checks.Pass || !out.Final.DidChange {
+		t.Fatalf("expected fixed PASS, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.Path != "out/glossary_acme_en-de.csv" {
+		t.Fatalf("Final.Path = %q", out.Final.Path)
+	}
+	if string(out.Final.Data) != "term;en\n" {
+		t.Fatalf("data must not change")
+	}
+}
@@ -0,0 +1,45 @@
+package file_naming
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixFileNaming proposes a new Path through FixResult.Path; Data is passed through.
+// When every placeholder of the pattern has a known value the name is rendered from
+// the pattern; otherwise the current name is lowercased and spaces become "_".
+func fixFileNaming(ctx context.Context, a checks.Artifact, conv namingConvention) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	path := strings.TrimSpace(a.Path)
+	dir, base := splitPath(path)
+	if base == "" {
+		return checks.NoFix(a, "no file path provided")
+	}
+
+	proposed, ok := conv.render(a.Langs)
+	if !ok {
+		proposed = normalizeBaseName(base)
+	}
+
+	if proposed == base {
+		return checks.NoFix(a, "cannot derive a conventional file name (set "+settingProject+" or declare languages)")
+	}
+
+	return checks.FixResult{
+		Data:      a.Data,
+		Path:      dir + proposed,
+		DidChange: true,
+		Note:      "renamed " + base + " to " + proposed,
+	}, nil
+}
+
+// normalizeBaseName lowercases name and turns each run of whitespace into "_".
+func normalizeBaseName(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), unicode.IsSpace), "_")
+}
@@ -0,0 +1,50 @@
+package file_naming
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixFileNaming_NormalizesWithoutPattern(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixFileNaming(context.Background(), checks.Artifact{Path: `C:\Exports\My  Glossary.CSV`}, namingConvention{})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.DidChange || fr.Path != `C:\Exports\my_glossary.csv` {
+		t.Fatalf("unexpected fix %+v", fr)
+	}
+}
+
+func TestFixFileNaming_RendersPattern(t *testing.T) {
+	t.Parallel()
+
+	conv := namingConvention{pattern: "glossary_<project>_<lang-list>.csv", project: "acme"}
+
+	fr, err := fixFileNaming(context.Background(), checks.Artifact{Path: "terms.csv", Langs: []string{"en", "pt-BR"}}, conv)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if fr.Path != "glossary_acme_en-pt_br.csv" || fr.Note != "renamed terms.csv to glossary_acme_en-pt_br.csv" {
+		t.Fatalf("unexpected fix %+v", fr)
+	}
+}
+
+func TestFixFileNaming_NothingToPropose(t *testing.T) {
+	t.Parallel()
+
+	conv := namingConvention{pattern: "glossary_<project>.csv"}
+
+	fr, err := fixFileNaming(context.Background(), checks.Artifact{Path: "terms.csv"}, conv)
+	if !errors.Is(err, checks.ErrNoFix) || fr.DidChange {
+		t.Fatalf("expected ErrNoFix, got %+v (%v)", fr, err)
+	}
+
+	if _, err := fixFileNaming(context.Background(), checks.Artifact{}, conv); !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix for an empty path, got %v", err)
+	}
+}
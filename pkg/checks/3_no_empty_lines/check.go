@@ -13,30 +13,35 @@ import (
 const checkName = "ensure-no-empty-lines"
 
 const (
-	maxScannedLineSize = 16 << 20
-	ctxCheckEveryLine  = 1 << 16
-	maxReportedLines   = 10
+	ctxCheckEveryLine = 1 << 16
+	maxReportedLines  = 10
 )
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runNoEmptyLines,
-		checks.WithPriority(3),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runNoEmptyLines,
+			checks.WithPriority(checks.PrioStructural+20),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
 }
 
+// runNoEmptyLines — entry point for the check. Blank lines before the header are left
+// alone when the run keeps them (checks.LeadingBlankKeep); warn-leading-blank-lines owns them.
 func runNoEmptyLines(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	keepLeading := opts.KeepLeadingBlankLines()
+
 	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:             checkName,
-		Validate:         validateNoEmptyLines,
-		Fix:              fixRemoveEmptyLines,
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateEmptyLines(ctx, a, keepLeading)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixEmptyLines(ctx, a, keepLeading)
+		},
 		PassMsg:          "no empty lines detected",
 		FixedMsg:         "empty lines removed",
 		AppliedMsg:       "auto-fix applied (blank lines removed)",
@@ -46,11 +51,15 @@ func runNoEmptyLines(ctx context.Context, a checks.Artifact, opts checks.RunOpti
 }
 
 func validateNoEmptyLines(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	return validateEmptyLines(ctx, a, false)
+}
+
+func validateEmptyLines(ctx context.Context, a checks.Artifact, keepLeading bool) checks.ValidationResult {
 	if err := ctx.Err(); err != nil {
 		return cancelledValidation(err)
 	}
 
-	report, err := scanEmptyLines(ctx, a.Data)
+	report, err := scanEmptyLines(ctx, a.Data, keepLeading)
 	if err != nil {
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 			return cancelledValidation(err)
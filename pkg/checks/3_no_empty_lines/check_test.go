@@ -21,7 +21,7 @@ func TestNoEmptyLines_Metadata(t *testing.T) {
 	if c.FailFast() {
 		t.Fatalf("FailFast() = true, want false")
 	}
-	if got, want := c.Priority(), 3; got != want {
+	if got, want := c.Priority(), checks.PrioStructural+20; got != want {
 		t.Fatalf("Priority() = %d, want %d", got, want)
 	}
 }
@@ -121,3 +121,12 @@ func TestNoEmptyLines_EmptyFile(t *testing.T) {
 		t.Fatalf("expected PASS for empty file, got %s", out.Result.Status)
 	}
 }
+
+func TestValidateNoEmptyLines_HugeLineWithoutNewlines(t *testing.T) {
+	data := []byte(strings.Repeat("x;", 12<<20)) // 24 MB, no line breaks
+
+	res := validateNoEmptyLines(context.Background(), checks.Artifact{Data: data})
+	if !res.OK || res.Err != nil {
+		t.Fatalf("expected OK=true without error, got %+v", res)
+	}
+}
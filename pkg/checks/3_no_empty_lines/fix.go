@@ -1,7 +1,6 @@
 package empty_lines
 
 import (
-	"bufio"
 	"bytes"
 	"context"
 	"fmt"
@@ -22,6 +21,12 @@ type removeEmptyLinesResult struct {
 // If the input is empty, returns unchanged. If all lines are blank,
 // returns an empty output.
 func fixRemoveEmptyLines(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	return fixEmptyLines(ctx, a, false)
+}
+
+// fixEmptyLines is fixRemoveEmptyLines that, with keepLeading, also keeps the blank
+// lines before the first non-blank one.
+func fixEmptyLines(ctx context.Context, a checks.Artifact, keepLeading bool) (checks.FixResult, error) {
 	if err := ctx.Err(); err != nil {
 		return checks.FixResult{}, err
 	}
@@ -35,7 +40,7 @@ func fixRemoveEmptyLines(ctx context.Context, a checks.Artifact) (checks.FixResu
 		}, nil
 	}
 
-	result, err := removeEmptyLines(ctx, a.Data)
+	result, err := removeEmptyLines(ctx, a.Data, keepLeading)
 	if err != nil {
 		return checks.FixResult{}, err
 	}
@@ -57,37 +62,27 @@ func fixRemoveEmptyLines(ctx context.Context, a checks.Artifact) (checks.FixResu
 	}, nil
 }
 
-func removeEmptyLines(ctx context.Context, data []byte) (removeEmptyLinesResult, error) {
+func removeEmptyLines(ctx context.Context, data []byte, keepLeading bool) (removeEmptyLinesResult, error) {
 	fixer := newEmptyLineFixer(data)
+	fixer.leading = keepLeading
 
-	scanner := newEmptyLineFixScanner(data)
-	for scanner.Scan() {
-		if err := ctx.Err(); err != nil {
+	for lineNo, line := range checks.Lines(data) {
+		if err := checkContextEveryLine(ctx, lineNo); err != nil {
 			return removeEmptyLinesResult{}, err
 		}
 
-		fixer.consumeLine(scanner.Bytes())
-	}
-
-	if err := scanner.Err(); err != nil {
-		return removeEmptyLinesResult{}, err
+		fixer.consumeLine(line)
 	}
 
 	return fixer.result(), nil
 }
 
-func newEmptyLineFixScanner(data []byte) *bufio.Scanner {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Buffer(make([]byte, 0, 64<<10), maxScannedLineSize)
-
-	return scanner
-}
-
 type emptyLineFixer struct {
 	sep      string
 	out      bytes.Buffer
 	wroteAny bool
 	dropped  int
+	leading  bool // still before the first non-blank line, whose blank lines are kept
 }
 
 func newEmptyLineFixer(data []byte) *emptyLineFixer {
@@ -97,13 +92,18 @@ func newEmptyLineFixer(data []byte) *emptyLineFixer {
 }
 
 func (f *emptyLineFixer) consumeLine(line []byte) {
-	line = normalizeScannedLine(line)
-
 	if checks.IsBlankUnicode(line) {
+		if f.leading {
+			f.writeLine(line)
+			return
+		}
+
 		f.dropped++
 		return
 	}
 
+	f.leading = false
+
 	f.writeLine(line)
 }
 
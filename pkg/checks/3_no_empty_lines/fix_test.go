@@ -301,3 +301,25 @@ func Test_emptyLinesRemovedNote(t *testing.T) {
 		})
 	}
 }
+
+func TestRunNoEmptyLines_KeepLeadingBlankLines(t *testing.T) {
+	t.Parallel()
+
+	in := "\ufeff\n \nterm;en\n\nx;y\n"
+	opts := checks.RunOptions{
+		FixMode: checks.FixIfFailed,
+		Settings: map[string]checks.CheckSettings{
+			checks.LeadingBlankLinesCheck: {checks.SettingLeadingBlank: checks.LeadingBlankKeep},
+		},
+	}
+
+	out := runNoEmptyLines(context.Background(), checks.Artifact{Data: []byte(in), Path: "file.csv"}, opts)
+	if got, want := string(out.Final.Data), "\ufeff\n \nterm;en\nx;y"; got != want {
+		t.Fatalf("data = %q, want %q", got, want)
+	}
+
+	res := validateEmptyLines(context.Background(), checks.Artifact{Data: []byte("\n\nterm\n")}, true)
+	if !res.OK {
+		t.Fatalf("leading blank lines must not be reported with keep policy: %q", res.Msg)
+	}
+}
@@ -1,8 +1,6 @@
 package empty_lines
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
@@ -13,40 +11,27 @@ type emptyLinesReport struct {
 	first []int
 }
 
-func newLineScanner(data []byte) *bufio.Scanner {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Buffer(make([]byte, 0, 64<<10), maxScannedLineSize)
-
-	return scanner
-}
-
-func scanEmptyLines(ctx context.Context, data []byte) (emptyLinesReport, error) {
-	scanner := newLineScanner(data)
-
+// scanEmptyLines reports blank lines; with keepLeading, those before the first
+// non-blank line are not counted.
+func scanEmptyLines(ctx context.Context, data []byte, keepLeading bool) (emptyLinesReport, error) {
 	var report emptyLinesReport
 
-	for lineNo := 1; scanner.Scan(); lineNo++ {
+	leading := keepLeading
+
+	for lineNo, line := range checks.Lines(data) {
 		if err := checkContextEveryLine(ctx, lineNo); err != nil {
 			return emptyLinesReport{}, err
 		}
 
-		line := normalizeScannedLine(scanner.Bytes())
-		if checks.IsBlankUnicode(line) {
-			report.add(lineNo)
+		if !checks.IsBlankUnicode(line) {
+			leading = false
+			continue
 		}
-	}
 
-	if err := scanner.Err(); err != nil {
-		return emptyLinesReport{}, err
+		if !leading {
+			report.add(lineNo)
+		}
 	}
 
 	return report, nil
 }
-
-func normalizeScannedLine(line []byte) []byte {
-	if n := len(line); n > 0 && line[n-1] == '\r' {
-		return line[:n-1]
-	}
-
-	return line
-}
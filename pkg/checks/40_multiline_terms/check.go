@@ -0,0 +1,371 @@
+package multiline_terms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-multiline-terms"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnMultilineTerms,
+			checks.WithPriority(checks.PrioContent+55),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type multilineConfig struct {
+	maxFindings int
+	stopAfter   int
+}
+
+func configFrom(opts checks.RunOptions) multilineConfig {
+	return multilineConfig{
+		maxFindings: opts.FindingsLimit(),
+		stopAfter:   opts.StopAfter(),
+	}
+}
+
+func runWarnMultilineTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnMultilineTerms(ctx, a, cfg)
+		},
+		Fix:              fixMultilineTerms,
+		PassMsg:          "no line breaks inside term or locale values",
+		FixedMsg:         "replaced line breaks inside term and locale values with spaces",
+		AppliedMsg:       "auto-fix applied: replaced line breaks inside term and locale values with spaces",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "line breaks are still present in term or locale values after fix",
+	})
+}
+
+// validateWarnMultilineTerms reports term and locale values spanning several lines.
+// Quoted CSV allows them, but Lokalise renders such terms badly and they never match
+// source text. Descriptions may legitimately hold several lines and are not checked.
+func validateWarnMultilineTerms(ctx context.Context, a checks.Artifact, cfg multilineConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for multi-line values",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readMultilineHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := targetColumns(header)
+	if len(cols) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no term or locale columns found (skipping multi-line values check)",
+		}
+	}
+
+	hits, err := findMultilineCells(ctx, r, rowNum, cols, cfg.maxFindings, cfg.stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating multi-line values",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no line breaks inside term or locale values",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       multilineMessage(hits),
+		Findings:  multilineFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readMultilineHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for multi-line values)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type targetColumn struct {
+	name string
+	pos  int
+}
+
+// targetColumns picks the term column and locale value columns.
+// Description, flag and tags columns are never touched.
+func targetColumns(header []string) []targetColumn {
+	var cols []targetColumn
+
+	for i, h := range header {
+		name := normalizeHeaderCell(h)
+		if name == "" {
+			continue
+		}
+
+		switch {
+		case name == "term":
+		case name == "description" || strings.HasSuffix(name, "_description"):
+			continue
+		default:
+			if _, known := checks.KnownHeaders[name]; known {
+				continue
+			}
+		}
+
+		cols = append(cols, targetColumn{
+			name: strings.TrimSpace(h),
+			pos:  i,
+		})
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// isLineBreak reports CR, LF and the Unicode line and paragraph separators.
+func isLineBreak(r rune) bool {
+	return r == '\n' || r == '\r' || r == '\u2028' || r == '\u2029'
+}
+
+// countLineBreaks counts line breaks in s; CRLF counts once.
+func countLineBreaks(s string) int {
+	return strings.Count(s, "\n") + strings.Count(s, "\r") - strings.Count(s, "\r\n") +
+		strings.Count(s, "\u2028") + strings.Count(s, "\u2029")
+}
+
+// joinLines replaces every run of line breaks, together with the whitespace
+// around it, with a single space. Breaks at the very start or end are dropped.
+// Whitespace away from line breaks is kept. ok is false when s has no line breaks.
+func joinLines(s string) (string, bool) {
+	first := strings.IndexFunc(s, isLineBreak)
+	if first < 0 {
+		return s, false
+	}
+	last := strings.LastIndexFunc(s, isLineBreak)
+	_, size := utf8.DecodeRuneInString(s[last:])
+
+	parts := []string{strings.TrimRightFunc(s[:first], unicode.IsSpace)}
+	for line := range strings.FieldsFuncSeq(s[first:last+size], isLineBreak) {
+		parts = append(parts, strings.TrimFunc(line, unicode.IsSpace))
+	}
+	parts = append(parts, strings.TrimLeftFunc(s[last+size:], unicode.IsSpace))
+
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(part)
+	}
+
+	return b.String(), true
+}
+
+type multilineHit struct {
+	rowNum int
+	column string
+	value  string
+	breaks int
+}
+
+func findMultilineCells(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols []targetColumn,
+	limit int,
+	stopAfter int,
+) (checks.Capped[multilineHit], error) {
+	hits := checks.Capped[multilineHit]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[multilineHit]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[multilineHit]{}, ctxErr
+			}
+
+			return checks.Capped[multilineHit]{}, err
+		}
+
+		rowNum++
+
+		for _, col := range cols {
+			if col.pos >= len(rec) {
+				continue
+			}
+
+			v := rec[col.pos]
+			if !strings.ContainsFunc(v, isLineBreak) {
+				continue
+			}
+
+			hits.Add(multilineHit{
+				rowNum: rowNum,
+				column: col.name,
+				value:  v,
+				breaks: countLineBreaks(v),
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+func multilineMessage(hits checks.Capped[multilineHit]) string {
+	limit := len(hits.Items)
+	if limit > maxReportedCells {
+		limit = maxReportedCells
+	}
+
+	var b strings.Builder
+	b.WriteString("line breaks inside term or locale values: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(") x")
+		b.WriteString(strconv.Itoa(hit.breaks))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func multilineFindings(hits checks.Capped[multilineHit]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Value:   hit.value,
+			Message: "line breaks x" + strconv.Itoa(hit.breaks),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,115 @@
+package multiline_terms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestJoinLines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"ice cream", "ice cream", false},
+		{"ice\ncream", "ice cream", true},
+		{"ice \r\n\r\n  cream", "ice cream", true},
+		{"\nice cream\n", "ice cream", true},
+		{" ice\ncream ", " ice cream ", true},
+		{"a b c", "a b c", true},
+		{"\n\n", "", true},
+	}
+
+	for _, tt := range tests {
+		got, ok := joinLines(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Fatalf("joinLines(%q) = %q, %v; want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestCountLineBreaks(t *testing.T) {
+	t.Parallel()
+
+	if n := countLineBreaks("a\r\nb\nc\rd e"); n != 4 {
+		t.Fatalf("expected 4 line breaks, got %d", n)
+	}
+}
+
+func TestValidateWarnMultilineTerms_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;en;en_description\n" +
+		"cloud;\"Remote\nservers\";cloud;\"line one\nline two\"\n"
+
+	res := validateWarnMultilineTerms(context.Background(), checks.Artifact{Data: []byte(csv)}, multilineConfig{})
+	if !res.OK {
+		t.Fatalf("expected OK=true (descriptions may span lines), got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnMultilineTerms_ReportsCells(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;tags;en;fr\n" +
+		"cloud;x;\"a\nb\";cloud;nuage\n" +
+		"\"ice\ncream\";dessert;;\"ice\r\ncream\";\"glace\n\nà la crème\"\n"
+
+	res := validateWarnMultilineTerms(context.Background(), checks.Artifact{Data: []byte(csv)}, multilineConfig{})
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{"term (row 3) x1", "en (row 3) x1", "fr (row 3) x2", "total 3 cells"} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("message %q does not contain %q", res.Msg, want)
+		}
+	}
+	if strings.Contains(res.Msg, "tags") {
+		t.Fatalf("tags column must not be checked: %q", res.Msg)
+	}
+
+	if len(res.Findings) != 3 || res.Findings[0].Row != 3 || res.Findings[0].Column != "term" || res.Findings[0].Value != "ice\ncream" {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateWarnMultilineTerms_CapsFindings(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	b.WriteString("term;en\n")
+	for range 15 {
+		b.WriteString("\"a\nb\";x\n")
+	}
+
+	res := validateWarnMultilineTerms(context.Background(), checks.Artifact{Data: []byte(b.String())}, multilineConfig{maxFindings: 12})
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if len(res.Findings) != 12 {
+		t.Fatalf("expected 12 findings, got %d", len(res.Findings))
+	}
+	if !strings.Contains(res.Msg, " ...") || !strings.Contains(res.Msg, "total 15 cells") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnMultilineTerms_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnMultilineTerms(ctx, checks.Artifact{Data: []byte("term\nx\n")}, multilineConfig{})
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
@@ -0,0 +1,79 @@
+package multiline_terms
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixMultilineTerms_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixMultilineTerms(context.Background(), checks.Artifact{Data: []byte(" \n")})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixMultilineTerms_JoinsTermsOnly(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF" +
+		"term;description;en\r\n" +
+		"\"ice\r\ncream\";\"a\r\ndessert\";\"ice \r\n cream\"\r\n" +
+		"apple;fruit;apple"
+
+	fr, err := fixMultilineTerms(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF" +
+		"term;description;en\r\n" +
+		"ice cream;\"a\r\ndessert\";ice cream\r\n" +
+		"apple;fruit;apple"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if !strings.Contains(fr.Note, "joined 2 multi-line cells") {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixMultilineTerms_NothingToJoin(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description\napple;\"a\nfruit\"\n"
+
+	fr, err := fixMultilineTerms(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected unchanged data, got DidChange=%v Data=%q", fr.DidChange, fr.Data)
+	}
+}
+
+func TestFixMultilineTerms_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixMultilineTerms(ctx, checks.Artifact{Data: []byte("term\nx\n")})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
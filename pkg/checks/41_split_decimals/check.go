@@ -0,0 +1,323 @@
+package split_decimals
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-split-decimals"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+var (
+	// integerTail matches a cell ending in the integer part of a number: "3", "-3", "approx. 3", "€3".
+	integerTail = regexp.MustCompile(`(?:^|[\s(+\-−€$£¥])\d+$`)
+	// fractionHead matches a cell starting with the fraction part: "5", "5%", "5 kg".
+	fractionHead = regexp.MustCompile(`^\d+(?:$|[\s%‰)])`)
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnSplitDecimals,
+			checks.WithPriority(checks.PrioContent+60),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnSplitDecimals — entry point for the check.
+// Report-only: merging the wrong pair of cells would shift a whole row,
+// so every suspected split is left for manual review.
+func runWarnSplitDecimals(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnSplitDecimals(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no numbers split across cells",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnSplitDecimals reports rows that have more cells than the header and
+// contain two adjacent cells that read as one decimal number ("3" + "5 kg").
+// Spreadsheets in locales with ',' as decimal mark and ';' as list separator
+// sometimes export "3,5 kg" that way.
+func validateWarnSplitDecimals(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for split decimals",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readSplitHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	hits, err := findSplitDecimals(ctx, r, header, rowNum, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating split decimals",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no numbers split across cells",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       splitDecimalsMessage(hits),
+		Findings:  splitDecimalsFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readSplitHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for split decimals)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitDecimal is one suspected number split into cells pos and pos+1 of a row.
+type splitDecimal struct {
+	rowNum int
+	pos    int // 0-based position of the integer part
+	first  string
+	second string
+	column string
+	next   string
+}
+
+// merged is the value the two cells most likely held before the export.
+func (s splitDecimal) merged() string {
+	return s.first + "," + s.second
+}
+
+func findSplitDecimals(
+	ctx context.Context,
+	r csvReader,
+	header []string,
+	rowNum int,
+	limit int,
+	stopAfter int,
+) (checks.Capped[splitDecimal], error) {
+	hits := checks.Capped[splitDecimal]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[splitDecimal]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[splitDecimal]{}, ctxErr
+			}
+
+			return checks.Capped[splitDecimal]{}, err
+		}
+
+		rowNum++
+
+		if extraCells(rec, len(header)) == 0 {
+			continue
+		}
+
+		for _, pos := range splitCandidates(rec) {
+			hits.Add(splitDecimal{
+				rowNum: rowNum,
+				pos:    pos,
+				first:  rec[pos],
+				second: rec[pos+1],
+				column: columnLabel(header, pos),
+				next:   columnLabel(header, pos+1),
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+// extraCells returns how many cells rec has beyond the header width,
+// ignoring blank trailing cells (a stray ";" at the end of a line).
+func extraCells(rec []string, width int) int {
+	n := len(rec)
+	for n > width && strings.TrimSpace(rec[n-1]) == "" {
+		n--
+	}
+
+	return max(n-width, 0)
+}
+
+// splitCandidates returns the positions i where rec[i] ends in an integer and
+// rec[i+1] starts with one. Pairs do not overlap: "1";"2";"3" yields only 0.
+func splitCandidates(rec []string) []int {
+	var out []int
+
+	for i := 0; i+1 < len(rec); i++ {
+		if integerTail.MatchString(rec[i]) && fractionHead.MatchString(rec[i+1]) {
+			out = append(out, i)
+			i++
+		}
+	}
+
+	return out
+}
+
+// columnLabel names a column by its header cell, falling back to a 1-based position
+// for cells beyond the header or under a blank header cell.
+func columnLabel(header []string, pos int) string {
+	if pos < len(header) {
+		if name := strings.TrimSpace(header[pos]); name != "" {
+			return name
+		}
+	}
+
+	return "#" + strconv.Itoa(pos+1)
+}
+
+func splitDecimalsMessage(hits checks.Capped[splitDecimal]) string {
+	limit := len(hits.Items)
+	if limit > maxReportedCells {
+		limit = maxReportedCells
+	}
+
+	var b strings.Builder
+	b.WriteString("numbers possibly split across cells (review manually): ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString("row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(" ")
+		b.WriteString(hit.column)
+		b.WriteString("+")
+		b.WriteString(hit.next)
+		b.WriteString(" ")
+		b.WriteString(strconv.Quote(hit.merged()))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" x)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func splitDecimalsFindings(hits checks.Capped[splitDecimal]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:    hit.rowNum,
+			Column: hit.column,
+			Value:  hit.first + ";" + hit.second,
+			Message: "cells " + strconv.Itoa(hit.pos+1) + " and " + strconv.Itoa(hit.pos+2) +
+				" look like one decimal split by ';' (" + hit.merged() + "?)",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
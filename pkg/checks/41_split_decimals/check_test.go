@@ -0,0 +1,104 @@
+package split_decimals
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestSplitCandidates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rec  []string
+		want []int
+	}{
+		{[]string{"weight", "3", "5 kg"}, []int{1}},
+		{[]string{"approx. 3", "5%", "x"}, []int{0}},
+		{[]string{"-3", "25"}, []int{0}},
+		{[]string{"1", "2", "3"}, []int{0}},
+		{[]string{"v3", "5"}, nil},
+		{[]string{"3", "kg5"}, nil},
+		{[]string{"3.", "5"}, nil},
+	}
+
+	for _, tt := range tests {
+		got := splitCandidates(tt.rec)
+		if len(got) != len(tt.want) {
+			t.Fatalf("splitCandidates(%q) = %v, want %v", tt.rec, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Fatalf("splitCandidates(%q) = %v, want %v", tt.rec, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestExtraCells(t *testing.T) {
+	t.Parallel()
+
+	if n := extraCells([]string{"a", "b", "c", " ", ""}, 2); n != 1 {
+		t.Fatalf("expected 1 extra cell, got %d", n)
+	}
+	if n := extraCells([]string{"a"}, 2); n != 0 {
+		t.Fatalf("expected 0 extra cells, got %d", n)
+	}
+}
+
+func TestValidateWarnSplitDecimals_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;de\n" +
+		"load;max 3;5 kg\n" +
+		"pressure;\"3,5 bar\";\"3,5 bar\";\n"
+
+	res := validateWarnSplitDecimals(context.Background(), checks.Artifact{Data: []byte(csv)}, 0, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true (rows fit the header), got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnSplitDecimals_ReportsPositions(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;de\n" +
+		"load;max 3;5 kg;Last\n" +
+		"ok;fine;gut\n" +
+		"rate;per cent;3;5%\n"
+
+	res := validateWarnSplitDecimals(context.Background(), checks.Artifact{Data: []byte(csv)}, 0, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{`row 2 description+de "max 3,5 kg"`, `row 4 de+#4 "3,5%"`, "total 2 x"} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("message %q does not contain %q", res.Msg, want)
+		}
+	}
+
+	if len(res.Findings) != 2 {
+		t.Fatalf("expected 2 findings, got %+v", res.Findings)
+	}
+	f := res.Findings[1]
+	if f.Row != 4 || f.Column != "de" || f.Value != "3;5%" || !strings.Contains(f.Message, "cells 3 and 4") {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+}
+
+func TestValidateWarnSplitDecimals_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := validateWarnSplitDecimals(ctx, checks.Artifact{Data: []byte("term\nx\n")}, 0, 0)
+	if res.OK || res.Err == nil {
+		t.Fatalf("expected cancelled validation, got %+v", res)
+	}
+}
@@ -0,0 +1,202 @@
+package unused_terms
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/usage"
+)
+
+const checkName = "warn-unused-terms"
+
+const (
+	maxReportedTerms = 10
+	maxFrequentTerms = 5
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnUnusedTerms,
+			checks.WithPriority(checks.PrioSemantic+65),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runWarnUnusedTerms — entry point for the check.
+// Informational only (INFO), and skipped unless RunOptions.SourceStrings is set:
+// whether an unused term is dead or just not shipped yet is for a human to decide.
+func runWarnUnusedTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnUnusedTerms(ctx, a, opts, limit, stopAfter)
+		},
+		Fix:    nil,
+		FailAs: checks.Info,
+	})
+}
+
+// validateWarnUnusedTerms counts every term in the source strings and reports the
+// ones that never occur. The message also names the most frequent terms, so a
+// review sees both ends of the distribution; usage.Count gives the full numbers.
+func validateWarnUnusedTerms(ctx context.Context, a checks.Artifact, opts checks.RunOptions, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	if opts.SourceStrings == nil {
+		return checks.ValidationResult{
+			Skipped: true,
+			Msg:     "no source strings provided (skipping unused terms check)",
+		}
+	}
+
+	if checks.IsBlankUnicode(checks.StripUTF8BOM(a.Data)) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for unused terms",
+		}
+	}
+
+	counted, err := usage.Count(ctx, a.Data, opts.SourceStrings)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+		if errors.Is(err, usage.ErrNoTermColumn) {
+			return checks.ValidationResult{
+				OK:  true,
+				Msg: "no 'term' column found (skipping unused terms check)",
+			}
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while counting term usage",
+			Err: err,
+		}
+	}
+
+	if len(counted) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no terms to look up in source strings",
+		}
+	}
+
+	unused := checks.Capped[usage.Term]{Limit: limit, StopAfter: stopAfter}
+	for _, t := range usage.Unused(counted) {
+		unused.Add(t)
+		if unused.Exhausted() {
+			break
+		}
+	}
+
+	frequent := frequentTerms(counted)
+
+	if unused.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "all " + strconv.Itoa(len(counted)) + " terms occur in source strings" + frequent,
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       unusedTermsMessage(unused, len(counted)) + frequent,
+		Findings:  unusedTermsFindings(unused),
+		Truncated: unused.Exhausted(),
+	}
+}
+
+// frequentTerms formats up to maxFrequentTerms used terms by descending occurrences
+// as " (most frequent: a x120, b x80)", or "" when no term occurs.
+func frequentTerms(counted []usage.Term) string {
+	used := slices.DeleteFunc(slices.Clone(counted), func(t usage.Term) bool { return t.Occurrences == 0 })
+	if len(used) == 0 {
+		return ""
+	}
+
+	slices.SortStableFunc(used, func(a, b usage.Term) int { return b.Occurrences - a.Occurrences })
+	used = used[:min(len(used), maxFrequentTerms)]
+
+	var b strings.Builder
+	b.WriteString(" (most frequent: ")
+	for i, t := range used {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(t.Term)
+		b.WriteString(" x")
+		b.WriteString(strconv.Itoa(t.Occurrences))
+	}
+	b.WriteString(")")
+
+	return b.String()
+}
+
+func unusedTermsMessage(unused checks.Capped[usage.Term], total int) string {
+	limit := min(len(unused.Items), maxReportedTerms)
+
+	var b strings.Builder
+	b.WriteString("terms never found in source strings: ")
+
+	for i := range limit {
+		t := unused.Items[i]
+
+		b.WriteString(t.Term)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(t.Row))
+		b.WriteString(")")
+
+		if i != limit-1 {
+			b.WriteString(", ")
+		}
+	}
+
+	if unused.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(unused.Total()))
+	b.WriteString(" of ")
+	b.WriteString(strconv.Itoa(total))
+	b.WriteString(" terms)")
+	b.WriteString(checks.OverflowNote(unused.Overflow))
+	b.WriteString(unused.TruncatedNote())
+
+	return b.String()
+}
+
+func unusedTermsFindings(unused checks.Capped[usage.Term]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(unused.Items))
+	for _, t := range unused.Items {
+		out = append(out, checks.Finding{
+			Row:     t.Row,
+			Column:  "term",
+			Value:   t.Term,
+			Message: "term never occurs in source strings",
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
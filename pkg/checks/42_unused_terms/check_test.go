@@ -0,0 +1,68 @@
+package unused_terms
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const glossary = "term;description\n" +
+	"cloud;x\n" +
+	"server;x\n" +
+	"kiosk;x\n"
+
+func TestValidateWarnUnusedTerms_NoSources_Skipped(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnUnusedTerms(context.Background(), checks.Artifact{Data: []byte(glossary)}, checks.RunOptions{}, 0, 0)
+	if !res.Skipped || !strings.Contains(res.Msg, "no source strings") {
+		t.Fatalf("expected skip, got %+v", res)
+	}
+}
+
+func TestValidateWarnUnusedTerms_ReportsUnusedAndStats(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{SourceStrings: slices.Values([]string{
+		"Cloud server", "cloud sync", "the cloud",
+	})}
+
+	res := validateWarnUnusedTerms(context.Background(), checks.Artifact{Data: []byte(glossary)}, opts, 0, 0)
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{"kiosk (row 4)", "total 1 of 3 terms", "most frequent: cloud x3, server x1"} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("message %q does not contain %q", res.Msg, want)
+		}
+	}
+	if len(res.Findings) != 1 || res.Findings[0].Row != 4 || res.Findings[0].Value != "kiosk" {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestValidateWarnUnusedTerms_AllUsed_Pass(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{SourceStrings: slices.Values([]string{"cloud server kiosk"})}
+
+	res := validateWarnUnusedTerms(context.Background(), checks.Artifact{Data: []byte(glossary)}, opts, 0, 0)
+	if !res.OK || !strings.HasPrefix(res.Msg, "all 3 terms occur in source strings") {
+		t.Fatalf("expected pass with stats, got %+v", res)
+	}
+}
+
+func TestValidateWarnUnusedTerms_NoTermColumn_Pass(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{SourceStrings: slices.Values([]string{"x"})}
+
+	res := validateWarnUnusedTerms(context.Background(), checks.Artifact{Data: []byte("name;en\nx;y\n")}, opts, 0, 0)
+	if !res.OK {
+		t.Fatalf("expected OK=true, got %+v", res)
+	}
+}
@@ -0,0 +1,378 @@
+package sparse_flag_columns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/flags"
+)
+
+const checkName = "warn-sparse-flag-columns"
+
+const (
+	// settingMinFillPercent is the fill rate (percent of data rows) below which
+	// a used flag column is reported. 0 disables the check.
+	settingMinFillPercent = "min-fill-percent"
+	// settingDefaultPrefix + <flag column> overrides the value the fix writes
+	// into blank cells of that column ("default-translatable=no").
+	settingDefaultPrefix = "default-"
+)
+
+const (
+	defaultMinFillPercent = 50
+	ctxCheckEveryRows     = 1 << 12
+	maxReportedColumns    = 10
+)
+
+// Runs together with the other non-fail-fast checks at Content+20, i.e. before
+// no-invalid-flags, which would otherwise fail on the blank cells first.
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnSparseFlagColumns,
+			checks.WithPriority(checks.PrioContent+25),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type sparseConfig struct {
+	minFill  int
+	defaults map[string]string
+	err      error
+	errMsg   string
+}
+
+func configFrom(opts checks.RunOptions) sparseConfig {
+	cfg := sparseConfig{
+		minFill:  opts.SettingInt(checkName, settingMinFillPercent, defaultMinFillPercent),
+		defaults: make(map[string]string),
+	}
+	if cfg.minFill < 0 || cfg.minFill > 100 {
+		cfg.errMsg = "invalid " + settingMinFillPercent + " " + strconv.Itoa(cfg.minFill) + " (expected 0..100)"
+		cfg.err = errors.New("invalid minimum flag column fill rate")
+		return cfg
+	}
+
+	for _, col := range flags.Columns() {
+		cfg.defaults[col] = flags.Default(col)
+
+		key := settingDefaultPrefix + col
+		v, ok := opts.Setting(checkName, key)
+		if !ok {
+			continue
+		}
+		value, ok := flags.Parse(v)
+		if !ok {
+			cfg.errMsg = "invalid " + key + " " + strconv.Quote(v) + " (expected yes or no)"
+			cfg.err = errors.New("invalid flag column default")
+			return cfg
+		}
+		cfg.defaults[col] = flags.No
+		if value {
+			cfg.defaults[col] = flags.Yes
+		}
+	}
+
+	return cfg
+}
+
+func runWarnSparseFlagColumns(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnSparseFlagColumns(ctx, a, cfg)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixSparseFlagColumns(ctx, a, cfg)
+		},
+		PassMsg:          "flag columns are filled consistently",
+		FixedMsg:         "filled blank cells of sparse flag columns with defaults",
+		AppliedMsg:       "auto-fix applied: filled blank cells of sparse flag columns with defaults",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "flag columns are still sparsely filled after fix",
+	})
+}
+
+// validateWarnSparseFlagColumns reports flag columns that some rows set but most leave blank.
+// Blank cells fall back to Lokalise defaults on import, so a column filled for only a few
+// rows usually means the remaining rows were forgotten rather than meant to use the default.
+// Columns nobody fills are fine and are not reported.
+func validateWarnSparseFlagColumns(ctx context.Context, a checks.Artifact, cfg sparseConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	if cfg.err != nil {
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: cfg.errMsg,
+			Err: cfg.err,
+		}
+	}
+
+	if cfg.minFill == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "flag column fill rate check disabled",
+		}
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for flag column fill rate",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readSparseHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := findFlagColumns(header)
+	if len(cols) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no flag columns found",
+		}
+	}
+
+	rows, err := countFilledFlags(ctx, r, rowNum, cols)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating flag column fill rate",
+			Err: err,
+		}
+	}
+
+	sparse := sparseColumns(cols, rows, cfg.minFill)
+	if len(sparse) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "flag columns are filled consistently",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:       false,
+		Msg:      sparseMessage(sparse, rows, cfg.minFill),
+		Findings: sparseFindings(sparse, rows, rowNum, cfg),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readSparseHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for flag column fill rate)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type flagColumn struct {
+	name   string
+	pos    int
+	filled int
+}
+
+func findFlagColumns(header []string) []flagColumn {
+	cols := make([]flagColumn, 0)
+
+	for i, h := range header {
+		if !flags.IsColumn(h) {
+			continue
+		}
+
+		cols = append(cols, flagColumn{
+			name: strings.ToLower(strings.TrimSpace(h)),
+			pos:  i,
+		})
+	}
+
+	return cols
+}
+
+// countFilledFlags counts non-blank data rows and, per column, the rows with a non-blank flag.
+func countFilledFlags(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols []flagColumn,
+) (int, error) {
+	rows := 0
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return 0, ctxErr
+			}
+
+			return 0, err
+		}
+
+		rowNum++
+
+		if isBlankCSVRecord(rec) {
+			continue
+		}
+		rows++
+
+		for i := range cols {
+			if flagValue(rec, cols[i].pos) != "" {
+				cols[i].filled++
+			}
+		}
+	}
+}
+
+func flagValue(record []string, pos int) string {
+	if pos >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[pos])
+}
+
+// sparseColumns returns the used columns filled in fewer than minFill percent of rows.
+func sparseColumns(cols []flagColumn, rows, minFill int) []flagColumn {
+	var out []flagColumn
+	for _, col := range cols {
+		if col.filled == 0 || col.filled*100 >= minFill*rows {
+			continue
+		}
+		out = append(out, col)
+	}
+
+	return out
+}
+
+func fillPercent(filled, rows int) int {
+	if rows == 0 {
+		return 0
+	}
+
+	return filled * 100 / rows
+}
+
+func describeFill(col flagColumn, rows int) string {
+	return strconv.Itoa(fillPercent(col.filled, rows)) + "% filled (" +
+		strconv.Itoa(col.filled) + " of " + strconv.Itoa(rows) + " rows)"
+}
+
+func sparseMessage(sparse []flagColumn, rows, minFill int) string {
+	limit := min(len(sparse), maxReportedColumns)
+
+	var b strings.Builder
+	b.WriteString("flag columns filled in fewer than ")
+	b.WriteString(strconv.Itoa(minFill))
+	b.WriteString("% of rows: ")
+
+	for i := range limit {
+		b.WriteString(sparse[i].name)
+		b.WriteString(" ")
+		b.WriteString(describeFill(sparse[i], rows))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if len(sparse) > limit {
+		b.WriteString(" ...")
+	}
+
+	return b.String()
+}
+
+// sparseFindings reports one finding per column, located at the header cell.
+// Suggestion carries the value the fix would write into blank cells.
+func sparseFindings(sparse []flagColumn, rows, headerRow int, cfg sparseConfig) []checks.Finding {
+	out := make([]checks.Finding, 0, len(sparse))
+	for _, col := range sparse {
+		out = append(out, checks.Finding{
+			Row:        headerRow,
+			Column:     col.name,
+			Message:    describeFill(col, rows),
+			Suggestion: cfg.defaults[col.name],
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
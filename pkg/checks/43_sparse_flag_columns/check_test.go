@@ -0,0 +1,104 @@
+package sparse_flag_columns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/flags"
+)
+
+func sparseOpts(settings checks.CheckSettings) checks.RunOptions {
+	return checks.RunOptions{Settings: map[string]checks.CheckSettings{checkName: settings}}
+}
+
+func TestConfigFrom_Defaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := configFrom(checks.RunOptions{})
+	if cfg.err != nil || cfg.minFill != defaultMinFillPercent {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+	if cfg.defaults[flags.ColumnTranslatable] != flags.Yes || cfg.defaults[flags.ColumnForbidden] != flags.No {
+		t.Fatalf("unexpected defaults: %v", cfg.defaults)
+	}
+
+	cfg = configFrom(sparseOpts(map[string]string{"default-translatable": "N"}))
+	if cfg.err != nil || cfg.defaults[flags.ColumnTranslatable] != flags.No {
+		t.Fatalf("expected translatable default no, got %+v", cfg)
+	}
+}
+
+func TestValidateWarnSparseFlagColumns_InvalidSettings(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("term;forbidden\na;yes\n")
+
+	for _, settings := range []checks.CheckSettings{
+		{settingMinFillPercent: "101"},
+		{"default-forbidden": "maybe"},
+	} {
+		res := validateWarnSparseFlagColumns(context.Background(), checks.Artifact{Data: data}, configFrom(sparseOpts(settings)))
+		if res.OK || res.Err == nil || !strings.HasPrefix(res.Msg, "invalid ") {
+			t.Fatalf("settings %v: expected config error, got %+v", settings, res)
+		}
+	}
+}
+
+func TestValidateWarnSparseFlagColumns_ReportsSparseColumns(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;casesensitive;translatable;forbidden;en\n" +
+		"a;yes;;;a\n" +
+		"b;no;;;b\n" +
+		"\n" +
+		"c;no;no;;c\n" +
+		"d;yes\n"
+
+	res := validateWarnSparseFlagColumns(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(checks.RunOptions{}))
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+	if !strings.Contains(res.Msg, "translatable 25% filled (1 of 4 rows)") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+	if strings.Contains(res.Msg, "casesensitive") || strings.Contains(res.Msg, "forbidden") {
+		t.Fatalf("full and unused columns must not be reported: %q", res.Msg)
+	}
+
+	if len(res.Findings) != 1 {
+		t.Fatalf("expected 1 finding, got %+v", res.Findings)
+	}
+	f := res.Findings[0]
+	if f.Row != 1 || f.Column != "translatable" || f.Suggestion != flags.Yes {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+}
+
+func TestValidateWarnSparseFlagColumns_ThresholdAndDisable(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;forbidden\na;yes\nb;\nc;\nd;\n"
+	a := checks.Artifact{Data: []byte(csv)}
+
+	if res := validateWarnSparseFlagColumns(context.Background(), a, configFrom(sparseOpts(map[string]string{settingMinFillPercent: "25"}))); !res.OK {
+		t.Fatalf("25%% filled meets a 25%% threshold, got Msg=%q", res.Msg)
+	}
+	if res := validateWarnSparseFlagColumns(context.Background(), a, configFrom(sparseOpts(map[string]string{settingMinFillPercent: "26"}))); res.OK {
+		t.Fatalf("expected 25%% filled to fail a 26%% threshold")
+	}
+	if res := validateWarnSparseFlagColumns(context.Background(), a, configFrom(sparseOpts(map[string]string{settingMinFillPercent: "0"}))); !res.OK {
+		t.Fatalf("expected check to be disabled, got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnSparseFlagColumns_NoFlagColumns_Pass(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnSparseFlagColumns(context.Background(), checks.Artifact{Data: []byte("term;en\na;a\n")}, configFrom(checks.RunOptions{}))
+	if !res.OK {
+		t.Fatalf("expected OK=true, got Msg=%q", res.Msg)
+	}
+}
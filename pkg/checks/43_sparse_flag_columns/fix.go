@@ -0,0 +1,279 @@
+package sparse_flag_columns
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixSparseFlagColumns fills blank cells of sparse flag columns with the configured
+// default (Lokalise's import default unless overridden). Rows shorter than the header
+// are padded up to the flag cell; blank rows and other columns are copied as-is.
+func fixSparseFlagColumns(ctx context.Context, a checks.Artifact, cfg sparseConfig) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+	if cfg.err != nil {
+		return checks.NoFix(a, cfg.errMsg)
+	}
+
+	in, bom := checks.SplitUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(in) {
+		return checks.NoFix(a, "no usable content to fix")
+	}
+
+	lineSep := checks.DetectLineEnding(in)
+	keepFinal := bytes.HasSuffix(in, []byte("\n"))
+
+	parts, ok, err := findSparseFixHeaderLine(ctx, in)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+	if !ok {
+		return checks.NoFix(a, "no header line found")
+	}
+
+	records, err := readSparseFixRecords(ctx, appendSparseFixHeaderAndRest(parts))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return checks.FixResult{}, ctxErr
+		}
+
+		return checks.NoFix(a, "cannot parse CSV with semicolon delimiter")
+	}
+	if len(records) == 0 || isBlankCSVRecord(records[0]) {
+		return checks.NoFix(a, "empty header line")
+	}
+
+	cols := findFlagColumns(records[0])
+	rows := 0
+	for _, row := range records[1:] {
+		if isBlankCSVRecord(row) {
+			continue
+		}
+		rows++
+		for i := range cols {
+			if flagValue(row, cols[i].pos) != "" {
+				cols[i].filled++
+			}
+		}
+	}
+
+	sparse := sparseColumns(cols, rows, cfg.minFill)
+	if len(sparse) == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no sparse flag columns to fill",
+		}, nil
+	}
+
+	filled, err := fillSparseCells(ctx, records, sparse, cfg.defaults)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+
+	outTail, err := writeSparseFixRecords(ctx, records, lineSep, keepFinal)
+	if err != nil {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "failed to serialize CSV: " + err.Error(),
+		}, err
+	}
+
+	out := stitchSparseFix(bom, parts.before, outTail)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      "filled " + strconv.Itoa(filled) + " blank flag cells with defaults",
+	}, nil
+}
+
+// fillSparseCells rewrites records in place and returns the number of filled cells.
+func fillSparseCells(
+	ctx context.Context,
+	records [][]string,
+	cols []flagColumn,
+	defaults map[string]string,
+) (int, error) {
+	filled := 0
+
+	for i := 1; i < len(records); i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		row := records[i]
+		if isBlankCSVRecord(row) {
+			continue
+		}
+
+		for _, col := range cols {
+			if flagValue(row, col.pos) != "" {
+				continue
+			}
+
+			for len(row) <= col.pos {
+				row = append(row, "")
+			}
+			row[col.pos] = defaults[col.name]
+			filled++
+		}
+
+		records[i] = row
+	}
+
+	return filled, nil
+}
+
+type sparseFixHeaderParts struct {
+	before []byte
+	line   []byte
+	rest   []byte
+}
+
+func findSparseFixHeaderLine(
+	ctx context.Context,
+	data []byte,
+) (sparseFixHeaderParts, bool, error) {
+	pos := 0
+
+	for pos <= len(data) {
+		if err := ctx.Err(); err != nil {
+			return sparseFixHeaderParts{}, false, err
+		}
+
+		line, rest, found := bytes.Cut(data[pos:], []byte("\n"))
+		lineForCheck := sparseFixTrimTrailingCR(line)
+
+		if !checks.IsBlankUnicode(lineForCheck) {
+			headerEnd := len(data) - len(rest)
+			if !found {
+				headerEnd = len(data)
+			}
+
+			return sparseFixHeaderParts{
+				before: data[:pos],
+				line:   data[pos:headerEnd],
+				rest:   data[headerEnd:],
+			}, true, nil
+		}
+
+		if !found {
+			break
+		}
+
+		pos += len(line) + 1
+	}
+
+	return sparseFixHeaderParts{}, false, nil
+}
+
+func sparseFixTrimTrailingCR(line []byte) []byte {
+	if len(line) > 0 && line[len(line)-1] == '\r' {
+		return line[:len(line)-1]
+	}
+
+	return line
+}
+
+func appendSparseFixHeaderAndRest(parts sparseFixHeaderParts) []byte {
+	out := make([]byte, 0, len(parts.line)+len(parts.rest))
+	out = append(out, parts.line...)
+	out = append(out, parts.rest...)
+
+	return out
+}
+
+func readSparseFixRecords(ctx context.Context, data []byte) ([][]string, error) {
+	r := checks.NewSemicolonCSVReader(data)
+
+	var records [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+}
+
+func writeSparseFixRecords(
+	ctx context.Context,
+	records [][]string,
+	lineSep string,
+	keepFinal bool,
+) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+
+	for i := range len(records) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := w.Write(records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	out := buf.Bytes()
+
+	if lineSep == "\r\n" {
+		out = bytes.ReplaceAll(out, []byte("\n"), []byte("\r\n"))
+	}
+
+	if !keepFinal {
+		out = trimFinalCSVWriterNewline(out)
+	}
+
+	return out, nil
+}
+
+func trimFinalCSVWriterNewline(data []byte) []byte {
+	if bytes.HasSuffix(data, []byte("\r\n")) {
+		return data[:len(data)-2]
+	}
+
+	if bytes.HasSuffix(data, []byte("\n")) {
+		return data[:len(data)-1]
+	}
+
+	return data
+}
+
+func stitchSparseFix(bom, before, tail []byte) []byte {
+	out := make([]byte, 0, len(bom)+len(before)+len(tail))
+	out = append(out, bom...)
+	out = append(out, before...)
+	out = append(out, tail...)
+
+	return out
+}
@@ -0,0 +1,81 @@
+package sparse_flag_columns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixSparseFlagColumns_FillsDefaults(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF" +
+		"term;translatable;forbidden\r\n" +
+		"a;no;\r\n" +
+		"b;;\r\n" +
+		";;\r\n" +
+		"c;;yes\r\n" +
+		"d\r\n" +
+		"e;;"
+
+	cfg := configFrom(sparseOpts(map[string]string{"default-forbidden": "no"}))
+
+	fr, err := fixSparseFlagColumns(context.Background(), checks.Artifact{Data: []byte(in)}, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF" +
+		"term;translatable;forbidden\r\n" +
+		"a;no;no\r\n" +
+		"b;yes;no\r\n" +
+		";;\r\n" +
+		"c;yes;yes\r\n" +
+		"d;yes;no\r\n" +
+		"e;yes;no"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if !strings.Contains(fr.Note, "filled 8 blank flag cells") {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+
+	res := validateWarnSparseFlagColumns(context.Background(), checks.Artifact{Data: fr.Data}, cfg)
+	if !res.OK {
+		t.Fatalf("expected fixed data to pass, got Msg=%q", res.Msg)
+	}
+}
+
+func TestFixSparseFlagColumns_NothingToFill(t *testing.T) {
+	t.Parallel()
+
+	in := "term;forbidden\na;\nb;\n"
+
+	fr, err := fixSparseFlagColumns(context.Background(), checks.Artifact{Data: []byte(in)}, configFrom(checks.RunOptions{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("unused columns must stay blank, got %q", fr.Data)
+	}
+}
+
+func TestFixSparseFlagColumns_InvalidConfig_NoFix(t *testing.T) {
+	t.Parallel()
+
+	cfg := configFrom(sparseOpts(map[string]string{"default-forbidden": "maybe"}))
+
+	_, err := fixSparseFlagColumns(context.Background(), checks.Artifact{Data: []byte("term;forbidden\na;yes\nb;\n")}, cfg)
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+}
@@ -0,0 +1,401 @@
+package nonstandard_hyphens
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-nonstandard-hyphens"
+
+// settingSkipDescriptions controls whether description columns are left untouched (default: true).
+const settingSkipDescriptions = "skip-descriptions"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnNonstandardHyphens,
+			checks.WithPriority(checks.PrioContent+65),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+type hyphenConfig struct {
+	skipDescriptions bool
+	maxFindings      int
+	stopAfter        int
+}
+
+func configFrom(opts checks.RunOptions) hyphenConfig {
+	return hyphenConfig{
+		skipDescriptions: opts.SettingBool(checkName, settingSkipDescriptions, true),
+		maxFindings:      opts.FindingsLimit(),
+		stopAfter:        opts.StopAfter(),
+	}
+}
+
+func runWarnNonstandardHyphens(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnNonstandardHyphens(ctx, a, cfg)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixNonstandardHyphens(ctx, a, cfg)
+		},
+		PassMsg:          "no soft hyphens or non-standard dashes in term or locale values",
+		FixedMsg:         "removed soft hyphens and replaced non-standard dashes with hyphen-minus",
+		AppliedMsg:       "auto-fix applied: removed soft hyphens and replaced non-standard dashes with hyphen-minus",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "soft hyphens or non-standard dashes are still present after fix",
+	})
+}
+
+// validateWarnNonstandardHyphens reports soft hyphens and dash look-alikes inside term and
+// locale values. Word processors insert them silently (Windows-1252 "smart" dashes end up
+// as U+2013/U+2014 once the file is UTF-8), and terms containing them never match source
+// text typed with a plain hyphen-minus.
+func validateWarnNonstandardHyphens(ctx context.Context, a checks.Artifact, cfg hyphenConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for non-standard hyphens",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readHyphenHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := targetColumns(header, cfg)
+	if len(cols) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no term or locale columns found (skipping non-standard hyphens check)",
+		}
+	}
+
+	hits, err := findNonstandardHyphens(ctx, r, rowNum, cols, cfg.maxFindings, cfg.stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating non-standard hyphens",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no soft hyphens or non-standard dashes in term or locale values",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       nonstandardHyphensMessage(hits),
+		Findings:  nonstandardHyphenFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readHyphenHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for non-standard hyphens)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type targetColumn struct {
+	name string
+	pos  int
+}
+
+// targetColumns picks the term column, locale value columns and (optionally) description columns.
+// Flag and tags columns are never touched.
+func targetColumns(header []string, cfg hyphenConfig) []targetColumn {
+	var cols []targetColumn
+
+	for i, h := range header {
+		name := normalizeHeaderCell(h)
+		if name == "" {
+			continue
+		}
+
+		switch {
+		case name == "term":
+		case name == "description" || strings.HasSuffix(name, "_description"):
+			if cfg.skipDescriptions {
+				continue
+			}
+		default:
+			if _, known := checks.KnownHeaders[name]; known {
+				continue
+			}
+		}
+
+		cols = append(cols, targetColumn{
+			name: strings.TrimSpace(h),
+			pos:  i,
+		})
+	}
+
+	return cols
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// softHyphen is invisible unless a line breaks at it, so the fix drops it
+// instead of turning it into a visible hyphen.
+const softHyphen = '\u00AD'
+
+// isNonstandardDash matches HYPHEN, NON-BREAKING HYPHEN, FIGURE DASH, EN DASH, EM DASH,
+// HORIZONTAL BAR and MINUS SIGN. Fullwidth and small forms are left alone: they are
+// regular punctuation in CJK text.
+func isNonstandardDash(r rune) bool {
+	switch r {
+	case '\u2010', '\u2011', '\u2012', '\u2013', '\u2014', '\u2015', '\u2212':
+		return true
+	default:
+		return false
+	}
+}
+
+// countHyphens counts soft hyphens and non-standard dashes in s.
+func countHyphens(s string) (soft, dashes int) {
+	for _, r := range s {
+		switch {
+		case r == softHyphen:
+			soft++
+		case isNonstandardDash(r):
+			dashes++
+		}
+	}
+
+	return soft, dashes
+}
+
+// normalizeHyphens drops soft hyphens and maps non-standard dashes to '-'.
+func normalizeHyphens(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == softHyphen:
+			return -1
+		case isNonstandardDash(r):
+			return '-'
+		default:
+			return r
+		}
+	}, s)
+}
+
+type hyphenHit struct {
+	rowNum int
+	column string
+	soft   int
+	dashes int
+}
+
+// hyphenHits keeps up to the findings limit of cells but counts every character.
+type hyphenHits struct {
+	checks.Capped[hyphenHit]
+	soft   int
+	dashes int
+}
+
+func findNonstandardHyphens(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols []targetColumn,
+	limit int,
+	stopAfter int,
+) (hyphenHits, error) {
+	hits := hyphenHits{Capped: checks.Capped[hyphenHit]{Limit: limit, StopAfter: stopAfter}}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return hyphenHits{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return hyphenHits{}, ctxErr
+			}
+
+			return hyphenHits{}, err
+		}
+
+		rowNum++
+
+		for _, col := range cols {
+			if col.pos >= len(rec) {
+				continue
+			}
+
+			soft, dashes := countHyphens(rec[col.pos])
+			if soft+dashes == 0 {
+				continue
+			}
+
+			hits.soft += soft
+			hits.dashes += dashes
+			hits.Add(hyphenHit{
+				rowNum: rowNum,
+				column: col.name,
+				soft:   soft,
+				dashes: dashes,
+			})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+// describeCounts renders "soft hyphens x1, dashes x2", leaving out zero counts.
+func describeCounts(soft, dashes int) string {
+	var parts []string
+	if soft > 0 {
+		parts = append(parts, "soft hyphens x"+strconv.Itoa(soft))
+	}
+	if dashes > 0 {
+		parts = append(parts, "dashes x"+strconv.Itoa(dashes))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func nonstandardHyphensMessage(hits hyphenHits) string {
+	limit := min(len(hits.Items), maxReportedCells)
+
+	var b strings.Builder
+	b.WriteString("soft hyphens or non-standard dashes found: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(") ")
+		b.WriteString(describeCounts(hit.soft, hit.dashes))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(describeCounts(hits.soft, hits.dashes))
+	b.WriteString(" in ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func nonstandardHyphenFindings(hits hyphenHits) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Message: describeCounts(hit.soft, hit.dashes),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
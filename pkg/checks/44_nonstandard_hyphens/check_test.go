@@ -0,0 +1,85 @@
+package nonstandard_hyphens
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestNormalizeHyphens(t *testing.T) {
+	t.Parallel()
+
+	in := "co\u00adoperate e\u2011mail 2010\u20132020 a\u2014b \u22121 \uff0d"
+	want := "cooperate e-mail 2010-2020 a-b -1 \uff0d"
+
+	if got := normalizeHyphens(in); got != want {
+		t.Fatalf("normalizeHyphens = %q, want %q", got, want)
+	}
+	if soft, dashes := countHyphens(in); soft != 1 || dashes != 4 {
+		t.Fatalf("countHyphens = %d, %d; want 1, 4", soft, dashes)
+	}
+}
+
+func TestValidateWarnNonstandardHyphens_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr\n" +
+		"e-mail;mail \u2013 electronic;courriel\n"
+
+	res := validateWarnNonstandardHyphens(context.Background(), checks.Artifact{Data: []byte(csv)}, hyphenConfig{skipDescriptions: true})
+	if !res.OK {
+		t.Fatalf("expected OK=true (descriptions skipped), got Msg=%q", res.Msg)
+	}
+}
+
+func TestValidateWarnNonstandardHyphens_TermAndLocale_Warn(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;fr;tags\n" +
+		"e\u2011mail;x;cour\u00adriel;a\u2013b\n" +
+		"check\u2013in;y;en\u2010re\u00adgis\u00adtre\u00adment;\n"
+
+	res := validateWarnNonstandardHyphens(context.Background(), checks.Artifact{Data: []byte(csv)}, hyphenConfig{skipDescriptions: true})
+	if res.OK {
+		t.Fatalf("expected OK=false")
+	}
+
+	for _, want := range []string{
+		"term (row 2) dashes x1",
+		"fr (row 2) soft hyphens x1",
+		"fr (row 3) soft hyphens x3, dashes x1",
+		"total soft hyphens x4, dashes x3 in 4 cells",
+	} {
+		if !strings.Contains(res.Msg, want) {
+			t.Fatalf("expected %q in message, got %q", want, res.Msg)
+		}
+	}
+	if strings.Contains(res.Msg, "tags") {
+		t.Fatalf("tags column must not be checked: %q", res.Msg)
+	}
+
+	if len(res.Findings) != 4 || res.Findings[0].Row != 2 || res.Findings[0].Column != "term" {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestRunWarnNonstandardHyphens_FixAndRerun_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;en\ne\u2011mail;e\u00admail\n")}
+
+	out := runWarnNonstandardHyphens(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixIfFailed,
+		RerunAfterFix: true,
+	})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s: %s", out.Result.Status, out.Result.Message)
+	}
+	if got := string(out.Final.Data); got != "term;en\ne-mail;email\n" {
+		t.Fatalf("unexpected fixed data: %q", got)
+	}
+}
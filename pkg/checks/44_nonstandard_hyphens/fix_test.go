@@ -0,0 +1,66 @@
+package nonstandard_hyphens
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixNonstandardHyphens_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixNonstandardHyphens(context.Background(), checks.Artifact{Data: []byte("  \n")}, hyphenConfig{skipDescriptions: true})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixNonstandardHyphens_ReplacesTargetColumnsOnly(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF" +
+		"term;description;fr;tags\r\n" +
+		"e\u2011mail;a\u2014b;cour\u00adriel;x\u2013y\r\n" +
+		"apple;fruit;pomme;x"
+
+	fr, err := fixNonstandardHyphens(context.Background(), checks.Artifact{Data: []byte(in)}, hyphenConfig{skipDescriptions: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF" +
+		"term;description;fr;tags\r\n" +
+		"e-mail;a\u2014b;courriel;x\u2013y\r\n" +
+		"apple;fruit;pomme;x"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if fr.Note != "removed 1 soft hyphens, replaced 1 non-standard dashes with hyphen-minus" {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixNonstandardHyphens_NothingToNormalize_NoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;description\ne-mail;a\u2014b\n"
+
+	fr, err := fixNonstandardHyphens(context.Background(), checks.Artifact{Data: []byte(in)}, hyphenConfig{skipDescriptions: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("expected unchanged data, got %q", fr.Data)
+	}
+}
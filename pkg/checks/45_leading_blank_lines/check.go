@@ -0,0 +1,152 @@
+package leading_blank_lines
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = checks.LeadingBlankLinesCheck
+
+const ctxCheckEveryLine = 1 << 12
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnLeadingBlankLines,
+			// Between the encoding gate and ensure-no-empty-lines, which would
+			// otherwise drop these lines together with a BOM-only first line.
+			checks.WithPriority(checks.PrioStructural+15),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
+}
+
+// runWarnLeadingBlankLines — entry point for the check.
+// Lokalise import treats blank lines before the header as data, so by default they are
+// reported and removed. With policy "keep" the check passes and line-level fixers
+// (see checks.RunOptions.KeepLeadingBlankLines) leave them alone as well.
+func runWarnLeadingBlankLines(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	policy, ok := opts.Setting(checkName, checks.SettingLeadingBlank)
+	if !ok {
+		policy = checks.LeadingBlankStrip
+	}
+	policy = strings.ToLower(policy)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateLeadingBlankLines(ctx, a, policy)
+		},
+		Fix:              fixLeadingBlankLines,
+		PassMsg:          "no blank lines before the header",
+		FixedMsg:         "removed blank lines before the header",
+		AppliedMsg:       "auto-fix applied: removed blank lines before the header",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "blank lines before the header are still present after fix",
+	})
+}
+
+func validateLeadingBlankLines(ctx context.Context, a checks.Artifact, policy string) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	switch policy {
+	case checks.LeadingBlankStrip:
+	case checks.LeadingBlankKeep:
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "blank lines before the header are kept (policy " + checks.LeadingBlankKeep + ")",
+		}
+	default:
+		return checks.ValidationResult{
+			OK: false,
+			Msg: "invalid " + checks.SettingLeadingBlank + " " + strconv.Quote(policy) +
+				" (expected " + checks.LeadingBlankStrip + " or " + checks.LeadingBlankKeep + ")",
+			Err: errors.New("invalid leading blank lines policy"),
+		}
+	}
+
+	lb, found, err := leadingBlankLines(ctx, a.Data)
+	if err != nil {
+		return cancelledValidation(err)
+	}
+
+	if !found {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no header line found (nothing to validate for leading blank lines)",
+		}
+	}
+
+	if lb.lines == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no blank lines before the header",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: leadingBlankMessage(lb.lines),
+	}
+}
+
+// leadingBlank locates the blank lines before the header in a BOM-less body.
+type leadingBlank struct {
+	lines  int // number of blank lines
+	offset int // byte offset of the header line
+}
+
+// leadingBlankLines counts the blank lines (whitespace and invisible code points, see
+// checks.IsBlankUnicode) before the first non-blank line of data, after a BOM.
+// found is false when every line is blank.
+func leadingBlankLines(ctx context.Context, data []byte) (leadingBlank, bool, error) {
+	body, _ := checks.SplitUTF8BOM(data)
+
+	var res leadingBlank
+	for len(body[res.offset:]) > 0 {
+		if res.lines%ctxCheckEveryLine == 0 {
+			if err := ctx.Err(); err != nil {
+				return leadingBlank{}, false, err
+			}
+		}
+
+		line, _, hasNext := bytes.Cut(body[res.offset:], []byte("\n"))
+		if !checks.IsBlankUnicode(bytes.TrimSuffix(line, []byte("\r"))) {
+			return res, true, nil
+		}
+		if !hasNext {
+			break
+		}
+
+		res.lines++
+		res.offset += len(line) + 1
+	}
+
+	return leadingBlank{}, false, nil
+}
+
+func leadingBlankMessage(n int) string {
+	if n == 1 {
+		return "found 1 blank line before the header (line 1); Lokalise imports it as data"
+	}
+
+	return "found " + strconv.Itoa(n) + " blank lines before the header (lines 1-" + strconv.Itoa(n) + "); Lokalise imports them as data"
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,120 @@
+package leading_blank_lines
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestLeadingBlankLines_Metadata(t *testing.T) {
+	c, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check %q not registered", checkName)
+	}
+	if c.FailFast() {
+		t.Fatalf("FailFast() = true, want false")
+	}
+	if got, want := c.Priority(), checks.PrioStructural+15; got != want {
+		t.Fatalf("Priority() = %d, want %d", got, want)
+	}
+}
+
+func TestValidateLeadingBlankLines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		in     string
+		policy string
+		ok     bool
+		msg    string
+	}{
+		{"clean", "term;description\nx;y\n", checks.LeadingBlankStrip, true, "no blank lines"},
+		{"bom on header", "\ufeffterm;description\nx;y\n", checks.LeadingBlankStrip, true, "no blank lines"},
+		{"one blank", "\nterm;description\n", checks.LeadingBlankStrip, false, "found 1 blank line before the header (line 1)"},
+		{"bom-only and whitespace", "\ufeff\r\n \u200b\r\nterm\r\n", checks.LeadingBlankStrip, false, "found 2 blank lines before the header (lines 1-2)"},
+		{"blank inside is not leading", "term\n\nx\n", checks.LeadingBlankStrip, true, "no blank lines"},
+		{"all blank", "\n \n", checks.LeadingBlankStrip, true, "no header line found"},
+		{"keep", "\n\nterm\n", checks.LeadingBlankKeep, true, "kept"},
+		{"invalid policy", "\nterm\n", "trim", false, `invalid policy "trim"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := validateLeadingBlankLines(context.Background(), checks.Artifact{Data: []byte(tc.in)}, tc.policy)
+			if res.OK != tc.ok {
+				t.Fatalf("OK = %v, want %v (%q)", res.OK, tc.ok, res.Msg)
+			}
+			if !strings.Contains(res.Msg, tc.msg) {
+				t.Fatalf("Msg = %q, want it to contain %q", res.Msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestRunLeadingBlankLines_FixKeepsBOM(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("\ufeff\n\nterm;description\nx;y\n"), Path: "file.csv"}
+
+	out := runWarnLeadingBlankLines(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed})
+	if !out.Final.DidChange {
+		t.Fatalf("expected the fix to change the data (%s)", out.Result.Message)
+	}
+	if got, want := string(out.Final.Data), "\ufeffterm;description\nx;y\n"; got != want {
+		t.Fatalf("data = %q, want %q", got, want)
+	}
+}
+
+func TestRunLeadingBlankLines_Warns(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("\nterm\nx\n"), Path: "file.csv"}
+
+	out := runWarnLeadingBlankLines(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN", out.Result.Status)
+	}
+}
+
+func TestRunLeadingBlankLines_KeepPolicy(t *testing.T) {
+	t.Parallel()
+
+	in := "\n\nterm\nx\n"
+	opts := checks.RunOptions{
+		FixMode: checks.FixIfFailed,
+		Settings: map[string]checks.CheckSettings{
+			checkName: {checks.SettingLeadingBlank: "Keep"},
+		},
+	}
+	if !opts.KeepLeadingBlankLines() {
+		t.Fatalf("KeepLeadingBlankLines() = false, want true")
+	}
+
+	out := runWarnLeadingBlankLines(context.Background(), checks.Artifact{Data: []byte(in)}, opts)
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("status = %s, want PASS", out.Result.Status)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("keep policy must not change the data")
+	}
+}
+
+func TestRunLeadingBlankLines_InvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {checks.SettingLeadingBlank: "sometimes"},
+		},
+	}
+
+	out := runWarnLeadingBlankLines(context.Background(), checks.Artifact{Data: []byte("term\n")}, opts)
+	if out.Result.Status == checks.Pass {
+		t.Fatalf("invalid policy must not pass")
+	}
+}
@@ -0,0 +1,51 @@
+package leading_blank_lines
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixLeadingBlankLines removes the blank lines before the header. A UTF-8 BOM stays
+// at the start of the file; everything from the header line on is kept byte for byte.
+func fixLeadingBlankLines(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	lb, found, err := leadingBlankLines(ctx, a.Data)
+	if err != nil {
+		return checks.FixResult{}, err
+	}
+
+	if !found || lb.lines == 0 {
+		return checks.FixResult{
+			Data:      a.Data,
+			Path:      "",
+			DidChange: false,
+			Note:      "no blank lines before the header",
+		}, nil
+	}
+
+	body, bom := checks.SplitUTF8BOM(a.Data)
+
+	out := make([]byte, 0, len(bom)+len(body)-lb.offset)
+	out = append(out, bom...)
+	out = append(out, body[lb.offset:]...)
+
+	return checks.FixResult{
+		Data:      out,
+		Path:      "",
+		DidChange: true,
+		Note:      removedNote(lb.lines),
+	}, nil
+}
+
+func removedNote(n int) string {
+	if n == 1 {
+		return "removed 1 blank line before the header"
+	}
+
+	return "removed " + strconv.Itoa(n) + " blank lines before the header"
+}
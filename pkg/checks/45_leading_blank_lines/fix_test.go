@@ -0,0 +1,61 @@
+package leading_blank_lines
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixLeadingBlankLines(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		changed bool
+		note    string
+	}{
+		{"lf", "\n\nterm;en\nx;y\n", "term;en\nx;y\n", true, "removed 2 blank lines before the header"},
+		{"crlf and inner blanks kept", " \r\nterm;en\r\n\r\nx;y", "term;en\r\n\r\nx;y", true, "removed 1 blank line before the header"},
+		{"bom-only first line", "\ufeff\nterm\n", "\ufeffterm\n", true, "removed 1 blank line before the header"},
+		{"bom then blanks", "\ufeff\u200b\n\t\nterm\n", "\ufeffterm\n", true, "removed 2 blank lines before the header"},
+		{"clean", "\ufeffterm\n\nx\n", "\ufeffterm\n\nx\n", false, "no blank lines before the header"},
+		{"all blank", "\n\n", "\n\n", false, "no blank lines before the header"},
+		{"empty", "", "", false, "no blank lines before the header"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fr, err := fixLeadingBlankLines(context.Background(), checks.Artifact{Data: []byte(tc.in)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := string(fr.Data); got != tc.want {
+				t.Fatalf("data = %q, want %q", got, tc.want)
+			}
+			if fr.DidChange != tc.changed {
+				t.Fatalf("DidChange = %v, want %v", fr.DidChange, tc.changed)
+			}
+			if fr.Note != tc.note {
+				t.Fatalf("Note = %q, want %q", fr.Note, tc.note)
+			}
+		})
+	}
+}
+
+func TestFixLeadingBlankLines_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fixLeadingBlankLines(ctx, checks.Artifact{Data: []byte("\nterm\n")})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,405 @@
+package translation_casing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/flags"
+)
+
+const checkName = "warn-translation-casing"
+
+// settingSkipLocales lists locales whose casing rules differ from the term's by design
+// (default "de,lb": nouns are capitalized). A locale matches itself and its regional
+// variants ("de" covers "de_AT").
+const settingSkipLocales = "skip-locales"
+
+var defaultSkipLocales = []string{"de", "lb"}
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnTranslationCasing,
+			checks.WithPriority(checks.PrioContent+70),
+			checks.WithScope(checks.ScopeRows),
+			checks.WithOptIn(),
+		)
+	})
+}
+
+type casingConfig struct {
+	skipLocales []string
+	maxFindings int
+	stopAfter   int
+}
+
+func configFrom(opts checks.RunOptions) casingConfig {
+	skip := opts.SettingList(checkName, settingSkipLocales, defaultSkipLocales)
+	for i, s := range skip {
+		skip[i] = strings.ToLower(strings.ReplaceAll(s, "-", "_"))
+	}
+
+	return casingConfig{
+		skipLocales: skip,
+		maxFindings: opts.FindingsLimit(),
+		stopAfter:   opts.StopAfter(),
+	}
+}
+
+// runWarnTranslationCasing — entry point for the check.
+// Opt-in and stylistic: casing conventions differ between languages and teams, so there
+// is no auto-fix and the result is only a warning.
+func runWarnTranslationCasing(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	cfg := configFrom(opts)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnTranslationCasing(ctx, a, cfg)
+		},
+		PassMsg: "translations of case-sensitive terms follow the term's casing",
+		FailAs:  checks.Warn,
+	})
+}
+
+// validateWarnTranslationCasing flags translations of casesensitive=yes terms whose first
+// letter has the other case than the term's ("cloud" translated as "Nuage"). With case-
+// sensitive matching such a translation is usually a slip. All-caps words (acronyms) and
+// values without cased letters are left alone.
+func validateWarnTranslationCasing(ctx context.Context, a checks.Artifact, cfg casingConfig) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for translation casing",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readCasingHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	cols := casingColumnsOf(header, cfg)
+	if cols.term < 0 || cols.casesensitive < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "term or casesensitive column not found (skipping translation casing check)",
+		}
+	}
+	if len(cols.locales) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no locale columns to compare (skipping translation casing check)",
+		}
+	}
+
+	hits, err := findCasingMismatches(ctx, r, rowNum, cols, cfg)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating translation casing",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "translations of case-sensitive terms follow the term's casing",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       casingMessage(hits),
+		Findings:  casingFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readCasingHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for translation casing)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type casingColumns struct {
+	term          int
+	casesensitive int
+	locales       []checks.LocaleColumn
+}
+
+func casingColumnsOf(header []string, cfg casingConfig) casingColumns {
+	cols := casingColumns{term: -1, casesensitive: -1}
+
+	for i, h := range header {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "term":
+			if cols.term < 0 {
+				cols.term = i
+			}
+		case "casesensitive":
+			if cols.casesensitive < 0 {
+				cols.casesensitive = i
+			}
+		}
+	}
+
+	for _, col := range checks.BuildLocaleIndex(header).Columns {
+		if col.Description || skipLocale(col.Key, cfg.skipLocales) {
+			continue
+		}
+		cols.locales = append(cols.locales, col)
+	}
+
+	return cols
+}
+
+func skipLocale(key string, skip []string) bool {
+	for _, s := range skip {
+		if key == s || strings.HasPrefix(key, s+"_") {
+			return true
+		}
+	}
+
+	return false
+}
+
+type casing int
+
+const (
+	casingNone  casing = iota // no cased letters, or all caps: not compared
+	casingLower               // first cased letter is lowercase
+	casingUpper               // first cased letter is uppercase, not all caps
+)
+
+// casingOf classifies s by its first cased letter. Words with two or more cased
+// letters that are all uppercase ("API", "PDF") count as casingNone.
+func casingOf(s string) casing {
+	first := casingNone
+	cased, upper := 0, 0
+
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r), unicode.IsTitle(r):
+			upper++
+		case unicode.IsLower(r):
+		default:
+			continue
+		}
+
+		cased++
+		if first == casingNone {
+			first = casingLower
+			if upper > 0 {
+				first = casingUpper
+			}
+		}
+	}
+
+	if cased >= 2 && upper == cased {
+		return casingNone
+	}
+
+	return first
+}
+
+type casingHit struct {
+	rowNum int
+	column string
+	term   string
+	value  string
+}
+
+func findCasingMismatches(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	cols casingColumns,
+	cfg casingConfig,
+) (checks.Capped[casingHit], error) {
+	hits := checks.Capped[casingHit]{Limit: cfg.maxFindings, StopAfter: cfg.stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return checks.Capped[casingHit]{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return checks.Capped[casingHit]{}, ctxErr
+			}
+
+			return checks.Capped[casingHit]{}, err
+		}
+
+		rowNum++
+
+		if sensitive, _ := flags.Parse(recordValue(rec, cols.casesensitive)); !sensitive {
+			continue
+		}
+
+		term := recordValue(rec, cols.term)
+		termCasing := casingOf(term)
+		if termCasing == casingNone {
+			continue
+		}
+
+		for _, col := range cols.locales {
+			value := recordValue(rec, col.Pos)
+			if c := casingOf(value); c == casingNone || c == termCasing {
+				continue
+			}
+
+			hits.Add(casingHit{rowNum: rowNum, column: col.Label, term: term, value: value})
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+func recordValue(rec []string, pos int) string {
+	if pos < 0 || pos >= len(rec) {
+		return ""
+	}
+
+	return strings.TrimSpace(rec[pos])
+}
+
+func describeHit(hit casingHit) string {
+	if casingOf(hit.term) == casingLower {
+		return "starts uppercase, term " + strconv.Quote(hit.term) + " starts lowercase"
+	}
+
+	return "starts lowercase, term " + strconv.Quote(hit.term) + " starts uppercase"
+}
+
+func casingMessage(hits checks.Capped[casingHit]) string {
+	limit := min(len(hits.Items), maxReportedCells)
+
+	var b strings.Builder
+	b.WriteString("translation casing differs from the case-sensitive term: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(") ")
+		b.WriteString(strconv.Quote(hit.value))
+		b.WriteString(" vs ")
+		b.WriteString(strconv.Quote(hit.term))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func casingFindings(hits checks.Capped[casingHit]) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Row:     hit.rowNum,
+			Column:  hit.column,
+			Value:   hit.value,
+			Message: describeHit(hit),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,112 @@
+package translation_casing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestCasingOf(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]casing{
+		"cloud":              casingLower,
+		"Cloud storage":      casingUpper,
+		"iPhone":             casingLower,
+		"API":                casingNone,
+		"A":                  casingUpper,
+		"42 \u00c3pfel":      casingUpper,
+		"\u4e91\u5b58\u50a8": casingNone,
+		"":                   casingNone,
+		"\u01c5emal":         casingUpper,
+	}
+
+	for in, want := range cases {
+		if got := casingOf(in); got != want {
+			t.Fatalf("casingOf(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestValidateWarnTranslationCasing(t *testing.T) {
+	t.Parallel()
+
+	csv := "" +
+		"term;description;casesensitive;en;fr;fr_description;de_AT;ja\n" +
+		"cloud;;yes;cloud;Nuage;Nuage;Wolke;\u30af\u30e9\u30a6\u30c9\n" +
+		"Settings;;yes;Settings;param\u00e8tres;;Einstellungen;\u8a2d\u5b9a\n" +
+		"storage;;no;Storage;Stockage;;Speicher;\n" +
+		"API;;yes;api;Api;;;\n" +
+		"login;;yes;log in;PDF;;;\n"
+
+	res := validateWarnTranslationCasing(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(checks.RunOptions{}))
+	if res.OK {
+		t.Fatalf("expected mismatches, got OK (%q)", res.Msg)
+	}
+	if len(res.Findings) != 2 {
+		t.Fatalf("findings = %+v, want 2", res.Findings)
+	}
+
+	f := res.Findings[0]
+	if f.Row != 2 || f.Column != "fr" || f.Value != "Nuage" || !strings.Contains(f.Message, "starts uppercase") {
+		t.Fatalf("unexpected first finding: %+v", f)
+	}
+	f = res.Findings[1]
+	if f.Row != 3 || f.Column != "fr" || f.Value != "param\u00e8tres" || !strings.Contains(f.Message, "starts lowercase") {
+		t.Fatalf("unexpected second finding: %+v", f)
+	}
+	if !strings.Contains(res.Msg, `fr (row 2) "Nuage" vs "cloud"`) || !strings.Contains(res.Msg, "(total 2 cells)") {
+		t.Fatalf("unexpected message: %q", res.Msg)
+	}
+}
+
+func TestValidateWarnTranslationCasing_SkipLocalesSetting(t *testing.T) {
+	t.Parallel()
+
+	csv := "term;casesensitive;de;fr\ncloud;yes;Wolke;Nuage\n"
+
+	opts := checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		checkName: {settingSkipLocales: "FR"},
+	}}
+	res := validateWarnTranslationCasing(context.Background(), checks.Artifact{Data: []byte(csv)}, configFrom(opts))
+	if res.OK || len(res.Findings) != 1 || res.Findings[0].Column != "de" {
+		t.Fatalf("expected only the de cell, got %+v", res)
+	}
+}
+
+func TestValidateWarnTranslationCasing_NoColumns(t *testing.T) {
+	t.Parallel()
+
+	res := validateWarnTranslationCasing(context.Background(), checks.Artifact{Data: []byte("term;fr\ncloud;Nuage\n")}, configFrom(checks.RunOptions{}))
+	if !res.OK || !strings.Contains(res.Msg, "casesensitive column not found") {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+}
+
+func TestRunWarnTranslationCasing_OptIn(t *testing.T) {
+	unit, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check %q is not registered", checkName)
+	}
+	if got, want := unit.Priority(), checks.PrioContent+70; got != want {
+		t.Fatalf("Priority() = %d, want %d", got, want)
+	}
+
+	a := checks.Artifact{Data: []byte("term;casesensitive;fr\ncloud;yes;Nuage\n"), Path: "g.csv"}
+
+	out := unit.Run(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Skipped {
+		t.Fatalf("expected disabled opt-in SKIPPED, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+
+	out = unit.Run(context.Background(), a, checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {checks.SettingEnabled: "yes"},
+		},
+	})
+	if out.Result.Status != checks.Warn || out.Final.DidChange {
+		t.Fatalf("expected WARN without changes, got %s (%s)", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,128 @@
+package final_newline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-final-newline"
+
+// settingPolicy selects what the check enforces (default policyKeep).
+const settingPolicy = "policy"
+
+const (
+	policyRequire = "require" // the file must end with a line break
+	policyForbid  = "forbid"  // the file must not end with a line break
+	policyKeep    = "keep"    // either is fine; nothing is changed
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnFinalNewline,
+			// Last in the structural band, after the line-level fixers settled the file end.
+			checks.WithPriority(checks.PrioStructural+85),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
+}
+
+// runWarnFinalNewline — entry point for the check.
+// Other fixers keep the file end as they found it; this check standardizes it.
+// With policy "require" a missing final line break is reported and added (CRLF when
+// the file already uses it), with "forbid" trailing line breaks are reported and removed.
+// The default "keep" accepts both.
+func runWarnFinalNewline(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	policy, ok := opts.Setting(checkName, settingPolicy)
+	if !ok {
+		policy = policyKeep
+	}
+	policy = strings.ToLower(policy)
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateFinalNewline(ctx, a, policy)
+		},
+		Fix: func(ctx context.Context, a checks.Artifact) (checks.FixResult, error) {
+			return fixFinalNewline(ctx, a, policy)
+		},
+		PassMsg:          "file end matches the final newline policy",
+		FixedMsg:         "file end adjusted to the final newline policy",
+		AppliedMsg:       "auto-fix applied: file end adjusted to the final newline policy",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "file end still does not match the final newline policy after fix",
+	})
+}
+
+func validateFinalNewline(ctx context.Context, a checks.Artifact, policy string) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return checks.ValidationResult{OK: false, Msg: "validation cancelled", Err: err}
+	}
+
+	switch policy {
+	case policyRequire, policyForbid:
+	case policyKeep:
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "final newline is kept as it is (policy " + policyKeep + ")",
+		}
+	default:
+		return checks.ValidationResult{
+			OK: false,
+			Msg: "invalid " + settingPolicy + " " + strconv.Quote(policy) +
+				" (expected " + policyRequire + ", " + policyForbid + " or " + policyKeep + ")",
+			Err: errors.New("invalid final newline policy"),
+		}
+	}
+
+	body, _ := checks.SplitUTF8BOM(a.Data)
+	if len(body) == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "file is empty (nothing to validate for the final newline)",
+		}
+	}
+
+	has := endsWithLineBreak(body)
+
+	switch {
+	case policy == policyRequire && !has:
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "file does not end with a line break (policy " + policyRequire + ")",
+		}
+	case policy == policyForbid && has:
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "file ends with a line break (policy " + policyForbid + ")",
+		}
+	default:
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "file end matches the final newline policy (" + policy + ")",
+		}
+	}
+}
+
+func endsWithLineBreak(b []byte) bool {
+	return len(b) > 0 && (b[len(b)-1] == '\n' || b[len(b)-1] == '\r')
+}
+
+// lineBreak returns the line break the file already uses: CRLF when its first one is, LF otherwise.
+func lineBreak(b []byte) string {
+	i := bytes.IndexByte(b, '\n')
+	if i > 0 && b[i-1] == '\r' {
+		return "\r\n"
+	}
+
+	return "\n"
+}
@@ -0,0 +1,85 @@
+package final_newline
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFinalNewline_Metadata(t *testing.T) {
+	c, ok := checks.Lookup(checkName)
+	if !ok {
+		t.Fatalf("check %q not registered", checkName)
+	}
+	if c.FailFast() {
+		t.Fatalf("FailFast() = true, want false")
+	}
+	if got, want := c.Priority(), checks.PrioStructural+85; got != want {
+		t.Fatalf("Priority() = %d, want %d", got, want)
+	}
+}
+
+func TestValidateFinalNewline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		in     string
+		policy string
+		ok     bool
+		msg    string
+	}{
+		{"require present", "term\nx\n", policyRequire, true, "matches"},
+		{"require missing", "term\nx", policyRequire, false, "does not end with a line break"},
+		{"require crlf present", "term\r\nx\r\n", policyRequire, true, "matches"},
+		{"forbid present", "term\nx\n", policyForbid, false, "ends with a line break"},
+		{"forbid missing", "term\nx", policyForbid, true, "matches"},
+		{"keep missing", "term\nx", policyKeep, true, "kept"},
+		{"empty", "", policyRequire, true, "file is empty"},
+		{"bom only", "\ufeff", policyRequire, true, "file is empty"},
+		{"invalid policy", "term\n", "always", false, `invalid policy "always"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := validateFinalNewline(context.Background(), checks.Artifact{Data: []byte(tc.in)}, tc.policy)
+			if res.OK != tc.ok {
+				t.Fatalf("OK = %v, want %v (%q)", res.OK, tc.ok, res.Msg)
+			}
+			if !strings.Contains(res.Msg, tc.msg) {
+				t.Fatalf("Msg = %q, want it to contain %q", res.Msg, tc.msg)
+			}
+		})
+	}
+}
+
+func TestRunFinalNewline_DefaultKeepsFileEnd(t *testing.T) {
+	t.Parallel()
+
+	out := runWarnFinalNewline(context.Background(), checks.Artifact{Data: []byte("term\nx")}, checks.RunOptions{FixMode: checks.FixIfFailed})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("status = %s, want PASS", out.Result.Status)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("default policy must not change the data")
+	}
+}
+
+func TestRunFinalNewline_WarnsWithoutFix(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingPolicy: "Require"},
+		},
+	}
+
+	out := runWarnFinalNewline(context.Background(), checks.Artifact{Data: []byte("term\nx")}, opts)
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN", out.Result.Status)
+	}
+}
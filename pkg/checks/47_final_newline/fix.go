@@ -0,0 +1,38 @@
+package final_newline
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// fixFinalNewline adds the missing final line break (policy "require") or removes
+// every trailing one (policy "forbid"). The rest of the file is kept byte for byte.
+func fixFinalNewline(ctx context.Context, a checks.Artifact, policy string) (checks.FixResult, error) {
+	if err := ctx.Err(); err != nil {
+		return checks.FixResult{}, err
+	}
+
+	body, _ := checks.SplitUTF8BOM(a.Data)
+	if len(body) == 0 {
+		return checks.FixResult{Data: a.Data, DidChange: false, Note: "file is empty"}, nil
+	}
+
+	switch {
+	case policy == policyRequire && !endsWithLineBreak(body):
+		out := make([]byte, 0, len(a.Data)+2)
+		out = append(out, a.Data...)
+		out = append(out, lineBreak(body)...)
+
+		return checks.FixResult{Data: out, DidChange: true, Note: "added the final line break"}, nil
+
+	case policy == policyForbid && endsWithLineBreak(body):
+		out := bytes.TrimRight(a.Data, "\r\n")
+
+		return checks.FixResult{Data: bytes.Clone(out), DidChange: true, Note: "removed the final line break"}, nil
+
+	default:
+		return checks.FixResult{Data: a.Data, DidChange: false, Note: "file end already matches the policy"}, nil
+	}
+}
@@ -0,0 +1,66 @@
+package final_newline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixFinalNewline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		policy  string
+		want    string
+		changed bool
+	}{
+		{"require adds lf", "term\nx", policyRequire, "term\nx\n", true},
+		{"require adds crlf", "term\r\nx", policyRequire, "term\r\nx\r\n", true},
+		{"require single line", "term", policyRequire, "term\n", true},
+		{"require keeps bom", "\ufeffterm\nx", policyRequire, "\ufeffterm\nx\n", true},
+		{"require present", "term\nx\n", policyRequire, "term\nx\n", false},
+		{"forbid strips lf", "term\nx\n", policyForbid, "term\nx", true},
+		{"forbid strips every crlf", "term\r\nx\r\n\r\n", policyForbid, "term\r\nx", true},
+		{"forbid missing", "term\nx", policyForbid, "term\nx", false},
+		{"empty", "", policyRequire, "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := fixFinalNewline(context.Background(), checks.Artifact{Data: []byte(tc.in)}, tc.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.DidChange != tc.changed {
+				t.Fatalf("DidChange = %v, want %v", res.DidChange, tc.changed)
+			}
+			if string(res.Data) != tc.want {
+				t.Fatalf("data = %q, want %q", res.Data, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunFinalNewline_FixForbid(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		FixMode: checks.FixIfFailed,
+		Settings: map[string]checks.CheckSettings{
+			checkName: {settingPolicy: policyForbid},
+		},
+	}
+
+	out := runWarnFinalNewline(context.Background(), checks.Artifact{Data: []byte("term\nx\n"), Path: "file.csv"}, opts)
+	if !out.Final.DidChange {
+		t.Fatalf("expected the fix to change the data (%s)", out.Result.Message)
+	}
+	if got, want := string(out.Final.Data), "term\nx"; got != want {
+		t.Fatalf("data = %q, want %q", got, want)
+	}
+}
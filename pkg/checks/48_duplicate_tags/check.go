@@ -0,0 +1,341 @@
+package duplicate_tags
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "warn-duplicate-tags"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedRows   = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runWarnDuplicateTags,
+			checks.WithPriority(checks.PrioContent+75),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+func runWarnDuplicateTags(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateWarnDuplicateTags(ctx, a, limit, stopAfter)
+		},
+		Fix:              fixDuplicateTags,
+		PassMsg:          "no repeated tags within a row",
+		FixedMsg:         "removed repeated tags",
+		AppliedMsg:       "auto-fix applied: removed repeated tags",
+		StatusAfterFixed: checks.Pass,
+		FailAs:           checks.Warn,
+		StillBadMsg:      "repeated tags are still present after fix",
+	})
+}
+
+// validateWarnDuplicateTags reports rows whose tags cell lists the same tag more than once,
+// e.g. "ui,ui,legal". Tags are compared after trimming surrounding whitespace, case-sensitively,
+// the way Lokalise stores them.
+func validateWarnDuplicateTags(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for duplicate tags",
+		}
+	}
+
+	r := checks.TableReaderOf(ctx, a)
+
+	header, rowNum, res, ok := readTagsHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	tagsCol := findTagsColumn(header)
+	if tagsCol < 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no 'tags' column found (skipping duplicate tags check)",
+		}
+	}
+
+	hits, err := findDuplicateTags(ctx, r, rowNum, tagsCol, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating duplicate tags",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no repeated tags within a row",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       duplicateTagsMessage(hits),
+		Findings:  duplicateTagFindings(hits, tagsCol),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+type csvReader interface {
+	Read() ([]string, error)
+}
+
+func readTagsHeader(
+	ctx context.Context,
+	r csvReader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for duplicate tags)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findTagsColumn(header []string) int {
+	for i, h := range header {
+		if normalizeHeaderCell(h) == "tags" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func normalizeHeaderCell(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// repeatedTags returns the tags of a comma-separated cell that occur more than once,
+// each listed once in order of first repetition. Blank segments are ignored.
+func repeatedTags(cell string) []string {
+	if !strings.Contains(cell, ",") {
+		return nil
+	}
+
+	var out []string
+	seen := make(map[string]int)
+	for t := range strings.SplitSeq(cell, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+
+		seen[t]++
+		if seen[t] == 2 {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+// dedupeTags drops repeated tags from a comma-separated cell, keeping the first occurrence
+// of each tag (with its original spacing) and every other segment in place.
+// It returns the new cell and the number of removed tags.
+func dedupeTags(cell string) (string, int) {
+	if !strings.Contains(cell, ",") {
+		return cell, 0
+	}
+
+	segments := strings.Split(cell, ",")
+	kept := segments[:0]
+	seen := make(map[string]struct{}, len(segments))
+	removed := 0
+
+	for _, seg := range segments {
+		t := strings.TrimSpace(seg)
+		if t != "" {
+			if _, dup := seen[t]; dup {
+				removed++
+				continue
+			}
+			seen[t] = struct{}{}
+		}
+		kept = append(kept, seg)
+	}
+
+	if removed == 0 {
+		return cell, 0
+	}
+
+	return strings.Join(kept, ","), removed
+}
+
+type duplicateTagsRow struct {
+	rowNum   int
+	cell     string
+	repeated []string
+}
+
+func findDuplicateTags(
+	ctx context.Context,
+	r csvReader,
+	rowNum int,
+	tagsCol int,
+	limit int,
+	stopAfter int,
+) (checks.Capped[duplicateTagsRow], error) {
+	hits := checks.Capped[duplicateTagsRow]{Limit: limit, StopAfter: stopAfter}
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return hits, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return hits, ctxErr
+			}
+
+			return hits, err
+		}
+
+		rowNum++
+
+		if tagsCol >= len(rec) {
+			continue
+		}
+
+		repeated := repeatedTags(rec[tagsCol])
+		if len(repeated) == 0 {
+			continue
+		}
+
+		hits.Add(duplicateTagsRow{
+			rowNum:   rowNum,
+			cell:     rec[tagsCol],
+			repeated: repeated,
+		})
+		if hits.Exhausted() {
+			return hits, nil
+		}
+	}
+}
+
+func duplicateTagsMessage(hits checks.Capped[duplicateTagsRow]) string {
+	limit := min(len(hits.Items), maxReportedRows)
+
+	var b strings.Builder
+	b.WriteString("repeated tags found: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString("tags (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(") ")
+		b.WriteString(strings.Join(hit.repeated, ", "))
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" rows)")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func duplicateTagFindings(hits checks.Capped[duplicateTagsRow], tagsCol int) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		out = append(out, checks.Finding{
+			Code:    "duplicate-tag",
+			Row:     hit.rowNum,
+			Field:   tagsCol + 1,
+			Column:  "tags",
+			Value:   hit.cell,
+			Message: "repeated tags: " + strings.Join(hit.repeated, ", "),
+		})
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
@@ -0,0 +1,107 @@
+package duplicate_tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestDedupeTags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    string
+		removed int
+	}{
+		{"ui", "ui", 0},
+		{"ui,legal", "ui,legal", 0},
+		{"ui,ui,legal", "ui,legal", 1},
+		{"legal, ui ,ui,legal", "legal, ui ", 2},
+		{"ui,,ui", "ui,", 1},
+		{"UI,ui", "UI,ui", 0},
+	}
+
+	for _, tc := range tests {
+		got, removed := dedupeTags(tc.in)
+		if got != tc.want || removed != tc.removed {
+			t.Fatalf("dedupeTags(%q) = %q, %d; want %q, %d", tc.in, got, removed, tc.want, tc.removed)
+		}
+	}
+}
+
+func TestValidateWarnDuplicateTags_Clean_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;tags\napple;ui,legal\npear;\n")}
+
+	res := validateWarnDuplicateTags(context.Background(), a, 0, 0)
+	if !res.OK {
+		t.Fatalf("expected OK, got %q", res.Msg)
+	}
+}
+
+func TestValidateWarnDuplicateTags_NoTagsColumn_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;en\napple;ui,ui\n")}
+
+	res := validateWarnDuplicateTags(context.Background(), a, 0, 0)
+	if !res.OK || !strings.Contains(res.Msg, "no 'tags' column") {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+}
+
+func TestValidateWarnDuplicateTags_Repeated_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;tags\napple;ui,ui,legal\npear;legal\nplum;a, b ,a,b\n")}
+
+	res := validateWarnDuplicateTags(context.Background(), a, 0, 0)
+	if res.OK {
+		t.Fatalf("expected failure")
+	}
+
+	want := "repeated tags found: tags (row 2) ui; tags (row 4) a, b (total 2 rows)"
+	if res.Msg != want {
+		t.Fatalf("Msg = %q, want %q", res.Msg, want)
+	}
+
+	if len(res.Findings) != 2 {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+	f := res.Findings[0]
+	if f.Code != "duplicate-tag" || f.Row != 2 || f.Field != 2 || f.Column != "tags" || f.Value != "ui,ui,legal" {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+}
+
+func TestRunWarnDuplicateTags_FixAndRerun_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;tags\napple;ui,ui,legal\n")}
+
+	out := runWarnDuplicateTags(context.Background(), a, checks.RunOptions{
+		FixMode:       checks.FixIfFailed,
+		RerunAfterFix: true,
+	})
+	if out.Result.Status != checks.Pass {
+		t.Fatalf("expected PASS after fix, got %s: %s", out.Result.Status, out.Result.Message)
+	}
+	if got := string(out.Final.Data); got != "term;tags\napple;ui,legal\n" {
+		t.Fatalf("unexpected fixed data: %q", got)
+	}
+}
+
+func TestRunWarnDuplicateTags_WithoutFix_Warn(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;tags\napple;ui,ui\n")}
+
+	out := runWarnDuplicateTags(context.Background(), a, checks.RunOptions{})
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("expected WARN, got %s: %s", out.Result.Status, out.Result.Message)
+	}
+}
@@ -0,0 +1,68 @@
+package duplicate_tags
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFixDuplicateTags_NoContent_NoFix(t *testing.T) {
+	t.Parallel()
+
+	fr, err := fixDuplicateTags(context.Background(), checks.Artifact{Data: []byte("  \n")})
+	if !errors.Is(err, checks.ErrNoFix) {
+		t.Fatalf("expected ErrNoFix, got %v", err)
+	}
+	if fr.DidChange {
+		t.Fatalf("DidChange must be false")
+	}
+}
+
+func TestFixDuplicateTags_KeepsOrderAndLayout(t *testing.T) {
+	t.Parallel()
+
+	in := "" +
+		"\xEF\xBB\xBF" +
+		"term;tags;en\r\n" +
+		"apple;legal,ui,legal, ui;apple\r\n" +
+		"pear;ui;pear\r\n" +
+		"plum;x,x,x;plum"
+
+	fr, err := fixDuplicateTags(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected DidChange=true")
+	}
+
+	want := "" +
+		"\xEF\xBB\xBF" +
+		"term;tags;en\r\n" +
+		"apple;legal,ui;apple\r\n" +
+		"pear;ui;pear\r\n" +
+		"plum;x;plum"
+
+	if got := string(fr.Data); got != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", got, want)
+	}
+	if fr.Note != "removed 4 duplicate tags in 2 rows" {
+		t.Fatalf("unexpected note: %q", fr.Note)
+	}
+}
+
+func TestFixDuplicateTags_NothingToRemove_NoChange(t *testing.T) {
+	t.Parallel()
+
+	in := "term;tags\napple;ui,legal\n"
+
+	fr, err := fixDuplicateTags(context.Background(), checks.Artifact{Data: []byte(in)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fr.DidChange || string(fr.Data) != in {
+		t.Fatalf("data must be unchanged: %+v", fr)
+	}
+}
@@ -0,0 +1,349 @@
+package semicolon_cells
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+const checkName = "info-semicolon-cells"
+
+const (
+	ctxCheckEveryRows = 1 << 12
+	maxReportedCells  = 10
+)
+
+func init() {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runInfoSemicolonCells,
+			checks.WithPriority(checks.PrioContent+80),
+			checks.WithScope(checks.ScopeRows),
+		)
+	})
+}
+
+// runInfoSemicolonCells — entry point for the check.
+// Informational only (INFO): semicolons inside quoted cells are valid CSV, but they
+// usually come from the source system and split on a naive downstream parse.
+func runInfoSemicolonCells(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+	limit := opts.FindingsLimit()
+	stopAfter := opts.StopAfter()
+
+	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			return validateInfoSemicolonCells(ctx, a, limit, stopAfter)
+		},
+		Fix:     nil,
+		PassMsg: "no cells contain semicolons",
+		FailAs:  checks.Info,
+	})
+}
+
+// validateInfoSemicolonCells counts data cells holding a semicolon and checks that each
+// one is written as a properly quoted field: opening and closing quote around the whole
+// value, inner quotes doubled. A cell that only parses thanks to lenient quote handling
+// is reported as malformed.
+func validateInfoSemicolonCells(ctx context.Context, a checks.Artifact, limit, stopAfter int) checks.ValidationResult {
+	if err := ctx.Err(); err != nil {
+		return cancelledValidation(err)
+	}
+
+	data := checks.StripUTF8BOM(a.Data)
+	if checks.IsBlankUnicode(data) {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no content to validate for semicolon cells",
+		}
+	}
+
+	// Raw field positions are needed, so this check reads the bytes itself
+	// instead of going through the shared table.
+	r := checks.NewSemicolonCSVReader(data)
+
+	header, rowNum, res, ok := readSemicolonHeader(ctx, r)
+	if !ok {
+		return res
+	}
+
+	hits, err := findSemicolonCells(ctx, r, data, header, rowNum, limit, stopAfter)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return cancelledValidation(ctxErr)
+		}
+
+		return checks.ValidationResult{
+			OK:  false,
+			Msg: "cannot parse CSV while validating semicolon cells",
+			Err: err,
+		}
+	}
+
+	if hits.Total() == 0 {
+		return checks.ValidationResult{
+			OK:  true,
+			Msg: "no cells contain semicolons",
+		}
+	}
+
+	return checks.ValidationResult{
+		OK:        false,
+		Msg:       semicolonCellsMessage(hits),
+		Findings:  semicolonCellFindings(hits),
+		Truncated: hits.Exhausted(),
+	}
+}
+
+func readSemicolonHeader(
+	ctx context.Context,
+	r *csv.Reader,
+) ([]string, int, checks.ValidationResult, bool) {
+	rowNum := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, rowNum, cancelledValidation(err), false
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, rowNum, checks.ValidationResult{
+					OK:  true,
+					Msg: "no header line found (nothing to validate for semicolon cells)",
+				}, false
+			}
+
+			return nil, rowNum, checks.ValidationResult{
+				OK:  false,
+				Msg: "cannot parse header with semicolon delimiter",
+				Err: err,
+			}, false
+		}
+
+		rowNum++
+
+		if !isBlankCSVRecord(rec) {
+			return rec, rowNum, checks.ValidationResult{}, true
+		}
+	}
+}
+
+func isBlankCSVRecord(record []string) bool {
+	for _, field := range record {
+		if !checks.IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type semicolonCell struct {
+	rowNum    int
+	field     int
+	column    string
+	value     string
+	malformed bool
+}
+
+// semicolonHits keeps up to the findings limit of cells but counts every malformed one.
+type semicolonHits struct {
+	checks.Capped[semicolonCell]
+	malformed int
+}
+
+func findSemicolonCells(
+	ctx context.Context,
+	r *csv.Reader,
+	data []byte,
+	header []string,
+	rowNum int,
+	limit int,
+	stopAfter int,
+) (semicolonHits, error) {
+	hits := semicolonHits{Capped: checks.Capped[semicolonCell]{Limit: limit, StopAfter: stopAfter}}
+	lines := lineStarts(data)
+
+	for {
+		if rowNum%ctxCheckEveryRows == 0 {
+			if err := ctx.Err(); err != nil {
+				return semicolonHits{}, err
+			}
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return hits, nil
+			}
+
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return semicolonHits{}, ctxErr
+			}
+
+			return semicolonHits{}, err
+		}
+
+		rowNum++
+
+		for i, cell := range rec {
+			if !strings.Contains(cell, ";") {
+				continue
+			}
+
+			raw := rawField(r, data, lines, len(rec), i)
+			hit := semicolonCell{
+				rowNum:    rowNum,
+				field:     i + 1,
+				column:    columnName(header, i),
+				value:     cell,
+				malformed: !isQuotedField(raw, cell),
+			}
+			if hit.malformed {
+				hits.malformed++
+			}
+
+			hits.Add(hit)
+			if hits.Exhausted() {
+				return hits, nil
+			}
+		}
+	}
+}
+
+// lineStarts returns the byte offset of every line in data, matching the 1-based
+// line numbers of csv.Reader.FieldPos.
+func lineStarts(data []byte) []int {
+	starts := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
+
+// rawField returns the bytes of field i of the record r has just read, delimiters and
+// the record's line break excluded.
+func rawField(r *csv.Reader, data []byte, lines []int, fields, i int) []byte {
+	line, col := r.FieldPos(i)
+	start := lines[line-1] + col - 1
+
+	var end int
+	if i+1 < fields {
+		nextLine, nextCol := r.FieldPos(i + 1)
+		end = lines[nextLine-1] + nextCol - 2
+	} else {
+		end = int(r.InputOffset())
+		if end > 0 && data[end-1] == '\n' {
+			end--
+			if end > 0 && data[end-1] == '\r' {
+				end--
+			}
+		}
+	}
+
+	if start > end || end > len(data) {
+		return nil
+	}
+
+	return data[start:end]
+}
+
+// isQuotedField reports whether raw is cell written as a strictly quoted CSV field.
+// csv.Reader turns CRLF inside quotes into LF, so raw is compared the same way.
+func isQuotedField(raw []byte, cell string) bool {
+	raw = bytes.ReplaceAll(raw, []byte("\r\n"), []byte("\n"))
+
+	return string(raw) == `"`+strings.ReplaceAll(cell, `"`, `""`)+`"`
+}
+
+func columnName(header []string, i int) string {
+	if i < len(header) {
+		if name := strings.TrimSpace(header[i]); name != "" {
+			return name
+		}
+	}
+
+	return "column " + strconv.Itoa(i+1)
+}
+
+func semicolonCellsMessage(hits semicolonHits) string {
+	limit := min(len(hits.Items), maxReportedCells)
+
+	var b strings.Builder
+	b.WriteString("cells containing semicolons: ")
+
+	for i := range limit {
+		hit := hits.Items[i]
+
+		b.WriteString(hit.column)
+		b.WriteString(" (row ")
+		b.WriteString(strconv.Itoa(hit.rowNum))
+		b.WriteString(")")
+		if hit.malformed {
+			b.WriteString(" malformed quoting")
+		}
+
+		if i != limit-1 {
+			b.WriteString("; ")
+		}
+	}
+
+	if hits.Total() > limit {
+		b.WriteString(" ...")
+	}
+
+	b.WriteString(" (total ")
+	b.WriteString(strconv.Itoa(hits.Total()))
+	b.WriteString(" cells")
+	if hits.malformed > 0 {
+		b.WriteString(", ")
+		b.WriteString(strconv.Itoa(hits.malformed))
+		b.WriteString(" with malformed quoting")
+	}
+	b.WriteString(")")
+	b.WriteString(checks.OverflowNote(hits.Overflow))
+	b.WriteString(hits.TruncatedNote())
+
+	return b.String()
+}
+
+func semicolonCellFindings(hits semicolonHits) []checks.Finding {
+	out := make([]checks.Finding, 0, len(hits.Items))
+	for _, hit := range hits.Items {
+		f := checks.Finding{
+			Code:    "semicolon-cell",
+			Row:     hit.rowNum,
+			Field:   hit.field,
+			Column:  hit.column,
+			Value:   hit.value,
+			Message: "contains a semicolon (quoted)",
+		}
+		if hit.malformed {
+			f.Code = "malformed-semicolon-cell"
+			f.Message = "contains a semicolon but is not a properly quoted field"
+		}
+		out = append(out, f)
+	}
+
+	return out
+}
+
+func cancelledValidation(err error) checks.ValidationResult {
+	return checks.ValidationResult{
+		OK:  false,
+		Msg: "validation cancelled",
+		Err: err,
+	}
+}
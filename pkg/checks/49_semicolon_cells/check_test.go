@@ -0,0 +1,79 @@
+package semicolon_cells
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestValidateInfoSemicolonCells_None_Pass(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;en\napple;\"apple, red\"\n")}
+
+	res := validateInfoSemicolonCells(context.Background(), a, 0, 0)
+	if !res.OK {
+		t.Fatalf("expected OK, got %q", res.Msg)
+	}
+}
+
+func TestValidateInfoSemicolonCells_Quoted_Counted(t *testing.T) {
+	t.Parallel()
+
+	in := "\ufeffterm;en;description\r\n" +
+		"\"A;B\";ab;\"say \"\"x;y\"\"\"\r\n" +
+		"plain;\"multi\r\nline;cell\"\r\n"
+
+	res := validateInfoSemicolonCells(context.Background(), checks.Artifact{Data: []byte(in)}, 0, 0)
+	if res.OK {
+		t.Fatalf("expected semicolon cells to be reported")
+	}
+
+	want := "cells containing semicolons: term (row 2); description (row 2); en (row 3) (total 3 cells)"
+	if res.Msg != want {
+		t.Fatalf("Msg = %q, want %q", res.Msg, want)
+	}
+
+	for _, f := range res.Findings {
+		if f.Code != "semicolon-cell" {
+			t.Fatalf("unexpected finding: %+v", f)
+		}
+	}
+	if f := res.Findings[1]; f.Field != 3 || f.Value != `say "x;y"` {
+		t.Fatalf("unexpected finding: %+v", f)
+	}
+}
+
+func TestValidateInfoSemicolonCells_LenientQuotes_Malformed(t *testing.T) {
+	t.Parallel()
+
+	// The stray quote after "A;B" makes the lenient parser swallow the next delimiter.
+	in := "term;en\n\"A;B\" x;y\nok;fine\n"
+
+	res := validateInfoSemicolonCells(context.Background(), checks.Artifact{Data: []byte(in)}, 0, 0)
+	if res.OK {
+		t.Fatalf("expected failure")
+	}
+	if !strings.Contains(res.Msg, "term (row 2) malformed quoting") || !strings.Contains(res.Msg, "1 with malformed quoting") {
+		t.Fatalf("unexpected Msg: %q", res.Msg)
+	}
+	if len(res.Findings) != 1 || res.Findings[0].Code != "malformed-semicolon-cell" {
+		t.Fatalf("unexpected findings: %+v", res.Findings)
+	}
+}
+
+func TestRunInfoSemicolonCells_Info(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;en\n\"A;B\";ab\n")}
+
+	out := runInfoSemicolonCells(context.Background(), a, checks.RunOptions{FixMode: checks.FixIfFailed})
+	if out.Result.Status != checks.Info {
+		t.Fatalf("expected INFO, got %s: %s", out.Result.Status, out.Result.Message)
+	}
+	if out.Final.DidChange {
+		t.Fatalf("informational check must not change the data")
+	}
+}
@@ -10,18 +10,16 @@ import (
 const checkName = "ensure-not-empty"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureNotEmpty,
-		checks.WithFailFast(),
-		checks.WithPriority(4),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureNotEmpty,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+25),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
 }
 
 func runEnsureNotEmpty(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
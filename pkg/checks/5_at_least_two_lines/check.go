@@ -1,8 +1,6 @@
 package at_least_two_lines
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
@@ -10,24 +8,18 @@ import (
 
 const checkName = "ensure-at-least-two-lines"
 
-const (
-	requiredNonEmptyLines = 2
-	maxScannedLineSize    = 16 << 20
-)
+const requiredNonEmptyLines = 2
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureAtLeastTwoLines,
-		checks.WithFailFast(),
-		checks.WithPriority(5),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureAtLeastTwoLines,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+35),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
 }
 
 // runEnsureAtLeastTwoLines — entry point for the check.
@@ -78,15 +70,13 @@ func validateAtLeastTwoLines(ctx context.Context, a checks.Artifact) checks.Vali
 }
 
 func hasAtLeastNonEmptyLines(ctx context.Context, data []byte, want int) (bool, error) {
-	scanner := newLineScanner(data)
-
 	nonEmpty := 0
-	for scanner.Scan() {
+	for _, line := range checks.Lines(data) {
 		if err := ctx.Err(); err != nil {
 			return false, err
 		}
 
-		if isNonEmptyCSVLine(scanner.Bytes()) {
+		if !checks.IsBlankUnicode(line) {
 			nonEmpty++
 		}
 
@@ -95,32 +85,9 @@ func hasAtLeastNonEmptyLines(ctx context.Context, data []byte, want int) (bool,
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return false, err
-	}
-
 	return false, nil
 }
 
-func newLineScanner(data []byte) *bufio.Scanner {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Buffer(make([]byte, 0, 64<<10), maxScannedLineSize)
-
-	return scanner
-}
-
-func isNonEmptyCSVLine(line []byte) bool {
-	return !checks.IsBlankUnicode(normalizeScannedLine(line))
-}
-
-func normalizeScannedLine(line []byte) []byte {
-	if n := len(line); n > 0 && line[n-1] == '\r' {
-		return line[:n-1]
-	}
-
-	return line
-}
-
 func cancelledValidation(err error) checks.ValidationResult {
 	return checks.ValidationResult{
 		OK:  false,
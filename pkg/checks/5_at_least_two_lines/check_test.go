@@ -147,3 +147,17 @@ func TestRunEnsureAtLeastTwoLines_ContextCancelled_Error(t *testing.T) {
 		t.Fatalf("no changes expected on cancellation")
 	}
 }
+
+func TestValidateAtLeastTwoLines_HugeLinesBeyondScannerLimits(t *testing.T) {
+	huge := strings.Repeat("x;", 12<<20) // 24 MB per line
+
+	res := validateAtLeastTwoLines(context.Background(), checks.Artifact{Data: []byte(huge)})
+	if res.OK || res.Err != nil {
+		t.Fatalf("expected a clean failure for a single huge line, got %+v", res)
+	}
+
+	res = validateAtLeastTwoLines(context.Background(), checks.Artifact{Data: []byte(huge + "\n" + huge)})
+	if !res.OK {
+		t.Fatalf("expected OK=true for two huge lines, got %+v", res)
+	}
+}
@@ -9,24 +9,31 @@ import (
 const checkName = "ensure-semicolon-separators"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureSemicolonSeparators,
-		checks.WithFailFast(),
-		checks.WithPriority(6),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureSemicolonSeparators,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+45),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeFile),
+		)
+	})
 }
 
 func runEnsureSemicolonSeparators(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
-	return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
-		Name:             checkName,
-		Validate:         validateSemicolonSeparated,
+	// The detection describes the input as received, so only the first validation records it.
+	var detection *checks.Detection
+
+	out := checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+		Name: checkName,
+		Validate: func(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+			res, det := validateAndDetectSeparators(ctx, a)
+			if detection == nil {
+				detection = det
+			}
+			return res
+		},
 		Fix:              fixToSemicolonsIfConsistent,
 		PassMsg:          "file uses semicolons as separators",
 		FixedMsg:         "converted separators to semicolons",
@@ -34,11 +41,21 @@ func runEnsureSemicolonSeparators(ctx context.Context, a checks.Artifact, opts c
 		StillBadMsg:      "auto-fix attempted but file is still not cleanly semicolon-separated",
 		StatusAfterFixed: checks.Pass,
 	})
+
+	out.Detection = detection
+	return out
 }
 
 func validateSemicolonSeparated(ctx context.Context, a checks.Artifact) checks.ValidationResult {
+	res, _ := validateAndDetectSeparators(ctx, a)
+	return res
+}
+
+// validateAndDetectSeparators validates like validateSemicolonSeparated and also
+// reports what the sniffer concluded. The detection is nil when nothing was examined.
+func validateAndDetectSeparators(ctx context.Context, a checks.Artifact) (checks.ValidationResult, *checks.Detection) {
 	if err := ctx.Err(); err != nil {
-		return cancelledValidation(err)
+		return cancelledValidation(err), nil
 	}
 
 	dataBytes := checks.StripUTF8BOM(a.Data)
@@ -46,20 +63,22 @@ func validateSemicolonSeparated(ctx context.Context, a checks.Artifact) checks.V
 		return checks.ValidationResult{
 			OK:  false,
 			Msg: "cannot detect separators: no usable content",
-		}
+		}, nil
 	}
 
 	report, err := detectSeparators(ctx, dataBytes)
 	if err != nil {
-		return cancelledValidation(err)
+		return cancelledValidation(err), nil
 	}
 
+	detection := separatorDetection(dataBytes, report)
+
 	if report.semicolonOK {
-		return checks.ValidationResult{OK: true}
+		return checks.ValidationResult{OK: true}, detection
 	}
 
 	return checks.ValidationResult{
 		OK:  false,
 		Msg: separatorFailureMessage(report),
-	}
+	}, detection
 }
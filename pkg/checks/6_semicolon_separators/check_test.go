@@ -272,3 +272,36 @@ func TestRunEnsureSemicolonSeparators_EndToEnd_WithAutoFix(t *testing.T) {
 		t.Fatalf("expected final status PASS after autofix, got %s (%s)", out.Result.Status, out.Result.Message)
 	}
 }
+
+func TestRunEnsureSemicolonSeparators_Detection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		data       string
+		delimiter  string
+		confidence checks.Confidence
+		endings    string
+	}{
+		{"semicolons", "term;description\r\nhello;world\r\n", ";", checks.ConfidenceHigh, "CRLF"},
+		{"commas", "term,description\nhello,world\n", ",", checks.ConfidenceMedium, "LF"},
+		{"undecided", "term\nhello\n", "", checks.ConfidenceLow, "LF"},
+	}
+
+	for _, tt := range tests {
+		out := runEnsureSemicolonSeparators(context.Background(), checks.Artifact{
+			Data: []byte(tt.data),
+		}, checks.RunOptions{FixMode: checks.FixAlways, RerunAfterFix: true})
+
+		d := out.Detection
+		if d == nil {
+			t.Fatalf("%s: expected detection", tt.name)
+		}
+		if d.Delimiter != tt.delimiter || d.Confidence != tt.confidence || d.LineEndings != tt.endings {
+			t.Fatalf("%s: detection = %+v", tt.name, *d)
+		}
+		if d.DelimiterReason == "" {
+			t.Fatalf("%s: expected a reason", tt.name)
+		}
+	}
+}
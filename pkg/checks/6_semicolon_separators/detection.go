@@ -2,6 +2,8 @@ package semicolon_separator
 
 import (
 	"context"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
 )
 
 type separatorReport struct {
@@ -45,3 +47,33 @@ func separatorFailureMessage(report separatorReport) string {
 		return "could not confirm consistent semicolon-separated format; cannot confidently detect an alternative delimiter"
 	}
 }
+
+// separatorDetection explains the delimiter verdict for the Summary.
+// Semicolons parsing cleanly is a sure thing; a single fitting alternative is a good guess;
+// anything else is left undecided.
+func separatorDetection(data []byte, report separatorReport) *checks.Detection {
+	d := &checks.Detection{LineEndings: checks.DescribeLineEndings(data)}
+
+	switch {
+	case report.semicolonOK:
+		d.Delimiter = ";"
+		d.Confidence = checks.ConfidenceHigh
+		d.DelimiterReason = "semicolons parse into a consistent table"
+	case report.commaOK && !report.tabOK:
+		d.Delimiter = ","
+		d.Confidence = checks.ConfidenceMedium
+		d.DelimiterReason = "semicolons do not fit; only commas parse into a consistent table"
+	case report.tabOK && !report.commaOK:
+		d.Delimiter = "\t"
+		d.Confidence = checks.ConfidenceMedium
+		d.DelimiterReason = "semicolons do not fit; only tabs parse into a consistent table"
+	case report.commaOK && report.tabOK:
+		d.Confidence = checks.ConfidenceLow
+		d.DelimiterReason = "semicolons do not fit; both commas and tabs parse into a consistent table"
+	default:
+		d.Confidence = checks.ConfidenceLow
+		d.DelimiterReason = "no candidate delimiter (semicolon, comma, tab) parses into a consistent table"
+	}
+
+	return d
+}
@@ -262,3 +262,26 @@ func firstLine(s string) string {
 	line, _, _ := strings.Cut(s, "\n")
 	return line
 }
+
+func TestFixToSemicolonsIfConsistent_SemicolonCellsKeepCellCount(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term,description\n\"a;b\",x\nc,\"d;e;f\"\n")}
+	fr, err := fixToSemicolonsIfConsistent(context.Background(), a)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.DidChange {
+		t.Fatalf("expected conversion, note=%q", fr.Note)
+	}
+
+	recs, err := readCSVRecords(context.Background(), fr.Data, ';')
+	if err != nil || len(recs) != 3 {
+		t.Fatalf("unexpected records %q (err %v)", recs, err)
+	}
+	for i, rec := range recs {
+		if len(rec) != 2 {
+			t.Fatalf("record %d has %d cells: %q", i+1, len(rec), rec)
+		}
+	}
+}
@@ -13,18 +13,16 @@ import (
 const checkName = "no-spaces-in-header"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runNoSpacesInHeader,
-		checks.WithFailFast(),
-		checks.WithPriority(7),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runNoSpacesInHeader,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+50),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
 }
 
 func runNoSpacesInHeader(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
@@ -11,18 +11,16 @@ import (
 const checkName = "ensure-lowercase-header"
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureLowercaseHeader,
-		checks.WithFailFast(),
-		checks.WithPriority(8),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureLowercaseHeader,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+55),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
 }
 
 func runEnsureLowercaseHeader(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
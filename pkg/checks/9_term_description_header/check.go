@@ -15,18 +15,16 @@ type termDescriptionReport struct {
 }
 
 func init() {
-	ch, err := checks.NewCheckAdapter(
-		checkName,
-		runEnsureTermDescriptionHeader,
-		checks.WithFailFast(),
-		checks.WithPriority(9),
-	)
-	if err != nil {
-		panic(checkName + ": " + err.Error())
-	}
-	if _, err := checks.Register(ch); err != nil {
-		panic(checkName + " register: " + err.Error())
-	}
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(
+			checkName,
+			runEnsureTermDescriptionHeader,
+			checks.WithFailFast(),
+			checks.WithPriority(checks.PrioStructural+65),
+			checks.WithFix(),
+			checks.WithScope(checks.ScopeHeader),
+		)
+	})
 }
 
 func runEnsureTermDescriptionHeader(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
@@ -81,6 +79,7 @@ func readFirstNonBlankHeader(
 			Data:  data,
 			Path:  a.Path,
 			Langs: a.Langs,
+			Cache: a.Cache,
 		},
 		"cannot check header: no usable content",
 	)
@@ -28,6 +28,21 @@ func NewCheckAdapter(name string, run CheckFunc, opts ...Option) (*CheckAdapter,
 		if err := ctx.Err(); err != nil {
 			return OutcomeKeep(Error, name, err.Error(), a, "")
 		}
+		// opt-in checks are skipped unless explicitly enabled for this run
+		if ca.optIn && !ro.SettingBool(name, SettingEnabled, false) {
+			return OutcomeKeep(Skipped, name, "opt-in check is disabled (set "+SettingEnabled+"=true to run it)", a, "")
+		}
+		// comment lines are hidden from the check and put back into whatever it returns;
+		// row numbers it reports are translated back to the original file
+		if !ca.comments {
+			if mask := maskComments(a.Data, ro.CommentPrefix); mask != nil {
+				a.Data = mask.masked
+				defer func() {
+					out = mask.translate(out)
+					out.Final.Data = mask.restore(out.Final.Data)
+				}()
+			}
+		}
 		defer func() {
 			if r := recover(); r != nil {
 				out = CheckOutcome{
@@ -72,6 +87,39 @@ func (c *CheckAdapter) Run(ctx context.Context, a Artifact, opts RunOptions) Che
 func (c *CheckAdapter) FailFast() bool { return c.failFast }
 func (c *CheckAdapter) Priority() int  { return c.priority }
 
+// OptIn reports whether the check only runs when its "enabled" setting is true.
+func (c *CheckAdapter) OptIn() bool { return c.optIn }
+
+// Ensure *CheckAdapter implements CapableUnit.
+var _ CapableUnit = (*CheckAdapter)(nil)
+
+func (c *CheckAdapter) SupportsFix() bool { return c.fix }
+func (c *CheckAdapter) Scope() Scope      { return c.scope }
+func (c *CheckAdapter) Destructive() bool { return c.destructive }
+
+// Lossy reports whether the fix removes cell contents by design (see WithLossyFix).
+func (c *CheckAdapter) Lossy() bool { return c.lossy }
+
+// CapabilitiesOf describes u if it implements CapableUnit.
+func CapabilitiesOf(u CheckUnit) Capabilities {
+	cu, ok := u.(CapableUnit)
+	if !ok {
+		return Capabilities{}
+	}
+
+	caps := Capabilities{
+		Declared:    true,
+		SupportsFix: cu.SupportsFix(),
+		Scope:       cu.Scope(),
+		Destructive: cu.Destructive(),
+	}
+	if l, ok := u.(interface{ Lossy() bool }); ok {
+		caps.Lossy = l.Lossy()
+	}
+
+	return caps
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // Adapter options
 // ─────────────────────────────────────────────────────────────────────────────
@@ -81,6 +129,43 @@ func WithFailFast() Option {
 	return func(c *CheckAdapter) { c.failFast = true }
 }
 
+// WithOptIn marks the check as opt-in: it only runs when its "enabled" setting is true.
+func WithOptIn() Option {
+	return func(c *CheckAdapter) { c.optIn = true }
+}
+
+// WithComments lets the check see comment lines (RunOptions.CommentPrefix) instead of masking them.
+func WithComments() Option {
+	return func(c *CheckAdapter) { c.comments = true }
+}
+
+// WithFix declares that the check has an auto-fix. It does not install one: the run func does that.
+func WithFix() Option {
+	return func(c *CheckAdapter) { c.fix = true }
+}
+
+// WithDestructiveFix declares an auto-fix that restructures the file (see RunRecipe.Destructive).
+func WithDestructiveFix() Option {
+	return func(c *CheckAdapter) {
+		c.fix = true
+		c.destructive = true
+	}
+}
+
+// WithLossyFix declares an auto-fix that removes cell contents by design, such as
+// duplicate rows or unknown columns, so RunOptions.SafeFixes lets it through.
+func WithLossyFix() Option {
+	return func(c *CheckAdapter) {
+		c.fix = true
+		c.lossy = true
+	}
+}
+
+// WithScope declares what part of the file the check looks at and rewrites.
+func WithScope(s Scope) Option {
+	return func(c *CheckAdapter) { c.scope = s }
+}
+
 // WithPriority sets execution order (lower values run earlier).
 func WithPriority(p int) Option {
 	return func(c *CheckAdapter) { c.priority = p }
@@ -151,6 +151,50 @@ func TestNewCheckAdapter_ContextCanceledBeforeRun(t *testing.T) {
 	assertFinal(t, out.Final, "payload", "file.csv", false, "")
 }
 
+func TestNewCheckAdapter_OptInDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	unit, err := checks.NewCheckAdapter(
+		"opt-in-check",
+		func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			t.Fatalf("run func should not be called for a disabled opt-in check")
+			return checks.CheckOutcome{}
+		},
+		checks.WithOptIn(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := unit.Run(context.Background(), testAdapterArtifact(), checks.RunOptions{})
+
+	assertCheckOutcome(t, out, checks.Skipped, "opt-in-check", "opt-in check is disabled (set enabled=true to run it)")
+	assertFinal(t, out.Final, "payload", "file.csv", false, "")
+}
+
+func TestNewCheckAdapter_OptInEnabledBySetting(t *testing.T) {
+	t.Parallel()
+
+	unit, err := checks.NewCheckAdapter(
+		"opt-in-check",
+		func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Warn, "opt-in-check", "ran", a, "")
+		},
+		checks.WithOptIn(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := unit.Run(context.Background(), testAdapterArtifact(), checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			"Opt-In-Check": {checks.SettingEnabled: "true"},
+		},
+	})
+
+	assertCheckOutcome(t, out, checks.Warn, "opt-in-check", "ran")
+}
+
 func TestNewCheckAdapter_RunPanicRecovery(t *testing.T) {
 	t.Parallel()
 
@@ -282,3 +326,32 @@ func assertFinal(
 		t.Fatalf("Final.Note = %q, want %q", final.Note, wantNote)
 	}
 }
+
+func TestCapabilitiesOf(t *testing.T) {
+	t.Parallel()
+
+	plain := mkCheckOK(t, "plain")
+	if c := checks.CapabilitiesOf(plain); !c.Declared || c.SupportsFix || c.Destructive || c.Scope != checks.ScopeUnknown {
+		t.Fatalf("unexpected capabilities for a plain adapter: %+v", c)
+	}
+
+	fixer := mkCheckOK(t, "fixer", checks.WithFix(), checks.WithScope(checks.ScopeHeader))
+	if c := checks.CapabilitiesOf(fixer); !c.SupportsFix || c.Destructive || c.Scope != checks.ScopeHeader {
+		t.Fatalf("unexpected capabilities for a fixer: %+v", c)
+	}
+
+	destructive := mkCheckOK(t, "destructive", checks.WithDestructiveFix())
+	if c := checks.CapabilitiesOf(destructive); !c.SupportsFix || !c.Destructive {
+		t.Fatalf("WithDestructiveFix must imply a fix: %+v", c)
+	}
+
+	lossy := mkCheckOK(t, "lossy", checks.WithLossyFix())
+	if c := checks.CapabilitiesOf(lossy); !c.SupportsFix || !c.Lossy || c.Destructive {
+		t.Fatalf("WithLossyFix must imply a non-destructive fix: %+v", c)
+	}
+
+	// custom CheckUnit implementations predate CapableUnit and stay undeclared
+	if c := checks.CapabilitiesOf(fakeRegistryCheck{name: "legacy"}); c != (checks.Capabilities{}) {
+		t.Fatalf("expected zero capabilities for a legacy unit, got %+v", c)
+	}
+}
@@ -1,20 +1,17 @@
+// Package all provides and registers every built-in check. Blank-import it to get
+// the full set; embedders that need fewer checks import pkg/checks/structural,
+// pkg/checks/content or pkg/checks/semantic and call the matching checks.Load function.
 package all
 
 import (
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/10_allowed_columns_header"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/11_no_duplicate_header_cells"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/12_no_empty_term_values"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/13_no_duplicate_term_values"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/14_no_orphan_locale_descriptions"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/15_no_invalid_flags"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/16_no_forbidden_non_translatable_terms"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/1_valid_extension"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/2_valid_encoding"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/3_no_empty_lines"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/4_non_empty_file"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/5_at_least_two_lines"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/6_semicolon_separators"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/7_no_header_spaces"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/8_lowercase_header"
-	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/9_term_description_header"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/content"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/semantic"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/structural"
 )
+
+func init() {
+	if err := checks.LoadAll(); err != nil {
+		panic("checks/all: " + err.Error())
+	}
+}
@@ -0,0 +1,64 @@
+package all_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Built-in checks keep PrioStep apart, each on a slot of its own, so the run order never
+// depends on the tie-break and custom checks always find a free slot between two of them.
+func TestBuiltinPriorities(t *testing.T) {
+	t.Parallel()
+
+	units := checks.List()
+	if len(units) == 0 {
+		t.Fatal("no built-in checks registered")
+	}
+
+	seen := make(map[int]string, len(units))
+	for _, u := range units {
+		p := u.Priority()
+		if p%checks.PrioStep != 0 {
+			t.Errorf("%s: priority %d is not a multiple of PrioStep (%d)", u.Name(), p, checks.PrioStep)
+		}
+		if checks.PriorityBand(p) == "" {
+			t.Errorf("%s: priority %d is outside every band", u.Name(), p)
+		}
+		if other, dup := seen[p]; dup {
+			t.Errorf("%s and %s share priority %d", other, u.Name(), p)
+		}
+		seen[p] = u.Name()
+	}
+}
+
+// The locale columns ensure-allowed-columns-header adds for declared languages are empty
+// by nature; warn-empty-locale-columns must neither report nor drop them again.
+func TestDeclaredLocaleColumnsSurviveEmptyColumnFix(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Only:             []string{"ensure-allowed-columns-header", "warn-empty-locale-columns"},
+		FixMode:          checks.FixIfFailed,
+		AllowDestructive: true,
+	}
+	data := []byte("term;description;en;en_description;de\ncloud;noun;cloud;;\n")
+
+	sum, err := validator.Validate(context.Background(), "gloss.csv", data, []string{"en", "fr"}, opts)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	want := "term;description;en;en_description;fr;fr_description\ncloud;noun;cloud;;;\n"
+	if string(sum.FinalData) != want {
+		t.Fatalf("FinalData = %q, want %q", sum.FinalData, want)
+	}
+	for _, o := range sum.Outcomes {
+		if o.Result.Name == "warn-empty-locale-columns" && o.Result.Status != checks.Pass {
+			t.Errorf("%s: %s (%s), want PASS", o.Result.Name, o.Result.Status, o.Result.Message)
+		}
+	}
+}
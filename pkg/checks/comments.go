@@ -0,0 +1,266 @@
+package checks
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+// CommentLine is a single comment line found in the artifact data.
+type CommentLine struct {
+	Line int    // 1-based physical line number
+	Text string // line content without the line terminator
+}
+
+// FindCommentLines returns the physical lines that start with prefix.
+// Lines inside multi-line quoted cells are never treated as comments.
+// Returns nil when prefix is empty or data is not valid UTF-8.
+func FindCommentLines(data []byte, prefix string) []CommentLine {
+	body, _ := SplitUTF8BOM(data)
+	if prefix == "" || !utf8.Valid(body) {
+		return nil
+	}
+
+	var out []CommentLine
+	for i, ln := range scanCommentLines(body, prefix) {
+		if ln.comment {
+			out = append(out, CommentLine{Line: i + 1, Text: string(trimLineEnd(ln.raw))})
+		}
+	}
+	return out
+}
+
+// commentMask remembers comment lines removed from an artifact
+// so they can be put back after a check has (possibly) rewritten the data.
+type commentMask struct {
+	original []byte
+	masked   []byte
+	bom      []byte
+	kept     [][]byte       // non-comment lines (with terminators) as seen by the check
+	blocks   []commentBlock // comment runs in original order
+
+	// lines maps each kept line (0-based) to its 1-based line in original, and
+	// records maps each CSV record the check sees to its record number in original,
+	// where every comment line counts as a record of its own.
+	lines   []int
+	records []int
+}
+
+// commentBlock is a run of adjacent comment lines placed before kept[before]
+// (before == len(kept) means "at the end of the file").
+type commentBlock struct {
+	before int
+	lines  [][]byte
+}
+
+type scannedLine struct {
+	raw     []byte
+	comment bool
+	starts  bool // begins a new CSV record (not inside a quoted cell)
+}
+
+// maskComments removes comment lines from data. It returns nil when nothing was removed.
+func maskComments(data []byte, prefix string) *commentMask {
+	body, bom := SplitUTF8BOM(data)
+	if prefix == "" || !utf8.Valid(body) || !bytes.Contains(body, []byte(prefix)) {
+		return nil
+	}
+
+	m := &commentMask{original: data, bom: bom}
+	comments := 0
+	for i, ln := range scanCommentLines(body, prefix) {
+		if !ln.comment {
+			m.kept = append(m.kept, ln.raw)
+			m.lines = append(m.lines, i+1)
+			if ln.starts {
+				m.records = append(m.records, len(m.records)+1+comments)
+			}
+			continue
+		}
+		comments++
+		if n := len(m.blocks); n > 0 && m.blocks[n-1].before == len(m.kept) {
+			m.blocks[n-1].lines = append(m.blocks[n-1].lines, ln.raw)
+			continue
+		}
+		m.blocks = append(m.blocks, commentBlock{before: len(m.kept), lines: [][]byte{ln.raw}})
+	}
+	if len(m.blocks) == 0 {
+		return nil
+	}
+
+	m.masked = append(append([]byte(nil), bom...), bytes.Join(m.kept, nil)...)
+	return m
+}
+
+// restore puts the comment lines back into data produced by a check.
+// Unchanged data yields the original bytes. When a fix changed the number of lines,
+// each block follows the line it was originally attached to (matched by content),
+// falling back to the position right after the previously placed block.
+func (m *commentMask) restore(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+	if bytes.Equal(data, m.masked) {
+		return m.original
+	}
+
+	body, bom := SplitUTF8BOM(data)
+	lines := bytes.SplitAfter(body, []byte("\n"))
+	if len(lines) > 0 && len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	positions := make([]int, len(m.blocks))
+	if len(lines) == len(m.kept) {
+		for i, b := range m.blocks {
+			positions[i] = b.before
+		}
+	} else {
+		cursor := 0
+		for i, b := range m.blocks {
+			positions[i] = cursor
+			if b.before >= len(m.kept) {
+				positions[i] = len(lines)
+				continue
+			}
+			anchor := trimLineEnd(m.kept[b.before])
+			for j := cursor; j < len(lines); j++ {
+				if bytes.Equal(trimLineEnd(lines[j]), anchor) {
+					positions[i] = j
+					cursor = j + 1
+					break
+				}
+			}
+		}
+	}
+
+	eol := []byte(DetectLineEnding(body))
+	var out bytes.Buffer
+	out.Grow(len(m.original) + len(data))
+	out.Write(bom)
+
+	next := 0
+	writeLine := func(ln []byte, last bool) {
+		if out.Len() > len(bom) && !bytes.HasSuffix(out.Bytes(), []byte("\n")) {
+			out.Write(eol)
+		}
+		out.Write(ln)
+		if !last && !bytes.HasSuffix(ln, []byte("\n")) {
+			out.Write(eol)
+		}
+	}
+	for i, ln := range lines {
+		for next < len(m.blocks) && positions[next] <= i {
+			for _, c := range m.blocks[next].lines {
+				writeLine(c, false)
+			}
+			next++
+		}
+		out.Write(ln)
+	}
+	for ; next < len(m.blocks); next++ {
+		for _, c := range m.blocks[next].lines {
+			writeLine(c, true)
+		}
+	}
+
+	return out.Bytes()
+}
+
+// rowRefs matches the row and line numbers checks put in their messages:
+// "row 3", "rows 2, 5", "in rows: 4, 9", "at lines 2-4".
+var (
+	rowRefs = regexp.MustCompile(`\b(rows?|lines?)(:?\s+)(\d+(?:(?:, |-)\d+)*)`)
+	digits  = regexp.MustCompile(`\d+`)
+)
+
+// translate rewrites the row numbers of a result computed on the masked data
+// (finding rows, row and line numbers in messages, nested outcomes) so they point
+// into the original data. Slices are copied, never changed in place.
+func (m *commentMask) translate(out CheckOutcome) CheckOutcome {
+	res := out.Result
+	res.Message = m.translateMessage(res.Message)
+	res.Findings = m.translateFindings(res.Findings)
+	res.PreFixMessage = m.translateMessage(res.PreFixMessage)
+	res.PreFixFindings = m.translateFindings(res.PreFixFindings)
+	out.Result = res
+
+	if len(out.Children) > 0 {
+		children := make([]CheckOutcome, len(out.Children))
+		for i, c := range out.Children {
+			children[i] = m.translate(c)
+		}
+		out.Children = children
+	}
+
+	return out
+}
+
+func (m *commentMask) translateFindings(findings []Finding) []Finding {
+	if len(findings) == 0 {
+		return findings
+	}
+
+	out := make([]Finding, len(findings))
+	for i, f := range findings {
+		f.Row = shiftNumber(m.records, f.Row)
+		f.Message = m.translateMessage(f.Message)
+		out[i] = f
+	}
+	return out
+}
+
+func (m *commentMask) translateMessage(msg string) string {
+	return rowRefs.ReplaceAllStringFunc(msg, func(ref string) string {
+		sub := rowRefs.FindStringSubmatch(ref)
+		table := m.records
+		if sub[1][0] == 'l' {
+			table = m.lines
+		}
+
+		return sub[1] + sub[2] + digits.ReplaceAllStringFunc(sub[3], func(n string) string {
+			v, err := strconv.Atoi(n)
+			if err != nil {
+				return n
+			}
+			return strconv.Itoa(shiftNumber(table, v))
+		})
+	})
+}
+
+// shiftNumber maps a 1-based number through table. Numbers past the end keep
+// the offset of the last entry (a fix may have added rows); 0 stays 0.
+func shiftNumber(table []int, n int) int {
+	switch {
+	case n <= 0 || len(table) == 0:
+		return n
+	case n <= len(table):
+		return table[n-1]
+	default:
+		return n + table[len(table)-1] - len(table)
+	}
+}
+
+func scanCommentLines(body []byte, prefix string) []scannedLine {
+	raw := bytes.SplitAfter(body, []byte("\n"))
+	if len(raw) > 0 && len(raw[len(raw)-1]) == 0 {
+		raw = raw[:len(raw)-1]
+	}
+
+	out := make([]scannedLine, 0, len(raw))
+	inQuotes := false
+	for _, ln := range raw {
+		comment := !inQuotes && bytes.HasPrefix(ln, []byte(prefix))
+		out = append(out, scannedLine{raw: ln, comment: comment, starts: !inQuotes})
+		if !comment && bytes.Count(ln, []byte{'"'})%2 == 1 {
+			inQuotes = !inQuotes
+		}
+	}
+	return out
+}
+
+func trimLineEnd(ln []byte) []byte {
+	ln = bytes.TrimSuffix(ln, []byte("\n"))
+	return bytes.TrimSuffix(ln, []byte("\r"))
+}
@@ -0,0 +1,171 @@
+package checks_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestFindCommentLines(t *testing.T) {
+	t.Parallel()
+
+	data := "\xEF\xBB\xBF# top\r\nterm;description\r\n\"a\n#inside\";b\r\n#tail"
+
+	got := checks.FindCommentLines([]byte(data), "#")
+	if len(got) != 2 {
+		t.Fatalf("got %d comment lines, want 2: %+v", len(got), got)
+	}
+	if got[0].Line != 1 || got[0].Text != "# top" {
+		t.Fatalf("first = %+v, want line 1 %q", got[0], "# top")
+	}
+	if got[1].Line != 5 || got[1].Text != "#tail" {
+		t.Fatalf("second = %+v, want line 5 %q", got[1], "#tail")
+	}
+
+	if checks.FindCommentLines([]byte(data), "") != nil {
+		t.Fatalf("empty prefix must disable comments")
+	}
+}
+
+func TestNewCheckAdapter_MasksCommentLines(t *testing.T) {
+	t.Parallel()
+
+	data := "term;description\n# old;row\napple;fruit\n#tail\n"
+
+	unit, err := checks.NewCheckAdapter(
+		"masked",
+		func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			if bytes.Contains(a.Data, []byte("#")) {
+				t.Fatalf("check saw comment lines: %q", string(a.Data))
+			}
+			return checks.OutcomeKeep(checks.Pass, "masked", "ok", a, "")
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := unit.Run(context.Background(), checks.Artifact{Data: []byte(data), Path: "f.csv"},
+		checks.RunOptions{CommentPrefix: "#"})
+
+	assertCheckOutcome(t, out, checks.Pass, "masked", "ok")
+	assertFinal(t, out.Final, data, "f.csv", false, "")
+}
+
+func TestNewCheckAdapter_WithCommentsSeesRawData(t *testing.T) {
+	t.Parallel()
+
+	data := "term\n#c\n"
+
+	unit, err := checks.NewCheckAdapter(
+		"raw",
+		func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			if string(a.Data) != data {
+				t.Fatalf("Data = %q, want raw %q", string(a.Data), data)
+			}
+			return checks.OutcomeKeep(checks.Pass, "raw", "ok", a, "")
+		},
+		checks.WithComments(),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := unit.Run(context.Background(), checks.Artifact{Data: []byte(data)}, checks.RunOptions{CommentPrefix: "#"})
+	assertCheckOutcome(t, out, checks.Pass, "raw", "ok")
+}
+
+func TestNewCheckAdapter_RestoresCommentsAfterFix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		fix  func(string) string
+		want string
+	}{
+		{
+			name: "same line count keeps positions",
+			in:   "TERM;Description\n# keep me\napple;fruit\n",
+			fix:  func(s string) string { return "term;description\napple;fruit\n" },
+			want: "term;description\n# keep me\napple;fruit\n",
+		},
+		{
+			name: "removed line follows anchor",
+			in:   "term;description\n\n# about pear\npear;fruit\n#end",
+			fix:  func(s string) string { return "term;description\npear;fruit\n" },
+			want: "term;description\n# about pear\npear;fruit\n#end",
+		},
+		{
+			name: "lost anchor falls back after previous block",
+			in:   "term\n#a\nx\n#b\ny\nz\n",
+			fix:  func(s string) string { return "term\nx\nz\n" },
+			want: "term\n#a\nx\n#b\nz\n",
+		},
+		{
+			name: "crlf and missing final newline",
+			in:   "term\r\n#c\r\nx\r\n#tail\r\n",
+			fix:  func(s string) string { return "term\r\nX" },
+			want: "term\r\n#c\r\nX\r\n#tail\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			unit, err := checks.NewCheckAdapter(
+				"fixer",
+				func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+					final := checks.FixResult{Data: []byte(tt.fix(string(a.Data))), DidChange: true}
+					return checks.OutcomeWithFinal(checks.Warn, "fixer", "fixed", final)
+				},
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			out := unit.Run(context.Background(), checks.Artifact{Data: []byte(tt.in)}, checks.RunOptions{CommentPrefix: "#"})
+			if string(out.Final.Data) != tt.want {
+				t.Fatalf("Final.Data = %q, want %q", string(out.Final.Data), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCheckAdapter_TranslatesRowsPastCommentLines(t *testing.T) {
+	t.Parallel()
+
+	// Records the check sees: 1 header, 2 "a", 3 multi-line "b", 4 "c"; in the file
+	// every comment line is a record too, so they are records 2, 3, 5 and 8 (lines 2-9).
+	data := "# top\nterm\na\n# mid\n\"b\nb\"\n# one\n# two\nc\n"
+
+	unit, err := checks.NewCheckAdapter(
+		"rows",
+		func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Warn, "rows", "bad (row 2); bad rows: 3, 4; at lines 1-5", a, "")
+			out.Result.Findings = []checks.Finding{{Row: 3, Message: "like term \"a\" (row 2)"}, {Row: 4}}
+			out.Children = []checks.CheckOutcome{checks.OutcomeKeep(checks.Warn, "inner", "row 4", a, "")}
+			return out
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := unit.Run(context.Background(), checks.Artifact{Data: []byte(data), Path: "f.csv"},
+		checks.RunOptions{CommentPrefix: "#"})
+
+	if want := "bad (row 3); bad rows: 5, 8; at lines 2-9"; out.Result.Message != want {
+		t.Fatalf("Message = %q, want %q", out.Result.Message, want)
+	}
+	if f := out.Result.Findings; f[0].Row != 5 || f[1].Row != 8 || f[0].Message != "like term \"a\" (row 3)" {
+		t.Fatalf("Findings = %+v", f)
+	}
+	if got := out.Children[0].Result.Message; got != "row 8" {
+		t.Fatalf("child message = %q, want %q", got, "row 8")
+	}
+	assertFinal(t, out.Final, data, "f.csv", false, "")
+}
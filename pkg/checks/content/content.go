@@ -0,0 +1,28 @@
+// Package content provides the built-in checks of the content band (single cells and rows: empty or
+// duplicate terms, flags, characters).
+// Importing it registers nothing; call checks.LoadContent (or checks.LoadAll) afterwards:
+//
+//	import _ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/content"
+//
+//	if err := checks.LoadContent(); err != nil { ... }
+package content
+
+import (
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/12_no_empty_term_values"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/13_no_duplicate_term_values"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/14_no_orphan_locale_descriptions"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/15_no_invalid_flags"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/16_no_forbidden_non_translatable_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/17_no_replacement_characters"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/18_no_non_breaking_spaces"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/32_locale_description_policy"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/37_duplicate_translation_rows"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/38_empty_locale_columns"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/40_multiline_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/41_split_decimals"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/43_sparse_flag_columns"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/44_nonstandard_hyphens"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/46_translation_casing"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/48_duplicate_tags"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/49_semicolon_cells"
+)
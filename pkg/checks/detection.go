@@ -0,0 +1,86 @@
+package checks
+
+// Confidence grades how sure a sniffer is about its conclusion.
+type Confidence string
+
+const (
+	ConfidenceHigh   Confidence = "high"   // the expected format parsed cleanly
+	ConfidenceMedium Confidence = "medium" // exactly one alternative fits
+	ConfidenceLow    Confidence = "low"    // several or no candidates fit
+)
+
+// Detection records what the encoding and delimiter sniffers concluded about
+// the input and why. Each field is filled by the check that examined it;
+// empty fields mean nobody looked (e.g. the check was skipped or never ran).
+type Detection struct {
+	// Delimiter is the detected field separator (";", "," or "\t"); empty when undecided.
+	Delimiter       string
+	Confidence      Confidence
+	DelimiterReason string
+
+	// Encoding is the source encoding (e.g. "UTF-8", "UTF-16LE", "windows-1252").
+	Encoding string
+	// EncodingPath tells how the encoding was decided: "BOM", "valid UTF-8",
+	// "UTF-16 heuristic (no BOM)", "charset detection" or "charset detection (sampled)".
+	EncodingPath string
+	// BOM names the byte order mark found at the start of the file; empty if none.
+	BOM string
+
+	// LineEndings is "LF", "CRLF", "mixed" or "none".
+	LineEndings string
+}
+
+// IsZero reports whether no sniffer filled anything in.
+func (d Detection) IsZero() bool {
+	return d == Detection{}
+}
+
+// Merge copies the non-empty fields of other into d. A nil other is a no-op.
+func (d *Detection) Merge(other *Detection) {
+	if other == nil {
+		return
+	}
+
+	mergeField(&d.Delimiter, other.Delimiter)
+	mergeField(&d.DelimiterReason, other.DelimiterReason)
+	mergeField(&d.Encoding, other.Encoding)
+	mergeField(&d.EncodingPath, other.EncodingPath)
+	mergeField(&d.BOM, other.BOM)
+	mergeField(&d.LineEndings, other.LineEndings)
+
+	if other.Confidence != "" {
+		d.Confidence = other.Confidence
+	}
+}
+
+func mergeField(dst *string, src string) {
+	if src != "" {
+		*dst = src
+	}
+}
+
+// DescribeLineEndings labels the line endings used in b: "LF", "CRLF", "mixed" or "none".
+func DescribeLineEndings(b []byte) string {
+	crlf, lf := 0, 0
+	for i, ch := range b {
+		if ch != '\n' {
+			continue
+		}
+		if i > 0 && b[i-1] == '\r' {
+			crlf++
+		} else {
+			lf++
+		}
+	}
+
+	switch {
+	case crlf > 0 && lf > 0:
+		return "mixed"
+	case crlf > 0:
+		return "CRLF"
+	case lf > 0:
+		return "LF"
+	default:
+		return "none"
+	}
+}
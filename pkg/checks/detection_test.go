@@ -0,0 +1,56 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestDetection_Merge_FillsNonEmptyFields(t *testing.T) {
+	t.Parallel()
+
+	var d checks.Detection
+	if !d.IsZero() {
+		t.Fatalf("zero Detection must report IsZero")
+	}
+
+	d.Merge(&checks.Detection{Encoding: "UTF-8", EncodingPath: "valid UTF-8"})
+	d.Merge(nil)
+	d.Merge(&checks.Detection{
+		Delimiter:   ";",
+		Confidence:  checks.ConfidenceHigh,
+		LineEndings: "CRLF",
+	})
+
+	want := checks.Detection{
+		Delimiter:    ";",
+		Confidence:   checks.ConfidenceHigh,
+		Encoding:     "UTF-8",
+		EncodingPath: "valid UTF-8",
+		LineEndings:  "CRLF",
+	}
+	if d != want {
+		t.Fatalf("merged = %+v, want %+v", d, want)
+	}
+}
+
+func TestDescribeLineEndings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"", "none"},
+		{"a;b", "none"},
+		{"a\nb\n", "LF"},
+		{"a\r\nb\r\n", "CRLF"},
+		{"a\r\nb\n", "mixed"},
+	}
+
+	for _, tt := range tests {
+		if got := checks.DescribeLineEndings([]byte(tt.in)); got != tt.want {
+			t.Fatalf("DescribeLineEndings(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
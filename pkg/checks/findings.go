@@ -0,0 +1,25 @@
+package checks
+
+// Finding is one located problem behind a check result.
+// Checks fill Code/Row/Field/Column/Value/Message; RunWithFix stamps Check and,
+// unless the check set it, Severity.
+type Finding struct {
+	Check   string // name of the check that reported it
+	Code    string // stable machine-readable kind within the check (e.g. "empty-term"); empty if the check has none
+	Row     int    // 1-based CSV record number (header included); 0 when not tied to a row
+	Field   int    // 1-based position of the offending cell in its record; 0 when not tied to a cell
+	Column  string // header label of the offending cell, if any
+	Value   string // offending value, if any
+	Message string // short description of the problem in this cell/row
+
+	// Severity is the status the finding contributes to: the result status by default.
+	Severity Status
+
+	// Suggestion is a proposed replacement for Value (e.g. a column to rename to),
+	// for UIs that offer one-click repairs; empty when the check has none.
+	Suggestion string
+
+	// SuggestedFix previews the auto-fix for this finding's row (see RunOptions.PreviewFixes);
+	// nil when there is no preview.
+	SuggestedFix *SuggestedFix
+}
@@ -0,0 +1,90 @@
+package checks
+
+import "strconv"
+
+// DefaultMaxFindings caps how many findings a check keeps in memory
+// when RunOptions.MaxFindings is zero.
+const DefaultMaxFindings = 1000
+
+// FindingsLimit returns the per-check cap on stored findings:
+// MaxFindings when positive, DefaultMaxFindings when zero, and 0 (no cap) when negative.
+func (o RunOptions) FindingsLimit() int {
+	switch {
+	case o.MaxFindings > 0:
+		return o.MaxFindings
+	case o.MaxFindings == 0:
+		return DefaultMaxFindings
+	default:
+		return 0
+	}
+}
+
+// StopAfter returns how many findings a row-scanning check may collect before it
+// stops reading the file: MaxFailures when positive, 0 (scan everything) otherwise.
+func (o RunOptions) StopAfter() int {
+	if o.MaxFailures > 0 {
+		return o.MaxFailures
+	}
+
+	return 0
+}
+
+// Capped collects findings up to Limit and only counts the rest,
+// so a file with millions of bad rows cannot balloon memory.
+// A Limit of 0 or less keeps everything.
+//
+// StopAfter, when positive, marks the collector exhausted once Total reaches it;
+// scanning checks then stop reading and report a truncated result.
+type Capped[T any] struct {
+	Items     []T
+	Limit     int
+	Overflow  int
+	StopAfter int
+}
+
+// NewCapped returns an empty collector keeping at most limit items.
+func NewCapped[T any](limit int) Capped[T] {
+	return Capped[T]{Limit: limit}
+}
+
+// Add stores v, or counts it as overflow once the cap is reached.
+// It reports whether v was stored.
+func (c *Capped[T]) Add(v T) bool {
+	if c.Limit > 0 && len(c.Items) >= c.Limit {
+		c.Overflow++
+		return false
+	}
+
+	c.Items = append(c.Items, v)
+	return true
+}
+
+// Total is the number of findings seen, stored or not.
+func (c Capped[T]) Total() int {
+	return len(c.Items) + c.Overflow
+}
+
+// Exhausted reports whether StopAfter findings were seen and scanning should stop.
+func (c Capped[T]) Exhausted() bool {
+	return c.StopAfter > 0 && c.Total() >= c.StopAfter
+}
+
+// TruncatedNote renders " (stopped after N findings, rest of the file not scanned)"
+// for an exhausted collector, and "" otherwise.
+func (c Capped[T]) TruncatedNote() string {
+	if !c.Exhausted() {
+		return ""
+	}
+
+	return " (stopped after " + strconv.Itoa(c.Total()) + " findings, rest of the file not scanned)"
+}
+
+// OverflowNote renders " and N more (not stored)" for a positive n, and "" otherwise.
+// Checks append it to their messages so the dropped findings are still accounted for.
+func OverflowNote(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return " and " + strconv.Itoa(n) + " more (not stored)"
+}
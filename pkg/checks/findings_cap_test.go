@@ -0,0 +1,80 @@
+package checks_test
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestRunOptions_FindingsLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		max  int
+		want int
+	}{
+		{0, checks.DefaultMaxFindings},
+		{5, 5},
+		{-1, 0},
+	}
+
+	for _, tt := range tests {
+		if got := (checks.RunOptions{MaxFindings: tt.max}).FindingsLimit(); got != tt.want {
+			t.Fatalf("FindingsLimit(%d) = %d, want %d", tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestCapped_KeepsUpToLimitAndCountsOverflow(t *testing.T) {
+	t.Parallel()
+
+	c := checks.NewCapped[int](3)
+	for i := range 10 {
+		c.Add(i)
+	}
+
+	if len(c.Items) != 3 || c.Items[2] != 2 {
+		t.Fatalf("Items = %v, want first 3", c.Items)
+	}
+	if c.Overflow != 7 || c.Total() != 10 {
+		t.Fatalf("Overflow=%d Total=%d, want 7/10", c.Overflow, c.Total())
+	}
+	if got := checks.OverflowNote(c.Overflow); got != " and 7 more (not stored)" {
+		t.Fatalf("OverflowNote = %q", got)
+	}
+	if got := checks.OverflowNote(0); got != "" {
+		t.Fatalf("OverflowNote(0) = %q, want empty", got)
+	}
+
+	unlimited := checks.NewCapped[int](0)
+	for i := range 10 {
+		unlimited.Add(i)
+	}
+	if len(unlimited.Items) != 10 || unlimited.Overflow != 0 {
+		t.Fatalf("unlimited collector dropped items: %+v", unlimited)
+	}
+}
+
+func TestCapped_StopAfterMarksExhausted(t *testing.T) {
+	t.Parallel()
+
+	c := checks.Capped[int]{Limit: 2, StopAfter: 3}
+	for i := range 2 {
+		c.Add(i)
+	}
+	if c.Exhausted() || c.TruncatedNote() != "" {
+		t.Fatalf("collector exhausted too early: %+v", c)
+	}
+
+	c.Add(2)
+	if !c.Exhausted() {
+		t.Fatalf("expected collector to be exhausted after 3 findings")
+	}
+	if got := c.TruncatedNote(); got != " (stopped after 3 findings, rest of the file not scanned)" {
+		t.Fatalf("TruncatedNote = %q", got)
+	}
+
+	if (checks.RunOptions{}).StopAfter() != 0 || (checks.RunOptions{MaxFailures: 50}).StopAfter() != 50 {
+		t.Fatalf("StopAfter must mirror a positive MaxFailures")
+	}
+}
@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ─────────────────────────────────────────────────────────────────────────────
+// Legacy check generation
+// ─────────────────────────────────────────────────────────────────────────────
+
+// LegacyResult is what first-generation checks return: a status and a message, with no
+// findings and no fixed data.
+type LegacyResult struct {
+	Name    string // check name; empty means the LegacyCheck's Name
+	Status  string // "PASS", "WARN", "FAIL", "ERROR", "INFO" or "SKIPPED", case-insensitive
+	Message string
+	Err     error // underlying error of an ERROR result, if any
+}
+
+// LegacyCheck is the first-generation check interface: no context, no options, and a
+// check that cannot change the file.
+//
+// A legacy check may also implement FailFast() bool and Priority() int; FromLegacy
+// carries them over.
+type LegacyCheck interface {
+	Name() string
+	Run(data []byte, path string, langs []string) LegacyResult
+}
+
+// ErrLegacyStatus is the Err of the ERROR outcome a legacy check gets when its result
+// has a status this generation does not know.
+var ErrLegacyStatus = errors.New("checks: legacy check returned an unknown status")
+
+// FromLegacy wraps a first-generation check into a CheckUnit so it can be registered and
+// run next to current checks. The legacy result becomes the outcome's result and the
+// artifact is kept as-is. FailFast and Priority come from the legacy check when it has
+// them; opts are applied after and win. Panics are recovered like in NewCheckAdapter.
+func FromLegacy(old LegacyCheck, opts ...Option) (*CheckAdapter, error) {
+	if old == nil {
+		return nil, fmt.Errorf("checks.FromLegacy: nil check")
+	}
+
+	name := old.Name()
+
+	var legacyOpts []Option
+	if f, ok := old.(interface{ FailFast() bool }); ok && f.FailFast() {
+		legacyOpts = append(legacyOpts, WithFailFast())
+	}
+	if p, ok := old.(interface{ Priority() int }); ok {
+		legacyOpts = append(legacyOpts, WithPriority(p.Priority()))
+	}
+
+	run := func(_ context.Context, a Artifact, _ RunOptions) CheckOutcome {
+		res := old.Run(a.Data, a.Path, a.Langs)
+
+		st, ok := legacyStatus(res.Status)
+		if !ok {
+			out := OutcomeKeep(Error, name, "unknown legacy status "+strconv.Quote(res.Status)+": "+res.Message, a, "")
+			out.Result.Err = ErrLegacyStatus
+			return out
+		}
+
+		resName := res.Name
+		if resName == "" {
+			resName = name
+		}
+
+		out := OutcomeKeep(st, resName, res.Message, a, "")
+		out.Result.Err = res.Err
+		return out
+	}
+
+	return NewCheckAdapter(name, run, append(legacyOpts, opts...)...)
+}
+
+func legacyStatus(s string) (Status, bool) {
+	st := Status(strings.ToUpper(strings.TrimSpace(s)))
+	switch st {
+	case Pass, Warn, Fail, Error, Info, Skipped:
+		return st, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,118 @@
+package checks_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+type legacyCheck struct {
+	name string
+	res  checks.LegacyResult
+	seen *[]string
+}
+
+func (c legacyCheck) Name() string { return c.name }
+
+func (c legacyCheck) Run(data []byte, path string, langs []string) checks.LegacyResult {
+	if c.seen != nil {
+		*c.seen = append(*c.seen, string(data), path, strings.Join(langs, ","))
+	}
+	if c.res.Status == "panic" {
+		panic("boom")
+	}
+	return c.res
+}
+
+type criticalLegacyCheck struct{ legacyCheck }
+
+func (criticalLegacyCheck) FailFast() bool { return true }
+func (criticalLegacyCheck) Priority() int  { return 142 }
+
+func TestFromLegacy_MapsResult(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	cause := errors.New("disk")
+	u, err := checks.FromLegacy(legacyCheck{
+		name: "old-check",
+		res:  checks.LegacyResult{Status: "fail", Message: "bad file", Err: cause},
+		seen: &seen,
+	})
+	if err != nil {
+		t.Fatalf("FromLegacy: %v", err)
+	}
+
+	a := checks.Artifact{Data: []byte("term\nx\n"), Path: "g.csv", Langs: []string{"en", "fr"}}
+	out := u.Run(context.Background(), a, checks.RunOptions{})
+
+	if out.Result.Name != "old-check" || out.Result.Status != checks.Fail || out.Result.Message != "bad file" || out.Result.Err != cause {
+		t.Fatalf("unexpected result: %+v", out.Result)
+	}
+	if out.Final.DidChange || string(out.Final.Data) != "term\nx\n" || out.Final.Path != "g.csv" {
+		t.Fatalf("legacy checks must keep the artifact, got %+v", out.Final)
+	}
+	if !reflect.DeepEqual(seen, []string{"term\nx\n", "g.csv", "en,fr"}) {
+		t.Fatalf("legacy check saw %q", seen)
+	}
+	if u.FailFast() || u.Priority() != 0 {
+		t.Fatalf("plain legacy check must not be fail-fast or prioritized")
+	}
+}
+
+func TestFromLegacy_OptionalMethodsAndOptions(t *testing.T) {
+	t.Parallel()
+
+	old := criticalLegacyCheck{legacyCheck{name: "critical", res: checks.LegacyResult{Name: "renamed", Status: "PASS"}}}
+
+	u, err := checks.FromLegacy(old)
+	if err != nil {
+		t.Fatalf("FromLegacy: %v", err)
+	}
+	if !u.FailFast() || u.Priority() != 142 {
+		t.Fatalf("expected fail-fast priority 142, got %v %d", u.FailFast(), u.Priority())
+	}
+	if out := u.Run(context.Background(), checks.Artifact{}, checks.RunOptions{}); out.Result.Name != "renamed" || out.Result.Status != checks.Pass {
+		t.Fatalf("unexpected result: %+v", out.Result)
+	}
+
+	u, err = checks.FromLegacy(old, checks.WithPriority(210), checks.WithScope(checks.ScopeRows))
+	if err != nil {
+		t.Fatalf("FromLegacy: %v", err)
+	}
+	if u.Priority() != 210 || u.Scope() != checks.ScopeRows {
+		t.Fatalf("options must override the legacy check, got %d %q", u.Priority(), u.Scope())
+	}
+}
+
+func TestFromLegacy_UnknownStatusAndPanic(t *testing.T) {
+	t.Parallel()
+
+	u, err := checks.FromLegacy(legacyCheck{name: "odd", res: checks.LegacyResult{Status: "OK", Message: "fine"}})
+	if err != nil {
+		t.Fatalf("FromLegacy: %v", err)
+	}
+	out := u.Run(context.Background(), checks.Artifact{}, checks.RunOptions{})
+	if out.Result.Status != checks.Error || !errors.Is(out.Result.Err, checks.ErrLegacyStatus) || out.Result.Message != `unknown legacy status "OK": fine` {
+		t.Fatalf("unexpected result: %+v", out.Result)
+	}
+
+	u, err = checks.FromLegacy(legacyCheck{name: "crash", res: checks.LegacyResult{Status: "panic"}})
+	if err != nil {
+		t.Fatalf("FromLegacy: %v", err)
+	}
+	if out := u.Run(context.Background(), checks.Artifact{}, checks.RunOptions{}); out.Result.Status != checks.Error || !strings.Contains(out.Result.Message, "boom") {
+		t.Fatalf("panics must become ERROR, got %+v", out.Result)
+	}
+
+	if _, err := checks.FromLegacy(nil); err == nil {
+		t.Fatal("expected an error for a nil check")
+	}
+	if _, err := checks.FromLegacy(legacyCheck{}); err == nil {
+		t.Fatal("expected an error for an empty name")
+	}
+}
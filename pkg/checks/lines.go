@@ -0,0 +1,32 @@
+package checks
+
+import (
+	"bytes"
+	"iter"
+)
+
+// Lines iterates over the "\n"-separated lines of data with 1-based line numbers.
+// A trailing "\r" is dropped and a final newline does not produce an extra empty line,
+// matching bufio.ScanLines. Unlike a bufio.Scanner it works on the in-memory slice,
+// so there is no per-line size limit: a newline-free multi-MB file is one line.
+// Yielded slices alias data and must not be modified.
+func Lines(data []byte) iter.Seq2[int, []byte] {
+	return func(yield func(int, []byte) bool) {
+		rest := data
+		for lineNo := 1; len(rest) > 0; lineNo++ {
+			line, tail, found := bytes.Cut(rest, []byte("\n"))
+			if !found {
+				tail = nil
+			}
+			rest = tail
+
+			if n := len(line); n > 0 && line[n-1] == '\r' {
+				line = line[:n-1]
+			}
+
+			if !yield(lineNo, line) {
+				return
+			}
+		}
+	}
+}
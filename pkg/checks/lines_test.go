@@ -0,0 +1,80 @@
+package checks_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"single without newline", "a;b", []string{"a;b"}},
+		{"final newline adds nothing", "a\nb\n", []string{"a", "b"}},
+		{"crlf stripped", "a\r\nb\r\n", []string{"a", "b"}},
+		{"blank lines kept", "\n\na\n\n", []string{"", "", "a", ""}},
+		{"lone cr is not a break", "a\rb", []string{"a\rb"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			want := 1
+			for n, line := range checks.Lines([]byte(tc.in)) {
+				if n != want {
+					t.Fatalf("line number = %d, want %d", n, want)
+				}
+				want++
+				got = append(got, string(line))
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("got %q, want %q", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestLines_HugeLineWithoutNewlines(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte("x;"), 20<<20) // 40 MB, above any scanner buffer
+
+	count := 0
+	for _, line := range checks.Lines(data) {
+		count++
+		if len(line) != len(data) {
+			t.Fatalf("line length = %d, want %d", len(line), len(data))
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected exactly one line, got %d", count)
+	}
+}
+
+func TestLines_StopsEarly(t *testing.T) {
+	t.Parallel()
+
+	count := 0
+	for range checks.Lines([]byte("a\nb\nc\n")) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Fatalf("expected iteration to stop after one line, got %d", count)
+	}
+}
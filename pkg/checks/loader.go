@@ -0,0 +1,112 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ErrNoChecksLoaded fails a run when check packages were imported (their checks are
+// provided) but none was registered: LoadAll or a per-band Load was never called.
+var ErrNoChecksLoaded = errors.New("no checks loaded")
+
+// Provider builds a check for registration. Built-in check packages hand one to
+// Provide from init(); the check is only constructed and registered by a Load function.
+type Provider func() (CheckUnit, error)
+
+var (
+	providersMu sync.Mutex
+	providers   []Provider
+)
+
+// Provide records p without registering anything. Importing a check package only
+// makes its check available; LoadAll (or a per-band Load) puts it in the registry.
+func Provide(p Provider) {
+	if p == nil {
+		return
+	}
+
+	providersMu.Lock()
+	providers = append(providers, p)
+	providersMu.Unlock()
+}
+
+// providedCount returns the number of checks handed to Provide so far.
+func providedCount() int {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	return len(providers)
+}
+
+// providedNames returns the normalized names of the provided checks. Providers are
+// built to learn them, so callers only ask when a name is missing from the registry.
+func providedNames() map[string]struct{} {
+	providersMu.Lock()
+	ps := slices.Clone(providers)
+	providersMu.Unlock()
+
+	names := make(map[string]struct{}, len(ps))
+	for _, p := range ps {
+		if c, err := p(); err == nil && c != nil {
+			names[normalizeName(c.Name())] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+// LoadAll registers every provided check. pkg/checks/all (and so pkg/guard) calls it
+// on import; embedders that import band packages instead call the Load they need.
+// Loading again replaces checks registered under the same names.
+func LoadAll() error {
+	return load(func(int) bool { return true })
+}
+
+// LoadStructural registers the provided checks in the structural band
+// (file and header shape). Import pkg/checks/structural to provide them.
+func LoadStructural() error {
+	return loadBand("structural")
+}
+
+// LoadContent registers the provided checks in the content band (single cells and rows).
+// Import pkg/checks/content to provide them.
+func LoadContent() error {
+	return loadBand("content")
+}
+
+// LoadSemantic registers the provided checks in the semantic band (meaning across rows and languages).
+// Import pkg/checks/semantic to provide them.
+func LoadSemantic() error {
+	return loadBand("semantic")
+}
+
+func loadBand(band string) error {
+	return load(func(p int) bool { return PriorityBand(p) == band })
+}
+
+// load builds every provided check and registers those whose priority passes keep.
+// A provider error does not stop the others; all errors are joined.
+func load(keep func(priority int) bool) error {
+	providersMu.Lock()
+	ps := slices.Clone(providers)
+	providersMu.Unlock()
+
+	var errs []error
+	for _, p := range ps {
+		c, err := p()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("checks.Load: %w", err))
+			continue
+		}
+		if c == nil || !keep(c.Priority()) {
+			continue
+		}
+		if _, err := Register(c); err != nil {
+			errs = append(errs, fmt.Errorf("checks.Load %s: %w", c.Name(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,140 @@
+package checks_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func provideTestCheck(name string, prio int) {
+	checks.Provide(func() (checks.CheckUnit, error) {
+		return checks.NewCheckAdapter(name, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+		}, checks.WithPriority(prio))
+	})
+}
+
+func TestLoad_RegistersOnlyRequestedBand(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	provideTestCheck("loader-structural", checks.PrioStructural+1)
+	provideTestCheck("loader-content", checks.PrioContent+1)
+	provideTestCheck("loader-semantic", checks.PrioSemantic+1)
+
+	if _, ok := checks.Lookup("loader-content"); ok {
+		t.Fatalf("Provide must not register")
+	}
+
+	if err := checks.LoadContent(); err != nil {
+		t.Fatalf("LoadContent: %v", err)
+	}
+	if _, ok := checks.Lookup("loader-content"); !ok {
+		t.Fatalf("content check not loaded")
+	}
+	if _, ok := checks.Lookup("loader-structural"); ok {
+		t.Fatalf("structural check loaded by LoadContent")
+	}
+
+	if err := checks.LoadStructural(); err != nil {
+		t.Fatalf("LoadStructural: %v", err)
+	}
+	if err := checks.LoadSemantic(); err != nil {
+		t.Fatalf("LoadSemantic: %v", err)
+	}
+	for _, name := range []string{"loader-structural", "loader-content", "loader-semantic"} {
+		if _, ok := checks.Lookup(name); !ok {
+			t.Fatalf("%s not loaded", name)
+		}
+	}
+
+	// loading again replaces, it does not duplicate
+	n := len(checks.List())
+	if err := checks.LoadAll(); err != nil {
+		t.Fatalf("LoadAll: %v", err)
+	}
+	if got := len(checks.List()); got != n {
+		t.Fatalf("LoadAll changed the check count: %d -> %d", n, got)
+	}
+}
+
+func TestLoad_JoinsProviderErrors(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	// providers cannot be removed; switch this one off for the other tests
+	var failing atomic.Bool
+	failing.Store(true)
+	t.Cleanup(func() { failing.Store(false) })
+
+	boom := errors.New("boom")
+	checks.Provide(func() (checks.CheckUnit, error) {
+		if failing.Load() {
+			return nil, boom
+		}
+		return nil, nil
+	})
+	provideTestCheck("loader-after-error", checks.PrioContent+2)
+
+	err := checks.LoadAll()
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected provider error, got %v", err)
+	}
+	if _, ok := checks.Lookup("loader-after-error"); !ok {
+		t.Fatalf("a failing provider must not stop the others")
+	}
+}
+
+func TestResolveRun_FailsWhenProvidedChecksWereNotLoaded(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	provideTestCheck("loader-forgotten", checks.PrioContent+2)
+
+	if _, err := checks.ResolveRun(checks.RunOptions{}); !errors.Is(err, checks.ErrNoChecksLoaded) {
+		t.Fatalf("err = %v, want ErrNoChecksLoaded", err)
+	}
+
+	if err := checks.LoadAll(); err != nil {
+		t.Fatalf("LoadAll: %v", err)
+	}
+	if _, err := checks.ResolveRun(checks.RunOptions{}); err != nil {
+		t.Fatalf("ResolveRun after LoadAll: %v", err)
+	}
+}
+
+func TestResolveRun_AcceptsSettingsForProvidedButUnloadedChecks(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	provideTestCheck("band-structural", checks.PrioStructural+3)
+	provideTestCheck("band-content", checks.PrioContent+3)
+	if err := checks.LoadStructural(); err != nil {
+		t.Fatalf("LoadStructural: %v", err)
+	}
+
+	opts := checks.RunOptions{
+		Settings:          map[string]checks.CheckSettings{"band-content": {"k": "v"}},
+		SeverityOverrides: map[string]checks.Status{"band-content": checks.Fail},
+		CheckTimeouts:     map[string]time.Duration{"band-content": time.Second},
+		PriorityOverrides: map[string]int{"band-content": 1},
+		Skip:              []string{"band-content"},
+	}
+	if _, err := checks.ResolveRun(opts); err != nil {
+		t.Fatalf("ResolveRun: %v", err)
+	}
+
+	opts.Settings["band-nowhere"] = checks.CheckSettings{"k": "v"}
+	var unknown *checks.UnknownChecksError
+	if _, err := checks.ResolveRun(opts); !errors.As(err, &unknown) || unknown.Names[0] != "band-nowhere" {
+		t.Fatalf("err = %v, want an *UnknownChecksError for band-nowhere", err)
+	}
+
+	if _, err := checks.ResolveRun(checks.RunOptions{Only: []string{"band-content"}}); !errors.Is(err, checks.ErrUnknownCheck) {
+		t.Fatalf("Only must still name loaded checks, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// LocaleColumn is one non-service header column, read as a locale value or description column.
+type LocaleColumn struct {
+	Pos         int    // 0-based position in the header
+	Label       string // header cell, trimmed
+	Base        string // Label without the "_description" suffix, as spelled
+	Key         string // canonical locale key of Base: lowercase, "-" replaced by "_"
+	Description bool   // Label ends with "_description"
+	LangLike    bool   // Base looks like a language code ("en", "pt_BR", "zh-Hant")
+}
+
+// LocaleIndex describes the locale columns of a header: every column that is not
+// blank and not a service column (see KnownHeaders), in header order.
+type LocaleIndex struct {
+	Header  []string
+	Columns []LocaleColumn
+
+	names map[string]int // lowercased label -> first position
+}
+
+// BuildLocaleIndex derives the locale index of a parsed header record.
+func BuildLocaleIndex(header []string) *LocaleIndex {
+	ix := &LocaleIndex{
+		Header: header,
+		names:  make(map[string]int, len(header)),
+	}
+
+	for i, cell := range header {
+		label := strings.TrimSpace(cell)
+		if label == "" {
+			continue
+		}
+
+		name := strings.ToLower(label)
+		if _, dup := ix.names[name]; !dup {
+			ix.names[name] = i
+		}
+		if _, known := KnownHeaders[name]; known {
+			continue
+		}
+
+		col := LocaleColumn{Pos: i, Label: label, Base: label}
+		if strings.HasSuffix(name, "_description") {
+			col.Description = true
+			col.Base = strings.TrimSpace(label[:len(label)-len("_description")])
+		}
+		col.Key = strings.ToLower(strings.ReplaceAll(col.Base, "-", "_"))
+		col.LangLike = looksLikeLangCode(col.Base)
+
+		ix.Columns = append(ix.Columns, col)
+	}
+
+	return ix
+}
+
+// HasColumn reports whether the header has a column named name (case-insensitive, trimmed).
+func (ix *LocaleIndex) HasColumn(name string) bool {
+	_, ok := ix.names[strings.ToLower(strings.TrimSpace(name))]
+	return ok
+}
+
+// LocaleIndexOf returns the locale index of a's header. With a.Cache set it is
+// computed at most once per Data state and shared by every check that asks.
+// io.EOF means the data has no non-blank header line.
+func LocaleIndexOf(ctx context.Context, a Artifact) (*LocaleIndex, error) {
+	return a.Cache.localeIndex(ctx, a.Data)
+}
+
+func buildLocaleIndexFromData(ctx context.Context, data []byte) (*LocaleIndex, error) {
+	r := NewSemicolonCSVReader(StripUTF8BOM(data))
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+
+		if !isBlankRecord(rec) {
+			return BuildLocaleIndex(rec), nil
+		}
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if !IsBlankUnicode([]byte(field)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func looksLikeLangCode(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	parts := strings.Split(strings.ReplaceAll(s, "-", "_"), "_")
+
+	first := parts[0]
+	if len(first) < 2 || len(first) > 3 {
+		return false
+	}
+
+	for _, r := range first {
+		if !isASCIILetter(r) {
+			return false
+		}
+	}
+
+	for _, seg := range parts[1:] {
+		if seg == "" {
+			return false
+		}
+
+		for _, r := range seg {
+			if !isASCIILetter(r) && !isASCIIDigit(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,85 @@
+package checks_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestBuildLocaleIndex(t *testing.T) {
+	t.Parallel()
+
+	ix := checks.BuildLocaleIndex([]string{"Term", " en-US ", "en-US_description", "", "notes_description", "Tags", "fr"})
+
+	want := []checks.LocaleColumn{
+		{Pos: 1, Label: "en-US", Base: "en-US", Key: "en_us", LangLike: true},
+		{Pos: 2, Label: "en-US_description", Base: "en-US", Key: "en_us", Description: true, LangLike: true},
+		{Pos: 4, Label: "notes_description", Base: "notes", Key: "notes", Description: true},
+		{Pos: 6, Label: "fr", Base: "fr", Key: "fr", LangLike: true},
+	}
+	if !reflect.DeepEqual(ix.Columns, want) {
+		t.Fatalf("Columns =\n%+v\nwant\n%+v", ix.Columns, want)
+	}
+
+	if !ix.HasColumn("term") || !ix.HasColumn(" EN-us ") || ix.HasColumn("notes") {
+		t.Fatalf("HasColumn mismatch")
+	}
+}
+
+func TestLocaleIndexOf_CachedPerDataState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := checks.NewParseCache()
+	a := checks.Artifact{Data: []byte("\ufeff\nterm;en;de_description\nx;y;z\n"), Cache: cache}
+
+	first, err := checks.LocaleIndexOf(ctx, a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Columns) != 2 {
+		t.Fatalf("unexpected columns %+v", first.Columns)
+	}
+
+	again, _ := checks.LocaleIndexOf(ctx, a)
+	if again != first {
+		t.Fatalf("expected the cached index for unchanged data")
+	}
+
+	fixed := a
+	fixed.Data = []byte("term;en;de_description\nx;y;z\n")
+	other, _ := checks.LocaleIndexOf(ctx, fixed)
+	if other == first {
+		t.Fatalf("expected a fresh index after Data changed")
+	}
+
+	uncached, _ := checks.LocaleIndexOf(ctx, checks.Artifact{Data: a.Data})
+	if uncached == first || !reflect.DeepEqual(uncached.Columns, first.Columns) {
+		t.Fatalf("nil cache must compute an equal, separate index")
+	}
+}
+
+func TestLocaleIndexOf_NoHeaderAndCancellation(t *testing.T) {
+	t.Parallel()
+
+	cache := checks.NewParseCache()
+
+	if _, err := checks.LocaleIndexOf(context.Background(), checks.Artifact{Data: []byte("\n \n"), Cache: cache}); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected io.EOF for blank data, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	a := checks.Artifact{Data: []byte("term;en\n"), Cache: cache}
+	if _, err := checks.LocaleIndexOf(ctx, a); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if ix, err := checks.LocaleIndexOf(context.Background(), a); err != nil || len(ix.Columns) != 1 {
+		t.Fatalf("cancellation must not be cached: ix=%+v err=%v", ix, err)
+	}
+}
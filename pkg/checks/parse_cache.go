@@ -0,0 +1,90 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"sync"
+)
+
+// ParseCache shares views derived from an artifact (its Table and LocaleIndex) between
+// the checks of one run, so each view is computed once per artifact state.
+// Entries are tied to the exact Data slice they were built from: a fix that replaces
+// Data invalidates them without any bookkeeping. A nil *ParseCache computes on every call.
+// It is safe for concurrent use.
+type ParseCache struct {
+	mu     sync.Mutex
+	locale *localeIndexEntry
+	parsed *tableEntry
+}
+
+type tableEntry struct {
+	data  []byte
+	table *Table
+}
+
+type localeIndexEntry struct {
+	data  []byte
+	index *LocaleIndex
+	err   error
+}
+
+// NewParseCache returns an empty cache; the validator creates one per run.
+func NewParseCache() *ParseCache {
+	return &ParseCache{}
+}
+
+func (c *ParseCache) localeIndex(ctx context.Context, data []byte) (*LocaleIndex, error) {
+	if c == nil {
+		return buildLocaleIndexFromData(ctx, data)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e := c.locale; e != nil && sameBytes(e.data, data) {
+		return e.index, e.err
+	}
+
+	index, err := buildLocaleIndexFromData(ctx, data)
+	if err != nil && isContextError(err) {
+		return nil, err // never cache cancellation
+	}
+
+	c.locale = &localeIndexEntry{data: data, index: index, err: err}
+
+	return index, err
+}
+
+// table matches entries by content as well as by slice: checks that hide comment
+// lines see equal data in fresh slices, and comparing is far cheaper than parsing.
+func (c *ParseCache) table(ctx context.Context, data []byte) (*Table, error) {
+	if c == nil {
+		return readTable(ctx, data)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e := c.parsed; e != nil && (sameBytes(e.data, data) || bytes.Equal(e.data, data)) {
+		return e.table, nil
+	}
+
+	t, err := readTable(ctx, data)
+	if err != nil {
+		return nil, err // never cache cancellation
+	}
+
+	c.parsed = &tableEntry{data: data, table: t}
+
+	return t, nil
+}
+
+// sameBytes reports whether a and b are the same slice (same backing array start and length).
+// Holding a reference in the cache keeps the array alive, so its address cannot be reused.
+func sameBytes(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	return len(a) == 0 || &a[0] == &b[0]
+}
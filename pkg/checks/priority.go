@@ -0,0 +1,97 @@
+package checks
+
+import (
+	"slices"
+	"strconv"
+)
+
+// Priority bands. Built-in checks sit PrioStep apart inside their band, so a custom check
+// can take a free slot between two of them (e.g. PrioContent + 12) without colliding.
+const (
+	// PrioStructural is for checks on the file and header shape (extension, encoding,
+	// line breaks, delimiter, header cells). Fail-fast gate checks belong here.
+	PrioStructural = 100
+
+	// PrioContent is for checks on single cells and rows (empty or duplicate terms, flags, characters).
+	PrioContent = 200
+
+	// PrioSemantic is for checks on meaning across rows and languages (overlaps, tags, translations).
+	// They run last, on data already fixed by the earlier bands.
+	PrioSemantic = 300
+
+	// PrioBandSize is the width of each band.
+	PrioBandSize = 100
+
+	// PrioStep is the spacing between built-in checks within a band.
+	PrioStep = 5
+)
+
+// RegistrationWarning is a suspicious priority noticed when a check was registered.
+// Warnings never block registration; see RegistrationWarnings.
+type RegistrationWarning struct {
+	Check    string
+	Priority int
+	Message  string
+}
+
+func (w RegistrationWarning) String() string {
+	return w.Check + " (priority " + strconv.Itoa(w.Priority) + "): " + w.Message
+}
+
+// RegistrationWarnings returns the warnings collected by Register, in registration order.
+// Re-registering a check replaces its warnings.
+func RegistrationWarnings() []RegistrationWarning {
+	return slices.Clone(snapshot().warnings)
+}
+
+// PriorityBand names the band p falls into: "structural", "content", "semantic",
+// or "" when p is outside every band.
+func PriorityBand(p int) string {
+	switch {
+	case p >= PrioStructural && p < PrioStructural+PrioBandSize:
+		return "structural"
+	case p >= PrioContent && p < PrioContent+PrioBandSize:
+		return "content"
+	case p >= PrioSemantic && p < PrioSemantic+PrioBandSize:
+		return "semantic"
+	default:
+		return ""
+	}
+}
+
+// priorityWarnings checks c against the registered checks in byName (c itself excluded).
+//   - a priority outside the bands cannot be placed relative to the built-ins;
+//   - sharing a priority with a fail-fast check makes their order, and so whether
+//     the other one runs at all, depend on TieBreak;
+//   - a fail-fast semantic check stops the run after content fixes were already applied.
+func priorityWarnings(byName map[string]CheckUnit, c CheckUnit, name string) []RegistrationWarning {
+	p := c.Priority()
+	warn := func(msg string) RegistrationWarning {
+		return RegistrationWarning{Check: c.Name(), Priority: p, Message: msg}
+	}
+
+	var out []RegistrationWarning
+
+	band := PriorityBand(p)
+	switch {
+	case band == "":
+		out = append(out, warn("priority is outside the structural, content and semantic bands"))
+	case band == "semantic" && c.FailFast():
+		out = append(out, warn("fail-fast check in the semantic band stops the run after content fixes were applied"))
+	}
+
+	others := make([]string, 0)
+	for other, u := range byName {
+		if other == name || u.Priority() != p || (!u.FailFast() && !c.FailFast()) {
+			continue
+		}
+		others = append(others, u.Name())
+	}
+	slices.Sort(others)
+
+	for _, other := range others {
+		out = append(out, warn("shares its priority with "+other+" and one of them is fail-fast; their order depends on TieBreak"))
+	}
+
+	return out
+}
@@ -0,0 +1,80 @@
+package checks_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestPriorityBand(t *testing.T) {
+	t.Parallel()
+
+	cases := map[int]string{
+		checks.PrioStructural - 1:                     "",
+		checks.PrioStructural:                         "structural",
+		checks.PrioContent - 1:                        "structural",
+		checks.PrioContent + 12:                       "content",
+		checks.PrioSemantic:                           "semantic",
+		checks.PrioSemantic + checks.PrioBandSize:     "",
+		checks.PrioSemantic + checks.PrioBandSize - 1: "semantic",
+	}
+	for p, want := range cases {
+		if got := checks.PriorityBand(p); got != want {
+			t.Fatalf("PriorityBand(%d) = %q, want %q", p, got, want)
+		}
+	}
+}
+
+func TestRegister_PriorityWarnings(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	mustRegister := func(c checks.CheckUnit) {
+		t.Helper()
+		if _, err := checks.Register(c); err != nil {
+			t.Fatalf("Register(%s): %v", c.Name(), err)
+		}
+	}
+
+	mustRegister(mkCheckOK(t, "gate", checks.WithPriority(checks.PrioStructural+10), checks.WithFailFast()))
+	mustRegister(mkCheckOK(t, "content", checks.WithPriority(checks.PrioContent+10)))
+	if w := checks.RegistrationWarnings(); len(w) != 0 {
+		t.Fatalf("expected no warnings for checks in free slots, got %v", w)
+	}
+
+	// same priority as a fail-fast check
+	mustRegister(mkCheckOK(t, "custom", checks.WithPriority(checks.PrioStructural+10)))
+	// same priority as a plain check is fine
+	mustRegister(mkCheckOK(t, "content-2", checks.WithPriority(checks.PrioContent+10)))
+	// outside every band
+	mustRegister(mkCheckOK(t, "legacy", checks.WithPriority(7)))
+	// fail-fast after content fixes
+	mustRegister(mkCheckOK(t, "late-gate", checks.WithPriority(checks.PrioSemantic+1), checks.WithFailFast()))
+
+	w := checks.RegistrationWarnings()
+	if len(w) != 3 {
+		t.Fatalf("expected 3 warnings, got %v", w)
+	}
+
+	for i, want := range []string{
+		"custom (priority 110): shares its priority with gate",
+		"legacy (priority 7): priority is outside",
+		"late-gate (priority 301): fail-fast check in the semantic band",
+	} {
+		if !strings.HasPrefix(w[i].String(), want) {
+			t.Fatalf("warning %d = %q, want prefix %q", i, w[i].String(), want)
+		}
+	}
+
+	// re-registering in a free slot clears the check's warnings
+	mustRegister(mkCheckOK(t, "custom", checks.WithPriority(checks.PrioStructural+12)))
+	if got := len(checks.RegistrationWarnings()); got != 2 {
+		t.Fatalf("expected 2 warnings after re-registration, got %d", got)
+	}
+
+	checks.Reset()
+	if got := len(checks.RegistrationWarnings()); got != 0 {
+		t.Fatalf("Reset must clear warnings, got %d", got)
+	}
+}
@@ -2,29 +2,128 @@ package checks
 
 import (
 	"errors"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 )
 
+// registryState is an immutable snapshot of the registry. Writers build a new state
+// and publish it atomically, so readers never lock and a snapshot never changes under them.
+type registryState struct {
+	byName   map[string]CheckUnit
+	seqOf    map[string]uint64 // registration sequence per normalized name
+	nextSeq  uint64
+	warnings []RegistrationWarning
+
+	// run orders, computed once per state; index is the TieBreak
+	ordered [2][]CheckUnit
+}
+
 var (
-	mu     sync.RWMutex
-	byName = map[string]CheckUnit{}
+	writeMu sync.Mutex // serializes Register and Reset
+	current atomic.Pointer[registryState]
+
+	emptyRegistry = newRegistryState(map[string]CheckUnit{}, map[string]uint64{}, 0, nil)
 )
 
-// Lookup returns a registered check by its case-insensitive name.
-func Lookup(name string) (CheckUnit, bool) {
-	name = normalizeName(name)
+func newRegistryState(
+	byName map[string]CheckUnit,
+	seqOf map[string]uint64,
+	nextSeq uint64,
+	warnings []RegistrationWarning,
+) *registryState {
+	st := &registryState{
+		byName:   byName,
+		seqOf:    seqOf,
+		nextSeq:  nextSeq,
+		warnings: warnings,
+	}
+
+	st.ordered[TieBreakName] = st.sorted(TieBreakName)
+	st.ordered[TieBreakRegistration] = st.sorted(TieBreakRegistration)
+
+	return st
+}
+
+func (st *registryState) sorted(tb TieBreak) []CheckUnit {
+	out := make([]CheckUnit, 0, len(st.byName))
+	for _, c := range st.byName {
+		out = append(out, c)
+	}
+
+	// registration order first, so a stable sort keeps it for TieBreakRegistration
+	sort.Slice(out, func(i, j int) bool {
+		return st.seqOf[normalizeName(out[i].Name())] < st.seqOf[normalizeName(out[j].Name())]
+	})
+
+	SortUnits(out, tb)
+
+	return out
+}
+
+// reorder sorts units in place like SortUnits, but by the priorities in overrides
+// (keyed by case-insensitive check name) where present. Units keep their own Priority;
+// only the order changes. Ties follow tb, using registration sequence for TieBreakRegistration.
+func (st *registryState) reorder(units []CheckUnit, overrides map[string]int, tb TieBreak) {
+	prio := make(map[string]int, len(overrides))
+	for name, p := range overrides {
+		prio[normalizeName(name)] = p
+	}
+	effective := func(u CheckUnit) int {
+		if p, ok := prio[normalizeName(u.Name())]; ok {
+			return p
+		}
+		return u.Priority()
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		pi, pj := effective(units[i]), effective(units[j])
+		if pi != pj {
+			return pi < pj
+		}
+
+		ki, kj := normalizeName(units[i].Name()), normalizeName(units[j].Name())
+		if tb == TieBreakRegistration {
+			return st.seqOf[ki] < st.seqOf[kj]
+		}
+		if ki != kj {
+			return ki < kj
+		}
+
+		return units[i].Name() < units[j].Name()
+	})
+}
 
-	mu.RLock()
-	c, ok := byName[name]
-	mu.RUnlock()
+func snapshot() *registryState {
+	if st := current.Load(); st != nil {
+		return st
+	}
+
+	return emptyRegistry
+}
 
+// TieBreak selects how checks with equal Priority are ordered.
+type TieBreak int
+
+const (
+	TieBreakName         TieBreak = iota // name asc (default; independent of init order)
+	TieBreakRegistration                 // registration sequence, then name
+)
+
+// Lookup returns a registered check by its case-insensitive name.
+func Lookup(name string) (CheckUnit, bool) {
+	c, ok := snapshot().byName[normalizeName(name)]
 	return c, ok
 }
 
-// Register adds or replaces a check in the registry.
+// Register adds or replaces a check in the registry. It is safe to call at any time,
+// including while runs are in progress: a run keeps the snapshot it started with.
 // It returns replaced=true if a check with the same normalized name already existed.
+// A replaced check keeps its original registration sequence.
+// Suspicious priorities are recorded as RegistrationWarnings, never as errors.
 func Register(c CheckUnit) (bool, error) {
 	if c == nil {
 		return false, errors.New("checks.Register: nil check")
@@ -35,58 +134,82 @@ func Register(c CheckUnit) (bool, error) {
 		return false, errors.New("checks.Register: empty name")
 	}
 
-	mu.Lock()
-	_, existed := byName[name]
+	writeMu.Lock()
+	defer writeMu.Unlock()
+
+	old := snapshot()
+	_, existed := old.byName[name]
+
+	warnings := slices.DeleteFunc(slices.Clone(old.warnings), func(w RegistrationWarning) bool {
+		return normalizeName(w.Check) == name
+	})
+	warnings = append(warnings, priorityWarnings(old.byName, c, name)...)
+
+	byName := maps.Clone(old.byName)
 	byName[name] = c
-	mu.Unlock()
+
+	seqOf, nextSeq := old.seqOf, old.nextSeq
+	if !existed {
+		seqOf = maps.Clone(old.seqOf)
+		nextSeq++
+		seqOf[name] = nextSeq
+	}
+
+	current.Store(newRegistryState(byName, seqOf, nextSeq, warnings))
 
 	return existed, nil
 }
 
 // List returns a snapshot of all registered checks in unspecified order.
 func List() []CheckUnit {
-	return registrySnapshot()
+	return slices.Collect(maps.Values(snapshot().byName))
 }
 
 // ListSorted returns all registered checks sorted by Priority asc, then Name asc.
 func ListSorted() []CheckUnit {
-	out := registrySnapshot()
+	return ListOrdered(TieBreakName)
+}
 
-	sort.Slice(out, func(i, j int) bool {
-		pi, pj := out[i].Priority(), out[j].Priority()
+// ListOrdered returns all registered checks sorted by Priority asc, with ties
+// resolved by tb. The result is deterministic for a given registry state and
+// is the caller's own copy: later registrations do not change it.
+func ListOrdered(tb TieBreak) []CheckUnit {
+	st := snapshot()
+	if tb != TieBreakRegistration {
+		tb = TieBreakName
+	}
+
+	return slices.Clone(st.ordered[tb])
+}
+
+// SortUnits sorts units in place by Priority asc. With TieBreakName ties are ordered by name;
+// with TieBreakRegistration the incoming slice order is kept for ties.
+func SortUnits(units []CheckUnit, tb TieBreak) {
+	sort.SliceStable(units, func(i, j int) bool {
+		pi, pj := units[i].Priority(), units[j].Priority()
 		if pi != pj {
 			return pi < pj
 		}
 
-		ki := normalizeName(out[i].Name())
-		kj := normalizeName(out[j].Name())
+		if tb == TieBreakRegistration {
+			return false
+		}
+
+		ki := normalizeName(units[i].Name())
+		kj := normalizeName(units[j].Name())
 		if ki != kj {
 			return ki < kj
 		}
 
-		return out[i].Name() < out[j].Name()
+		return units[i].Name() < units[j].Name()
 	})
-
-	return out
 }
 
 // Reset clears the registry. It is intended for tests.
 func Reset() {
-	mu.Lock()
-	byName = map[string]CheckUnit{}
-	mu.Unlock()
-}
-
-func registrySnapshot() []CheckUnit {
-	mu.RLock()
-	defer mu.RUnlock()
-
-	out := make([]CheckUnit, 0, len(byName))
-	for _, c := range byName {
-		out = append(out, c)
-	}
-
-	return out
+	writeMu.Lock()
+	current.Store(emptyRegistry)
+	writeMu.Unlock()
 }
 
 func normalizeName(name string) string {
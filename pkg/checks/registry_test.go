@@ -5,6 +5,8 @@ import (
 	"context"
 	"reflect"
 	"sort"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
@@ -110,6 +112,47 @@ func TestListSorted_SortsByPriorityThenName(t *testing.T) {
 	}
 }
 
+func TestListOrdered_TieBreakRegistration(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "zeta", checks.WithPriority(1)))
+	_, _ = checks.Register(mkCheckOK(t, "alpha", checks.WithPriority(1)))
+	_, _ = checks.Register(mkCheckOK(t, "first", checks.WithPriority(0)))
+	_, _ = checks.Register(mkCheckOK(t, "mid", checks.WithPriority(1)))
+	// replacing keeps the original sequence
+	_, _ = checks.Register(mkCheckOK(t, "ZETA", checks.WithPriority(1)))
+
+	got := names(checks.ListOrdered(checks.TieBreakRegistration))
+	want := []string{"first", "ZETA", "alpha", "mid"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("order mismatch\n got: %v\nwant: %v", got, want)
+	}
+
+	got = names(checks.ListOrdered(checks.TieBreakName))
+	want = []string{"first", "alpha", "mid", "ZETA"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("name order mismatch\n got: %v\nwant: %v", got, want)
+	}
+}
+
+func TestListOrdered_ResetRestartsSequence(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "b"))
+	_, _ = checks.Register(mkCheckOK(t, "a"))
+	checks.Reset()
+	_, _ = checks.Register(mkCheckOK(t, "a"))
+	_, _ = checks.Register(mkCheckOK(t, "b"))
+
+	got := names(checks.ListOrdered(checks.TieBreakRegistration))
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("order mismatch\n got: %v\nwant: %v", got, want)
+	}
+}
+
 func TestListSorted_ReturnsCopies_NotAliases(t *testing.T) {
 	checks.Reset()
 	t.Cleanup(checks.Reset)
@@ -324,3 +367,66 @@ func (f fakeRegistryCheck) Run(
 func (f fakeRegistryCheck) FailFast() bool { return f.failFast }
 
 func (f fakeRegistryCheck) Priority() int { return f.priority }
+
+func TestListSorted_SnapshotIsNotAffectedByLaterRegistration(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "a", checks.WithPriority(2)))
+	before := checks.ListSorted()
+
+	_, _ = checks.Register(mkCheckOK(t, "b", checks.WithPriority(1)))
+	_, _ = checks.Register(mkCheckOK(t, "a", checks.WithPriority(3)))
+
+	if len(before) != 1 || before[0].Priority() != 2 {
+		t.Fatalf("snapshot changed after registration: %v", names(before))
+	}
+	if got := names(checks.ListSorted()); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Fatalf("new snapshot = %v, want [b a]", got)
+	}
+
+	// callers own the returned slice
+	before[0] = nil
+	if c, ok := checks.Lookup("a"); !ok || c == nil {
+		t.Fatalf("mutating a snapshot must not touch the registry")
+	}
+}
+
+func TestRegister_ConcurrentWithReaders(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	for i := range 5 {
+		_, _ = checks.Register(mkCheckOK(t, "base-"+strconv.Itoa(i), checks.WithPriority(i)))
+	}
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 200 {
+				units, err := checks.ResolveRun(checks.RunOptions{})
+				if err != nil || len(units) < 5 {
+					t.Errorf("ResolveRun: %d units, err %v", len(units), err)
+					return
+				}
+				for i := 1; i < len(units); i++ {
+					if units[i].Priority() < units[i-1].Priority() {
+						t.Errorf("unsorted snapshot")
+						return
+					}
+				}
+				_ = checks.RegistrationWarnings()
+			}
+		})
+	}
+	wg.Go(func() {
+		for i := range 200 {
+			_, _ = checks.Register(mkCheckOK(t, "late-"+strconv.Itoa(i%20), checks.WithPriority(i%7)))
+		}
+	})
+	wg.Wait()
+
+	if got := len(checks.List()); got != 25 {
+		t.Fatalf("expected 25 registered checks, got %d", got)
+	}
+}
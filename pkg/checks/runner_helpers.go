@@ -23,7 +23,7 @@ func RunWithFix(ctx context.Context, a Artifact, opts RunOptions, r RunRecipe) C
 		return OutcomeKeep(Error, r.Name, "recipe.Validate is nil", a, "")
 	}
 	if err := ctx.Err(); err != nil {
-		return OutcomeKeep(Error, r.Name, err.Error(), a, "")
+		return withErr(OutcomeKeep(Error, r.Name, err.Error(), a, ""), err)
 	}
 
 	// 1) validate (panic-safe)
@@ -33,7 +33,10 @@ func RunWithFix(ctx context.Context, a Artifact, opts RunOptions, r RunRecipe) C
 		if msg == "" {
 			msg = "validation error: " + res.Err.Error()
 		}
-		return OutcomeKeep(Error, r.Name, msg, a, "")
+		return withErr(OutcomeKeep(Error, r.Name, msg, a, ""), res.Err)
+	}
+	if res.Skipped {
+		return OutcomeKeep(Skipped, r.Name, nz(res.Msg, "skipped"), a, "")
 	}
 	if res.OK {
 		return OutcomeKeep(Pass, r.Name, nz(r.PassMsg, nz(res.Msg, "ok")), a, "")
@@ -41,55 +44,67 @@ func RunWithFix(ctx context.Context, a Artifact, opts RunOptions, r RunRecipe) C
 
 	// 2) policy: attempt fix?
 	if r.Fix == nil || !shouldAttemptFix(opts, Fail) {
+		res = previewFindings(ctx, r, a, opts, res)
 		msg := nz(res.Msg, "validation failed")
 		if failAs == Error {
-			return OutcomeKeep(Error, r.Name, msg, a, "")
+			return withFindings(OutcomeKeep(Error, r.Name, msg, a, ""), r.Name, res)
 		}
-		return OutcomeKeep(failAs, r.Name, msg, a, "")
+		return withFindings(OutcomeKeep(failAs, r.Name, msg, a, ""), r.Name, res)
+	}
+	if r.Destructive && !opts.AllowDestructive {
+		res = previewFindings(ctx, r, a, opts, res)
+		return withFindings(OutcomeKeep(failAs, r.Name, nz(res.Msg, "validation failed"), a,
+			"destructive auto-fix skipped (set AllowDestructive to apply it)"), r.Name, res)
 	}
 	if err := ctx.Err(); err != nil {
-		return OutcomeKeep(failAs, r.Name, "cancelled before auto-fix: "+err.Error(), a, "")
+		return withFindings(OutcomeKeep(failAs, r.Name, "cancelled before auto-fix: "+err.Error(), a, ""), r.Name, res)
 	}
 
 	// 3) fix (panic-safe)
 	fr, fixErr := safeFix(r.Name, r.Fix, ctx, a)
 	if fixErr != nil {
 		if errors.Is(fixErr, ErrNoFix) {
-			return OutcomeKeep(failAs, r.Name, nz(res.Msg, "validation failed (no auto-fix)"), a, fr.Note)
+			return withFindings(OutcomeKeep(failAs, r.Name, nz(res.Msg, "validation failed (no auto-fix)"), a, fr.Note), r.Name, res)
 		}
-		return OutcomeKeep(Error, r.Name, "failed to auto-fix: "+fixErr.Error(), a, "")
+		return withErr(OutcomeKeep(Error, r.Name, "failed to auto-fix: "+fixErr.Error(), a, ""), fixErr)
 	}
 
 	// 4) propagate new state
 	outData, outPath, changed := propagateAfterFix(a, fr)
-	final := FixResult{Data: outData, Path: outPath, DidChange: changed, Note: fr.Note}
+	final := FixResult{Data: outData, Path: outPath, DidChange: changed, Note: fr.Note, Langs: fr.Langs}
+	outLangs := a.Langs
+	if fr.Langs != nil {
+		outLangs = fr.Langs
+	}
 
 	// 5) maybe revalidate (respect context again)
 	if err := ctx.Err(); err != nil {
 		// fix applied, but cancelled before re-validate
 		msg := nz(r.AppliedMsg, "auto-fix applied (cancelled before revalidate)")
-		st := Warn
-		if failAs == Error {
-			st = Error
-		}
-		return OutcomeWithFinal(st, r.Name, msg, final)
+		return OutcomeWithFinal(appliedStatus(failAs), r.Name, msg, final)
 	}
 
 	if opts.RerunAfterFix {
-		after := safeValidate(r.Name, r.Validate, ctx, Artifact{Data: outData, Path: outPath, Langs: a.Langs})
+		after := safeValidate(r.Name, r.Validate, ctx, Artifact{Data: outData, Path: outPath, Langs: outLangs, Cache: a.Cache})
 		if after.Err != nil {
 			msg := after.Msg
 			if msg == "" {
 				msg = "revalidation error: " + after.Err.Error()
 			}
-			return OutcomeWithFinal(Error, r.Name, msg, final)
+			return withErr(OutcomeWithFinal(Error, r.Name, msg, final), after.Err)
 		}
 		if after.OK {
 			st := nzStatus(r.StatusAfterFixed, Warn) // default: "fixed → WARN"
 			return OutcomeWithFinal(st, r.Name, nz(r.FixedMsg, "fixed"), final)
 		}
 		msg := nzPref(nz(r.StillBadMsg, "auto-fix attempted but still invalid"), after.Msg, ": ")
-		return OutcomeWithFinal(failAs, r.Name, msg, final)
+		if res.Msg != "" {
+			msg = "was: " + res.Msg + "; " + msg
+		}
+		out := withFindings(OutcomeWithFinal(failAs, r.Name, msg, final), r.Name, after)
+		out.Result.PreFixMessage = res.Msg
+		out.Result.PreFixFindings = stampFindings(r.Name, failAs, res.Findings)
+		return out
 	}
 
 	// no revalidate: just report that we applied something
@@ -97,11 +112,18 @@ func RunWithFix(ctx context.Context, a Artifact, opts RunOptions, r RunRecipe) C
 	if !changed {
 		applied = nz(fr.Note, "auto-fix attempted (no changes)")
 	}
-	st := Warn
-	if failAs == Error {
-		st = Error
+	return OutcomeWithFinal(appliedStatus(failAs), r.Name, applied, final)
+}
+
+// appliedStatus is the status of a fix applied without revalidation: WARN, unless the
+// check fails as ERROR or is informational (INFO).
+func appliedStatus(failAs Status) Status {
+	switch failAs {
+	case Error, Info:
+		return failAs
+	default:
+		return Warn
 	}
-	return OutcomeWithFinal(st, r.Name, applied, final)
 }
 
 func NoFix(a Artifact, note string) (FixResult, error) {
@@ -113,6 +135,37 @@ func NoFix(a Artifact, note string) (FixResult, error) {
 	}, ErrNoFix
 }
 
+// withErr attaches the underlying system error to an ERROR outcome.
+func withErr(out CheckOutcome, err error) CheckOutcome {
+	out.Result.Err = err
+	return out
+}
+
+// withFindings attaches located findings (and the truncation mark) of a failed
+// validation to an outcome, stamping the check name and the outcome status.
+func withFindings(out CheckOutcome, name string, res ValidationResult) CheckOutcome {
+	out.Result.Truncated = res.Truncated
+	out.Result.Findings = stampFindings(name, out.Result.Status, res.Findings)
+	return out
+}
+
+// stampFindings copies findings with Check set to name and an empty Severity set
+// to severity; nil when there are none.
+func stampFindings(name string, severity Status, findings []Finding) []Finding {
+	if len(findings) == 0 {
+		return nil
+	}
+	out := make([]Finding, len(findings))
+	for i, f := range findings {
+		f.Check = name
+		if f.Severity == "" {
+			f.Severity = severity
+		}
+		out[i] = f
+	}
+	return out
+}
+
 // panic-safe wrappers
 
 func safeFix(name string, f FixFunc, ctx context.Context, a Artifact) (fr FixResult, err error) {
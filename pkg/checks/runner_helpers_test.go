@@ -2,7 +2,10 @@ package checks_test
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
+	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -159,6 +162,100 @@ func TestRunWithFix_ValidationPass(t *testing.T) {
 	}
 }
 
+func TestRunWithFix_ValidationSkipped(t *testing.T) {
+	t.Parallel()
+
+	out := checks.RunWithFix(
+		context.Background(),
+		testArtifact(),
+		checks.RunOptions{FixMode: checks.FixAlways},
+		checks.RunRecipe{
+			Name:    "skip-check",
+			PassMsg: "custom pass",
+			Validate: func(context.Context, checks.Artifact) checks.ValidationResult {
+				return checks.ValidationResult{Skipped: true, Msg: "nothing to compare with"}
+			},
+			Fix: func(context.Context, checks.Artifact) (checks.FixResult, error) {
+				t.Fatalf("Fix should not be called when validation is skipped")
+				return checks.FixResult{}, nil
+			},
+		},
+	)
+
+	assertOutcome(t, out, checks.Skipped, "skip-check", "nothing to compare with")
+	assertNoFixApplied(t, out, "bad", "old.csv")
+}
+
+func TestRunWithFix_InfoCheck(t *testing.T) {
+	t.Parallel()
+
+	recipe := checks.RunRecipe{
+		Name:   "info-check",
+		FailAs: checks.Info,
+		Validate: func(context.Context, checks.Artifact) checks.ValidationResult {
+			return checks.ValidationResult{OK: false, Msg: "worth knowing"}
+		},
+		Fix: func(context.Context, checks.Artifact) (checks.FixResult, error) {
+			return checks.FixResult{Data: []byte("fixed")}, nil
+		},
+	}
+
+	out := checks.RunWithFix(context.Background(), testArtifact(), checks.RunOptions{}, recipe)
+	assertOutcome(t, out, checks.Info, "info-check", "worth knowing")
+
+	out = checks.RunWithFix(context.Background(), testArtifact(), checks.RunOptions{FixMode: checks.FixAlways}, recipe)
+	if out.Result.Status != checks.Info {
+		t.Fatalf("status after fix = %s, want INFO", out.Result.Status)
+	}
+}
+
+func TestRunWithFix_KeepsTypedParseError(t *testing.T) {
+	t.Parallel()
+
+	pe := &csv.ParseError{StartLine: 3, Line: 4, Column: 7, Err: csv.ErrQuote}
+
+	out := checks.RunWithFix(context.Background(), checks.Artifact{Data: []byte("x")}, checks.RunOptions{}, checks.RunRecipe{
+		Name: "parse",
+		Validate: func(context.Context, checks.Artifact) checks.ValidationResult {
+			return checks.ValidationResult{OK: false, Msg: "cannot parse CSV", Err: pe}
+		},
+	})
+
+	if out.Result.Status != checks.Error {
+		t.Fatalf("Status = %s, want ERROR", out.Result.Status)
+	}
+	if out.Result.Message != "cannot parse CSV" {
+		t.Fatalf("Message = %q, want the validation message", out.Result.Message)
+	}
+
+	got, ok := out.Result.ParseError()
+	if !ok || got != pe {
+		t.Fatalf("ParseError() = %v, %v; want the original *csv.ParseError", got, ok)
+	}
+	if got.Line != 4 || got.Column != 7 || !errors.Is(got, csv.ErrQuote) {
+		t.Fatalf("unexpected parse error details: %+v", got)
+	}
+}
+
+func TestValidationResult_ParseError(t *testing.T) {
+	t.Parallel()
+
+	pe := &csv.ParseError{Line: 2, Column: 1, Err: csv.ErrBareQuote}
+	res := checks.ValidationResult{Err: fmt.Errorf("wrapped: %w", pe)}
+
+	got, ok := res.ParseError()
+	if !ok || got != pe {
+		t.Fatalf("ParseError() = %v, %v; want wrapped parse error", got, ok)
+	}
+
+	if _, ok := (checks.ValidationResult{Err: errors.New("other")}).ParseError(); ok {
+		t.Fatalf("ParseError() must be false for non-parse errors")
+	}
+	if _, ok := (checks.CheckResult{}).ParseError(); ok {
+		t.Fatalf("ParseError() must be false without an error")
+	}
+}
+
 func TestRunWithFix_ValidationError(t *testing.T) {
 	t.Parallel()
 
@@ -534,9 +631,9 @@ func TestRunWithFix_RerunAfterFixStillInvalid(t *testing.T) {
 			StillBadMsg: "auto-fix failed validation",
 			Validate: func(_ context.Context, a checks.Artifact) checks.ValidationResult {
 				if string(a.Data) == "bad" {
-					return checks.ValidationResult{OK: false, Msg: "initial invalid"}
+					return checks.ValidationResult{OK: false, Msg: "initial invalid", Findings: []checks.Finding{{Row: 2}, {Row: 3}}}
 				}
-				return checks.ValidationResult{OK: false, Msg: "still invalid after fix"}
+				return checks.ValidationResult{OK: false, Msg: "still invalid after fix", Findings: []checks.Finding{{Row: 3}}}
 			},
 			Fix: func(context.Context, checks.Artifact) (checks.FixResult, error) {
 				return checks.FixResult{Data: []byte("fixed-ish")}, nil
@@ -549,9 +646,18 @@ func TestRunWithFix_RerunAfterFixStillInvalid(t *testing.T) {
 		out,
 		checks.Fail,
 		"rerun-still-invalid",
-		"auto-fix failed validation: still invalid after fix",
+		"was: initial invalid; auto-fix failed validation: still invalid after fix",
 	)
 	assertFixApplied(t, out, "fixed-ish", "old.csv")
+	if out.Result.PreFixMessage != "initial invalid" {
+		t.Fatalf("PreFixMessage = %q, want %q", out.Result.PreFixMessage, "initial invalid")
+	}
+	if len(out.Result.PreFixFindings) != 2 || out.Result.PreFixFindings[0].Check != "rerun-still-invalid" {
+		t.Fatalf("unexpected PreFixFindings: %+v", out.Result.PreFixFindings)
+	}
+	if len(out.Result.Findings) != 1 || out.Result.Findings[0].Row != 3 {
+		t.Fatalf("unexpected Findings: %+v", out.Result.Findings)
+	}
 }
 
 func TestRunWithFix_RerunAfterFixValidationError(t *testing.T) {
@@ -772,3 +878,73 @@ func TestRunWithFix_WarnCheckFixIfFailedStillAttemptsFix(t *testing.T) {
 	assertOutcome(t, out, checks.Warn, "warn-fix", "auto-fix applied")
 	assertFixApplied(t, out, "fixed", "old.csv")
 }
+
+func TestRunWithFix_DestructiveFixRequiresOptIn(t *testing.T) {
+	t.Parallel()
+
+	fixCalls := 0
+	recipe := checks.RunRecipe{
+		Name: "destructive",
+		Validate: func(_ context.Context, a checks.Artifact) checks.ValidationResult {
+			return checks.ValidationResult{OK: string(a.Data) == "fixed", Msg: "broken"}
+		},
+		Fix: func(context.Context, checks.Artifact) (checks.FixResult, error) {
+			fixCalls++
+			return checks.FixResult{Data: []byte("fixed"), DidChange: true}, nil
+		},
+		Destructive:      true,
+		StatusAfterFixed: checks.Pass,
+	}
+	a := checks.Artifact{Data: []byte("raw")}
+	opts := checks.RunOptions{FixMode: checks.FixAlways, RerunAfterFix: true}
+
+	out := checks.RunWithFix(context.Background(), a, opts, recipe)
+	if fixCalls != 0 {
+		t.Fatalf("destructive fix ran without AllowDestructive")
+	}
+	if out.Result.Status != checks.Fail || out.Result.Message != "broken" {
+		t.Fatalf("result = %+v, want FAIL broken", out.Result)
+	}
+	if !strings.Contains(out.Final.Note, "AllowDestructive") || string(out.Final.Data) != "raw" {
+		t.Fatalf("final = %+v, want untouched data and a note", out.Final)
+	}
+
+	opts.AllowDestructive = true
+	out = checks.RunWithFix(context.Background(), a, opts, recipe)
+	if fixCalls != 1 || out.Result.Status != checks.Pass || string(out.Final.Data) != "fixed" {
+		t.Fatalf("with AllowDestructive: calls=%d outcome=%+v", fixCalls, out)
+	}
+}
+
+func TestRunWithFix_StampsFindingsWithCheckName(t *testing.T) {
+	t.Parallel()
+
+	recipe := checks.RunRecipe{
+		Name: "located",
+		Validate: func(_ context.Context, a checks.Artifact) checks.ValidationResult {
+			return checks.ValidationResult{
+				OK:  false,
+				Msg: "broken",
+				Findings: []checks.Finding{
+					{Code: "empty-term", Row: 2, Field: 1, Column: "term", Message: "empty term"},
+					{Row: 3, Message: "odd", Severity: checks.Info},
+				},
+			}
+		},
+		FailAs: checks.Warn,
+	}
+
+	out := checks.RunWithFix(context.Background(), checks.Artifact{Data: []byte("x")}, checks.RunOptions{}, recipe)
+	if out.Result.Status != checks.Warn {
+		t.Fatalf("status = %s, want WARN", out.Result.Status)
+	}
+
+	// Severity defaults to the result status; one set by the check is kept.
+	want := []checks.Finding{
+		{Check: "located", Code: "empty-term", Row: 2, Field: 1, Column: "term", Message: "empty term", Severity: checks.Warn},
+		{Check: "located", Row: 3, Message: "odd", Severity: checks.Info},
+	}
+	if !reflect.DeepEqual(out.Result.Findings, want) {
+		t.Fatalf("findings = %+v, want %+v", out.Result.Findings, want)
+	}
+}
@@ -0,0 +1,25 @@
+// Package semantic provides the built-in checks of the semantic band (meaning across rows and languages:
+// overlaps, tags, translations).
+// Importing it registers nothing; call checks.LoadSemantic (or checks.LoadAll) afterwards:
+//
+//	import _ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/semantic"
+//
+//	if err := checks.LoadSemantic(); err != nil { ... }
+package semantic
+
+import (
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/19_term_overlaps"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/20_language_mismatch"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/21_no_redundant_locale_descriptions"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/22_no_comment_rows"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/23_tags_per_row"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/24_no_forbidden_translations"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/25_no_caseless_casesensitive_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/26_no_double_spaces"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/27_no_trailing_term_punctuation"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/29_lokalise_compat"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/34_known_tags"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/35_trivial_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/36_near_duplicate_terms"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/42_unused_terms"
+)
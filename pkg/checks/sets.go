@@ -0,0 +1,245 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrUnknownCheckSet is returned when RunOptions.CheckSet names a set that was never registered.
+	ErrUnknownCheckSet = errors.New("unknown check set")
+	// ErrUnknownCheck is matched by errors naming checks that are not registered (see UnknownChecksError).
+	ErrUnknownCheck = errors.New("unknown check")
+	// ErrDuplicateOverride is returned by ResolveRun when PriorityOverrides, SeverityOverrides
+	// or CheckTimeouts names the same check twice with different case ("Rows" and "rows"):
+	// either value could win.
+	ErrDuplicateOverride = errors.New("check overridden twice with different case")
+)
+
+// Set is a named, immutable subset of registered checks (e.g. per tenant).
+// Members are referenced by name and resolved against the registry at run time,
+// so one process can serve different check sets without touching the registry.
+type Set struct {
+	name    string
+	names   []string
+	members map[string]struct{}
+}
+
+var (
+	setsMu sync.RWMutex
+	sets   = map[string]*Set{}
+)
+
+// NewSet builds a check set. Names are matched case-insensitively; blanks and duplicates are dropped.
+func NewSet(name string, names ...string) *Set {
+	s := &Set{
+		name:    strings.TrimSpace(name),
+		members: make(map[string]struct{}, len(names)),
+	}
+
+	for _, n := range names {
+		key := normalizeName(n)
+		if key == "" {
+			continue
+		}
+		if _, dup := s.members[key]; dup {
+			continue
+		}
+		s.members[key] = struct{}{}
+		s.names = append(s.names, strings.TrimSpace(n))
+	}
+
+	return s
+}
+
+// Name returns the set name.
+func (s *Set) Name() string { return s.name }
+
+// Names returns a copy of the member check names in declaration order.
+func (s *Set) Names() []string {
+	out := make([]string, len(s.names))
+	copy(out, s.names)
+	return out
+}
+
+// Contains reports whether the set includes the named check (case-insensitive).
+func (s *Set) Contains(name string) bool {
+	_, ok := s.members[normalizeName(name)]
+	return ok
+}
+
+// Resolve returns the set members in run order (see ListOrdered).
+// It fails with an *UnknownChecksError if any member is not registered.
+func (s *Set) Resolve(tb TieBreak) ([]CheckUnit, error) {
+	return s.resolve(snapshot(), tb)
+}
+
+func (s *Set) resolve(st *registryState, tb TieBreak) ([]CheckUnit, error) {
+	if tb != TieBreakRegistration {
+		tb = TieBreakName
+	}
+	all := st.ordered[tb]
+
+	out := make([]CheckUnit, 0, len(s.members))
+	found := make(map[string]struct{}, len(s.members))
+	for _, unit := range all {
+		key := normalizeName(unit.Name())
+		if _, ok := s.members[key]; ok {
+			out = append(out, unit)
+			found[key] = struct{}{}
+		}
+	}
+
+	var missing []string
+	for _, n := range s.names {
+		if _, ok := found[normalizeName(n)]; !ok {
+			missing = append(missing, n)
+		}
+	}
+	if err := newUnknownChecksError(st, fmt.Sprintf("check set %q", s.name), missing); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// RegisterSet adds or replaces a named check set.
+// It returns replaced=true if a set with the same normalized name already existed.
+func RegisterSet(s *Set) (bool, error) {
+	if s == nil {
+		return false, errors.New("checks.RegisterSet: nil set")
+	}
+
+	name := normalizeName(s.name)
+	if name == "" {
+		return false, errors.New("checks.RegisterSet: empty name")
+	}
+
+	setsMu.Lock()
+	_, existed := sets[name]
+	sets[name] = s
+	setsMu.Unlock()
+
+	return existed, nil
+}
+
+// LookupSet returns a registered check set by its case-insensitive name.
+func LookupSet(name string) (*Set, bool) {
+	setsMu.RLock()
+	s, ok := sets[normalizeName(name)]
+	setsMu.RUnlock()
+
+	return s, ok
+}
+
+// ResolveRun returns the checks a run with these options should execute:
+// the named CheckSet when set, otherwise every registered check, narrowed by Only
+// and Skip, in run order (PriorityOverrides applied).
+// Set members and Only names that name no registered check fail the run with an
+// *UnknownChecksError instead of silently running fewer checks. Skip names, Settings keys
+// and override and timeout keys only configure checks: they fail the run when they name
+// no registered and no provided check, so a band-only load (LoadStructural) can share
+// a configuration written for every check.
+// An empty registry while check packages are imported fails with ErrNoChecksLoaded.
+func ResolveRun(opts RunOptions) ([]CheckUnit, error) {
+	st := snapshot()
+
+	if len(st.ordered[TieBreakName]) == 0 {
+		if n := providedCount(); n > 0 {
+			return nil, fmt.Errorf("%w: %d checks are provided but none is registered (call checks.LoadAll or a per-band Load)", ErrNoChecksLoaded, n)
+		}
+	}
+
+	units := slices.Clone(st.ordered[TieBreakName])
+	if opts.TieBreak == TieBreakRegistration {
+		units = slices.Clone(st.ordered[TieBreakRegistration])
+	}
+
+	if strings.TrimSpace(opts.CheckSet) != "" {
+		s, ok := LookupSet(opts.CheckSet)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownCheckSet, opts.CheckSet)
+		}
+
+		var err error
+		if units, err = s.resolve(st, opts.TieBreak); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := newUnknownChecksError(st, "settings", unconfigurableNames(st, maps.Keys(opts.Settings))); err != nil {
+		return nil, err
+	}
+	if err := validateSettings(opts.Settings); err != nil {
+		return nil, err
+	}
+
+	if len(opts.Only) > 0 || len(opts.Skip) > 0 {
+		if err := newUnknownChecksError(st, "only", unknownNames(st, slices.Values(opts.Only))); err != nil {
+			return nil, err
+		}
+		if err := newUnknownChecksError(st, "skip", unconfigurableNames(st, slices.Values(opts.Skip))); err != nil {
+			return nil, err
+		}
+		units = selectUnits(units, opts.Only, opts.Skip)
+	}
+
+	if len(opts.SeverityOverrides) > 0 {
+		if err := newUnknownChecksError(st, "severity overrides", unconfigurableNames(st, maps.Keys(opts.SeverityOverrides))); err != nil {
+			return nil, err
+		}
+		if err := errors.Join(caseDuplicates(ErrDuplicateOverride, "severity overrides", maps.Keys(opts.SeverityOverrides))...); err != nil {
+			return nil, err
+		}
+		if err := validateSeverityOverrides(opts.SeverityOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := newUnknownChecksError(st, "check timeouts", unconfigurableNames(st, maps.Keys(opts.CheckTimeouts))); err != nil {
+		return nil, err
+	}
+	if err := errors.Join(caseDuplicates(ErrDuplicateOverride, "check timeouts", maps.Keys(opts.CheckTimeouts))...); err != nil {
+		return nil, err
+	}
+
+	if len(opts.PriorityOverrides) > 0 {
+		if err := newUnknownChecksError(st, "priority overrides", unconfigurableNames(st, maps.Keys(opts.PriorityOverrides))); err != nil {
+			return nil, err
+		}
+		if err := errors.Join(caseDuplicates(ErrDuplicateOverride, "priority overrides", maps.Keys(opts.PriorityOverrides))...); err != nil {
+			return nil, err
+		}
+		st.reorder(units, opts.PriorityOverrides, opts.TieBreak)
+	}
+
+	return units, nil
+}
+
+// selectUnits keeps the units named in only (all when only is empty) that are not named in skip.
+func selectUnits(units []CheckUnit, only, skip []string) []CheckUnit {
+	names := func(list []string) map[string]bool {
+		m := make(map[string]bool, len(list))
+		for _, n := range list {
+			m[normalizeName(n)] = true
+		}
+		return m
+	}
+	keep, drop := names(only), names(skip)
+
+	return slices.DeleteFunc(units, func(u CheckUnit) bool {
+		name := normalizeName(u.Name())
+		return drop[name] || (len(keep) > 0 && !keep[name])
+	})
+}
+
+// ResetSets clears the check set registry. It is intended for tests.
+func ResetSets() {
+	setsMu.Lock()
+	sets = map[string]*Set{}
+	setsMu.Unlock()
+}
@@ -0,0 +1,341 @@
+package checks_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestNewSet_NormalizesMembers(t *testing.T) {
+	t.Parallel()
+
+	s := checks.NewSet(" tenant-a ", "Alpha", "", "alpha", " beta ")
+
+	if s.Name() != "tenant-a" {
+		t.Fatalf("Name = %q, want tenant-a", s.Name())
+	}
+	if got, want := s.Names(), []string{"Alpha", "beta"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Names = %v, want %v", got, want)
+	}
+	if !s.Contains("ALPHA") || s.Contains("gamma") {
+		t.Fatalf("Contains mismatch")
+	}
+}
+
+func TestSetResolve_UsesRunOrderAndReportsUnknown(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "c", checks.WithPriority(3)))
+	_, _ = checks.Register(mkCheckOK(t, "a", checks.WithPriority(1)))
+	_, _ = checks.Register(mkCheckOK(t, "b", checks.WithPriority(2)))
+
+	units, err := checks.NewSet("s", "c", "a").Resolve(checks.TieBreakName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := names(units); !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Fatalf("order = %v, want [a c]", got)
+	}
+
+	_, err = checks.NewSet("s", "a", "nope").Resolve(checks.TieBreakName)
+	if !errors.Is(err, checks.ErrUnknownCheck) {
+		t.Fatalf("expected ErrUnknownCheck, got %v", err)
+	}
+}
+
+func TestResolveRun_NamedSet(t *testing.T) {
+	checks.Reset()
+	checks.ResetSets()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(checks.ResetSets)
+
+	_, _ = checks.Register(mkCheckOK(t, "a", checks.WithPriority(1)))
+	_, _ = checks.Register(mkCheckOK(t, "b", checks.WithPriority(2)))
+
+	replaced, err := checks.RegisterSet(checks.NewSet("Tenant-A", "b"))
+	if err != nil || replaced {
+		t.Fatalf("RegisterSet: replaced=%v err=%v", replaced, err)
+	}
+
+	units, err := checks.ResolveRun(checks.RunOptions{CheckSet: "tenant-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := names(units); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Fatalf("units = %v, want [b]", got)
+	}
+
+	units, err = checks.ResolveRun(checks.RunOptions{})
+	if err != nil || len(units) != 2 {
+		t.Fatalf("without a set all checks must run: %v %v", names(units), err)
+	}
+
+	_, err = checks.ResolveRun(checks.RunOptions{CheckSet: "missing"})
+	if !errors.Is(err, checks.ErrUnknownCheckSet) {
+		t.Fatalf("expected ErrUnknownCheckSet, got %v", err)
+	}
+}
+
+func TestResolveRun_OnlyAndSkip(t *testing.T) {
+	checks.Reset()
+	checks.ResetSets()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(checks.ResetSets)
+
+	_, _ = checks.Register(mkCheckOK(t, "a", checks.WithPriority(1)))
+	_, _ = checks.Register(mkCheckOK(t, "b", checks.WithPriority(2)))
+	_, _ = checks.Register(mkCheckOK(t, "c", checks.WithPriority(3)))
+	_, _ = checks.RegisterSet(checks.NewSet("bc", "b", "c"))
+
+	cases := []struct {
+		name string
+		opts checks.RunOptions
+		want []string
+	}{
+		{"only", checks.RunOptions{Only: []string{" C ", "A"}}, []string{"a", "c"}},
+		{"skip", checks.RunOptions{Skip: []string{"b"}}, []string{"a", "c"}},
+		{"skip wins over only", checks.RunOptions{Only: []string{"a", "b"}, Skip: []string{"B"}}, []string{"a"}},
+		{"only within a set", checks.RunOptions{CheckSet: "bc", Only: []string{"a", "c"}}, []string{"c"}},
+		{"overrides still apply", checks.RunOptions{Skip: []string{"b"}, PriorityOverrides: map[string]int{"c": 0}}, []string{"c", "a"}},
+	}
+
+	for _, tc := range cases {
+		units, err := checks.ResolveRun(tc.opts)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if got := names(units); !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("%s: units = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	// the registry itself is untouched
+	if units, _ := checks.ResolveRun(checks.RunOptions{}); len(units) != 3 {
+		t.Fatalf("expected all checks without Only/Skip, got %v", names(units))
+	}
+
+	var ue *checks.UnknownChecksError
+	_, err := checks.ResolveRun(checks.RunOptions{Skip: []string{"d"}})
+	if !errors.As(err, &ue) || ue.Source != "skip" || !reflect.DeepEqual(ue.Names, []string{"d"}) {
+		t.Fatalf("expected unknown skip name, got %v", err)
+	}
+	_, err = checks.ResolveRun(checks.RunOptions{Only: []string{"bb"}})
+	if !errors.As(err, &ue) || ue.Source != "only" || ue.Suggestions["bb"] != "b" {
+		t.Fatalf("expected unknown only name with a suggestion, got %v", err)
+	}
+}
+
+func TestRegisterSet_RejectsInvalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := checks.RegisterSet(nil); err == nil {
+		t.Fatalf("expected error for nil set")
+	}
+	if _, err := checks.RegisterSet(checks.NewSet("  ", "a")); err == nil {
+		t.Fatalf("expected error for empty set name")
+	}
+}
+
+func TestResolveRun_UnknownSettingsChecks(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "warn-term-overlaps", checks.WithPriority(1)))
+
+	_, err := checks.ResolveRun(checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		"WARN-TERM-OVERLAPS": {"enabled": "true"},
+	}})
+	if err != nil {
+		t.Fatalf("settings for a registered check must be accepted, got %v", err)
+	}
+
+	_, err = checks.ResolveRun(checks.RunOptions{Settings: map[string]checks.CheckSettings{
+		"warn-term-overlap": {"enabled": "true"},
+		"zzz":               {"x": "1"},
+	}})
+	if !errors.Is(err, checks.ErrUnknownCheck) {
+		t.Fatalf("expected ErrUnknownCheck, got %v", err)
+	}
+
+	var uce *checks.UnknownChecksError
+	if !errors.As(err, &uce) {
+		t.Fatalf("expected *UnknownChecksError, got %T", err)
+	}
+	if uce.Source != "settings" || !reflect.DeepEqual(uce.Names, []string{"warn-term-overlap", "zzz"}) {
+		t.Fatalf("unexpected error fields: %+v", uce)
+	}
+	if uce.Suggestions["warn-term-overlap"] != "warn-term-overlaps" || uce.Suggestions["zzz"] != "" {
+		t.Fatalf("unexpected suggestions: %v", uce.Suggestions)
+	}
+	if want := "settings: unknown check: warn-term-overlap (did you mean warn-term-overlaps?), zzz"; err.Error() != want {
+		t.Fatalf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"rows", "rows", 0},
+		{"row", "rows", 1},
+		{"rwos", "rows", 1},
+		{"dsecription", "description", 1},
+		{"ключ", "клуч", 1},
+		{"abc", "", 3},
+	}
+
+	for _, c := range cases {
+		if got := checks.EditDistance(c.a, c.b); got != c.want {
+			t.Errorf("EditDistance(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestResolveRun_PriorityOverrides(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "header", checks.WithPriority(10)))
+	_, _ = checks.Register(mkCheckOK(t, "rows", checks.WithPriority(20)))
+	_, _ = checks.Register(mkCheckOK(t, "zeta", checks.WithPriority(5)))
+	_, _ = checks.Register(mkCheckOK(t, "alpha", checks.WithPriority(30)))
+
+	opts := checks.RunOptions{PriorityOverrides: map[string]int{"ROWS": 5, "alpha": 5}}
+
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := names(units); !reflect.DeepEqual(got, []string{"alpha", "rows", "zeta", "header"}) {
+		t.Fatalf("order = %v", got)
+	}
+
+	opts.TieBreak = checks.TieBreakRegistration
+	units, err = checks.ResolveRun(opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := names(units); !reflect.DeepEqual(got, []string{"rows", "zeta", "alpha", "header"}) {
+		t.Fatalf("registration order = %v", got)
+	}
+
+	for _, u := range units {
+		if u.Name() == "rows" && u.Priority() != 20 {
+			t.Fatalf("override must not change the unit's Priority, got %d", u.Priority())
+		}
+	}
+	if got := names(checks.ListSorted()); !reflect.DeepEqual(got, []string{"zeta", "header", "rows", "alpha"}) {
+		t.Fatalf("registry order changed: %v", got)
+	}
+
+	_, err = checks.ResolveRun(checks.RunOptions{PriorityOverrides: map[string]int{"row": 1}})
+	var uce *checks.UnknownChecksError
+	if !errors.As(err, &uce) || uce.Source != "priority overrides" || uce.Suggestions["row"] != "rows" {
+		t.Fatalf("expected unknown check error with suggestion, got %v", err)
+	}
+}
+
+func TestResolveRun_RejectsCaseDuplicatePriorityOverrides(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "rows", checks.WithPriority(20)))
+
+	_, err := checks.ResolveRun(checks.RunOptions{PriorityOverrides: map[string]int{"Rows": 50, "rows": 900}})
+	if !errors.Is(err, checks.ErrDuplicateOverride) || !strings.Contains(err.Error(), `priority overrides "Rows" and "rows"`) {
+		t.Fatalf("err = %v, want ErrDuplicateOverride naming both spellings", err)
+	}
+}
+
+func TestResolveRun_SeverityOverrides(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "rows", checks.WithPriority(20)))
+
+	if _, err := checks.ResolveRun(checks.RunOptions{SeverityOverrides: map[string]checks.Status{"ROWS": checks.Fail}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := checks.ResolveRun(checks.RunOptions{SeverityOverrides: map[string]checks.Status{"row": checks.Fail}})
+	var uce *checks.UnknownChecksError
+	if !errors.As(err, &uce) || uce.Source != "severity overrides" {
+		t.Fatalf("expected unknown check error, got %v", err)
+	}
+
+	_, err = checks.ResolveRun(checks.RunOptions{SeverityOverrides: map[string]checks.Status{"rows": checks.Error}})
+	if !errors.Is(err, checks.ErrInvalidSeverity) {
+		t.Fatalf("expected ErrInvalidSeverity, got %v", err)
+	}
+
+	_, err = checks.ResolveRun(checks.RunOptions{SeverityOverrides: map[string]checks.Status{"Rows": checks.Warn, "rows": checks.Fail}})
+	if !errors.Is(err, checks.ErrDuplicateOverride) {
+		t.Fatalf("expected ErrDuplicateOverride, got %v", err)
+	}
+}
+
+func TestRunOptions_SeverityOverride(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{SeverityOverrides: map[string]checks.Status{" No-Invalid-Flags ": checks.Warn}}
+
+	if st, ok := opts.SeverityOverride("no-invalid-flags"); !ok || st != checks.Warn {
+		t.Fatalf("SeverityOverride = %q, %v; want WARN, true", st, ok)
+	}
+	if _, ok := opts.SeverityOverride("other"); ok {
+		t.Fatalf("unexpected override for an unlisted check")
+	}
+
+	dup := checks.RunOptions{SeverityOverrides: map[string]checks.Status{"Rows": checks.Warn, "rows": checks.Fail, "ROWS": checks.Info}}
+	for range 20 {
+		if st, _ := dup.SeverityOverride("rows"); st != checks.Fail {
+			t.Fatalf("SeverityOverride = %q, want the exact match FAIL", st)
+		}
+		if st, _ := dup.SeverityOverride("rOWS"); st != checks.Info {
+			t.Fatalf("SeverityOverride = %q, want INFO of the first key in sorted order", st)
+		}
+	}
+}
+
+func TestResolveRun_CheckTimeouts(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheckOK(t, "rows", checks.WithPriority(20)))
+
+	_, err := checks.ResolveRun(checks.RunOptions{CheckTimeouts: map[string]time.Duration{"row": time.Second}})
+	var uce *checks.UnknownChecksError
+	if !errors.As(err, &uce) || uce.Source != "check timeouts" {
+		t.Fatalf("expected unknown check error, got %v", err)
+	}
+
+	opts := checks.RunOptions{CheckTimeout: time.Second, CheckTimeouts: map[string]time.Duration{"Rows": 0}}
+	if _, err := checks.ResolveRun(opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := opts.CheckTimeoutFor("rows"); got != 0 {
+		t.Fatalf("CheckTimeoutFor(rows) = %s, want the override", got)
+	}
+	if got := opts.CheckTimeoutFor("other"); got != time.Second {
+		t.Fatalf("CheckTimeoutFor(other) = %s, want the default", got)
+	}
+
+	dup := checks.RunOptions{CheckTimeouts: map[string]time.Duration{"Rows": time.Second, "rows": time.Minute}}
+	if _, err := checks.ResolveRun(dup); !errors.Is(err, checks.ErrDuplicateOverride) {
+		t.Fatalf("expected ErrDuplicateOverride, got %v", err)
+	}
+	for range 20 {
+		if got := dup.CheckTimeoutFor("rows"); got != time.Minute {
+			t.Fatalf("CheckTimeoutFor(rows) = %s, want the exact match", got)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package checks
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SettingEnabled is the setting key that switches on checks registered with WithOptIn.
+const SettingEnabled = "enabled"
+
+// ErrDuplicateSetting is returned by ResolveRun when RunOptions.Settings spells the same
+// check, or the same key of a check, twice with different case ("Keep" and "keep").
+var ErrDuplicateSetting = errors.New("setting given twice with different case")
+
+// Setting returns the raw value of a per-check setting.
+// Both the check name and the key are matched case-insensitively; an exact match wins,
+// so the result never depends on map order (ResolveRun rejects case duplicates anyway).
+func (o RunOptions) Setting(check, key string) (string, bool) {
+	if len(o.Settings) == 0 {
+		return "", false
+	}
+
+	set, ok := lookupFold(o.Settings, check)
+	if !ok {
+		return "", false
+	}
+	v, ok := lookupFold(set, key)
+
+	return strings.TrimSpace(v), ok
+}
+
+// lookupFold returns m[key], or else the value of the first key (in sorted order)
+// equal to key ignoring case and surrounding spaces.
+func lookupFold[V any](m map[string]V, key string) (V, bool) {
+	if v, ok := m[key]; ok {
+		return v, true
+	}
+
+	want := normalizeName(key)
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		if normalizeName(k) == want {
+			return m[k], true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// validateSettings rejects check names and keys that differ only in case.
+func validateSettings(settings map[string]CheckSettings) error {
+	errs := caseDuplicates(ErrDuplicateSetting, "checks", maps.Keys(settings))
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		errs = append(errs, caseDuplicates(ErrDuplicateSetting, "check "+strconv.Quote(name)+" keys", maps.Keys(settings[name]))...)
+	}
+
+	return errors.Join(errs...)
+}
+
+// SettingBool reads a boolean setting. Accepts the usual strconv forms plus yes/no.
+// Missing or unparsable values yield def.
+func (o RunOptions) SettingBool(check, key string, def bool) bool {
+	v, ok := o.Setting(check, key)
+	if !ok {
+		return def
+	}
+
+	switch strings.ToLower(v) {
+	case "yes", "y", "on":
+		return true
+	case "no", "n", "off":
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def
+	}
+
+	return b
+}
+
+// SettingInt reads an integer setting. Missing or unparsable values yield def.
+func (o RunOptions) SettingInt(check, key string, def int) int {
+	v, ok := o.Setting(check, key)
+	if !ok {
+		return def
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+
+	return n
+}
+
+// SettingList reads a comma-separated setting, trimming items and dropping empty ones.
+// A missing setting yields def; an explicitly empty one yields an empty list.
+func (o RunOptions) SettingList(check, key string, def []string) []string {
+	v, ok := o.Setting(check, key)
+	if !ok {
+		return def
+	}
+
+	out := make([]string, 0)
+	for item := range strings.SplitSeq(v, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
+
+// Leading blank lines before the header are owned by the warn-leading-blank-lines check.
+// Its "policy" setting is shared: line-level fixers that would otherwise drop those lines
+// ask KeepLeadingBlankLines first.
+const (
+	LeadingBlankLinesCheck = "warn-leading-blank-lines"
+	SettingLeadingBlank    = "policy"
+
+	LeadingBlankStrip = "strip" // remove blank lines before the header (default; a BOM stays)
+	LeadingBlankKeep  = "keep"  // leave them as they are
+)
+
+// KeepLeadingBlankLines reports whether the run preserves blank lines before the header
+// (LeadingBlankKeep policy).
+func (o RunOptions) KeepLeadingBlankLines() bool {
+	v, _ := o.Setting(LeadingBlankLinesCheck, SettingLeadingBlank)
+	return strings.EqualFold(v, LeadingBlankKeep)
+}
@@ -0,0 +1,144 @@
+package checks_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestRunOptionsSetting_CaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			"Some-Check": {"Skip-Descriptions": " false "},
+		},
+	}
+
+	v, ok := opts.Setting(" some-check ", "skip-descriptions")
+	if !ok {
+		t.Fatalf("expected setting to be found")
+	}
+	if v != "false" {
+		t.Fatalf("value = %q, want trimmed %q", v, "false")
+	}
+
+	if _, ok := opts.Setting("other-check", "skip-descriptions"); ok {
+		t.Fatalf("setting must not leak to other checks")
+	}
+	if _, ok := (checks.RunOptions{}).Setting("some-check", "x"); ok {
+		t.Fatalf("zero RunOptions must have no settings")
+	}
+}
+
+func TestRunOptionsSetting_CaseDuplicates(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			"some-check": {"Keep": "last", "keep": "first", "KEEP": "longest-description"},
+		},
+	}
+
+	for range 20 {
+		if v, _ := opts.Setting("some-check", "keep"); v != "first" {
+			t.Fatalf("exact key must win, got %q", v)
+		}
+		if v, _ := opts.Setting("some-check", "kEEp"); v != "longest-description" {
+			t.Fatalf("fallback must be deterministic (first key in sorted order), got %q", v)
+		}
+	}
+}
+
+func TestResolveRun_RejectsCaseDuplicateSettings(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+	if _, err := checks.Register(mkCheckOK(t, "some-check", checks.WithPriority(checks.PrioContent))); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	for _, settings := range []map[string]checks.CheckSettings{
+		{"some-check": {"Keep": "last", "keep": "first"}},
+		{"some-check": {"keep": "first"}, "Some-Check": {"keep": "last"}},
+	} {
+		if _, err := checks.ResolveRun(checks.RunOptions{Settings: settings}); !errors.Is(err, checks.ErrDuplicateSetting) {
+			t.Fatalf("Settings %v: err = %v, want ErrDuplicateSetting", settings, err)
+		}
+	}
+
+	ok := map[string]checks.CheckSettings{"some-check": {"keep": "first", "other": "x"}}
+	if _, err := checks.ResolveRun(checks.RunOptions{Settings: ok}); err != nil {
+		t.Fatalf("ResolveRun: %v", err)
+	}
+}
+
+func TestRunOptionsSettingBool(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			"c": {"a": "yes", "b": "0", "c": "TRUE", "d": "maybe", "e": "off"},
+		},
+	}
+
+	tests := []struct {
+		key  string
+		def  bool
+		want bool
+	}{
+		{"a", false, true},
+		{"b", true, false},
+		{"c", false, true},
+		{"d", true, true},
+		{"e", true, false},
+		{"missing", true, true},
+	}
+
+	for _, tt := range tests {
+		if got := opts.SettingBool("c", tt.key, tt.def); got != tt.want {
+			t.Fatalf("SettingBool(%q, def=%v) = %v, want %v", tt.key, tt.def, got, tt.want)
+		}
+	}
+}
+
+func TestRunOptionsSettingInt(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			"c": {"n": "42", "bad": "4x"},
+		},
+	}
+
+	if got := opts.SettingInt("c", "n", 1); got != 42 {
+		t.Fatalf("SettingInt(n) = %d, want 42", got)
+	}
+	if got := opts.SettingInt("c", "bad", 7); got != 7 {
+		t.Fatalf("SettingInt(bad) = %d, want default 7", got)
+	}
+	if got := opts.SettingInt("c", "missing", 3); got != 3 {
+		t.Fatalf("SettingInt(missing) = %d, want default 3", got)
+	}
+}
+
+func TestRunOptionsSettingList(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		Settings: map[string]checks.CheckSettings{
+			"c": {"list": " a, ,b ,c,", "empty": ""},
+		},
+	}
+
+	if got := opts.SettingList("c", "list", nil); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("SettingList(list) = %#v", got)
+	}
+	if got := opts.SettingList("c", "empty", []string{"x"}); len(got) != 0 {
+		t.Fatalf("explicitly empty list must override default, got %#v", got)
+	}
+	if got := opts.SettingList("c", "missing", []string{"x"}); !reflect.DeepEqual(got, []string{"x"}) {
+		t.Fatalf("missing list must yield default, got %#v", got)
+	}
+}
@@ -0,0 +1,43 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidSeverity is returned when RunOptions.SeverityOverrides maps a check to a status
+// other than INFO, WARN or FAIL.
+var ErrInvalidSeverity = errors.New("invalid severity override")
+
+// overridableStatuses are the statuses a check's problem report can be turned into.
+// PASS, SKIPPED and ERROR are facts about the run, not severities.
+var overridableStatuses = []Status{Info, Warn, Fail}
+
+// SeverityOverride returns the status RunOptions.SeverityOverrides assigns to the check
+// named name (case-insensitive), if any. An exact match wins, so the result never depends
+// on map order (ResolveRun rejects case duplicates anyway).
+func (o RunOptions) SeverityOverride(name string) (Status, bool) {
+	if len(o.SeverityOverrides) == 0 {
+		return "", false
+	}
+
+	return lookupFold(o.SeverityOverrides, name)
+}
+
+// validateSeverityOverrides rejects override values that are not INFO, WARN or FAIL.
+func validateSeverityOverrides(overrides map[string]Status) error {
+	var bad []string
+	for name, st := range overrides {
+		if !slices.Contains(overridableStatuses, st) {
+			bad = append(bad, fmt.Sprintf("%s: %q", strings.TrimSpace(name), st))
+		}
+	}
+	if len(bad) == 0 {
+		return nil
+	}
+	slices.Sort(bad)
+
+	return fmt.Errorf("%w (expected INFO, WARN or FAIL): %s", ErrInvalidSeverity, strings.Join(bad, ", "))
+}
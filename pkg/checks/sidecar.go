@@ -0,0 +1,116 @@
+package checks
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultSidecarMarker is appended to messages whose findings were written to a sidecar.
+const DefaultSidecarMarker = " (all {count} findings in {name})"
+
+// sidecarMessageItems is how many items checks list in a message before cutting it short.
+const sidecarMessageItems = 10
+
+// FindingsSidecar receives every stored finding of a run as JSON lines, so messages can
+// stay short without losing the items they leave out. Set it in RunOptions.FindingsSidecar;
+// the validator writes each check's findings after the check ran and points long messages
+// at Name. Findings beyond RunOptions.MaxFindings are only counted, never stored; use a
+// negative MaxFindings to keep all of them. Safe for concurrent use.
+type FindingsSidecar struct {
+	// Name is how messages refer to the sidecar, e.g. "gloss.findings.jsonl".
+	Name string
+	// Marker is appended to messages listing fewer items than the check found.
+	// "{count}" and "{name}" are replaced; empty uses DefaultSidecarMarker.
+	Marker string
+	// W receives one JSON object per line.
+	W io.Writer
+
+	mu      sync.Mutex
+	written int
+	err     error
+}
+
+// SidecarRecord is one line of a findings sidecar.
+type SidecarRecord struct {
+	Check      string `json:"check"`
+	Status     Status `json:"status"`
+	Code       string `json:"code,omitempty"`
+	Row        int    `json:"row,omitempty"`
+	Field      int    `json:"field,omitempty"`
+	Column     string `json:"column,omitempty"`
+	Value      string `json:"value,omitempty"`
+	Message    string `json:"message,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
+
+	SuggestedFix *SuggestedFix `json:"suggested_fix,omitempty"`
+}
+
+// Record writes the findings of out and returns out with the marker appended to its
+// message when the check found more than it could list. After the first write error
+// the sidecar stops writing and leaves messages alone; see Err.
+func (s *FindingsSidecar) Record(out CheckOutcome) CheckOutcome {
+	if s == nil || len(out.Result.Findings) == 0 {
+		return out
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil || s.W == nil {
+		return out
+	}
+
+	enc := json.NewEncoder(s.W)
+	for _, f := range out.Result.Findings {
+		rec := SidecarRecord{
+			Check:      nz(f.Check, out.Result.Name),
+			Status:     nzStatus(f.Severity, out.Result.Status),
+			Code:       f.Code,
+			Row:        f.Row,
+			Field:      f.Field,
+			Column:     f.Column,
+			Value:      f.Value,
+			Message:    f.Message,
+			Suggestion: f.Suggestion,
+
+			SuggestedFix: f.SuggestedFix,
+		}
+		if err := enc.Encode(rec); err != nil {
+			s.err = err
+			return out
+		}
+		s.written++
+	}
+
+	if len(out.Result.Findings) > sidecarMessageItems {
+		out.Result.Message += s.marker(len(out.Result.Findings))
+	}
+
+	return out
+}
+
+// Written is the number of findings written so far.
+func (s *FindingsSidecar) Written() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.written
+}
+
+// Err returns the first write error, if any.
+func (s *FindingsSidecar) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+func (s *FindingsSidecar) marker(count int) string {
+	return strings.NewReplacer(
+		"{count}", strconv.Itoa(count),
+		"{name}", s.Name,
+	).Replace(nz(s.Marker, DefaultSidecarMarker))
+}
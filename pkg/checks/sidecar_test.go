@@ -0,0 +1,112 @@
+package checks_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func outcomeWithFindings(name string, n int) checks.CheckOutcome {
+	out := checks.CheckOutcome{Result: checks.CheckResult{Name: name, Status: checks.Warn, Message: "found stuff"}}
+	for i := range n {
+		out.Result.Findings = append(out.Result.Findings, checks.Finding{
+			Check: name, Code: "bad-term", Row: i + 2, Field: 1, Column: "term", Value: "v" + strconv.Itoa(i), Message: "bad",
+		})
+	}
+	return out
+}
+
+func TestFindingsSidecar_WritesJSONLinesAndMarksLongMessages(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	sc := &checks.FindingsSidecar{Name: "gloss.findings.jsonl", W: &buf}
+
+	short := sc.Record(outcomeWithFindings("short", 3))
+	if short.Result.Message != "found stuff" {
+		t.Fatalf("short message must stay as is, got %q", short.Result.Message)
+	}
+
+	long := sc.Record(outcomeWithFindings("long", 12))
+	if want := "found stuff (all 12 findings in gloss.findings.jsonl)"; long.Result.Message != want {
+		t.Fatalf("message = %q, want %q", long.Result.Message, want)
+	}
+
+	if sc.Written() != 15 || sc.Err() != nil {
+		t.Fatalf("written=%d err=%v", sc.Written(), sc.Err())
+	}
+
+	var lines []checks.SidecarRecord
+	sx := bufio.NewScanner(&buf)
+	for sx.Scan() {
+		var rec checks.SidecarRecord
+		if err := json.Unmarshal(sx.Bytes(), &rec); err != nil {
+			t.Fatalf("bad line %q: %v", sx.Text(), err)
+		}
+		lines = append(lines, rec)
+	}
+	if len(lines) != 15 {
+		t.Fatalf("got %d lines", len(lines))
+	}
+	if got := lines[14]; got.Check != "long" || got.Status != checks.Warn || got.Code != "bad-term" ||
+		got.Row != 13 || got.Field != 1 || got.Value != "v11" {
+		t.Fatalf("unexpected last record %+v", got)
+	}
+}
+
+func TestFindingsSidecar_CustomMarkerAndNil(t *testing.T) {
+	t.Parallel()
+
+	sc := &checks.FindingsSidecar{Name: "side.jsonl", Marker: " [+{count} -> {name}]", W: &bytes.Buffer{}}
+	out := sc.Record(outcomeWithFindings("c", 11))
+	if !strings.HasSuffix(out.Result.Message, " [+11 -> side.jsonl]") {
+		t.Fatalf("unexpected message %q", out.Result.Message)
+	}
+
+	var none *checks.FindingsSidecar
+	if got := none.Record(outcomeWithFindings("c", 20)); got.Result.Message != "found stuff" {
+		t.Fatalf("nil sidecar must be a no-op, got %q", got.Result.Message)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestFindingsSidecar_StopsAfterWriteError(t *testing.T) {
+	t.Parallel()
+
+	sc := &checks.FindingsSidecar{Name: "x", W: failingWriter{}}
+
+	out := sc.Record(outcomeWithFindings("c", 20))
+	if out.Result.Message != "found stuff" {
+		t.Fatalf("message must not point at a sidecar that failed, got %q", out.Result.Message)
+	}
+	if sc.Err() == nil || sc.Written() != 0 {
+		t.Fatalf("expected the write error to be kept, written=%d err=%v", sc.Written(), sc.Err())
+	}
+}
+
+func TestFindingsSidecar_ConcurrentRecord(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	sc := &checks.FindingsSidecar{Name: "x", W: &buf}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() { sc.Record(outcomeWithFindings("c", 25)) })
+	}
+	wg.Wait()
+
+	if sc.Written() != 200 || strings.Count(buf.String(), "\n") != 200 {
+		t.Fatalf("written=%d lines=%d", sc.Written(), strings.Count(buf.String(), "\n"))
+	}
+}
@@ -0,0 +1,29 @@
+// Package structural provides the built-in checks of the structural band (file and header shape: extension, encoding,
+// line breaks, delimiter, header cells).
+// Importing it registers nothing; call checks.LoadStructural (or checks.LoadAll) afterwards:
+//
+//	import _ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/structural"
+//
+//	if err := checks.LoadStructural(); err != nil { ... }
+package structural
+
+import (
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/10_allowed_columns_header"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/11_no_duplicate_header_cells"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/1_valid_extension"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/28_no_transposed_table"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/2_valid_encoding"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/30_declared_langs"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/31_locale_description_suffix"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/33_single_line_file"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/39_file_naming"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/3_no_empty_lines"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/45_leading_blank_lines"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/47_final_newline"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/4_non_empty_file"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/5_at_least_two_lines"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/6_semicolon_separators"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/7_no_header_spaces"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/8_lowercase_header"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/9_term_description_header"
+)
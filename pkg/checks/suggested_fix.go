@@ -0,0 +1,138 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+)
+
+// SuggestedFix previews what a check's auto-fix would do to the row of one finding,
+// without applying it. Checks may set it themselves during validation; with
+// RunOptions.PreviewFixes, RunWithFix fills it in for the other findings of a
+// fixable check that was not allowed to fix, by running the fixer on the side.
+type SuggestedFix struct {
+	// Before and After are the finding's record as it is and as the fix would write it,
+	// semicolon-separated. Both are empty when the change cannot be pinned to the row
+	// (Row 0, or the fix reshaped the file around it); Note still describes it.
+	Before string
+	After  string
+
+	// Removed is set when the fix would drop the record; After is then empty.
+	Removed bool
+
+	// Note is the fixer's summary of the whole change.
+	Note string
+}
+
+// previewFindings runs r.Fix on a and attaches the result to findings that have no
+// SuggestedFix yet. The fixed data is thrown away. Fixers that fail, decline (ErrNoFix)
+// or change nothing leave res untouched.
+func previewFindings(ctx context.Context, r RunRecipe, a Artifact, opts RunOptions, res ValidationResult) ValidationResult {
+	if !opts.PreviewFixes || r.Fix == nil || len(res.Findings) == 0 || ctx.Err() != nil {
+		return res
+	}
+
+	fr, err := safeFix(r.Name, r.Fix, ctx, a)
+	if err != nil {
+		return res
+	}
+	outData, _, changed := propagateAfterFix(a, fr)
+	if !changed {
+		return res
+	}
+
+	before, errBefore := previewRecords(a.Data)
+	after, errAfter := previewRecords(outData)
+	rows := alignPreviewRows(before, after, errBefore == nil && errAfter == nil)
+
+	findings := slices.Clone(res.Findings)
+	for i := range findings {
+		if findings[i].SuggestedFix != nil {
+			continue
+		}
+
+		sf := &SuggestedFix{Note: fr.Note}
+		if p, ok := rows[findings[i].Row-1]; ok {
+			sf.Before, sf.After, sf.Removed = p.before, p.after, p.removed
+		}
+		findings[i].SuggestedFix = sf
+	}
+	res.Findings = findings
+
+	return res
+}
+
+type previewRow struct {
+	before  string
+	after   string
+	removed bool
+}
+
+// alignPreviewRows pairs records before and after the fix by index (0-based record number).
+// Records the fix inserted or dropped are located by skipping the common prefix and suffix;
+// rows in a middle region that both lost and gained records are left out.
+func alignPreviewRows(before, after [][]string, ok bool) map[int]previewRow {
+	out := make(map[int]previewRow)
+	if !ok {
+		return out
+	}
+
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && slices.Equal(before[prefix], after[prefix]) {
+		prefix++
+	}
+
+	if len(before) == len(after) {
+		for i := prefix; i < len(before); i++ {
+			if !slices.Equal(before[i], after[i]) {
+				out[i] = previewRow{before: formatPreviewRecord(before[i]), after: formatPreviewRecord(after[i])}
+			}
+		}
+		return out
+	}
+
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		slices.Equal(before[len(before)-1-suffix], after[len(after)-1-suffix]) {
+		suffix++
+	}
+
+	if prefix+suffix == len(after) {
+		for i := prefix; i < len(before)-suffix; i++ {
+			out[i] = previewRow{before: formatPreviewRecord(before[i]), removed: true}
+		}
+	}
+
+	return out
+}
+
+func previewRecords(data []byte) ([][]string, error) {
+	r := NewSemicolonCSVReader(StripUTF8BOM(data))
+
+	var records [][]string
+	for {
+		rec, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+}
+
+func formatPreviewRecord(rec []string) string {
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	w.Comma = ';'
+	_ = w.Write(rec)
+	w.Flush()
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,101 @@
+package checks_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestRunWithFix_PreviewFixesAttachesSuggestedFixes(t *testing.T) {
+	t.Parallel()
+
+	fixCalls := 0
+	preset := &checks.SuggestedFix{Note: "from the check"}
+	recipe := checks.RunRecipe{
+		Name: "upper",
+		Validate: func(context.Context, checks.Artifact) checks.ValidationResult {
+			return checks.ValidationResult{
+				OK:  false,
+				Msg: "lowercase terms",
+				Findings: []checks.Finding{
+					{Row: 2, Column: "term", Value: "cloud"},
+					{Row: 3, Column: "term", Value: "OK"},
+					{Message: "file-level"},
+					{Row: 2, SuggestedFix: preset},
+				},
+			}
+		},
+		Fix: func(_ context.Context, a checks.Artifact) (checks.FixResult, error) {
+			fixCalls++
+			return checks.FixResult{Data: []byte(strings.ToUpper(string(a.Data))), Note: "uppercased"}, nil
+		},
+		FailAs: checks.Warn,
+	}
+	a := checks.Artifact{Data: []byte("term;en\ncloud;\"a;b\"\nOK;OK\n")}
+
+	out := checks.RunWithFix(context.Background(), a, checks.RunOptions{PreviewFixes: true}, recipe)
+	if fixCalls != 1 {
+		t.Fatalf("fix calls = %d, want 1 preview run", fixCalls)
+	}
+	if out.Result.Status != checks.Warn || out.Final.DidChange || string(out.Final.Data) != string(a.Data) {
+		t.Fatalf("preview must not apply the fix: %+v", out)
+	}
+
+	f := out.Result.Findings
+	if len(f) != 4 {
+		t.Fatalf("findings = %+v", f)
+	}
+	want := &checks.SuggestedFix{Before: `cloud;"a;b"`, After: `CLOUD;"A;B"`, Note: "uppercased"}
+	if !reflect.DeepEqual(f[0].SuggestedFix, want) {
+		t.Fatalf("row 2 fix = %+v, want %+v", f[0].SuggestedFix, want)
+	}
+	if got := f[1].SuggestedFix; got == nil || got.Before != "" || got.Note != "uppercased" {
+		t.Fatalf("unchanged row must carry only the note, got %+v", got)
+	}
+	if got := f[2].SuggestedFix; got == nil || got.Before != "" || got.Note != "uppercased" {
+		t.Fatalf("file-level finding must carry only the note, got %+v", got)
+	}
+	if f[3].SuggestedFix != preset {
+		t.Fatalf("check-provided SuggestedFix must be kept, got %+v", f[3].SuggestedFix)
+	}
+
+	fixCalls = 0
+	out = checks.RunWithFix(context.Background(), a, checks.RunOptions{}, recipe)
+	if fixCalls != 0 || out.Result.Findings[0].SuggestedFix != nil {
+		t.Fatalf("without PreviewFixes: calls=%d findings=%+v", fixCalls, out.Result.Findings)
+	}
+}
+
+func TestRunWithFix_PreviewFixesMarksRemovedRows(t *testing.T) {
+	t.Parallel()
+
+	recipe := checks.RunRecipe{
+		Name: "dedupe",
+		Validate: func(context.Context, checks.Artifact) checks.ValidationResult {
+			return checks.ValidationResult{
+				OK:       false,
+				Msg:      "duplicate row",
+				Findings: []checks.Finding{{Row: 3}},
+			}
+		},
+		Fix: func(context.Context, checks.Artifact) (checks.FixResult, error) {
+			return checks.FixResult{Data: []byte("term\na\nb\n"), Note: "dropped 1 row"}, nil
+		},
+		Destructive: true,
+	}
+	a := checks.Artifact{Data: []byte("term\na\na\nb\n")}
+	opts := checks.RunOptions{FixMode: checks.FixAlways, PreviewFixes: true}
+
+	out := checks.RunWithFix(context.Background(), a, opts, recipe)
+	if string(out.Final.Data) != string(a.Data) {
+		t.Fatalf("destructive fix applied without AllowDestructive: %q", out.Final.Data)
+	}
+
+	want := &checks.SuggestedFix{Before: "a", Removed: true, Note: "dropped 1 row"}
+	if got := out.Result.Findings[0].SuggestedFix; !reflect.DeepEqual(got, want) {
+		t.Fatalf("suggested fix = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+)
+
+// Table is the parsed CSV view of an artifact: Data without its BOM, read with
+// NewSemicolonCSVReader. Checks share it through Artifact.Cache instead of parsing
+// the same data again. It must be treated as read-only.
+type Table struct {
+	// Records holds every record in read order, blank ones included
+	// (the reader itself skips empty lines).
+	Records [][]string
+
+	// Lines holds the 1-based physical line each record starts on: Lines[i] is for Records[i].
+	Lines []int
+
+	// Err is the parse error that stopped reading; Records holds what was read before it.
+	// Nil when the whole data was read.
+	Err error
+}
+
+// Header returns the first non-blank record and its 1-based record number.
+// ok is false when every record is blank.
+func (t *Table) Header() (header []string, row int, ok bool) {
+	for i, rec := range t.Records {
+		if !isBlankRecord(rec) {
+			return rec, i + 1, true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// Reader replays the table record by record, like the csv.Reader it was read with:
+// Read returns a copy of each record, then Err (or io.EOF).
+func (t *Table) Reader() *TableReader {
+	return &TableReader{table: t}
+}
+
+// TableReader reads the records of a Table. See Table.Reader.
+type TableReader struct {
+	table *Table
+	next  int
+}
+
+// Read returns the next record. The caller may modify it.
+func (r *TableReader) Read() ([]string, error) {
+	if r.next >= len(r.table.Records) {
+		if r.table.Err != nil {
+			return nil, r.table.Err
+		}
+		return nil, io.EOF
+	}
+
+	rec := r.table.Records[r.next]
+	r.next++
+
+	return slices.Clone(rec), nil
+}
+
+// Line returns the 1-based physical line the record last returned by Read starts on
+// (0 before the first record).
+func (r *TableReader) Line() int {
+	if r.next == 0 {
+		return 0
+	}
+
+	return r.table.Lines[r.next-1]
+}
+
+// TableOf returns the parsed table of a. With a.Cache set it is parsed at most once
+// per Data state and shared by every check that asks; a fix that replaces Data
+// invalidates it. The error is only ever ctx's: parse errors are kept in Table.Err.
+func TableOf(ctx context.Context, a Artifact) (*Table, error) {
+	return a.Cache.table(ctx, a.Data)
+}
+
+// TableReaderOf is TableOf(ctx, a).Reader() for checks written against csv.Reader.
+// When ctx is done, Read returns its error.
+func TableReaderOf(ctx context.Context, a Artifact) *TableReader {
+	t, err := TableOf(ctx, a)
+	if err != nil {
+		return (&Table{Err: err}).Reader()
+	}
+
+	return t.Reader()
+}
+
+const ctxCheckEveryRecords = 1 << 12
+
+func readTable(ctx context.Context, data []byte) (*Table, error) {
+	r := NewSemicolonCSVReader(StripUTF8BOM(data))
+
+	t := &Table{}
+	for {
+		if len(t.Records)%ctxCheckEveryRecords == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return t, nil
+		}
+		if err != nil {
+			t.Err = err
+			return t, nil
+		}
+
+		line, _ := r.FieldPos(0)
+		t.Records = append(t.Records, rec)
+		t.Lines = append(t.Lines, line)
+	}
+}
@@ -0,0 +1,124 @@
+package checks_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestTableOf_RecordsAndLines(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("\ufeff \nterm;en\n\nx;\"multi\nline\"\ny;z\n")}
+
+	table, err := checks.TableOf(context.Background(), a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantRecords := [][]string{{" "}, {"term", "en"}, {"x", "multi\nline"}, {"y", "z"}}
+	if !reflect.DeepEqual(table.Records, wantRecords) {
+		t.Fatalf("Records = %q, want %q", table.Records, wantRecords)
+	}
+	if want := []int{1, 2, 4, 6}; !reflect.DeepEqual(table.Lines, want) {
+		t.Fatalf("Lines = %v, want %v", table.Lines, want)
+	}
+	if table.Err != nil {
+		t.Fatalf("Err = %v, want nil", table.Err)
+	}
+
+	header, row, ok := table.Header()
+	if !ok || row != 2 || !reflect.DeepEqual(header, []string{"term", "en"}) {
+		t.Fatalf("Header() = %q, %d, %v; want [term en], 2, true", header, row, ok)
+	}
+}
+
+func TestTableReader_ReplaysCopies(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Artifact{Data: []byte("term;en\nx;y\n"), Cache: checks.NewParseCache()}
+	ctx := context.Background()
+
+	r := checks.TableReaderOf(ctx, a)
+	if r.Line() != 0 {
+		t.Fatalf("Line() before Read = %d, want 0", r.Line())
+	}
+
+	rec, err := r.Read()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec[0] = "changed"
+
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Line() != 2 {
+		t.Fatalf("Line() = %d, want 2", r.Line())
+	}
+	if _, err := r.Read(); !errors.Is(err, io.EOF) {
+		t.Fatalf("err = %v, want io.EOF", err)
+	}
+
+	again, err := checks.TableReaderOf(ctx, a).Read()
+	if err != nil || again[0] != "term" {
+		t.Fatalf("second reader got %q, %v; the cached table must not change", again, err)
+	}
+}
+
+func TestTableOf_CachedPerDataState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := checks.NewParseCache()
+	data := []byte("term;en\nx;y\n")
+
+	first, err := checks.TableOf(ctx, checks.Artifact{Data: data, Cache: cache})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Equal content in a fresh slice (as seen by checks with masked comments) is shared too.
+	same, _ := checks.TableOf(ctx, checks.Artifact{Data: bytes.Clone(data), Cache: cache})
+	if same != first {
+		t.Fatalf("expected the cached table for equal data")
+	}
+
+	fixed, _ := checks.TableOf(ctx, checks.Artifact{Data: []byte("term;en\nx;z\n"), Cache: cache})
+	if fixed == first {
+		t.Fatalf("changed data must not reuse the cached table")
+	}
+	if got := fixed.Records[1][1]; got != "z" {
+		t.Fatalf("Records[1][1] = %q, want z", got)
+	}
+
+	uncached, _ := checks.TableOf(ctx, checks.Artifact{Data: data})
+	if uncached == first {
+		t.Fatalf("an artifact without cache must be parsed on its own")
+	}
+}
+
+func TestTableReaderOf_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cache := checks.NewParseCache()
+	a := checks.Artifact{Data: []byte("term\n"), Cache: cache}
+
+	if _, err := checks.TableReaderOf(ctx, a).Read(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+
+	// Cancellation is never cached.
+	table, err := checks.TableOf(context.Background(), a)
+	if err != nil || len(table.Records) != 1 {
+		t.Fatalf("TableOf after cancellation = %v, %v; want one record", table, err)
+	}
+}
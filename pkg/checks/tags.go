@@ -0,0 +1,27 @@
+package checks
+
+import "strings"
+
+// SplitTags parses a comma-separated tags cell, trimming each tag and dropping blanks
+// and exact duplicates. Checks and the term graph share it so they read tags alike.
+func SplitTags(cell string) []string {
+	if cell == "" {
+		return nil
+	}
+
+	var out []string
+	seen := make(map[string]struct{})
+	for t := range strings.SplitSeq(cell, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if _, dup := seen[t]; dup {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+
+	return out
+}
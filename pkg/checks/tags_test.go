@@ -0,0 +1,25 @@
+package checks_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+func TestSplitTags(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string][]string{
+		"":                      nil,
+		" , ,":                  nil,
+		"ui":                    {"ui"},
+		" ui , Legal,ui,legal ": {"ui", "Legal", "legal"},
+	}
+
+	for in, want := range cases {
+		if got := checks.SplitTags(in); !slices.Equal(got, want) {
+			t.Errorf("SplitTags(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package checks
+
+import "time"
+
+// CheckTimeoutFor returns the time budget of the check named name: its entry in
+// CheckTimeouts (case-insensitive; an exact match wins) when there is one, CheckTimeout
+// otherwise. Zero or less means no limit.
+func (o RunOptions) CheckTimeoutFor(name string) time.Duration {
+	if d, ok := lookupFold(o.CheckTimeouts, name); ok {
+		return d
+	}
+
+	return o.CheckTimeout
+}
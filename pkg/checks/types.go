@@ -1,6 +1,12 @@
 package checks
 
-import "context"
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"iter"
+	"time"
+)
 
 // ─────────────────────────────────────────────────────────────────────────────
 // Status & results (unchanged semantics)
@@ -14,6 +20,12 @@ const (
 	Warn  Status = "WARN"
 	Fail  Status = "FAIL"
 	Error Status = "ERROR"
+
+	// Info is a finding worth showing that never asks for action (e.g. unused terms).
+	Info Status = "INFO"
+	// Skipped means the check did not run on this input: it is disabled for the run
+	// or lacks what it needs (see ValidationResult.Skipped).
+	Skipped Status = "SKIPPED"
 )
 
 // FixMode controls whether the runner is allowed to attempt auto-fixes.
@@ -31,13 +43,129 @@ type RunOptions struct {
 	FixMode       FixMode // fix policy
 	RerunAfterFix bool    // if true, re-run validation after a successful fix
 	HardFailOnErr bool    // if true, a single ERROR may abort the whole pipeline (runner decides)
+
+	// AllowDestructive permits fixers that restructure the file (e.g. transposing the table).
+	// Such fixers are skipped unless this is set, even when FixMode allows fixing.
+	AllowDestructive bool
+
+	// PreviewFixes makes fixable checks that fail without fixing (FixNone, or a destructive
+	// fix without AllowDestructive) run their fixer on the side and attach the result to
+	// their findings as SuggestedFix. The input is never changed.
+	PreviewFixes bool
+
+	// MaxFindings caps the findings each check keeps in memory (see FindingsLimit).
+	// Zero uses DefaultMaxFindings; a negative value removes the cap.
+	MaxFindings int
+
+	// MaxFailures ends the run once checks reported this many FAIL findings (0: no limit).
+	// Row-scanning checks also stop reading after that many findings and mark
+	// their result as truncated. The validator passes each check the remaining budget.
+	MaxFailures int
+
+	// CheckSet names a registered Set to run instead of every registered check.
+	CheckSet string
+
+	// Only, when not empty, runs just the named checks (case-insensitive) out of the ones
+	// CheckSet selects; Skip leaves the named checks out. Skip wins over Only.
+	// Only names that match no registered check, and Skip names that match no registered
+	// or provided check, fail the run with an *UnknownChecksError.
+	Only []string
+	Skip []string
+
+	// TieBreak orders checks that share a Priority (name by default).
+	TieBreak TieBreak
+
+	// PriorityOverrides replaces the Priority of the named checks (case-insensitive) when
+	// the run order is built, e.g. to run a row check before the header fixes rewrite the file.
+	// The registry is not changed. Names that match no registered or provided check fail
+	// the run with an *UnknownChecksError, a check named twice with different case with
+	// ErrDuplicateOverride.
+	PriorityOverrides map[string]int
+
+	// SeverityOverrides replaces the status of the named checks (case-insensitive) when they
+	// report a problem: a WARN, FAIL or INFO result becomes the mapped status (INFO, WARN or
+	// FAIL), e.g. to treat duplicate terms as FAIL. PASS, SKIPPED and ERROR results are kept.
+	// The validator applies it after each check returns, so it also decides fail-fast stops
+	// and the MaxFailures budget. Names that match no registered or provided check fail the
+	// run with an *UnknownChecksError, a check named twice with different case with
+	// ErrDuplicateOverride, other statuses with ErrInvalidSeverity.
+	SeverityOverrides map[string]Status
+
+	// CheckTimeout bounds the time each check may take (0: no limit), so one slow check on a
+	// pathological file cannot use up the whole run's deadline. The validator runs every check
+	// with a context that expires after its budget; a check that runs out reports ERROR and the
+	// run goes on with the next one. Checks stop only when they notice their context is done.
+	CheckTimeout time.Duration
+
+	// CheckTimeouts replaces CheckTimeout for the named checks (case-insensitive);
+	// zero or a negative value removes the limit for that check. Names that match no
+	// registered or provided check fail the run with an *UnknownChecksError, a check named
+	// twice with different case with ErrDuplicateOverride.
+	CheckTimeouts map[string]time.Duration
+
+	// PreserveHeaderCase keeps locale header labels as spelled ("pt-BR_Description") when
+	// header fixers rewrite them. By default they are normalized to lowercase keys.
+	PreserveHeaderCase bool
+
+	// CommentPrefix marks lines starting with it (e.g. "#") as comments.
+	// Comment lines are hidden from checks and kept verbatim in the final data. Empty disables.
+	// Row and line numbers in findings and messages still point into the file as given,
+	// each comment line counting as a row of its own.
+	CommentPrefix string
+
+	// FindingsSidecar, when set, receives every stored finding as JSON lines, and messages
+	// that list only part of their findings point at it (see FindingsSidecar).
+	FindingsSidecar *FindingsSidecar
+
+	// SafeFixes makes the validator count the non-empty cells before and after every fix.
+	// A fix that loses some without declaring it (neither destructive nor lossy, see
+	// Capabilities) is taken for a fixer bug: its outcome becomes ERROR and the data
+	// it produced is discarded.
+	SafeFixes bool
+
+	// RecheckAfterFixes makes the validator re-run, after the last check, every check that
+	// passed before a later fix touched its scope (file, header or rows), without fixing,
+	// and report the ones that no longer pass as Summary.Regressions.
+	RecheckAfterFixes bool
+
+	// VerifySummary makes the validator check the finished Summary with validator.Verify
+	// and report violations as a RunError. A debugging aid for runner and middleware changes.
+	VerifySummary bool
+
+	// SourceStrings yields the project's base-language strings, for checks that compare
+	// the glossary with real content (e.g. terms that never occur). Nil skips them.
+	// It may be iterated once per check run, so it must be re-iterable (slices.Values is).
+	SourceStrings iter.Seq[string]
+
+	// Settings holds per-check knobs keyed by check name (case-insensitive).
+	// Checks read them through the Setting* helpers and ignore unknown keys.
+	Settings map[string]CheckSettings
 }
 
+// CheckSettings is a free-form set of string knobs for a single check.
+// Values are strings so they can come straight from flags or config files.
+type CheckSettings map[string]string
+
 // CheckResult is a single validation outcome (no fix application info here).
 type CheckResult struct {
 	Name    string // check name that produced this result
 	Status  Status
 	Message string // human-readable description or diagnostic info
+
+	// Err is the underlying system error for ERROR outcomes (e.g. *csv.ParseError), if any.
+	Err error
+
+	// Findings locates what Message summarizes (rows, columns, values), when the check knows.
+	// Empty for passing results and for checks that only report file-level problems.
+	Findings []Finding
+
+	// Truncated is set when the check stopped scanning early (see RunOptions.MaxFailures).
+	Truncated bool
+
+	// PreFixMessage and PreFixFindings keep the original diagnosis when an auto-fix
+	// was applied but revalidation still failed; Message and Findings describe the fixed data.
+	PreFixMessage  string
+	PreFixFindings []Finding
 }
 
 // FixResult describes what an auto-fix did to the artifact (if anything).
@@ -47,12 +175,22 @@ type FixResult struct {
 	Path      string // new file path; empty means "keep original"
 	DidChange bool   // true if data and/or path were modified
 	Note      string // optional description of what was fixed
+
+	// Langs is the declared language list to propagate; nil means "keep original".
+	// A langs-only change does not set DidChange: the file itself is untouched.
+	Langs []string
 }
 
 // CheckOutcome = validation result + final artifact state after optional fix.
 type CheckOutcome struct {
 	Result CheckResult
 	Final  FixResult
+
+	// Children holds nested outcomes when the check wraps a whole sub-pipeline.
+	Children []CheckOutcome
+
+	// Detection carries what the check sniffed about the input (encoding, delimiter, ...), if anything.
+	Detection *Detection
 }
 
 // ValidationResult is the contract for ValidateFunc.
@@ -61,6 +199,35 @@ type ValidationResult struct {
 	OK  bool
 	Msg string
 	Err error
+
+	// Findings are the located problems behind a failed validation (optional).
+	Findings []Finding
+
+	// Truncated marks a validation that stopped before reading the whole file.
+	Truncated bool
+
+	// Skipped marks a validation that did not apply (e.g. an input it needs was not
+	// provided); RunWithFix reports it as SKIPPED with Msg. OK is ignored.
+	Skipped bool
+}
+
+// ParseError returns the CSV parse error behind this result, if any,
+// so tools can jump to the exact line/column without parsing Msg.
+func (r ValidationResult) ParseError() (*csv.ParseError, bool) {
+	return parseErrorOf(r.Err)
+}
+
+// ParseError returns the CSV parse error behind this result, if any.
+func (r CheckResult) ParseError() (*csv.ParseError, bool) {
+	return parseErrorOf(r.Err)
+}
+
+func parseErrorOf(err error) (*csv.ParseError, bool) {
+	var pe *csv.ParseError
+	if errors.As(err, &pe) {
+		return pe, true
+	}
+	return nil, false
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -73,6 +240,9 @@ type Artifact struct {
 	Data  []byte
 	Path  string
 	Langs []string
+
+	// Cache shares parsed views of Data between checks (see LocaleIndexOf). Optional.
+	Cache *ParseCache
 }
 
 // ─────────────────────────────────────────────────────────────────────────────
@@ -98,8 +268,16 @@ type ValidateFunc func(ctx context.Context, a Artifact) ValidationResult
 type CheckAdapter struct {
 	name     string
 	failFast bool
+	optIn    bool
+	comments bool // sees comment lines instead of having them masked
 	priority int
 	run      CheckFunc // main entry the runner will call
+
+	// declared capabilities (see CapableUnit)
+	fix         bool
+	destructive bool
+	lossy       bool
+	scope       Scope
 }
 
 // Option configures a CheckAdapter (priority, fail-fast, etc.).
@@ -123,6 +301,44 @@ type CheckUnit interface {
 	Priority() int
 }
 
+// Scope is the part of the file a check inspects and, if it has a fixer, rewrites.
+type Scope string
+
+const (
+	ScopeUnknown Scope = ""       // not declared
+	ScopeFile    Scope = "file"   // path, encoding, line breaks, delimiter, table shape
+	ScopeHeader  Scope = "header" // the header record
+	ScopeRows    Scope = "rows"   // data records and their cells
+)
+
+// CapableUnit is an optional extension of CheckUnit for checks that describe what they can do.
+// It is separate so existing CheckUnit implementations keep compiling; *CheckAdapter implements it.
+// Use CapabilitiesOf to query any CheckUnit.
+type CapableUnit interface {
+	CheckUnit
+
+	// SupportsFix reports whether the check has an auto-fix.
+	SupportsFix() bool
+
+	// Scope tells what the check looks at and rewrites.
+	Scope() Scope
+
+	// Destructive reports whether the fix restructures the file, so it only runs with RunOptions.AllowDestructive.
+	Destructive() bool
+}
+
+// Capabilities is what CapabilitiesOf knows about a check.
+type Capabilities struct {
+	Declared    bool // false when the check does not implement CapableUnit; the other fields are then zero
+	SupportsFix bool
+	Scope       Scope
+	Destructive bool
+
+	// Lossy fixes remove cell contents by design (duplicate rows, unknown columns);
+	// RunOptions.SafeFixes does not hold them to the cell count.
+	Lossy bool
+}
+
 // ─────────────────────────────────────────────────────────────────────────────
 // Errors (types only)
 // ─────────────────────────────────────────────────────────────────────────────
@@ -143,12 +359,16 @@ type RunRecipe struct {
 	Validate ValidateFunc // required
 	Fix      FixFunc      // optional
 
+	// Destructive marks Fix as restructuring the file; it only runs with RunOptions.AllowDestructive.
+	Destructive bool
+
 	PassMsg     string // message when validation passes
 	FixedMsg    string // message when fix succeeded and re-validated
 	AppliedMsg  string // message when fix applied without re-validation
 	StillBadMsg string // message/prefix when fix applied but still invalid
 
-	// Default failure status (FAIL if not set). Use ERROR for system-level failures you want to surface.
+	// Default failure status (FAIL if not set). Use ERROR for system-level failures you want to surface,
+	// INFO for informational checks that should never ask for action.
 	FailAs Status
 
 	// Status when fix succeeded and re-validation passed.
@@ -0,0 +1,161 @@
+package checks
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// maxSuggestDistance is the largest edit distance at which a registered check
+// is offered as the intended name for an unknown one.
+const maxSuggestDistance = 3
+
+// UnknownChecksError is returned when a run's configuration names checks that are
+// not registered: a typo, or a config written for a different version of the checks.
+// It matches ErrUnknownCheck with errors.Is.
+type UnknownChecksError struct {
+	// Source is where the names came from: "settings", "priority overrides", "severity overrides", "check timeouts", "only", "skip" or `check set "name"`.
+	Source string
+	// Names lists the unknown names as spelled in the configuration.
+	Names []string
+	// Suggestions maps an unknown name to the closest registered check, when one is close enough.
+	Suggestions map[string]string
+}
+
+func (e *UnknownChecksError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Source)
+	b.WriteString(": ")
+	b.WriteString(ErrUnknownCheck.Error())
+	b.WriteString(": ")
+
+	for i, n := range e.Names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(n)
+		if s, ok := e.Suggestions[n]; ok {
+			b.WriteString(" (did you mean ")
+			b.WriteString(s)
+			b.WriteString("?)")
+		}
+	}
+
+	return b.String()
+}
+
+// Unwrap makes errors.Is(err, ErrUnknownCheck) hold.
+func (e *UnknownChecksError) Unwrap() error { return ErrUnknownCheck }
+
+// newUnknownChecksError builds the error for names missing from the registry state st,
+// or returns nil when names is empty.
+func newUnknownChecksError(st *registryState, source string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	e := &UnknownChecksError{Source: source, Names: names}
+	for _, n := range names {
+		if s := closestCheck(st, n); s != "" {
+			if e.Suggestions == nil {
+				e.Suggestions = make(map[string]string)
+			}
+			e.Suggestions[n] = s
+		}
+	}
+
+	return e
+}
+
+// unknownNames returns the names (e.g. Settings keys) that match no registered check, sorted.
+func unknownNames(st *registryState, names iter.Seq[string]) []string {
+	var out []string
+	for name := range names {
+		if _, ok := st.byName[normalizeName(name)]; !ok {
+			out = append(out, strings.TrimSpace(name))
+		}
+	}
+	slices.Sort(out)
+
+	return out
+}
+
+// unconfigurableNames is unknownNames without the names of provided checks, for options
+// that only configure checks: settings for a check a band-only load left out are
+// harmless, so only names no provider knows fail the run.
+func unconfigurableNames(st *registryState, names iter.Seq[string]) []string {
+	out := unknownNames(st, names)
+	if len(out) == 0 {
+		return nil
+	}
+
+	provided := providedNames()
+	return slices.DeleteFunc(out, func(name string) bool {
+		_, ok := provided[normalizeName(name)]
+		return ok
+	})
+}
+
+// caseDuplicates reports the keys that differ only in case or surrounding spaces,
+// each as err naming what and both spellings, in sorted key order.
+func caseDuplicates(err error, what string, keys iter.Seq[string]) []error {
+	var out []error
+	seen := make(map[string]string)
+	for _, k := range slices.Sorted(keys) {
+		n := normalizeName(k)
+		if prev, dup := seen[n]; dup {
+			out = append(out, fmt.Errorf("%w: %s %q and %q", err, what, prev, k))
+			continue
+		}
+		seen[n] = k
+	}
+
+	return out
+}
+
+// closestCheck returns the registered check name nearest to name, or "" when none
+// is within maxSuggestDistance. Ties go to the name that sorts first.
+func closestCheck(st *registryState, name string) string {
+	key := normalizeName(name)
+	best, bestDist := "", maxSuggestDistance+1
+
+	for _, u := range st.ordered[TieBreakName] {
+		d := EditDistance(key, normalizeName(u.Name()))
+		if d < bestDist || (d == bestDist && u.Name() < best) {
+			best, bestDist = u.Name(), d
+		}
+	}
+
+	return best
+}
+
+// EditDistance is the optimal string alignment distance between a and b, in runes:
+// Levenshtein plus adjacent transpositions ("dsecription" is one edit from "description").
+// Every "did you mean" suggestion (check names, header columns) is ranked by it.
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
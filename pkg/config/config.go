@@ -0,0 +1,242 @@
+// Package config loads validation policies from JSON files, so one policy can drive
+// every repository's glossary validation: an organization publishes a base policy
+// (see Remote) and each repository layers its own file on top (see Merge).
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/guard"
+)
+
+// Policy is one policy file, JSON (Parse) or YAML (ParseYAML). Every field is optional:
+// unset scalars (nil) and missing map keys leave the value from the layer below, or the
+// guard.Config being applied to.
+type Policy struct {
+	Langs              []string `json:"langs,omitempty" yaml:"langs,omitempty"`
+	CheckSet           *string  `json:"check_set,omitempty" yaml:"check_set,omitempty"`
+	Only               []string `json:"only,omitempty" yaml:"only,omitempty"`
+	Skip               []string `json:"skip,omitempty" yaml:"skip,omitempty"`
+	AllowDestructive   *bool    `json:"allow_destructive,omitempty" yaml:"allow_destructive,omitempty"`
+	PreserveHeaderCase *bool    `json:"preserve_header_case,omitempty" yaml:"preserve_header_case,omitempty"`
+	MaxFindings        *int     `json:"max_findings,omitempty" yaml:"max_findings,omitempty"`
+	MaxFailures        *int     `json:"max_failures,omitempty" yaml:"max_failures,omitempty"`
+	CommentPrefix      *string  `json:"comment_prefix,omitempty" yaml:"comment_prefix,omitempty"`
+	HardFailOnErr      *bool    `json:"hard_fail_on_error,omitempty" yaml:"hard_fail_on_error,omitempty"`
+
+	// FixMode is "none", "if-failed", "if-not-pass" or "always" (see RunOptions).
+	// Apply ignores it: guard.Validate and guard.Fix choose whether to fix.
+	FixMode *string `json:"fix_mode,omitempty" yaml:"fix_mode,omitempty"`
+
+	// Settings are per-check knobs: check name -> key -> value. Merge combines them key by key.
+	Settings map[string]map[string]string `json:"settings,omitempty" yaml:"settings,omitempty"`
+
+	// PriorityOverrides maps check names to run priorities (see guard.Config.PriorityOverrides).
+	PriorityOverrides map[string]int `json:"priority_overrides,omitempty" yaml:"priority_overrides,omitempty"`
+
+	// SeverityOverrides maps check names to INFO, WARN or FAIL (see guard.Config.SeverityOverrides).
+	SeverityOverrides map[string]guard.Status `json:"severity_overrides,omitempty" yaml:"severity_overrides,omitempty"`
+}
+
+// Parse decodes a policy. Unknown fields are an error, so typos do not go unnoticed.
+func Parse(data []byte) (Policy, error) {
+	var p Policy
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return Policy{}, fmt.Errorf("config: parse policy: %w", err)
+	}
+	if err := dec.Decode(new(json.RawMessage)); !errors.Is(err, io.EOF) {
+		return Policy{}, errors.New("config: parse policy: trailing data after the policy object")
+	}
+
+	return p, nil
+}
+
+// LoadFile reads and parses the policy file at path: YAML for .yaml and .yml files,
+// JSON otherwise.
+func LoadFile(path string) (Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseYAML(data)
+	default:
+		return Parse(data)
+	}
+}
+
+// Merge layers the policies from lowest to highest precedence, e.g.
+// Merge(orgPolicy, repoPolicy): later policies override set fields of earlier ones,
+// and Settings are merged per check and key. Check names and setting keys match
+// case-insensitively, so "Rows" in a later policy replaces "rows" of an earlier one.
+func Merge(layers ...Policy) Policy {
+	var out Policy
+
+	for _, p := range layers {
+		if p.Langs != nil {
+			out.Langs = append([]string(nil), p.Langs...)
+		}
+		if p.Only != nil {
+			out.Only = append([]string(nil), p.Only...)
+		}
+		if p.Skip != nil {
+			out.Skip = append([]string(nil), p.Skip...)
+		}
+		override(&out.CheckSet, p.CheckSet)
+		override(&out.AllowDestructive, p.AllowDestructive)
+		override(&out.PreserveHeaderCase, p.PreserveHeaderCase)
+		override(&out.MaxFindings, p.MaxFindings)
+		override(&out.MaxFailures, p.MaxFailures)
+		override(&out.CommentPrefix, p.CommentPrefix)
+		override(&out.HardFailOnErr, p.HardFailOnErr)
+		override(&out.FixMode, p.FixMode)
+
+		if len(p.Settings) > 0 {
+			if out.Settings == nil {
+				out.Settings = make(map[string]map[string]string)
+			}
+			mergeSettings(out.Settings, p.Settings)
+		}
+
+		if len(p.PriorityOverrides) > 0 {
+			if out.PriorityOverrides == nil {
+				out.PriorityOverrides = make(map[string]int, len(p.PriorityOverrides))
+			}
+			mergeFold(out.PriorityOverrides, p.PriorityOverrides)
+		}
+
+		if len(p.SeverityOverrides) > 0 {
+			if out.SeverityOverrides == nil {
+				out.SeverityOverrides = make(map[string]guard.Status, len(p.SeverityOverrides))
+			}
+			mergeFold(out.SeverityOverrides, p.SeverityOverrides)
+		}
+	}
+
+	return out
+}
+
+// mergeSettings copies src into dst per check and key. Check names and keys match
+// case-insensitively, as the checks read them: a later "keep" replaces an earlier "Keep"
+// instead of sitting next to it.
+func mergeSettings(dst, src map[string]map[string]string) {
+	for _, check := range slices.Sorted(maps.Keys(src)) {
+		name := foldKey(dst, check)
+		if dst[name] == nil {
+			dst[name] = make(map[string]string, len(src[check]))
+		}
+		mergeFold(dst[name], src[check])
+	}
+}
+
+// mergeFold copies src into dst, replacing the entry of dst whose key matches
+// case-insensitively (check names are read that way) instead of adding a second one.
+func mergeFold[V any](dst, src map[string]V) {
+	for _, k := range slices.Sorted(maps.Keys(src)) {
+		delete(dst, foldKey(dst, k))
+		dst[k] = src[k]
+	}
+}
+
+// foldKey returns the key of m equal to key ignoring case and surrounding spaces, or key.
+func foldKey[V any](m map[string]V, key string) string {
+	if _, ok := m[key]; ok {
+		return key
+	}
+	for k := range m {
+		if strings.EqualFold(strings.TrimSpace(k), strings.TrimSpace(key)) {
+			return k
+		}
+	}
+
+	return key
+}
+
+func override[T any](dst **T, src *T) {
+	if src != nil {
+		v := *src
+		*dst = &v
+	}
+}
+
+// Apply returns cfg with the fields set in p replacing its own. Settings, PriorityOverrides
+// and SeverityOverrides are merged into copies of cfg's maps; cfg itself is not modified.
+func (p Policy) Apply(cfg guard.Config) guard.Config {
+	if p.Langs != nil {
+		cfg.Langs = append([]string(nil), p.Langs...)
+	}
+	if p.Only != nil {
+		cfg.Only = append([]string(nil), p.Only...)
+	}
+	if p.Skip != nil {
+		cfg.Skip = append([]string(nil), p.Skip...)
+	}
+	set(&cfg.CheckSet, p.CheckSet)
+	set(&cfg.AllowDestructive, p.AllowDestructive)
+	set(&cfg.PreserveHeaderCase, p.PreserveHeaderCase)
+	set(&cfg.MaxFindings, p.MaxFindings)
+	set(&cfg.MaxFailures, p.MaxFailures)
+	set(&cfg.CommentPrefix, p.CommentPrefix)
+	set(&cfg.HardFailOnErr, p.HardFailOnErr)
+
+	if len(p.Settings) > 0 {
+		settings := make(map[string]map[string]string, len(cfg.Settings)+len(p.Settings))
+		for check, s := range cfg.Settings {
+			settings[check] = maps.Clone(s)
+		}
+		mergeSettings(settings, p.Settings)
+		cfg.Settings = settings
+	}
+
+	if len(p.PriorityOverrides) > 0 {
+		overrides := maps.Clone(cfg.PriorityOverrides)
+		if overrides == nil {
+			overrides = make(map[string]int, len(p.PriorityOverrides))
+		}
+		mergeFold(overrides, p.PriorityOverrides)
+		cfg.PriorityOverrides = overrides
+	}
+
+	if len(p.SeverityOverrides) > 0 {
+		overrides := maps.Clone(cfg.SeverityOverrides)
+		if overrides == nil {
+			overrides = make(map[string]guard.Status, len(p.SeverityOverrides))
+		}
+		mergeFold(overrides, p.SeverityOverrides)
+		cfg.SeverityOverrides = overrides
+	}
+
+	return cfg
+}
+
+func set[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
+
+// Load fetches the remote policy and layers the local ones over it
+// (Merge(remote, local...)).
+func Load(ctx context.Context, remote *Remote, local ...Policy) (Policy, error) {
+	base, err := remote.Fetch(ctx)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	return Merge(append([]Policy{base}, local...)...), nil
+}
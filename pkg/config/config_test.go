@@ -0,0 +1,204 @@
+package config_test
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/config"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/guard"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	p, err := config.Parse([]byte(`{
+		"langs": ["en", "de"],
+		"max_failures": 10,
+		"allow_destructive": false,
+		"settings": {"warn-leading-blank-lines": {"policy": "keep"}}
+	}`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !slices.Equal(p.Langs, []string{"en", "de"}) || p.MaxFailures == nil || *p.MaxFailures != 10 {
+		t.Fatalf("unexpected policy: %+v", p)
+	}
+	if p.AllowDestructive == nil || *p.AllowDestructive {
+		t.Fatalf("explicit false must be kept, got %v", p.AllowDestructive)
+	}
+	if p.CheckSet != nil || p.MaxFindings != nil {
+		t.Fatalf("unset fields must stay nil: %+v", p)
+	}
+
+	if _, err := config.Parse([]byte(`{"max_failure": 1}`)); err == nil || !strings.Contains(err.Error(), "max_failure") {
+		t.Fatalf("expected unknown field error, got %v", err)
+	}
+	if _, err := config.Parse([]byte(`{} {}`)); err == nil {
+		t.Fatalf("expected trailing data error")
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte(`{"check_set": "strict"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+	if p.CheckSet == nil || *p.CheckSet != "strict" {
+		t.Fatalf("unexpected policy: %+v", p)
+	}
+
+	if _, err := config.LoadFile(filepath.Join(t.TempDir(), "missing.json")); !os.IsNotExist(err) {
+		t.Fatalf("expected not-exist error, got %v", err)
+	}
+}
+
+func TestMerge_LocalOverridesRemote(t *testing.T) {
+	t.Parallel()
+
+	org, err := config.Parse([]byte(`{
+		"langs": ["en"],
+		"max_failures": 10,
+		"hard_fail_on_error": true,
+		"settings": {"c": {"a": "1", "b": "2"}},
+		"priority_overrides": {"x": 1},
+		"severity_overrides": {"x": "WARN"}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := config.Parse([]byte(`{
+		"max_failures": 0,
+		"settings": {"c": {"b": "3"}, "d": {"e": "4"}},
+		"priority_overrides": {"y": 2},
+		"severity_overrides": {"x": "FAIL"}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := config.Merge(org, repo)
+
+	if *p.MaxFailures != 0 || !*p.HardFailOnErr || !slices.Equal(p.Langs, []string{"en"}) {
+		t.Fatalf("unexpected scalars: %+v", p)
+	}
+	if p.Settings["c"]["a"] != "1" || p.Settings["c"]["b"] != "3" || p.Settings["d"]["e"] != "4" {
+		t.Fatalf("unexpected settings: %v", p.Settings)
+	}
+	if p.PriorityOverrides["x"] != 1 || p.PriorityOverrides["y"] != 2 {
+		t.Fatalf("unexpected overrides: %v", p.PriorityOverrides)
+	}
+	if p.SeverityOverrides["x"] != guard.Fail {
+		t.Fatalf("unexpected severity overrides: %v", p.SeverityOverrides)
+	}
+	if org.Settings["c"]["b"] != "2" {
+		t.Fatalf("Merge must not modify its inputs")
+	}
+}
+
+func TestMerge_SettingsMatchCaseInsensitively(t *testing.T) {
+	t.Parallel()
+
+	org, err := config.Parse([]byte(`{"settings": {"Check": {"Keep": "a", "other": "b"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := config.Parse([]byte(`{"settings": {"check": {"keep": "c"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := config.Merge(org, repo)
+
+	want := map[string]map[string]string{"Check": {"keep": "c", "other": "b"}}
+	if len(p.Settings) != 1 || !maps.Equal(p.Settings["Check"], want["Check"]) {
+		t.Fatalf("unexpected settings: %v", p.Settings)
+	}
+}
+
+func TestMerge_PriorityOverridesMatchCaseInsensitively(t *testing.T) {
+	t.Parallel()
+
+	org, err := config.Parse([]byte(`{"priority_overrides": {"Warn-Duplicate-Term-Values": 50, "other": 1}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := config.Parse([]byte(`{"priority_overrides": {"warn-duplicate-term-values": 900}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := config.Merge(org, repo)
+	want := map[string]int{"warn-duplicate-term-values": 900, "other": 1}
+	if !maps.Equal(p.PriorityOverrides, want) {
+		t.Fatalf("PriorityOverrides = %v, want %v", p.PriorityOverrides, want)
+	}
+
+	cfg := repo.Apply(guard.Config{PriorityOverrides: map[string]int{"Warn-Duplicate-Term-Values": 50, "other": 1}})
+	if !maps.Equal(cfg.PriorityOverrides, want) {
+		t.Fatalf("Apply PriorityOverrides = %v, want %v", cfg.PriorityOverrides, want)
+	}
+}
+
+func TestMerge_SeverityOverridesMatchCaseInsensitively(t *testing.T) {
+	t.Parallel()
+
+	org, err := config.Parse([]byte(`{"severity_overrides": {"Warn-X": "WARN"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := config.Parse([]byte(`{"severity_overrides": {"warn-x": "FAIL"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := config.Merge(org, repo)
+	if want := map[string]guard.Status{"warn-x": guard.Fail}; !maps.Equal(p.SeverityOverrides, want) {
+		t.Fatalf("SeverityOverrides = %v, want %v", p.SeverityOverrides, want)
+	}
+
+	cfg := repo.Apply(guard.Config{SeverityOverrides: map[string]guard.Status{"Warn-X": guard.Warn}})
+	if want := map[string]guard.Status{"warn-x": guard.Fail}; !maps.Equal(cfg.SeverityOverrides, want) {
+		t.Fatalf("Apply SeverityOverrides = %v, want %v", cfg.SeverityOverrides, want)
+	}
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	t.Parallel()
+
+	base := guard.Config{
+		Langs:       []string{"fr"},
+		MaxFindings: 5,
+		Settings:    map[string]map[string]string{"c": {"a": "1"}},
+	}
+
+	p, err := config.Parse([]byte(`{"langs": ["en"], "comment_prefix": "#", "skip": ["warn-term-overlaps"], "settings": {"c": {"b": "2"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := p.Apply(base)
+
+	if !slices.Equal(cfg.Langs, []string{"en"}) || cfg.CommentPrefix != "#" || cfg.MaxFindings != 5 {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+	if !slices.Equal(cfg.Skip, []string{"warn-term-overlaps"}) || cfg.Only != nil {
+		t.Fatalf("unexpected check selection: only=%v skip=%v", cfg.Only, cfg.Skip)
+	}
+	if cfg.Settings["c"]["a"] != "1" || cfg.Settings["c"]["b"] != "2" {
+		t.Fatalf("unexpected settings: %v", cfg.Settings)
+	}
+	if _, ok := base.Settings["c"]["b"]; ok {
+		t.Fatalf("Apply must not modify the base config")
+	}
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/internal/atomicfile"
+)
+
+// SignatureHeader carries the Ed25519 signature of a remote policy body as
+// "ed25519=<hex>". Remote.PublicKey verifies it.
+const SignatureHeader = "X-Glossary-Guard-Signature"
+
+// maxPolicySize bounds the remote policy body.
+const maxPolicySize = 1 << 20
+
+var (
+	// ErrInsecureURL is returned for policy URLs that are not https.
+	ErrInsecureURL = errors.New("config: remote policy URL must use https")
+	// ErrBadSignature is returned when a remote policy has no valid signature
+	// for Remote.PublicKey.
+	ErrBadSignature = errors.New("config: remote policy signature is missing or invalid")
+)
+
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// Remote fetches an organization-wide policy over HTTPS.
+type Remote struct {
+	URL string
+
+	// PublicKey, when set, makes Fetch reject policies without a valid SignatureHeader.
+	// A key that is not ed25519.PublicKeySize bytes long rejects every policy.
+	PublicKey ed25519.PublicKey
+
+	// CacheDir, when set, keeps the last fetched policy with its ETag. Later fetches
+	// send If-None-Match and reuse the cached copy on 304 Not Modified.
+	CacheDir string
+
+	// Header holds extra request headers (e.g. an auth token).
+	Header http.Header
+
+	// Client sends the requests; nil uses a client with a 10s timeout.
+	Client *http.Client
+}
+
+// cacheEntry is what Remote stores in CacheDir for one URL.
+type cacheEntry struct {
+	ETag      string `json:"etag"`
+	Signature string `json:"signature,omitempty"`
+	Body      []byte `json:"body"`
+}
+
+// Fetch downloads and parses the policy. The signature is checked on every fetch,
+// cached copies included, so a tampered cache is rejected too.
+func (r *Remote) Fetch(ctx context.Context) (Policy, error) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return Policy{}, fmt.Errorf("config: remote policy URL: %w", err)
+	}
+	if u.Scheme != "https" {
+		return Policy{}, ErrInsecureURL
+	}
+
+	cached, haveCache := r.loadCache()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.URL, nil)
+	if err != nil {
+		return Policy{}, fmt.Errorf("config: build request: %w", err)
+	}
+	for k, vs := range r.Header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	if haveCache && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	client := r.Client
+	if client == nil {
+		client = defaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Policy{}, fmt.Errorf("config: fetch policy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var entry cacheEntry
+	switch {
+	case resp.StatusCode == http.StatusNotModified && haveCache:
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		entry = cached
+	case resp.StatusCode >= 200 && resp.StatusCode <= 299:
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxPolicySize+1))
+		if err != nil {
+			return Policy{}, fmt.Errorf("config: read policy: %w", err)
+		}
+		if len(body) > maxPolicySize {
+			return Policy{}, fmt.Errorf("config: remote policy exceeds %d bytes", maxPolicySize)
+		}
+		entry = cacheEntry{
+			ETag:      resp.Header.Get("ETag"),
+			Signature: resp.Header.Get(SignatureHeader),
+			Body:      body,
+		}
+	default:
+		return Policy{}, fmt.Errorf("config: policy server responded %s", resp.Status)
+	}
+
+	if err := r.verify(entry); err != nil {
+		return Policy{}, err
+	}
+
+	p, err := Parse(entry.Body)
+	if err != nil {
+		return Policy{}, err
+	}
+
+	if entry.ETag != "" && (!haveCache || entry.ETag != cached.ETag) {
+		// A cache that cannot be written only costs a full download next time.
+		_ = r.saveCache(entry)
+	}
+
+	return p, nil
+}
+
+func (r *Remote) verify(e cacheEntry) error {
+	if len(r.PublicKey) == 0 {
+		return nil
+	}
+
+	sig, ok := strings.CutPrefix(e.Signature, "ed25519=")
+	if !ok || len(r.PublicKey) != ed25519.PublicKeySize {
+		return ErrBadSignature
+	}
+	raw, err := hex.DecodeString(sig)
+	if err != nil || !ed25519.Verify(r.PublicKey, e.Body, raw) {
+		return ErrBadSignature
+	}
+
+	return nil
+}
+
+// Sign returns the SignatureHeader value for body, for tools that publish policies.
+func Sign(key ed25519.PrivateKey, body []byte) string {
+	return "ed25519=" + hex.EncodeToString(ed25519.Sign(key, body))
+}
+
+func (r *Remote) cachePath() string {
+	sum := sha256.Sum256([]byte(r.URL))
+	return filepath.Join(r.CacheDir, "policy-"+hex.EncodeToString(sum[:8])+".json")
+}
+
+func (r *Remote) loadCache() (cacheEntry, bool) {
+	if r.CacheDir == "" {
+		return cacheEntry{}, false
+	}
+
+	data, err := os.ReadFile(r.cachePath())
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var e cacheEntry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return cacheEntry{}, false
+	}
+
+	return e, true
+}
+
+func (r *Remote) saveCache(e cacheEntry) error {
+	if r.CacheDir == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(r.CacheDir, 0o755); err != nil {
+		return err
+	}
+
+	return atomicfile.Write(r.cachePath(), data, 0o600)
+}
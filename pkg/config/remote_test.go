@@ -0,0 +1,159 @@
+package config_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/config"
+)
+
+const orgPolicy = `{"langs": ["en", "de"], "max_failures": 20}`
+
+func newPolicyServer(t *testing.T, key ed25519.PrivateKey, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var full atomic.Int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		if key != nil {
+			w.Header().Set(config.SignatureHeader, config.Sign(key, []byte(body)))
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &full
+}
+
+func TestRemote_FetchVerifiesAndCaches(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, full := newPolicyServer(t, priv, orgPolicy)
+
+	r := &config.Remote{
+		URL:       srv.URL + "/policy.json",
+		PublicKey: pub,
+		CacheDir:  t.TempDir(),
+		Header:    http.Header{"Authorization": {"Bearer t"}},
+		Client:    srv.Client(),
+	}
+
+	for range 2 {
+		p, err := r.Fetch(context.Background())
+		if err != nil {
+			t.Fatalf("Fetch: %v", err)
+		}
+		if len(p.Langs) != 2 || *p.MaxFailures != 20 {
+			t.Fatalf("unexpected policy: %+v", p)
+		}
+	}
+	if n := full.Load(); n != 1 {
+		t.Fatalf("full downloads = %d, want 1 (second fetch must use the ETag)", n)
+	}
+
+	// A tampered cache fails verification even on 304.
+	files, _ := filepath.Glob(filepath.Join(r.CacheDir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("cache files = %v, want one", files)
+	}
+	if err := os.WriteFile(files[0], []byte(`{"etag":"\"v1\"","signature":"ed25519=00","body":"e30="}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Fetch(context.Background()); !errors.Is(err, config.ErrBadSignature) {
+		t.Fatalf("err = %v, want ErrBadSignature", err)
+	}
+}
+
+func TestRemote_RejectsUnsignedPolicy(t *testing.T) {
+	t.Parallel()
+
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, _ := newPolicyServer(t, nil, orgPolicy)
+
+	r := &config.Remote{
+		URL:       srv.URL,
+		PublicKey: pub,
+		Header:    http.Header{"Authorization": {"Bearer t"}},
+		Client:    srv.Client(),
+	}
+	if _, err := r.Fetch(context.Background()); !errors.Is(err, config.ErrBadSignature) {
+		t.Fatalf("err = %v, want ErrBadSignature", err)
+	}
+}
+
+func TestRemote_RejectsMalformedPublicKey(t *testing.T) {
+	t.Parallel()
+
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, _ := newPolicyServer(t, priv, orgPolicy)
+
+	r := &config.Remote{
+		URL:       srv.URL,
+		PublicKey: []byte("short"),
+		Header:    http.Header{"Authorization": {"Bearer t"}},
+		Client:    srv.Client(),
+	}
+	if _, err := r.Fetch(context.Background()); !errors.Is(err, config.ErrBadSignature) {
+		t.Fatalf("err = %v, want ErrBadSignature", err)
+	}
+}
+
+func TestRemote_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := (&config.Remote{URL: "http://example.com/p.json"}).Fetch(context.Background()); !errors.Is(err, config.ErrInsecureURL) {
+		t.Fatalf("err = %v, want ErrInsecureURL", err)
+	}
+
+	srv, _ := newPolicyServer(t, nil, orgPolicy)
+	r := &config.Remote{URL: srv.URL, Client: srv.Client()}
+	if _, err := r.Fetch(context.Background()); err == nil {
+		t.Fatalf("expected error for 401 response")
+	}
+}
+
+func TestLoad_MergesLocalOverRemote(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newPolicyServer(t, nil, orgPolicy)
+	r := &config.Remote{URL: srv.URL, Header: http.Header{"Authorization": {"Bearer t"}}, Client: srv.Client()}
+
+	local, err := config.Parse([]byte(`{"max_failures": 5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := config.Load(context.Background(), r, local)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(p.Langs) != 2 || *p.MaxFailures != 5 {
+		t.Fatalf("unexpected policy: %+v", p)
+	}
+}
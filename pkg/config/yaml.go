@@ -0,0 +1,115 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"gopkg.in/yaml.v3"
+)
+
+// FileNames are the repository policy files FindFile looks for, in order of preference.
+var FileNames = []string{".glossary-guard.yaml", ".glossary-guard.yml", ".glossary-guard.json"}
+
+// ErrNoPolicyFile is returned by FindFile when no directory up to the root holds a policy file.
+var ErrNoPolicyFile = errors.New("config: no policy file found")
+
+// fixModes are the fix_mode values, indexed by checks.FixMode.
+var fixModes = []string{"none", "if-failed", "if-not-pass", "always"}
+
+// ParseYAML decodes a YAML policy. It uses the same keys as the JSON form:
+//
+//	langs: [en, fr]
+//	fix_mode: if-failed
+//	skip: [warn-unused-terms]
+//	max_failures: 50
+//	severity_overrides:
+//	  warn-duplicate-term-values: FAIL
+//	settings:
+//	  warn-duplicate-term-values:
+//	    keep: last
+//
+// Unknown keys are an error, and setting values may be written unquoted (true, 3).
+func ParseYAML(data []byte) (Policy, error) {
+	var p Policy
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&p); err != nil && !errors.Is(err, io.EOF) {
+		return Policy{}, fmt.Errorf("config: parse policy: %w", err)
+	}
+	if err := dec.Decode(new(yaml.Node)); !errors.Is(err, io.EOF) {
+		return Policy{}, errors.New("config: parse policy: more than one YAML document")
+	}
+
+	return p, nil
+}
+
+// FindFile returns the path of the first of FileNames found in dir or, failing that,
+// in its parents, so a tool started anywhere in a repository finds the repository policy.
+func FindFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		for _, name := range FileNames {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path, nil
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ErrNoPolicyFile
+		}
+		dir = parent
+	}
+}
+
+// RunOptions turns the policy into options for validator.Validate, for callers below the
+// guard package. The fix mode defaults to "none"; any other mode re-runs a check after
+// its fix. Langs are not part of RunOptions: pass p.Langs to the validator.
+func (p Policy) RunOptions() (checks.RunOptions, error) {
+	fixMode := checks.FixNone
+	if p.FixMode != nil {
+		i := slices.Index(fixModes, *p.FixMode)
+		if i < 0 {
+			return checks.RunOptions{}, fmt.Errorf("config: fix_mode: unknown value %q (expected none, if-failed, if-not-pass or always)", *p.FixMode)
+		}
+		fixMode = checks.FixMode(i)
+	}
+
+	opts := checks.RunOptions{
+		FixMode:           fixMode,
+		RerunAfterFix:     fixMode != checks.FixNone,
+		Only:              slices.Clone(p.Only),
+		Skip:              slices.Clone(p.Skip),
+		PriorityOverrides: maps.Clone(p.PriorityOverrides),
+		SeverityOverrides: maps.Clone(p.SeverityOverrides),
+	}
+	set(&opts.CheckSet, p.CheckSet)
+	set(&opts.AllowDestructive, p.AllowDestructive)
+	set(&opts.PreserveHeaderCase, p.PreserveHeaderCase)
+	set(&opts.MaxFindings, p.MaxFindings)
+	set(&opts.MaxFailures, p.MaxFailures)
+	set(&opts.CommentPrefix, p.CommentPrefix)
+	set(&opts.HardFailOnErr, p.HardFailOnErr)
+
+	if len(p.Settings) > 0 {
+		opts.Settings = make(map[string]checks.CheckSettings, len(p.Settings))
+		for name, s := range p.Settings {
+			opts.Settings[name] = checks.CheckSettings(maps.Clone(s))
+		}
+	}
+
+	return opts, nil
+}
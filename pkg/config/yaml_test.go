@@ -0,0 +1,125 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/config"
+)
+
+const yamlPolicy = `
+langs: [en, fr]
+fix_mode: if-failed
+skip:
+  - warn-unused-terms
+max_failures: 50
+severity_overrides:
+  warn-duplicate-term-values: FAIL
+settings:
+  warn-duplicate-term-values:
+    keep: last
+  warn-nonstandard-hyphens:
+    skip-descriptions: false
+`
+
+func TestParseYAML(t *testing.T) {
+	t.Parallel()
+
+	p, err := config.ParseYAML([]byte(yamlPolicy))
+	if err != nil {
+		t.Fatalf("ParseYAML: %v", err)
+	}
+	if !slices.Equal(p.Langs, []string{"en", "fr"}) || *p.MaxFailures != 50 || *p.FixMode != "if-failed" {
+		t.Fatalf("unexpected policy: %+v", p)
+	}
+	if p.Settings["warn-nonstandard-hyphens"]["skip-descriptions"] != "false" {
+		t.Fatalf("unquoted setting values must decode as strings: %v", p.Settings)
+	}
+
+	if _, err := config.ParseYAML([]byte("max_failure: 1\n")); err == nil || !strings.Contains(err.Error(), "max_failure") {
+		t.Fatalf("expected unknown field error, got %v", err)
+	}
+	if _, err := config.ParseYAML([]byte("langs: [en]\n---\nlangs: [fr]\n")); err == nil {
+		t.Fatalf("expected an error for a second document")
+	}
+	if p, err := config.ParseYAML(nil); err != nil || p.Langs != nil {
+		t.Fatalf("an empty file is an empty policy, got %+v, %v", p, err)
+	}
+}
+
+func TestPolicy_RunOptions(t *testing.T) {
+	t.Parallel()
+
+	p, err := config.ParseYAML([]byte(yamlPolicy))
+	if err != nil {
+		t.Fatalf("ParseYAML: %v", err)
+	}
+
+	opts, err := p.RunOptions()
+	if err != nil {
+		t.Fatalf("RunOptions: %v", err)
+	}
+	if opts.FixMode != checks.FixIfFailed || !opts.RerunAfterFix || opts.MaxFailures != 50 {
+		t.Fatalf("unexpected options: %+v", opts)
+	}
+	if !slices.Equal(opts.Skip, []string{"warn-unused-terms"}) || opts.SeverityOverrides["warn-duplicate-term-values"] != checks.Fail {
+		t.Fatalf("unexpected selection or overrides: %+v", opts)
+	}
+	if v, _ := opts.Setting("warn-duplicate-term-values", "keep"); v != "last" {
+		t.Fatalf("settings not carried over: %v", opts.Settings)
+	}
+
+	bad := "sometimes"
+	if _, err := (config.Policy{FixMode: &bad}).RunOptions(); err == nil {
+		t.Fatalf("expected an error for an unknown fix mode")
+	}
+	if opts, _ := (config.Policy{}).RunOptions(); opts.FixMode != checks.FixNone || opts.RerunAfterFix {
+		t.Fatalf("default fix mode must be none, got %+v", opts)
+	}
+}
+
+func TestLoadFile_YAML(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), ".glossary-guard.yml")
+	if err := os.WriteFile(path, []byte("langs: [de]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := config.LoadFile(path)
+	if err != nil || !slices.Equal(p.Langs, []string{"de"}) {
+		t.Fatalf("LoadFile = %+v, %v", p, err)
+	}
+}
+
+func TestFindFile(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	nested := filepath.Join(root, "glossaries", "web")
+	if err := os.MkdirAll(nested, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// A policy file above the temp dir would be found legitimately, so only the error kind is checked.
+	if _, err := config.FindFile(nested); err != nil && !errors.Is(err, config.ErrNoPolicyFile) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := filepath.Join(root, ".glossary-guard.yaml")
+	for _, name := range []string{".glossary-guard.json", ".glossary-guard.yaml"} {
+		if err := os.WriteFile(filepath.Join(root, name), []byte("{}"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := config.FindFile(nested)
+	if err != nil || got != want {
+		t.Fatalf("FindFile = %q, %v; want %q", got, err, want)
+	}
+}
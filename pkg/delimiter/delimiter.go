@@ -0,0 +1,163 @@
+// Package delimiter rewrites delimited text from one field separator to another,
+// the way the semicolon check's auto-fix converts comma and tab files.
+// A UTF-8 BOM, the line ending style and the presence of a final newline are kept.
+package delimiter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+var (
+	// ErrParse means data does not parse as CSV with the source delimiter.
+	ErrParse = errors.New("delimiter: data does not parse with the source delimiter")
+
+	// ErrCellCountChanged means the converted data does not read back as the same table.
+	ErrCellCountChanged = errors.New("delimiter: conversion changed the cell count")
+
+	// ErrInvalidDelimiter is returned for delimiters encoding/csv cannot use
+	// (quotes, line breaks, the replacement character) or from == to.
+	ErrInvalidDelimiter = errors.New("delimiter: invalid delimiter")
+)
+
+// Convert returns data re-written with to as the field separator. Cells that
+// contain to, quotes or line breaks are quoted. data is not modified.
+func Convert(data []byte, from, to rune) ([]byte, error) {
+	return ConvertContext(context.Background(), data, from, to)
+}
+
+// ConvertContext is Convert with cancellation.
+func ConvertContext(ctx context.Context, data []byte, from, to rune) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if !validDelimiter(from) || !validDelimiter(to) || from == to {
+		return nil, fmt.Errorf("%w: %q -> %q", ErrInvalidDelimiter, from, to)
+	}
+
+	in, bom := checks.SplitUTF8BOM(data)
+	if checks.IsBlankUnicode(in) {
+		return data, nil
+	}
+
+	records, err := readRecords(ctx, in, from)
+	if err != nil {
+		return nil, err
+	}
+
+	out := write(records, to, checks.DetectLineEnding(in), bytes.HasSuffix(in, []byte("\n")))
+	if err := verify(ctx, records, out, to); err != nil {
+		return nil, err
+	}
+
+	return prependBOM(bom, out), nil
+}
+
+func validDelimiter(r rune) bool {
+	return r != 0 && r != '"' && r != '\r' && r != '\n' && r != 0xFFFD
+}
+
+func readRecords(ctx context.Context, data []byte, delim rune) ([][]string, error) {
+	r := checks.NewCSVReader(data, delim)
+
+	var recs [][]string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return recs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrParse, err)
+		}
+
+		recs = append(recs, rec)
+	}
+}
+
+// verify reads out back and compares it with the records it was written from.
+// A cell holding a raw delimiter that escaped quoting would turn into an extra column;
+// failing here is better than handing the caller a silently shifted table.
+func verify(ctx context.Context, src [][]string, out []byte, delim rune) error {
+	got, err := readRecords(ctx, out, delim)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		return fmt.Errorf("%w: converted data does not parse back", ErrCellCountChanged)
+	}
+
+	if len(got) != len(src) {
+		return fmt.Errorf("%w: %d records before conversion, %d after", ErrCellCountChanged, len(src), len(got))
+	}
+
+	for i := range src {
+		if len(got[i]) != len(src[i]) {
+			return fmt.Errorf("%w: record %d has %d cells before conversion, %d after",
+				ErrCellCountChanged, i+1, len(src[i]), len(got[i]))
+		}
+
+		for j := range src[i] {
+			if got[i][j] != src[i][j] {
+				return fmt.Errorf("%w: record %d cell %d changed during conversion", ErrCellCountChanged, i+1, j+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+func write(recs [][]string, delim rune, lineSep string, keepFinal bool) []byte {
+	var b strings.Builder
+
+	for i, row := range recs {
+		for j, col := range row {
+			if j > 0 {
+				b.WriteRune(delim)
+			}
+			b.WriteString(escapeField(col, delim))
+		}
+
+		if i < len(recs)-1 || keepFinal {
+			b.WriteString(lineSep)
+		}
+	}
+
+	return []byte(b.String())
+}
+
+func escapeField(field string, delim rune) string {
+	if !needsQuotes(field, delim) {
+		return field
+	}
+
+	return `"` + strings.ReplaceAll(field, `"`, `""`) + `"`
+}
+
+func needsQuotes(field string, delim rune) bool {
+	return strings.ContainsRune(field, delim) ||
+		strings.ContainsAny(field, "\"\n\r")
+}
+
+func prependBOM(bom, data []byte) []byte {
+	if len(bom) == 0 {
+		return data
+	}
+
+	out := make([]byte, 0, len(bom)+len(data))
+	out = append(out, bom...)
+	out = append(out, data...)
+	return out
+}
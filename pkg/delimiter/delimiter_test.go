@@ -0,0 +1,58 @@
+package delimiter_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/delimiter"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		in       string
+		from, to rune
+		want     string
+	}{
+		{"commas", "term,description\nfoo,bar\n", ',', ';', "term;description\nfoo;bar\n"},
+		{"tabs", "term\tdescription\nfoo\tbar", '\t', ';', "term;description\nfoo;bar"},
+		{"crlf and bom", "\xEF\xBB\xBFa,b\r\nc,d\r\n", ',', ';', "\xEF\xBB\xBFa;b\r\nc;d\r\n"},
+		{"quotes target delimiter", "term,description\n\"a;b\",\"say \"\"hi\"\"\"\n", ',', ';', "term;description\n\"a;b\";\"say \"\"hi\"\"\"\n"},
+		{"back to commas", "a;\"x,y\"\n", ';', ',', "a,\"x,y\"\n"},
+		{"blank input", "  \n", ',', ';', "  \n"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			in := []byte(tc.in)
+			orig := bytes.Clone(in)
+
+			got, err := delimiter.Convert(in, tc.from, tc.to)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("Convert() = %q, want %q", got, tc.want)
+			}
+			if !bytes.Equal(in, orig) {
+				t.Fatalf("input was modified")
+			}
+		})
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := delimiter.Convert([]byte("a,b\n"), ',', ','); !errors.Is(err, delimiter.ErrInvalidDelimiter) {
+		t.Fatalf("expected ErrInvalidDelimiter for from == to, got %v", err)
+	}
+	if _, err := delimiter.Convert([]byte("a,b\n"), ',', '"'); !errors.Is(err, delimiter.ErrInvalidDelimiter) {
+		t.Fatalf("expected ErrInvalidDelimiter for a quote, got %v", err)
+	}
+}
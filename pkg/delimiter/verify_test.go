@@ -0,0 +1,51 @@
+package delimiter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestVerify_MatchingTablePasses(t *testing.T) {
+	t.Parallel()
+
+	src := [][]string{{"term", "description"}, {"a;b", "say \"hi\""}}
+	out := write(src, ';', "\n", true)
+
+	if err := verify(context.Background(), src, out, ';'); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
+func TestVerify_ExtraColumnFails(t *testing.T) {
+	t.Parallel()
+
+	src := [][]string{{"term", "description"}, {"a;b", "x"}}
+	// simulate a writer that forgot to quote the raw semicolon
+	out := []byte("term;description\na;b;x\n")
+
+	err := verify(context.Background(), src, out, ';')
+	if !errors.Is(err, ErrCellCountChanged) {
+		t.Fatalf("expected ErrCellCountChanged, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "record 2 has 2 cells before conversion, 3 after") {
+		t.Fatalf("unexpected err text: %v", err)
+	}
+}
+
+func TestVerify_RecordCountAndCellChanges(t *testing.T) {
+	t.Parallel()
+
+	src := [][]string{{"term", "description"}, {"a", "b"}}
+
+	err := verify(context.Background(), src, []byte("term;description\n"), ';')
+	if !errors.Is(err, ErrCellCountChanged) || !strings.Contains(err.Error(), "2 records before conversion, 1 after") {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	err = verify(context.Background(), src, []byte("term;description\na;c\n"), ';')
+	if !errors.Is(err, ErrCellCountChanged) || !strings.Contains(err.Error(), "record 2 cell 2") {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
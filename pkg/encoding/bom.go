@@ -1,4 +1,4 @@
-package valid_encoding
+package encoding
 
 import (
 	"bytes"
@@ -33,3 +33,20 @@ func sniffBOM(b []byte) bomKind {
 		return bomNone
 	}
 }
+
+func bomName(kind bomKind) string {
+	switch kind {
+	case bomUTF8:
+		return "UTF-8"
+	case bomUTF16LE:
+		return "UTF-16LE"
+	case bomUTF16BE:
+		return "UTF-16BE"
+	case bomUTF32LE:
+		return "UTF-32LE"
+	case bomUTF32BE:
+		return "UTF-32BE"
+	default:
+		return ""
+	}
+}
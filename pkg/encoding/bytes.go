@@ -1,4 +1,4 @@
-package valid_encoding
+package encoding
 
 import (
 	"context"
@@ -0,0 +1,232 @@
+// Package encoding re-encodes glossary files to UTF-8 without BOM, the same way
+// the encoding check's auto-fix does, for importers that want the repair outside
+// the check pipeline. Line endings and content are kept; only the bytes change.
+package encoding
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"unicode/utf8"
+)
+
+// DefaultSampleThreshold is the file size from which the encoding check samples
+// the file for charset detection instead of sniffing its start only.
+const DefaultSampleThreshold = 32 << 20
+
+// Options tune ToUTF8Context. The zero value never samples.
+type Options struct {
+	// SampleThreshold switches charset detection for non-UTF-8 data to sampling
+	// for inputs of at least this many bytes (0: never).
+	SampleThreshold int
+}
+
+// Result is what ToUTF8Context did.
+type Result struct {
+	Data    []byte // UTF-8 without BOM; the input itself when nothing changed
+	Changed bool
+	Note    string // e.g. "re-encoded from UTF-16LE", "removed UTF-8 BOM"
+}
+
+// Detection tells which source encoding ToUTF8Context would assume and why.
+type Detection struct {
+	Encoding string // e.g. "UTF-8", "UTF-16LE", "windows-1252"
+	// Path is "BOM", "valid UTF-8", "UTF-16 heuristic (no BOM)",
+	// "charset detection" or "charset detection (sampled)".
+	Path string
+	BOM  string // byte order mark found at the start; empty if none
+}
+
+// ToUTF8 returns data as UTF-8 without BOM, decoding UTF-16/32 (with or without BOM)
+// and legacy charsets. data is not modified.
+func ToUTF8(data []byte) ([]byte, error) {
+	res, err := ToUTF8Context(context.Background(), data, Options{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Data, nil
+}
+
+// ToUTF8Context is ToUTF8 with cancellation, options and a description of what changed.
+// Decoding order: BOM, UTF-16 without BOM, valid UTF-8, charset detection.
+func ToUTF8Context(ctx context.Context, data []byte, opts Options) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	if len(data) == 0 {
+		return Result{Data: data, Note: "empty file"}, nil
+	}
+
+	if res, ok, err := fromBOM(ctx, data); ok || err != nil {
+		return res, err
+	}
+
+	if res, ok, err := fromUTF16NoBOM(ctx, data); ok || err != nil {
+		return res, err
+	}
+
+	if utf8.Valid(data) {
+		return fromValidUTF8(data), nil
+	}
+
+	return fromDetectedEncoding(data, opts.SampleThreshold)
+}
+
+// Detect explains how ToUTF8Context would decode data, following the same path.
+// ok is false for empty input.
+func Detect(data []byte, opts Options) (d Detection, ok bool) {
+	if len(data) == 0 {
+		return Detection{}, false
+	}
+
+	if kind := sniffBOM(data); kind != bomNone {
+		name := bomName(kind)
+		return Detection{Encoding: name, Path: "BOM", BOM: name}, true
+	}
+
+	if utf8.Valid(data) {
+		return Detection{Encoding: "UTF-8", Path: "valid UTF-8"}, true
+	}
+
+	if yes, be := looksLikeUTF16NoBOM(data); yes {
+		name := "UTF-16LE"
+		if be {
+			name = "UTF-16BE"
+		}
+		return Detection{Encoding: name, Path: "UTF-16 heuristic (no BOM)"}, true
+	}
+
+	det := detectEncoding(data, opts.SampleThreshold)
+	path := "charset detection"
+	if det.mode == detectSampled {
+		path += " (sampled)"
+	}
+
+	return Detection{Encoding: det.name, Path: path}, true
+}
+
+func fromBOM(ctx context.Context, data []byte) (Result, bool, error) {
+	switch sniffBOM(data) {
+	case bomUTF8:
+		trimmed := bytes.TrimPrefix(data, utf8BOM)
+		return Result{
+			Data:    trimmed,
+			Changed: !bytes.Equal(trimmed, data),
+			Note:    "removed UTF-8 BOM",
+		}, true, nil
+
+	case bomUTF16LE:
+		decoded, err := decodeUTF16(ctx, data[2:], false, true)
+		if err != nil {
+			return Result{}, true, fmt.Errorf("decode UTF-16LE: %w", err)
+		}
+		return reencoded(decoded, "re-encoded from UTF-16LE"), true, nil
+
+	case bomUTF16BE:
+		decoded, err := decodeUTF16(ctx, data[2:], true, true)
+		if err != nil {
+			return Result{}, true, fmt.Errorf("decode UTF-16BE: %w", err)
+		}
+		return reencoded(decoded, "re-encoded from UTF-16BE"), true, nil
+
+	case bomUTF32LE:
+		decoded, err := decodeUTF32(ctx, data[4:], false, true)
+		if err != nil {
+			return Result{}, true, fmt.Errorf("decode UTF-32LE: %w", err)
+		}
+		return reencoded(decoded, "re-encoded from UTF-32LE"), true, nil
+
+	case bomUTF32BE:
+		decoded, err := decodeUTF32(ctx, data[4:], true, true)
+		if err != nil {
+			return Result{}, true, fmt.Errorf("decode UTF-32BE: %w", err)
+		}
+		return reencoded(decoded, "re-encoded from UTF-32BE"), true, nil
+
+	default:
+		return Result{}, false, nil
+	}
+}
+
+func fromUTF16NoBOM(ctx context.Context, data []byte) (Result, bool, error) {
+	yes, be := looksLikeUTF16NoBOM(data)
+	if !yes {
+		return Result{}, false, nil
+	}
+
+	decoded, err := decodeUTF16(ctx, data, be, false)
+	if err != nil {
+		return Result{}, true, fmt.Errorf("decode UTF-16 heuristic: %w", err)
+	}
+
+	dir := "LE"
+	if be {
+		dir = "BE"
+	}
+
+	return reencoded(
+		decoded,
+		fmt.Sprintf("re-encoded from UTF-16%s (no BOM)", dir),
+	), true, nil
+}
+
+func fromValidUTF8(data []byte) Result {
+	trimmed := bytes.TrimPrefix(data, utf8BOM)
+	if !bytes.Equal(trimmed, data) {
+		return Result{
+			Data:    trimmed,
+			Changed: true,
+			Note:    "removed UTF-8 BOM",
+		}
+	}
+
+	return Result{
+		Data: data,
+		Note: "already valid UTF-8",
+	}
+}
+
+func fromDetectedEncoding(data []byte, sampleThreshold int) (Result, error) {
+	det := detectEncoding(data, sampleThreshold)
+
+	decoded, err := det.decode(data)
+	if det.mode == detectSampled && (err != nil || suspiciousDecode(decoded)) {
+		det = detectEncodingFull(data, detectFallback)
+		decoded, err = det.decode(data)
+	}
+	if err != nil {
+		return Result{}, fmt.Errorf("decode using %s: %w", det.name, err)
+	}
+
+	decoded = bytes.TrimPrefix(decoded, utf8BOM)
+	if !utf8.Valid(decoded) {
+		return Result{}, fmt.Errorf("failed to produce valid UTF-8 (source=%s)", det.name)
+	}
+
+	noteName := det.name
+	if noteName == "utf-8" {
+		noteName = "detected UTF-8"
+	}
+
+	changed := !bytes.Equal(decoded, data)
+	note := fmt.Sprintf("re-encoded from %s to UTF-8 (no BOM; detection: %s)", noteName, det.mode)
+	if !changed {
+		note = "data unchanged; valid UTF-8"
+	}
+
+	return Result{
+		Data:    decoded,
+		Changed: changed,
+		Note:    note,
+	}, nil
+}
+
+func reencoded(data []byte, note string) Result {
+	return Result{
+		Data:    data,
+		Changed: true,
+		Note:    note,
+	}
+}
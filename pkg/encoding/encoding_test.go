@@ -0,0 +1,78 @@
+package encoding_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/encoding"
+)
+
+func TestToUTF8(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		in   []byte
+		want string
+	}{
+		{"plain", []byte("term;en\r\nfoo;bar\r\n"), "term;en\r\nfoo;bar\r\n"},
+		{"utf-8 bom", []byte("\xEF\xBB\xBFterm;en\n"), "term;en\n"},
+		{"utf-16le bom", []byte("\xFF\xFEa\x00;\x00\xE9\x00\r\x00\n\x00"), "a;é\r\n"},
+		{"utf-16be no bom", []byte("\x00t\x00e\x00r\x00m\x00;\x00e\x00n\x00\n"), "term;en\n"},
+		{"windows-1252", []byte("term;en\ncaf\xe9;coffee\n"), "term;en\ncafé;coffee\n"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			orig := bytes.Clone(tc.in)
+
+			got, err := encoding.ToUTF8(tc.in)
+			if err != nil {
+				t.Fatalf("unexpected err: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("ToUTF8() = %q, want %q", got, tc.want)
+			}
+			if !bytes.Equal(tc.in, orig) {
+				t.Fatalf("input was modified")
+			}
+		})
+	}
+}
+
+func TestToUTF8Context_ResultAndCancel(t *testing.T) {
+	t.Parallel()
+
+	res, err := encoding.ToUTF8Context(context.Background(), []byte("ok\n"), encoding.Options{})
+	if err != nil || res.Changed || res.Note != "already valid UTF-8" {
+		t.Fatalf("unexpected result %+v (err %v)", res, err)
+	}
+
+	res, err = encoding.ToUTF8Context(context.Background(), []byte("\xEF\xBB\xBFok"), encoding.Options{})
+	if err != nil || !res.Changed || res.Note != "removed UTF-8 BOM" {
+		t.Fatalf("unexpected result %+v (err %v)", res, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := encoding.ToUTF8Context(ctx, []byte("ok"), encoding.Options{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := encoding.Detect(nil, encoding.Options{}); ok {
+		t.Fatalf("expected no detection for empty input")
+	}
+
+	d, ok := encoding.Detect([]byte("\xFF\xFEa\x00"), encoding.Options{})
+	if !ok || d.Encoding != "UTF-16LE" || d.Path != "BOM" || d.BOM != "UTF-16LE" {
+		t.Fatalf("unexpected detection %+v", d)
+	}
+}
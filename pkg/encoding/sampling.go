@@ -0,0 +1,134 @@
+package encoding
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"slices"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	sampleWindow        = 64 << 10 // bytes taken from the head, the tail and each random window
+	sampleRandomWindows = 8
+	sniffChunk          = 1 << 10 // charset.DetermineEncoding only looks at this many bytes
+)
+
+// detectMode tells how the legacy encoding was picked; it ends up in notes and detection paths.
+type detectMode string
+
+const (
+	detectFull     detectMode = "full scan"
+	detectSampled  detectMode = "sampled"
+	detectFallback detectMode = "sampled, fell back to full scan"
+)
+
+type detectedEncoding struct {
+	name   string
+	mode   detectMode
+	decode func([]byte) ([]byte, error)
+}
+
+// detectEncoding picks the source charset for data that is not valid UTF-8.
+// Below threshold bytes (or with threshold <= 0) it keeps the classic charset sniffing
+// of the file start. Above it the charset is voted on by chunks of a sample taken
+// from the whole file (see sampleForDetection), so a long ASCII header does not hide
+// the legacy bytes further down. The caller verifies the sampled guess and falls back
+// to detectEncodingFull if decoding with it looks wrong.
+func detectEncoding(data []byte, threshold int) detectedEncoding {
+	if threshold <= 0 || len(data) < threshold {
+		return detectEncodingFull(data, detectFull)
+	}
+
+	sample := sampleForDetection(data)
+	votes := make(map[string]int)
+	best := ""
+
+	for chunk := range slices.Chunk(sample, sniffChunk) {
+		chunk = trimLeadingContinuation(chunk)
+		if !hasHighBit(chunk) {
+			continue // pure ASCII says nothing about the charset
+		}
+
+		_, name, _ := charset.DetermineEncoding(chunk, "")
+		votes[name]++
+		if best == "" || votes[name] > votes[best] {
+			best = name
+		}
+	}
+
+	if best == "" {
+		return detectEncodingFull(data, detectFallback)
+	}
+
+	enc, name := charset.Lookup(best)
+	if enc == nil {
+		return detectEncodingFull(data, detectFallback)
+	}
+
+	return detectedEncoding{name: name, mode: detectSampled, decode: enc.NewDecoder().Bytes}
+}
+
+func detectEncodingFull(data []byte, mode detectMode) detectedEncoding {
+	enc, name, _ := charset.DetermineEncoding(data, "")
+
+	return detectedEncoding{name: name, mode: mode, decode: enc.NewDecoder().Bytes}
+}
+
+// sampleForDetection concatenates the head, the tail and a few windows from the middle.
+// Window offsets are pseudo-random but seeded with the file size, so the same file
+// is always sampled the same way. Windows start after a line break when there is one
+// nearby, which keeps multi-byte sequences and CSV records mostly intact.
+func sampleForDetection(data []byte) []byte {
+	if len(data) <= sampleWindow*(sampleRandomWindows+2) {
+		return data
+	}
+
+	out := make([]byte, 0, sampleWindow*(sampleRandomWindows+2)+sampleRandomWindows+1)
+	out = append(out, data[:sampleWindow]...)
+
+	rng := rand.New(rand.NewPCG(uint64(len(data)), sampleRandomWindows))
+	span := len(data) - 3*sampleWindow
+
+	for range sampleRandomWindows {
+		start := sampleWindow + rng.IntN(span)
+		if nl := bytes.IndexByte(data[start:start+sampleWindow/2], '\n'); nl >= 0 {
+			start += nl + 1
+		}
+
+		out = append(out, '\n')
+		out = append(out, data[start:start+sampleWindow]...)
+	}
+
+	out = append(out, '\n')
+	out = append(out, data[len(data)-sampleWindow:]...)
+
+	return out
+}
+
+// suspiciousDecode reports whether decoding produced replacement characters or invalid UTF-8,
+// which means the sampled guess does not fit the rest of the file.
+func suspiciousDecode(decoded []byte) bool {
+	return !utf8.Valid(decoded) || bytes.ContainsRune(decoded, utf8.RuneError)
+}
+
+func hasHighBit(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trimLeadingContinuation drops the tail of a multi-byte sequence cut by chunking,
+// so a UTF-8 chunk does not look invalid just because it starts mid-rune.
+func trimLeadingContinuation(b []byte) []byte {
+	for i := 0; i < utf8.UTFMax-1 && len(b) > 0 && !utf8.RuneStart(b[0]); i++ {
+		b = b[1:]
+	}
+
+	return b
+}
@@ -0,0 +1,104 @@
+package encoding
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSampleForDetection_BoundedAndDeterministic(t *testing.T) {
+	t.Parallel()
+
+	small := []byte("term;description\nfoo;bar\n")
+	if got := sampleForDetection(small); !bytes.Equal(got, small) {
+		t.Fatalf("small input must be used whole")
+	}
+
+	big := bytes.Repeat([]byte("term;description\n"), 200_000)
+	a := sampleForDetection(big)
+	b := sampleForDetection(big)
+	if !bytes.Equal(a, b) {
+		t.Fatalf("sampling must be deterministic for the same input")
+	}
+	if limit := sampleWindow*(sampleRandomWindows+2) + sampleRandomWindows + 1; len(a) > limit {
+		t.Fatalf("sample too large: %d > %d", len(a), limit)
+	}
+	if !bytes.HasPrefix(a, big[:sampleWindow]) || !bytes.HasSuffix(a, big[len(big)-sampleWindow:]) {
+		t.Fatalf("sample must include the head and the tail")
+	}
+}
+
+func TestToUTF8Context_SampledDetectionLooksPastUTF8Head(t *testing.T) {
+	t.Parallel()
+
+	// The first KB is valid UTF-8 with high bits, so head-only sniffing says utf-8;
+	// the rest of the file is windows-1252.
+	var b bytes.Buffer
+	b.WriteString("term;description\n")
+	for b.Len() < 1100 {
+		b.WriteString("gr\xc3\xbc\xc3\x9fe;hello\n")
+	}
+	for b.Len() < 2<<20 {
+		b.WriteString("caf\xe9;coffee\n")
+	}
+	data := b.Bytes()
+
+	if _, err := ToUTF8Context(context.Background(), data, Options{}); err == nil {
+		t.Fatalf("expected head-only detection to fail on this input")
+	}
+
+	fr, err := ToUTF8Context(context.Background(), data, Options{SampleThreshold: 1 << 20})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !fr.Changed || !strings.Contains(fr.Note, "windows-1252") || !strings.Contains(fr.Note, "detection: sampled") {
+		t.Fatalf("unexpected note %q", fr.Note)
+	}
+	if !bytes.Contains(fr.Data, []byte("café;coffee")) {
+		t.Fatalf("windows-1252 rows not decoded")
+	}
+}
+
+func TestToUTF8Context_SampledDetectionFallsBackToFullScan(t *testing.T) {
+	t.Parallel()
+
+	base := bytes.Repeat([]byte("term;description\n"), 150_000)
+
+	// Put the only legacy byte somewhere the sample does not cover.
+	var data []byte
+	for off := len(base) / 3; off < len(base)*2/3; off += 17 {
+		candidate := append(append(append([]byte(nil), base[:off]...), "caf\xe9\n"...), base[off:]...)
+		if !hasHighBit(sampleForDetection(candidate)) {
+			data = candidate
+			break
+		}
+	}
+	if data == nil {
+		t.Fatalf("could not place a byte outside the sample")
+	}
+
+	fr, err := ToUTF8Context(context.Background(), data, Options{SampleThreshold: 1 << 20})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !strings.Contains(fr.Note, "detection: sampled, fell back to full scan") {
+		t.Fatalf("expected fallback in note, got %q", fr.Note)
+	}
+	if !bytes.Contains(fr.Data, []byte("café\n")) {
+		t.Fatalf("legacy byte not decoded")
+	}
+}
+
+func TestDetect_ReportsSampledPath(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte("caf\xe9;coffee\n"), 200_000)
+
+	if d, ok := Detect(data, Options{SampleThreshold: 1 << 20}); !ok || d.Path != "charset detection (sampled)" || d.Encoding != "windows-1252" {
+		t.Fatalf("unexpected detection %+v", d)
+	}
+	if d, _ := Detect(data, Options{}); d.Path != "charset detection" {
+		t.Fatalf("unexpected detection %+v", d)
+	}
+}
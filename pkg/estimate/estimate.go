@@ -0,0 +1,217 @@
+// Package estimate predicts how long a validation run will take before it starts,
+// so services can pick timeouts and queues for a file from its size alone.
+//
+// Predictions come from a Model of per-check benchmark coefficients: a fixed cost
+// plus a cost per megabyte of input. DefaultModel is a rough generic baseline;
+// Calibrate measures a model on the service's own hardware, and models are stored
+// as JSON (Save, Load).
+package estimate
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Coefficient is the cost model of one check: Fixed + PerMB * size in megabytes.
+type Coefficient struct {
+	Fixed time.Duration `json:"fixed_ns"`
+	PerMB time.Duration `json:"per_mb_ns"`
+}
+
+// At returns the predicted duration for an input of size bytes.
+func (c Coefficient) At(size int64) time.Duration {
+	return c.Fixed + time.Duration(float64(c.PerMB)*float64(size)/(1<<20))
+}
+
+// Model holds the coefficients of known checks plus a fallback for the others.
+type Model struct {
+	// Checks maps check names to their coefficients.
+	Checks map[string]Coefficient `json:"checks"`
+
+	// Default applies to checks missing from Checks.
+	Default Coefficient `json:"default"`
+
+	// FixFactor multiplies the prediction of fixable checks (and of checks that do not
+	// declare capabilities) when the run may fix
+	// (fix plus revalidation); values below 1 count as 1.
+	FixFactor float64 `json:"fix_factor"`
+}
+
+// DefaultModel is a conservative generic baseline measured for a typical check
+// on commodity hardware. Calibrate a model for accurate numbers.
+var DefaultModel = Model{
+	Default:   Coefficient{Fixed: 250 * time.Microsecond, PerMB: 10 * time.Millisecond},
+	FixFactor: 2,
+}
+
+// CheckEstimate is the prediction for one check.
+type CheckEstimate struct {
+	Name     string
+	Duration time.Duration
+	Measured bool // the model has coefficients for this check (not Default)
+}
+
+// Estimate is the prediction for a whole run.
+type Estimate struct {
+	Size  int64
+	Total time.Duration
+
+	// Checks are in run order.
+	Checks []CheckEstimate
+}
+
+// Slowest returns up to n check estimates, longest first.
+func (e Estimate) Slowest(n int) []CheckEstimate {
+	out := slices.Clone(e.Checks)
+	slices.SortStableFunc(out, func(a, b CheckEstimate) int {
+		return cmp.Compare(b.Duration, a.Duration)
+	})
+
+	return out[:min(n, len(out))]
+}
+
+// Run predicts a run over size bytes with opts: it resolves the checks the run would
+// execute (checks.ResolveRun) and sums their predictions. Nothing is executed.
+func (m Model) Run(size int64, opts checks.RunOptions) (Estimate, error) {
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		return Estimate{}, err
+	}
+
+	return m.Units(size, units, opts.FixMode != checks.FixNone), nil
+}
+
+// Units predicts a run of units over size bytes; fixing says whether fixes may run.
+func (m Model) Units(size int64, units []checks.CheckUnit, fixing bool) Estimate {
+	est := Estimate{Size: size, Checks: make([]CheckEstimate, 0, len(units))}
+
+	for _, u := range units {
+		c, measured := m.Checks[u.Name()]
+		if !measured {
+			c = m.Default
+		}
+
+		d := c.At(size)
+		if caps := checks.CapabilitiesOf(u); fixing && (caps.SupportsFix || !caps.Declared) && m.FixFactor > 1 {
+			d = time.Duration(float64(d) * m.FixFactor)
+		}
+
+		est.Checks = append(est.Checks, CheckEstimate{Name: u.Name(), Duration: d, Measured: measured})
+		est.Total += d
+	}
+
+	return est
+}
+
+// Load reads a model written by Save.
+func Load(r io.Reader) (Model, error) {
+	var m Model
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return Model{}, err
+	}
+
+	return m, nil
+}
+
+// Save writes m as indented JSON.
+func (m Model) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(m)
+}
+
+// Calibrate measures a model by running every check in units on every sample without
+// fixing, and fitting Fixed and PerMB per check by least squares over the sample sizes.
+// Use samples of clearly different sizes. Default is the mean of the fitted checks;
+// FixFactor is taken from DefaultModel.
+func Calibrate(ctx context.Context, units []checks.CheckUnit, samples [][]byte, opts checks.RunOptions) (Model, error) {
+	opts.FixMode = checks.FixNone
+
+	m := Model{Checks: make(map[string]Coefficient, len(units)), FixFactor: DefaultModel.FixFactor}
+
+	for _, u := range units {
+		sizes := make([]float64, 0, len(samples))
+		nanos := make([]float64, 0, len(samples))
+
+		for _, data := range samples {
+			if err := ctx.Err(); err != nil {
+				return Model{}, err
+			}
+
+			a := checks.Artifact{Data: data, Path: "calibrate.csv", Cache: checks.NewParseCache()}
+			start := time.Now()
+			_ = u.Run(ctx, a, opts)
+			elapsed := time.Since(start)
+
+			sizes = append(sizes, float64(len(data)))
+			nanos = append(nanos, float64(elapsed))
+		}
+
+		m.Checks[u.Name()] = fit(sizes, nanos)
+	}
+
+	if len(m.Checks) > 0 {
+		var fixed, perMB time.Duration
+		for _, c := range m.Checks {
+			fixed += c.Fixed
+			perMB += c.PerMB
+		}
+		n := time.Duration(len(m.Checks))
+		m.Default = Coefficient{Fixed: fixed / n, PerMB: perMB / n}
+	}
+
+	return m, nil
+}
+
+// fit returns the least-squares line through (size, nanos), with both terms clamped
+// at zero. A single size yields a pure per-megabyte cost.
+func fit(sizes, nanos []float64) Coefficient {
+	if len(sizes) == 0 {
+		return Coefficient{}
+	}
+
+	var meanX, meanY float64
+	for i := range sizes {
+		meanX += sizes[i]
+		meanY += nanos[i]
+	}
+	meanX /= float64(len(sizes))
+	meanY /= float64(len(sizes))
+
+	var cov, varX float64
+	for i := range sizes {
+		dx := sizes[i] - meanX
+		cov += dx * (nanos[i] - meanY)
+		varX += dx * dx
+	}
+
+	var slope, intercept float64
+	switch {
+	case varX > 0:
+		slope = cov / varX
+		intercept = meanY - slope*meanX
+	case meanX > 0:
+		slope = meanY / meanX
+	default:
+		intercept = meanY
+	}
+
+	if slope < 0 {
+		slope, intercept = 0, meanY
+	}
+	if intercept < 0 {
+		intercept = 0
+	}
+
+	return Coefficient{
+		Fixed: time.Duration(intercept),
+		PerMB: time.Duration(slope * (1 << 20)),
+	}
+}
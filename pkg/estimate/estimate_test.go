@@ -0,0 +1,137 @@
+package estimate_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/estimate"
+)
+
+func mkUnit(t *testing.T, name string, prio int, run func(a checks.Artifact), opts ...checks.Option) checks.CheckUnit {
+	t.Helper()
+
+	opts = append(opts, checks.WithPriority(prio))
+	u, err := checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		if run != nil {
+			run(a)
+		}
+		return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	return u
+}
+
+func TestCoefficient_At(t *testing.T) {
+	t.Parallel()
+
+	c := estimate.Coefficient{Fixed: time.Millisecond, PerMB: 10 * time.Millisecond}
+	if got := c.At(0); got != time.Millisecond {
+		t.Fatalf("At(0) = %v", got)
+	}
+	if got := c.At(3 << 19); got != 16*time.Millisecond {
+		t.Fatalf("At(1.5MB) = %v, want 16ms", got)
+	}
+}
+
+func TestModel_Units(t *testing.T) {
+	t.Parallel()
+
+	units := []checks.CheckUnit{
+		mkUnit(t, "fast", 1, nil),
+		mkUnit(t, "slow", 2, nil, checks.WithFix()),
+	}
+	m := estimate.Model{
+		Checks:    map[string]estimate.Coefficient{"slow": {PerMB: 100 * time.Millisecond}},
+		Default:   estimate.Coefficient{Fixed: time.Millisecond},
+		FixFactor: 3,
+	}
+
+	est := m.Units(2<<20, units, false)
+	if est.Total != 201*time.Millisecond || len(est.Checks) != 2 {
+		t.Fatalf("unexpected estimate: %+v", est)
+	}
+	if est.Checks[0].Measured || !est.Checks[1].Measured {
+		t.Fatalf("Measured flags wrong: %+v", est.Checks)
+	}
+	if s := est.Slowest(1); len(s) != 1 || s[0].Name != "slow" {
+		t.Fatalf("Slowest(1) = %+v", s)
+	}
+
+	// Only the fixable check is scaled when fixing.
+	if est := m.Units(2<<20, units, true); est.Total != 601*time.Millisecond {
+		t.Fatalf("fixing total = %v, want 601ms", est.Total)
+	}
+}
+
+func TestModel_Run(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	if _, err := checks.Register(mkUnit(t, "a", 1, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := checks.Register(mkUnit(t, "b", 2, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	est, err := estimate.DefaultModel.Run(1<<20, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	want := 2 * estimate.DefaultModel.Default.At(1<<20)
+	if est.Total != want || est.Checks[0].Name != "a" {
+		t.Fatalf("unexpected estimate: %+v (want total %v)", est, want)
+	}
+
+	if _, err := estimate.DefaultModel.Run(1, checks.RunOptions{CheckSet: "missing"}); err == nil {
+		t.Fatalf("expected error for unknown check set")
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	t.Parallel()
+
+	// Cost grows with the input: 1µs per byte, no fixed cost to speak of.
+	slow := mkUnit(t, "slow", 1, func(a checks.Artifact) {
+		time.Sleep(time.Duration(len(a.Data)) * time.Microsecond)
+	})
+
+	samples := [][]byte{bytes.Repeat([]byte("x"), 2000), bytes.Repeat([]byte("x"), 20000)}
+
+	m, err := estimate.Calibrate(context.Background(), []checks.CheckUnit{slow}, samples, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("Calibrate: %v", err)
+	}
+
+	c := m.Checks["slow"]
+	if perByte := c.PerMB / (1 << 20); perByte < 900*time.Nanosecond || perByte > 5*time.Microsecond {
+		t.Fatalf("PerMB = %v, want about 1µs per byte", c.PerMB)
+	}
+	if m.Default != c {
+		t.Fatalf("Default = %+v, want the only fitted check %+v", m.Default, c)
+	}
+
+	var buf bytes.Buffer
+	if err := m.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := estimate.Load(&buf)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.Checks["slow"] != c || loaded.FixFactor != m.FixFactor {
+		t.Fatalf("round trip mismatch: %+v vs %+v", loaded, m)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := estimate.Calibrate(ctx, []checks.CheckUnit{slow}, samples, checks.RunOptions{}); err == nil {
+		t.Fatalf("expected context error")
+	}
+}
@@ -0,0 +1,230 @@
+// Package fixstats keeps per-check fix statistics across runs: how often each check
+// ran, how often its fixer changed the file, and how many rows those fixes touched.
+// Maintainers use them to see which real-world problems dominate.
+//
+// Register Middleware (or call Install) with a Sink to record every check execution.
+// JSONFile is a Sink that accumulates in memory and persists to a JSON file on Save.
+package fixstats
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/internal/atomicfile"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Event is one check execution.
+type Event struct {
+	Time        time.Time
+	Check       string
+	Status      checks.Status
+	Fixed       bool // the check's fix changed the data
+	RowsTouched int  // lines the fix changed, added or removed (see RowsTouched)
+}
+
+// Sink receives events. Implementations must be safe for concurrent use.
+type Sink interface {
+	Record(ev Event) error
+}
+
+// Stats are the accumulated numbers of one check.
+type Stats struct {
+	Check       string    `json:"check"`
+	Runs        int       `json:"runs"`
+	Fixes       int       `json:"fixes"`
+	RowsTouched int       `json:"rows_touched"`
+	LastFixed   time.Time `json:"last_fixed,omitzero"`
+}
+
+// AvgRowsTouched is the mean number of rows one fix touched, 0 without fixes.
+func (s Stats) AvgRowsTouched() float64 {
+	if s.Fixes == 0 {
+		return 0
+	}
+
+	return float64(s.RowsTouched) / float64(s.Fixes)
+}
+
+// FixRate is the share of runs in which the fix changed the data.
+func (s Stats) FixRate() float64 {
+	if s.Runs == 0 {
+		return 0
+	}
+
+	return float64(s.Fixes) / float64(s.Runs)
+}
+
+// fileVersion is the format version written by JSONFile.Save.
+const fileVersion = 1
+
+type fileFormat struct {
+	Version int     `json:"version"`
+	Checks  []Stats `json:"checks"`
+}
+
+// JSONFile accumulates Stats in memory and persists them to a JSON file.
+// Safe for concurrent use.
+type JSONFile struct {
+	path string
+
+	mu    sync.Mutex
+	stats map[string]*Stats
+}
+
+// OpenJSONFile loads the statistics stored at path. A missing file starts empty;
+// it is created by the first Save.
+func OpenJSONFile(path string) (*JSONFile, error) {
+	f := &JSONFile{path: path, stats: make(map[string]*Stats)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ff fileFormat
+	if err := json.Unmarshal(data, &ff); err != nil {
+		return nil, err
+	}
+	for _, s := range ff.Checks {
+		f.stats[s.Check] = &s
+	}
+
+	return f, nil
+}
+
+// Record adds ev to the statistics of ev.Check. It never fails.
+func (f *JSONFile) Record(ev Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s, ok := f.stats[ev.Check]
+	if !ok {
+		s = &Stats{Check: ev.Check}
+		f.stats[ev.Check] = s
+	}
+
+	s.Runs++
+	if ev.Fixed {
+		s.Fixes++
+		s.RowsTouched += ev.RowsTouched
+		s.LastFixed = ev.Time
+	}
+
+	return nil
+}
+
+// Stats returns a copy of the statistics, most frequently fixing checks first
+// (ties by name).
+func (f *JSONFile) Stats() []Stats {
+	f.mu.Lock()
+	out := make([]Stats, 0, len(f.stats))
+	for _, s := range f.stats {
+		out = append(out, *s)
+	}
+	f.mu.Unlock()
+
+	slices.SortFunc(out, func(a, b Stats) int {
+		return cmp.Or(cmp.Compare(b.Fixes, a.Fixes), cmp.Compare(a.Check, b.Check))
+	})
+
+	return out
+}
+
+// Save writes the statistics to the file, replacing it atomically.
+func (f *JSONFile) Save() error {
+	data, err := json.MarshalIndent(fileFormat{Version: fileVersion, Checks: f.Stats()}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(f.path, append(data, '\n'), 0o600)
+}
+
+// RowsTouched estimates how many rows a fix touched by comparing lines. With equal
+// line counts it counts the lines that differ; otherwise it counts the longer side
+// of the region between the common leading and trailing lines.
+func RowsTouched(before, after []byte) int {
+	if bytes.Equal(before, after) {
+		return 0
+	}
+
+	b, a := splitLines(before), splitLines(after)
+
+	if len(b) == len(a) {
+		n := 0
+		for i := range b {
+			if !bytes.Equal(b[i], a[i]) {
+				n++
+			}
+		}
+		return n
+	}
+
+	prefix := 0
+	for prefix < len(b) && prefix < len(a) && bytes.Equal(b[prefix], a[prefix]) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(b)-prefix && suffix < len(a)-prefix &&
+		bytes.Equal(b[len(b)-1-suffix], a[len(a)-1-suffix]) {
+		suffix++
+	}
+
+	return max(len(b), len(a)) - prefix - suffix
+}
+
+func splitLines(data []byte) [][]byte {
+	data = bytes.TrimSuffix(data, []byte("\n"))
+	lines := bytes.Split(data, []byte("\n"))
+	for i, l := range lines {
+		lines[i] = bytes.TrimSuffix(l, []byte("\r"))
+	}
+
+	return lines
+}
+
+// Middleware returns a validator middleware that records every check execution to s.
+// Sink errors never change validation results.
+func Middleware(s Sink) validator.Middleware {
+	return func(next validator.Step) validator.Step {
+		return func(
+			ctx context.Context,
+			unit checks.CheckUnit,
+			a checks.Artifact,
+			ro checks.RunOptions,
+		) checks.CheckOutcome {
+			out := next(ctx, unit, a, ro)
+
+			ev := Event{
+				Time:   time.Now().UTC(),
+				Check:  unit.Name(),
+				Status: out.Result.Status,
+				Fixed:  out.Final.DidChange,
+			}
+			if ev.Fixed && out.Final.Data != nil {
+				ev.RowsTouched = RowsTouched(a.Data, out.Final.Data)
+			}
+			_ = s.Record(ev)
+
+			return out
+		}
+	}
+}
+
+// Install registers Middleware globally via validator.Use.
+func Install(s Sink) {
+	validator.Use(Middleware(s))
+}
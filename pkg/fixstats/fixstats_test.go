@@ -0,0 +1,126 @@
+package fixstats_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/fixstats"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestRowsTouched(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		before, after string
+		want          int
+	}{
+		{"a\nb\nc\n", "a\nb\nc\n", 0},
+		{"a\nb\nc\n", "a\nB\nC\n", 2},
+		{"a\r\nb\r\n", "a\nb\n", 0},
+		{"h\na\na\nb\n", "h\na\nb\n", 1},
+		{"h\nb\n", "h\nx\ny\nb\n", 2},
+	}
+
+	for _, tt := range tests {
+		if got := fixstats.RowsTouched([]byte(tt.before), []byte(tt.after)); got != tt.want {
+			t.Fatalf("RowsTouched(%q, %q) = %d, want %d", tt.before, tt.after, got, tt.want)
+		}
+	}
+}
+
+func TestJSONFile_PersistsAcrossRuns(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "stats.json")
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	f, err := fixstats.OpenJSONFile(path)
+	if err != nil {
+		t.Fatalf("open missing file: %v", err)
+	}
+	_ = f.Record(fixstats.Event{Check: "a", Fixed: true, RowsTouched: 4, Time: at})
+	_ = f.Record(fixstats.Event{Check: "a"})
+	_ = f.Record(fixstats.Event{Check: "b"})
+	if err := f.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	f, err = fixstats.OpenJSONFile(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	_ = f.Record(fixstats.Event{Check: "a", Fixed: true, RowsTouched: 2, Time: at.Add(time.Hour)})
+
+	got := f.Stats()
+	if len(got) != 2 || got[0].Check != "a" || got[1].Check != "b" {
+		t.Fatalf("stats = %+v", got)
+	}
+	a := got[0]
+	if a.Runs != 3 || a.Fixes != 2 || a.RowsTouched != 6 || !a.LastFixed.Equal(at.Add(time.Hour)) {
+		t.Fatalf("a = %+v", a)
+	}
+	if a.AvgRowsTouched() != 3 || a.FixRate() != 2.0/3 {
+		t.Fatalf("avg=%v rate=%v", a.AvgRowsTouched(), a.FixRate())
+	}
+	if got[1].Runs != 1 || got[1].AvgRowsTouched() != 0 {
+		t.Fatalf("b = %+v", got[1])
+	}
+}
+
+func TestMiddleware_RecordsFixes(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	register(t, "alpha", 1, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, "alpha", "ok", a, "")
+	})
+	register(t, "beta", 2, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		final := checks.FixResult{Data: []byte("term\nX\ny\n"), Path: a.Path, DidChange: true}
+		return checks.OutcomeWithFinal(checks.Warn, "beta", "fixed", final)
+	})
+
+	f, err := fixstats.OpenJSONFile(filepath.Join(t.TempDir(), "stats.json"))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	fixstats.Install(f)
+
+	if _, err := validator.Validate(context.Background(), "file.csv", []byte("term\nx\ny\n"), nil,
+		checks.RunOptions{FixMode: checks.FixAlways}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := f.Stats()
+	if len(got) != 2 {
+		t.Fatalf("stats = %+v", got)
+	}
+	if got[0].Check != "beta" || got[0].Fixes != 1 || got[0].RowsTouched != 1 || got[0].LastFixed.IsZero() {
+		t.Fatalf("beta = %+v", got[0])
+	}
+	if got[1].Check != "alpha" || got[1].Runs != 1 || got[1].Fixes != 0 {
+		t.Fatalf("alpha = %+v", got[1])
+	}
+}
+
+func register(
+	t *testing.T,
+	name string,
+	prio int,
+	run func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome,
+) {
+	t.Helper()
+
+	ch, err := checks.NewCheckAdapter(name, run, checks.WithPriority(prio))
+	if err != nil {
+		t.Fatalf("NewCheckAdapter(%s): %v", name, err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("Register(%s): %v", name, err)
+	}
+}
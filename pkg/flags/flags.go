@@ -0,0 +1,94 @@
+// Package flags holds the canonical vocabulary of glossary flag columns
+// (casesensitive, translatable, forbidden), so checks and other tools agree
+// on which columns are flags and which values they accept.
+package flags
+
+import "strings"
+
+// Flag column names, as spelled in a normalized header.
+const (
+	ColumnCaseSensitive = "casesensitive"
+	ColumnTranslatable  = "translatable"
+	ColumnForbidden     = "forbidden"
+)
+
+// Canonical flag values. Lokalise accepts nothing else.
+const (
+	Yes = "yes"
+	No  = "no"
+)
+
+var columns = []string{
+	ColumnCaseSensitive,
+	ColumnTranslatable,
+	ColumnForbidden,
+}
+
+// defaults are the values Lokalise assumes for blank flag cells on import.
+var defaults = map[string]string{
+	ColumnCaseSensitive: No,
+	ColumnTranslatable:  Yes,
+	ColumnForbidden:     No,
+}
+
+// Default returns the value Lokalise assumes when a flag cell is blank,
+// or "" when name is not a flag column. Matching ignores case and surrounding whitespace.
+func Default(name string) string {
+	return defaults[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// Columns returns the flag column names in canonical header order.
+// The returned slice is a copy.
+func Columns() []string {
+	out := make([]string, len(columns))
+	copy(out, columns)
+
+	return out
+}
+
+// IsColumn reports whether a header cell names a flag column.
+// Matching ignores case and surrounding whitespace.
+func IsColumn(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, col := range columns {
+		if name == col {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsValid reports whether v is a canonical flag value ("yes" or "no").
+// Surrounding whitespace is ignored; case is not.
+func IsValid(v string) bool {
+	v = strings.TrimSpace(v)
+	return v == Yes || v == No
+}
+
+// Parse reads a flag value leniently: yes/y/true/1 and no/n/false/0 in any case,
+// surrounded by any whitespace. ok is false for blank or unrecognized values.
+func Parse(v string) (value, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "yes", "y", "true", "1":
+		return true, true
+	case "no", "n", "false", "0":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// Normalize rewrites a leniently spelled flag value to "yes" or "no".
+// Blank and unrecognized values are returned unchanged.
+func Normalize(v string) string {
+	value, ok := Parse(v)
+	if !ok {
+		return v
+	}
+	if value {
+		return Yes
+	}
+
+	return No
+}
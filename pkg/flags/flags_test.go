@@ -0,0 +1,110 @@
+package flags_test
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/flags"
+)
+
+func TestColumns(t *testing.T) {
+	t.Parallel()
+
+	got := flags.Columns()
+	want := []string{"casesensitive", "translatable", "forbidden"}
+	if len(got) != len(want) {
+		t.Fatalf("Columns() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Columns() = %q, want %q", got, want)
+		}
+	}
+
+	got[0] = "mutated"
+	if flags.Columns()[0] != flags.ColumnCaseSensitive {
+		t.Fatalf("Columns() must return a copy")
+	}
+}
+
+func TestIsColumn(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"casesensitive", " CaseSensitive ", "TRANSLATABLE", "forbidden"} {
+		if !flags.IsColumn(name) {
+			t.Fatalf("IsColumn(%q) = false, want true", name)
+		}
+	}
+	for _, name := range []string{"", "term", "tags", "case sensitive"} {
+		if flags.IsColumn(name) {
+			t.Fatalf("IsColumn(%q) = true, want false", name)
+		}
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]bool{
+		"yes":   true,
+		" no ":  true,
+		"Yes":   false,
+		"y":     false,
+		"true":  false,
+		"":      false,
+		"maybe": false,
+	}
+	for in, want := range cases {
+		if got := flags.IsValid(in); got != want {
+			t.Fatalf("IsValid(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
+
+func TestParseAndNormalize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		in        string
+		value, ok bool
+		norm      string
+	}{
+		{"yes", true, true, "yes"},
+		{" Y ", true, true, "yes"},
+		{"TRUE", true, true, "yes"},
+		{"1", true, true, "yes"},
+		{"No", false, true, "no"},
+		{"n", false, true, "no"},
+		{"false", false, true, "no"},
+		{"0", false, true, "no"},
+		{"", false, false, ""},
+		{"  ", false, false, "  "},
+		{"maybe", false, false, "maybe"},
+	}
+
+	for _, tc := range cases {
+		value, ok := flags.Parse(tc.in)
+		if value != tc.value || ok != tc.ok {
+			t.Fatalf("Parse(%q) = %v, %v; want %v, %v", tc.in, value, ok, tc.value, tc.ok)
+		}
+		if got := flags.Normalize(tc.in); got != tc.norm {
+			t.Fatalf("Normalize(%q) = %q, want %q", tc.in, got, tc.norm)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"casesensitive":  flags.No,
+		" Translatable ": flags.Yes,
+		"FORBIDDEN":      flags.No,
+		"tags":           "",
+		"":               "",
+	}
+	for col, want := range tests {
+		if got := flags.Default(col); got != want {
+			t.Fatalf("Default(%q) = %q, want %q", col, got, want)
+		}
+	}
+}
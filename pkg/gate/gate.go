@@ -0,0 +1,102 @@
+// Package gate turns a validation summary into a single pass/fail decision,
+// e.g. for CI pipelines and notifications.
+package gate
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Policy configures Decide. The zero value fails the gate on FAIL and ERROR results.
+type Policy struct {
+	// FailOn is the least severe status that fails the gate (Info, Warn, Fail or Error).
+	// Empty means Fail. SKIPPED results never fail the gate.
+	FailOn checks.Status
+
+	// FailOnTruncated fails the gate when results are partial (Summary.Truncated).
+	FailOnTruncated bool
+
+	// FailOnRegressions fails the gate when a fix broke a check that had passed
+	// (Summary.Regressions, see RunOptions.RecheckAfterFixes).
+	FailOnRegressions bool
+}
+
+// Decision is the gate verdict for one summary.
+type Decision struct {
+	Passed bool
+	Reason string // e.g. "2 FAIL, 1 ERROR" or "no WARN, FAIL or ERROR results"
+}
+
+// Decide applies p to sum.
+func Decide(sum validator.Summary, p Policy) Decision {
+	failOn := p.FailOn
+	if failOn == "" {
+		failOn = checks.Fail
+	}
+	minRank := severity(failOn)
+
+	counts := []struct {
+		status checks.Status
+		n      int
+	}{
+		{checks.Info, sum.Info},
+		{checks.Warn, sum.Warn},
+		{checks.Fail, sum.Fail},
+		{checks.Error, sum.Error},
+	}
+
+	var blocking, considered []string
+	for _, c := range counts {
+		if severity(c.status) < minRank {
+			continue
+		}
+		considered = append(considered, string(c.status))
+		if c.n > 0 {
+			blocking = append(blocking, strconv.Itoa(c.n)+" "+string(c.status))
+		}
+	}
+
+	if p.FailOnTruncated && sum.Truncated {
+		blocking = append(blocking, "results truncated")
+	}
+
+	if p.FailOnRegressions && len(sum.Regressions) > 0 {
+		blocking = append(blocking, strconv.Itoa(len(sum.Regressions))+" regressed after fixes")
+	}
+
+	if len(blocking) > 0 {
+		return Decision{Passed: false, Reason: strings.Join(blocking, ", ")}
+	}
+
+	return Decision{Passed: true, Reason: "no " + joinOr(considered) + " results"}
+}
+
+// severity ranks statuses from least to most severe; unknown statuses rank as Fail.
+func severity(s checks.Status) int {
+	switch s {
+	case checks.Pass, checks.Skipped:
+		return 0
+	case checks.Info:
+		return 1
+	case checks.Warn:
+		return 2
+	case checks.Error:
+		return 4
+	default:
+		return 3
+	}
+}
+
+func joinOr(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
+	}
+}
@@ -0,0 +1,45 @@
+package gate_test
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/gate"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestDecide(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		sum    validator.Summary
+		policy gate.Policy
+		passed bool
+		reason string
+	}{
+		{"clean run passes", validator.Summary{Pass: 3}, gate.Policy{}, true, "no FAIL or ERROR results"},
+		{"warnings pass by default", validator.Summary{Pass: 1, Warn: 2}, gate.Policy{}, true, "no FAIL or ERROR results"},
+		{"fail and error block", validator.Summary{Fail: 2, Error: 1}, gate.Policy{}, false, "2 FAIL, 1 ERROR"},
+		{"fail on warn", validator.Summary{Warn: 1}, gate.Policy{FailOn: checks.Warn}, false, "1 WARN"},
+		{"fail on warn, clean", validator.Summary{Pass: 1}, gate.Policy{FailOn: checks.Warn}, true, "no WARN, FAIL or ERROR results"},
+		{"fail on error ignores fail", validator.Summary{Fail: 1}, gate.Policy{FailOn: checks.Error}, true, "no ERROR results"},
+		{"info and skipped pass on warn", validator.Summary{Info: 2, Skipped: 3}, gate.Policy{FailOn: checks.Warn}, true, "no WARN, FAIL or ERROR results"},
+		{"fail on info", validator.Summary{Info: 2, Skipped: 3}, gate.Policy{FailOn: checks.Info}, false, "2 INFO"},
+		{"truncated ignored by default", validator.Summary{Truncated: true}, gate.Policy{}, true, "no FAIL or ERROR results"},
+		{"truncated blocks when asked", validator.Summary{Truncated: true}, gate.Policy{FailOnTruncated: true}, false, "results truncated"},
+		{"regressions ignored by default", validator.Summary{Regressions: make([]validator.Regression, 2)}, gate.Policy{}, true, "no FAIL or ERROR results"},
+		{"regressions block when asked", validator.Summary{Regressions: make([]validator.Regression, 2)}, gate.Policy{FailOnRegressions: true}, false, "2 regressed after fixes"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := gate.Decide(tc.sum, tc.policy)
+			if d.Passed != tc.passed || d.Reason != tc.reason {
+				t.Fatalf("Decide() = %+v, want passed=%v reason=%q", d, tc.passed, tc.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Package guard is the stable entry point of the library.
+//
+// Everything exported here follows semantic versioning: within a major version,
+// names are not removed, signatures do not change and behaviour only changes to fix bugs.
+// New checks may be added to the catalog in minor releases. Packages under pkg/checks
+// and pkg/validator remain available but may change between minor releases;
+// prefer this package unless you write your own checks.
+//
+// Importing guard registers every built-in check.
+package guard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	_ "github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks/all"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Re-exported core types. They are aliases, so values pass freely between
+// this package and the lower-level ones.
+type (
+	Summary    = validator.Summary
+	Status     = checks.Status
+	Finding    = checks.Finding
+	Outcome    = checks.CheckOutcome
+	Result     = checks.CheckResult
+	RunError   = validator.RunError
+	Regression = validator.Regression
+	Languages  = validator.Languages
+
+	// SummaryDocument is the JSON form a Summary marshals to.
+	SummaryDocument = validator.SummaryDocument
+	Scope           = checks.Scope
+
+	FindingsSidecar = checks.FindingsSidecar
+)
+
+// Check statuses.
+const (
+	Pass    = checks.Pass
+	Warn    = checks.Warn
+	Fail    = checks.Fail
+	Error   = checks.Error
+	Info    = checks.Info
+	Skipped = checks.Skipped
+)
+
+// SchemaVersion is the version of the JSON document a Summary marshals to.
+const SchemaVersion = validator.SchemaVersion
+
+// Check scopes reported by Catalog.
+const (
+	ScopeUnknown = checks.ScopeUnknown
+	ScopeFile    = checks.ScopeFile
+	ScopeHeader  = checks.ScopeHeader
+	ScopeRows    = checks.ScopeRows
+)
+
+// Config selects what a run does. The zero value runs every default check without fixing.
+type Config struct {
+	// Langs are the languages the glossary is expected to contain.
+	Langs []string
+
+	// CheckSet runs only the named set of checks instead of all registered ones.
+	CheckSet string
+
+	// Only runs just the named checks; Skip leaves the named checks out (case-insensitive).
+	Only []string
+	Skip []string
+
+	// AllowDestructive lets Fix apply fixers that restructure the file.
+	AllowDestructive bool
+
+	// PreviewFixes makes Validate attach what each available fix would change to the
+	// findings (Finding.SuggestedFix) without applying it.
+	PreviewFixes bool
+
+	// PreserveHeaderCase keeps locale column labels as spelled when Fix rewrites the header.
+	PreserveHeaderCase bool
+
+	// FindingsSidecar receives every finding as JSON lines; long messages point at it.
+	FindingsSidecar *FindingsSidecar
+
+	// SourceStrings yields the project's base-language strings for the unused-terms check.
+	// It must be re-iterable; slices.Values(strs) is.
+	SourceStrings iter.Seq[string]
+
+	// MaxFindings caps findings kept per check (0: library default, negative: no cap).
+	MaxFindings int
+
+	// MaxFailures ends the run after this many FAIL findings (0: no limit).
+	MaxFailures int
+
+	// CommentPrefix marks comment lines that checks ignore (empty: none).
+	CommentPrefix string
+
+	// SafeFixes makes Fix reject fixes that lose non-empty cells without declaring it
+	// (CheckInfo.Lossy); the rejected check reports ERROR and the data stays as it was.
+	SafeFixes bool
+
+	// RecheckAfterFixes makes Fix re-run checks that passed before a later fix touched
+	// what they inspect, and report the ones that broke in Summary.Regressions.
+	RecheckAfterFixes bool
+
+	// HardFailOnErr makes any ERROR result end the run with a *RunError.
+	HardFailOnErr bool
+
+	// Settings holds per-check knobs: check name -> key -> value.
+	Settings map[string]map[string]string
+
+	// PriorityOverrides reorders checks for this run: check name -> priority.
+	PriorityOverrides map[string]int
+
+	// SeverityOverrides changes what a check's problems count as: check name -> INFO, WARN or FAIL.
+	SeverityOverrides map[string]Status
+
+	// CheckTimeout bounds each check's run time (0: no limit); CheckTimeouts overrides it
+	// per check name. A check that runs out reports ERROR and the run goes on.
+	CheckTimeout  time.Duration
+	CheckTimeouts map[string]time.Duration
+}
+
+func (c Config) runOptions(fix bool) checks.RunOptions {
+	opts := checks.RunOptions{
+		FixMode:            checks.FixNone,
+		HardFailOnErr:      c.HardFailOnErr,
+		AllowDestructive:   c.AllowDestructive,
+		PreviewFixes:       c.PreviewFixes,
+		PreserveHeaderCase: c.PreserveHeaderCase,
+		FindingsSidecar:    c.FindingsSidecar,
+		SourceStrings:      c.SourceStrings,
+		MaxFindings:        c.MaxFindings,
+		MaxFailures:        c.MaxFailures,
+		CheckSet:           c.CheckSet,
+		Only:               c.Only,
+		Skip:               c.Skip,
+		CommentPrefix:      c.CommentPrefix,
+		PriorityOverrides:  c.PriorityOverrides,
+		SeverityOverrides:  c.SeverityOverrides,
+		CheckTimeout:       c.CheckTimeout,
+		CheckTimeouts:      c.CheckTimeouts,
+	}
+	if fix {
+		opts.FixMode = checks.FixIfFailed
+		opts.RerunAfterFix = true
+		opts.RecheckAfterFixes = c.RecheckAfterFixes
+		opts.SafeFixes = c.SafeFixes
+	}
+
+	if len(c.Settings) > 0 {
+		opts.Settings = make(map[string]checks.CheckSettings, len(c.Settings))
+		for name, set := range c.Settings {
+			opts.Settings[name] = checks.CheckSettings(set)
+		}
+	}
+
+	return opts
+}
+
+// Validate checks data without changing it. path is used for messages and
+// extension checks only; nothing is read from disk.
+// A non-nil error is a *RunError; the summary is valid either way.
+func Validate(ctx context.Context, path string, data []byte, cfg Config) (Summary, error) {
+	return validator.Validate(ctx, path, data, cfg.Langs, cfg.runOptions(false))
+}
+
+// Fix validates data and applies every available fix to failing checks, re-validating after each.
+// The fixed content is in Summary.FinalData (and Summary.FinalPath, if a fix renamed the file);
+// the input slice is not modified.
+func Fix(ctx context.Context, path string, data []byte, cfg Config) (Summary, error) {
+	return validator.Validate(ctx, path, data, cfg.Langs, cfg.runOptions(true))
+}
+
+// Report writes a plain-text report of sum: a totals line, then one line per check.
+func Report(w io.Writer, sum Summary) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s: %d passed, %d warnings, %d failed, %d errors",
+		reportName(sum), sum.Pass, sum.Warn, sum.Fail, sum.Error)
+	if sum.Info > 0 {
+		fmt.Fprintf(&b, ", %d info", sum.Info)
+	}
+	if sum.Skipped > 0 {
+		fmt.Fprintf(&b, ", %d skipped", sum.Skipped)
+	}
+	b.WriteString("\n")
+
+	for _, o := range sum.Outcomes {
+		fmt.Fprintf(&b, "  [%s] %s: %s\n", o.Result.Status, o.Result.Name, o.Result.Message)
+	}
+
+	if sum.EarlyExit {
+		fmt.Fprintf(&b, "  stopped early at %s (%s)\n", sum.EarlyCheck, sum.EarlyStatus)
+	}
+	if sum.AppliedFixes {
+		b.WriteString("  fixes applied\n")
+	}
+	for _, r := range sum.Regressions {
+		fmt.Fprintf(&b, "  regressed [%s] %s after fixes by %s: %s\n",
+			r.Outcome.Result.Status, r.Check, strings.Join(r.FixedBy, ", "), r.Outcome.Result.Message)
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func reportName(sum Summary) string {
+	if sum.FilePath == "" {
+		return "glossary"
+	}
+
+	return sum.FilePath
+}
+
+// CheckInfo describes a registered check.
+type CheckInfo struct {
+	Name     string
+	Priority int
+
+	// FailFast checks stop the run when they fail.
+	FailFast bool
+
+	// OptIn checks only run with Settings[Name]["enabled"] = "true".
+	OptIn bool
+
+	// SupportsFix checks can repair what they report; Destructive ones only with Config.AllowDestructive.
+	SupportsFix bool
+	Destructive bool
+
+	// Lossy fixes drop cell contents by design (duplicates, unknown columns), which
+	// Config.SafeFixes allows.
+	Lossy bool
+
+	// Scope tells what the check looks at; ScopeUnknown for custom checks that do not declare it.
+	Scope Scope
+}
+
+// Catalog lists every registered check in execution order.
+func Catalog() []CheckInfo {
+	units := checks.ListSorted()
+
+	out := make([]CheckInfo, 0, len(units))
+	for _, u := range units {
+		info := CheckInfo{
+			Name:     u.Name(),
+			Priority: u.Priority(),
+			FailFast: u.FailFast(),
+		}
+		if o, ok := u.(interface{ OptIn() bool }); ok {
+			info.OptIn = o.OptIn()
+		}
+		if c := checks.CapabilitiesOf(u); c.Declared {
+			info.SupportsFix = c.SupportsFix
+			info.Destructive = c.Destructive
+			info.Lossy = c.Lossy
+			info.Scope = c.Scope
+		}
+
+		out = append(out, info)
+	}
+
+	return out
+}
@@ -0,0 +1,196 @@
+package guard_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/guard"
+)
+
+const validGlossary = "term;description;casesensitive;translatable;forbidden;tags;en;en_description\n" +
+	"cloud;Remote servers;no;yes;no;;cloud;\n"
+
+func TestValidate_ValidGlossary(t *testing.T) {
+	t.Parallel()
+
+	sum, err := guard.Validate(context.Background(), "gloss.csv", []byte(validGlossary), guard.Config{Langs: []string{"en"}})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if sum.Fail != 0 || sum.Error != 0 {
+		var b bytes.Buffer
+		_ = guard.Report(&b, sum)
+		t.Fatalf("expected no failures:\n%s", b.String())
+	}
+	if sum.AppliedFixes {
+		t.Fatalf("Validate must not apply fixes")
+	}
+}
+
+func TestFix_AppliesFixesWithoutTouchingInput(t *testing.T) {
+	t.Parallel()
+
+	in := []byte("Term;Description;casesensitive;translatable;forbidden;tags;en;en_description\n" +
+		"cloud;Remote servers;YES;yes;no;;cloud;\n")
+	orig := append([]byte(nil), in...)
+
+	cfg := guard.Config{Langs: []string{"en"}}
+
+	sum, err := guard.Validate(context.Background(), "gloss.csv", in, cfg)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if sum.Warn+sum.Fail == 0 {
+		t.Fatalf("expected problems before fixing")
+	}
+
+	sum, err = guard.Fix(context.Background(), "gloss.csv", in, cfg)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !sum.AppliedFixes {
+		t.Fatalf("expected fixes to be applied")
+	}
+	if !strings.HasPrefix(string(sum.FinalData), "term;description;") || !strings.Contains(string(sum.FinalData), ";yes;yes;") {
+		t.Fatalf("unexpected fixed data: %q", sum.FinalData)
+	}
+	if !bytes.Equal(in, orig) {
+		t.Fatalf("input slice was modified")
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	sum := guard.Summary{
+		FilePath: "gloss.csv",
+		Pass:     1,
+		Fail:     1,
+		Outcomes: []guard.Outcome{
+			{Result: resultOf(guard.Pass, "a", "ok")},
+			{Result: resultOf(guard.Fail, "b", "broken")},
+		},
+	}
+
+	var b bytes.Buffer
+	if err := guard.Report(&b, sum); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	want := "gloss.csv: 1 passed, 0 warnings, 1 failed, 0 errors\n" +
+		"  [PASS] a: ok\n" +
+		"  [FAIL] b: broken\n"
+	if b.String() != want {
+		t.Fatalf("report mismatch:\n got:  %q\n want: %q", b.String(), want)
+	}
+}
+
+func TestCatalog_ListsBuiltInChecksInOrder(t *testing.T) {
+	t.Parallel()
+
+	cat := guard.Catalog()
+	if len(cat) < 10 {
+		t.Fatalf("expected built-in checks, got %d", len(cat))
+	}
+
+	seen := make(map[string]guard.CheckInfo, len(cat))
+	for i, c := range cat {
+		if i > 0 && c.Priority < cat[i-1].Priority {
+			t.Fatalf("catalog not sorted by priority at %d: %+v", i, c)
+		}
+		if checks.PriorityBand(c.Priority) == "" {
+			t.Fatalf("built-in check outside the priority bands: %+v", c)
+		}
+		seen[c.Name] = c
+	}
+
+	if c, ok := seen["ensure-at-least-two-lines"]; !ok || !c.FailFast {
+		t.Fatalf("expected fail-fast ensure-at-least-two-lines, got %+v (%v)", c, ok)
+	}
+	if c, ok := seen["warn-term-overlaps"]; !ok || !c.OptIn {
+		t.Fatalf("expected opt-in warn-term-overlaps, got %+v (%v)", c, ok)
+	}
+
+	if c := seen["ensure-not-transposed"]; !c.SupportsFix || !c.Destructive || c.Scope != guard.ScopeFile {
+		t.Fatalf("expected destructive file-scope fix for ensure-not-transposed, got %+v", c)
+	}
+	if c := seen["warn-duplicate-term-values"]; !c.SupportsFix || !c.Lossy {
+		t.Fatalf("expected lossy fix for warn-duplicate-term-values, got %+v", c)
+	}
+	if c := seen["warn-term-overlaps"]; c.SupportsFix || c.Scope != guard.ScopeRows {
+		t.Fatalf("expected report-only row check warn-term-overlaps, got %+v", c)
+	}
+	for _, c := range cat {
+		if c.Scope == guard.ScopeUnknown {
+			t.Fatalf("built-in check without a declared scope: %+v", c)
+		}
+		if (c.Destructive || c.Lossy) && !c.SupportsFix {
+			t.Fatalf("destructive or lossy check without a fix: %+v", c)
+		}
+	}
+
+	if w := checks.RegistrationWarnings(); len(w) != 0 {
+		t.Fatalf("built-in checks must register without priority warnings, got %v", w)
+	}
+}
+
+func resultOf(st guard.Status, name, msg string) guard.Result {
+	return guard.Result{Name: name, Status: st, Message: msg}
+}
+
+func TestFix_PreserveHeaderCase(t *testing.T) {
+	t.Parallel()
+
+	in := []byte("term;description;casesensitive;translatable;forbidden;tags;pt-BR;pt-BR_Description\n" +
+		"cloud;Remote servers;no;yes;no;;nuvem;\n")
+
+	sum, err := guard.Fix(context.Background(), "gloss.csv", in, guard.Config{Langs: []string{"pt-BR"}, PreserveHeaderCase: true})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if !strings.HasPrefix(string(sum.FinalData), "term;description;casesensitive;translatable;forbidden;tags;pt-BR;pt-BR_Description\n") {
+		t.Fatalf("locale header casing not preserved: %q", sum.FinalData)
+	}
+
+	sum, err = guard.Fix(context.Background(), "gloss.csv", in, guard.Config{Langs: []string{"pt-BR"}})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if strings.Contains(string(sum.FinalData), "_Description") {
+		t.Fatalf("default mode should canonicalize the suffix: %q", sum.FinalData)
+	}
+}
+
+func TestValidate_PreviewFixes(t *testing.T) {
+	t.Parallel()
+
+	in := []byte("term;description;casesensitive;translatable;forbidden;tags;en;en_description\n" +
+		"\"ice\ncream\";Dessert;no;yes;no;;\"ice\ncream\";\n")
+	orig := append([]byte(nil), in...)
+
+	sum, err := guard.Validate(context.Background(), "gloss.csv", in, guard.Config{Langs: []string{"en"}, PreviewFixes: true})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if sum.AppliedFixes || !bytes.Equal(in, orig) {
+		t.Fatalf("PreviewFixes must not apply fixes")
+	}
+
+	for _, out := range sum.Outcomes {
+		if out.Result.Name != "warn-multiline-terms" {
+			continue
+		}
+		if len(out.Result.Findings) == 0 {
+			t.Fatalf("expected findings: %+v", out.Result)
+		}
+		sf := out.Result.Findings[0].SuggestedFix
+		if sf == nil || sf.After != "ice cream;Dessert;no;yes;no;;ice cream;" {
+			t.Fatalf("unexpected suggested fix: %+v", sf)
+		}
+		return
+	}
+	t.Fatalf("warn-multiline-terms did not run")
+}
@@ -0,0 +1,153 @@
+// Package header normalizes glossary header cells the same way the checks do,
+// so upload UIs and importers can share the exact header semantics.
+package header
+
+import (
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// CasePolicy controls how Normalize changes the case of header cells.
+type CasePolicy int
+
+const (
+	// CaseServiceLower lowercases service columns (term, description, flags, tags)
+	// and keeps other cells as-is. This is what the lowercase-header check enforces.
+	CaseServiceLower CasePolicy = iota
+	// CaseLowerAll lowercases every cell.
+	CaseLowerAll
+	// CaseKeep leaves case untouched.
+	CaseKeep
+)
+
+// LocalePolicy controls how Normalize spells language columns.
+type LocalePolicy int
+
+const (
+	// LocaleCanonical rewrites language columns to lowercase with "_" separators
+	// ("en-US" -> "en_us", "PT-br_Description" -> "pt_br_description"),
+	// matching the allowed-columns fix.
+	LocaleCanonical LocalePolicy = iota
+	// LocaleKeep leaves language columns as spelled (after trimming).
+	LocaleKeep
+)
+
+// Options tune Normalize. The zero value applies the same rules as the checks.
+type Options struct {
+	Case    CasePolicy
+	Locales LocalePolicy
+
+	// Synonyms maps alternative names to canonical ones ("desc" -> "description").
+	// Keys match case-insensitively after trimming. Nil means no mapping.
+	Synonyms map[string]string
+}
+
+// Normalize returns a normalized copy of cols; the input is not modified.
+// Each cell is trimmed, stripped of a leading UTF-8 BOM, mapped through Synonyms,
+// then case and locale policies are applied. Empty cells stay empty.
+func Normalize(cols []string, opts Options) []string {
+	if cols == nil {
+		return nil
+	}
+
+	synonyms := make(map[string]string, len(opts.Synonyms))
+	for alias, canonical := range opts.Synonyms {
+		synonyms[strings.ToLower(strings.TrimSpace(alias))] = strings.TrimSpace(canonical)
+	}
+
+	out := make([]string, len(cols))
+	for i, col := range cols {
+		out[i] = normalizeCell(col, opts, synonyms)
+	}
+
+	return out
+}
+
+func normalizeCell(col string, opts Options, synonyms map[string]string) string {
+	col = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(col), "\ufeff"))
+	if col == "" {
+		return ""
+	}
+
+	if canonical, ok := synonyms[strings.ToLower(col)]; ok {
+		col = canonical
+	}
+
+	lower := strings.ToLower(col)
+	if _, ok := checks.KnownHeaders[lower]; ok {
+		if opts.Case == CaseKeep {
+			return col
+		}
+		return lower
+	}
+
+	if opts.Locales == LocaleCanonical {
+		if canonical, ok := canonicalLangColumn(col); ok {
+			return canonical
+		}
+	}
+
+	if opts.Case == CaseLowerAll {
+		return lower
+	}
+
+	return col
+}
+
+// canonicalLangColumn canonicalizes "<lang>" and "<lang>_description" cells.
+func canonicalLangColumn(col string) (string, bool) {
+	base, suffix := col, ""
+	if strings.HasSuffix(strings.ToLower(col), "_description") {
+		base, suffix = col[:len(col)-len("_description")], "_description"
+	}
+
+	if !looksLikeLangCode(base) {
+		return "", false
+	}
+
+	return strings.ToLower(strings.ReplaceAll(base, "-", "_")) + suffix, true
+}
+
+func looksLikeLangCode(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	s = strings.ReplaceAll(s, "-", "_")
+
+	parts := strings.Split(s, "_")
+
+	first := parts[0]
+	if len(first) < 2 || len(first) > 3 {
+		return false
+	}
+
+	for _, r := range first {
+		if !isASCIILetter(r) {
+			return false
+		}
+	}
+
+	for _, seg := range parts[1:] {
+		if seg == "" {
+			return false
+		}
+
+		for _, r := range seg {
+			if !isASCIILetter(r) && !isASCIIDigit(r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,70 @@
+package header_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/header"
+)
+
+func TestNormalize_Defaults(t *testing.T) {
+	t.Parallel()
+
+	in := []string{"\ufeff Term", " Description ", "CaseSensitive", "en-US", "PT-br_Description", "Notes", ""}
+
+	got := header.Normalize(in, header.Options{})
+	want := []string{"term", "description", "casesensitive", "en_us", "pt_br_description", "Notes", ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+	if in[0] != "\ufeff Term" {
+		t.Fatalf("input must not be modified")
+	}
+}
+
+func TestNormalize_Policies(t *testing.T) {
+	t.Parallel()
+
+	in := []string{"Term", "en-US", "Notes"}
+
+	tests := []struct {
+		name string
+		opts header.Options
+		want []string
+	}{
+		{"lower all", header.Options{Case: header.CaseLowerAll}, []string{"term", "en_us", "notes"}},
+		{"keep case", header.Options{Case: header.CaseKeep}, []string{"Term", "en_us", "Notes"}},
+		{"keep locales", header.Options{Locales: header.LocaleKeep}, []string{"term", "en-US", "Notes"}},
+		{"keep locales lower all", header.Options{Locales: header.LocaleKeep, Case: header.CaseLowerAll}, []string{"term", "en-us", "notes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := header.Normalize(in, tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNormalize_Synonyms(t *testing.T) {
+	t.Parallel()
+
+	opts := header.Options{Synonyms: map[string]string{" Desc ": "description", "source": "Term"}}
+
+	got := header.Normalize([]string{"DESC", "Source", "en"}, opts)
+	want := []string{"description", "term", "en"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %q, got %q", want, got)
+	}
+}
+
+func TestNormalize_Nil(t *testing.T) {
+	t.Parallel()
+
+	if got := header.Normalize(nil, header.Options{}); got != nil {
+		t.Fatalf("want nil, got %q", got)
+	}
+}
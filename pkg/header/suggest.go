@@ -0,0 +1,126 @@
+package header
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Suggestion proposes a column an unrecognized header cell most likely meant,
+// so UIs can offer a rename before the allowed-columns fix drops the column.
+type Suggestion struct {
+	From   string // header cell as spelled (trimmed)
+	To     string // service column, "<lang>" or "<lang>_description"
+	Reason string // ReasonSpelling or ReasonLanguageName
+}
+
+// Suggestion reasons.
+const (
+	ReasonSpelling     = "similar spelling"
+	ReasonLanguageName = "language name"
+)
+
+// languageNames maps English and native language names to ISO 639-1 codes.
+var languageNames = map[string]string{
+	"arabic": "ar", "chinese": "zh", "czech": "cs", "čeština": "cs", "danish": "da", "dansk": "da",
+	"dutch": "nl", "nederlands": "nl", "english": "en", "finnish": "fi", "suomi": "fi",
+	"french": "fr", "français": "fr", "francais": "fr", "german": "de", "deutsch": "de",
+	"greek": "el", "hebrew": "he", "hindi": "hi", "hungarian": "hu", "magyar": "hu",
+	"indonesian": "id", "italian": "it", "italiano": "it", "japanese": "ja", "korean": "ko",
+	"norwegian": "no", "norsk": "no", "polish": "pl", "polski": "pl", "portuguese": "pt",
+	"português": "pt", "portugues": "pt", "romanian": "ro", "română": "ro", "russian": "ru",
+	"spanish": "es", "español": "es", "espanol": "es", "swedish": "sv", "svenska": "sv",
+	"thai": "th", "turkish": "tr", "türkçe": "tr", "ukrainian": "uk", "vietnamese": "vi",
+}
+
+// Suggest proposes a replacement for a header cell the checks do not recognize:
+// a misspelled service column ("dsecription" -> "description") or a language
+// written out ("English" -> "en", "German description" -> "de_description").
+// When langs is non-empty, language suggestions are limited to declared languages
+// and use their spelling ("english" -> "en_US" if only en_US is declared).
+// ok is false for cells that need no suggestion or have no close match.
+func Suggest(label string, langs []string) (s Suggestion, ok bool) {
+	from := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(label), "\ufeff"))
+	name := strings.ToLower(from)
+	if name == "" {
+		return Suggestion{}, false
+	}
+	if _, known := checks.KnownHeaders[name]; known {
+		return Suggestion{}, false
+	}
+
+	if to, ok := suggestLanguage(name, langs); ok {
+		return Suggestion{From: from, To: to, Reason: ReasonLanguageName}, true
+	}
+
+	if to, ok := suggestServiceColumn(name); ok {
+		return Suggestion{From: from, To: to, Reason: ReasonSpelling}, true
+	}
+
+	return Suggestion{}, false
+}
+
+func suggestLanguage(name string, langs []string) (string, bool) {
+	base, suffix := name, ""
+	for _, sep := range []string{"_", " ", "-"} {
+		if b, found := strings.CutSuffix(name, sep+"description"); found {
+			base, suffix = strings.TrimSpace(b), "_description"
+			break
+		}
+	}
+
+	code, ok := languageNames[base]
+	if !ok {
+		return "", false
+	}
+	if len(langs) == 0 {
+		return code + suffix, true
+	}
+
+	var match string
+	for _, lang := range langs {
+		lang = strings.TrimSpace(lang)
+		key := strings.ToLower(strings.ReplaceAll(lang, "-", "_"))
+		if key != code && !strings.HasPrefix(key, code+"_") {
+			continue
+		}
+		if match != "" {
+			return "", false // ambiguous: en_US and en_GB
+		}
+		match = lang
+	}
+	if match == "" {
+		return "", false
+	}
+
+	return match + suffix, true
+}
+
+// suggestServiceColumn matches name against the service columns by edit distance:
+// one edit for short names, two for longer ones.
+func suggestServiceColumn(name string) (string, bool) {
+	known := make([]string, 0, len(checks.KnownHeaders))
+	for k := range checks.KnownHeaders {
+		known = append(known, k)
+	}
+	slices.Sort(known)
+
+	best, bestDist := "", 0
+	for _, k := range known {
+		limit := 1
+		if len(k) > 4 {
+			limit = 2
+		}
+
+		d := checks.EditDistance(name, k)
+		if d > limit {
+			continue
+		}
+		if best == "" || d < bestDist {
+			best, bestDist = k, d
+		}
+	}
+
+	return best, best != ""
+}
@@ -0,0 +1,56 @@
+package header_test
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/header"
+)
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		label  string
+		langs  []string
+		want   string
+		reason string
+	}{
+		{"dsecription", nil, "description", header.ReasonSpelling},
+		{" Transalatable ", nil, "translatable", header.ReasonSpelling},
+		{"tag", nil, "tags", header.ReasonSpelling},
+		{"case sensitive", nil, "casesensitive", header.ReasonSpelling},
+		{"english", nil, "en", header.ReasonLanguageName},
+		{"Deutsch_Description", nil, "de_description", header.ReasonLanguageName},
+		{"english", []string{"en_US", "fr"}, "en_US", header.ReasonLanguageName},
+		{"\ufeffFrançais", []string{"fr"}, "fr", header.ReasonLanguageName},
+	}
+
+	for _, tt := range tests {
+		s, ok := header.Suggest(tt.label, tt.langs)
+		if !ok || s.To != tt.want || s.Reason != tt.reason {
+			t.Fatalf("Suggest(%q, %v) = %+v, %v; want %q (%s)", tt.label, tt.langs, s, ok, tt.want, tt.reason)
+		}
+	}
+}
+
+func TestSuggest_NoSuggestion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		label string
+		langs []string
+	}{
+		{"description", nil},                    // already known
+		{"", nil},                               // blank
+		{"wtff", nil},                           // nothing close
+		{"notes", nil},                          // too far from every service column
+		{"english", []string{"fr"}},             // language not declared
+		{"english", []string{"en_US", "en_GB"}}, // ambiguous
+	}
+
+	for _, tt := range tests {
+		if s, ok := header.Suggest(tt.label, tt.langs); ok {
+			t.Fatalf("Suggest(%q, %v) = %+v, want no suggestion", tt.label, tt.langs, s)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Package health condenses a validation summary and a few glossary figures into a
+// 0–100 score and a letter grade, e.g. for a "glossary health" badge in a README.
+//
+// The score weighs three parts, each a fraction between 0 and 1:
+//
+//	checks     = max(0, 1 − 0.05·WARN − 0.25·FAIL − 0.5·ERROR)   (outcome counts of the summary)
+//	coverage   = TranslatedCells / ExpectedCells                  (1 when nothing is expected)
+//	duplicates = 1 − min(1, 5 · DuplicateTerms / Rows)            (1 without rows; 20% repeats give 0)
+//
+//	score = round(50·checks + 35·coverage + 15·duplicates)
+//
+// Grades: A from 90, B from 80, C from 70, D from 60, F below.
+package health
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Weights of the score parts (see the package documentation).
+const (
+	ChecksWeight     = 50
+	CoverageWeight   = 35
+	DuplicatesWeight = 15
+)
+
+// Stats are the glossary figures Score weighs besides the check results.
+type Stats struct {
+	// Rows is the number of non-blank data records (header excluded).
+	Rows int
+
+	// ExpectedCells is Rows times the number of languages; TranslatedCells counts
+	// the non-blank value cells among them.
+	ExpectedCells   int
+	TranslatedCells int
+
+	// DuplicateTerms counts rows whose term (trimmed) repeats an earlier row's.
+	DuplicateTerms int
+}
+
+// StatsOf reads the glossary in data (semicolon-separated, with a header). langs are the
+// languages expected to be translated; a language without a value column counts as
+// untranslated on every row. With no langs, every language-like value column counts.
+// It fails on ctx cancellation and on CSV that does not parse.
+func StatsOf(ctx context.Context, data []byte, langs []string) (Stats, error) {
+	var st Stats
+
+	table, err := checks.TableOf(ctx, checks.Artifact{Data: data})
+	if err != nil {
+		return st, err
+	}
+	if table.Err != nil {
+		return st, table.Err
+	}
+
+	header, row, ok := table.Header()
+	if !ok {
+		return st, nil
+	}
+
+	cols := valueColumns(checks.BuildLocaleIndex(header), langs)
+	termCol := -1
+	for i, cell := range header {
+		if strings.EqualFold(strings.TrimSpace(cell), "term") {
+			termCol = i
+			break
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, rec := range table.Records[row:] {
+		if blank(rec) {
+			continue
+		}
+		st.Rows++
+
+		for _, pos := range cols {
+			st.ExpectedCells++
+			if pos >= 0 && pos < len(rec) && strings.TrimSpace(rec[pos]) != "" {
+				st.TranslatedCells++
+			}
+		}
+
+		if termCol >= 0 && termCol < len(rec) {
+			term := strings.TrimSpace(rec[termCol])
+			if term == "" {
+				continue
+			}
+			if seen[term] {
+				st.DuplicateTerms++
+			}
+			seen[term] = true
+		}
+	}
+
+	return st, nil
+}
+
+// valueColumns returns the header position of each language's value column, -1 for
+// a language without one.
+func valueColumns(ix *checks.LocaleIndex, langs []string) []int {
+	positions := make(map[string]int)
+	var detected []int
+	for _, col := range ix.Columns {
+		if !col.LangLike || col.Description {
+			continue
+		}
+		if _, dup := positions[col.Key]; dup {
+			continue
+		}
+		positions[col.Key] = col.Pos
+		detected = append(detected, col.Pos)
+	}
+
+	if len(langs) == 0 {
+		return detected
+	}
+
+	var out []int
+	seen := make(map[string]bool)
+	for _, lang := range langs {
+		key := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "-", "_"))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		pos, ok := positions[key]
+		if !ok {
+			pos = -1
+		}
+		out = append(out, pos)
+	}
+
+	return out
+}
+
+func blank(rec []string) bool {
+	for _, cell := range rec {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Result is the health of one glossary.
+type Result struct {
+	Score int    // 0–100
+	Grade string // "A" to "F"
+
+	// Checks, Coverage and Duplicates are the score parts, each between 0 and 1.
+	Checks     float64
+	Coverage   float64
+	Duplicates float64
+}
+
+// Score rates sum and stats with the formula in the package documentation.
+func Score(sum validator.Summary, stats Stats) Result {
+	r := Result{
+		Checks:     clamp(1 - 0.05*float64(sum.Warn) - 0.25*float64(sum.Fail) - 0.5*float64(sum.Error)),
+		Coverage:   1,
+		Duplicates: 1,
+	}
+
+	if stats.ExpectedCells > 0 {
+		r.Coverage = clamp(float64(stats.TranslatedCells) / float64(stats.ExpectedCells))
+	}
+	if stats.Rows > 0 {
+		r.Duplicates = clamp(1 - 5*float64(stats.DuplicateTerms)/float64(stats.Rows))
+	}
+
+	r.Score = int(math.Round(ChecksWeight*r.Checks + CoverageWeight*r.Coverage + DuplicatesWeight*r.Duplicates))
+	r.Grade = gradeOf(r.Score)
+
+	return r
+}
+
+func clamp(f float64) float64 {
+	return min(max(f, 0), 1)
+}
+
+func gradeOf(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	case score >= 60:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+// Badge is the JSON a shields.io endpoint badge reads
+// (https://shields.io/badges/endpoint-badge).
+type Badge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// Badge renders r as endpoint badge data, e.g. "glossary health | A (93)" in bright green.
+func (r Result) Badge() Badge {
+	return Badge{
+		SchemaVersion: 1,
+		Label:         "glossary health",
+		Message:       r.Grade + " (" + strconv.Itoa(r.Score) + ")",
+		Color:         colorOf(r.Grade),
+	}
+}
+
+func colorOf(grade string) string {
+	switch grade {
+	case "A":
+		return "brightgreen"
+	case "B":
+		return "green"
+	case "C":
+		return "yellow"
+	case "D":
+		return "orange"
+	default:
+		return "red"
+	}
+}
@@ -0,0 +1,107 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/health"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestStatsOf(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("term;description;en;fr;fr_description\n" +
+		"cloud;;cloud;nuage;\n" +
+		"cloud;;cloud;;\n" +
+		";;;;\n" +
+		"sync;;;synchro;\n")
+
+	cases := []struct {
+		name  string
+		langs []string
+		want  health.Stats
+	}{
+		{"detected columns", nil, health.Stats{Rows: 3, ExpectedCells: 6, TranslatedCells: 4, DuplicateTerms: 1}},
+		{"declared languages", []string{"FR", "fr", "de"}, health.Stats{Rows: 3, ExpectedCells: 6, TranslatedCells: 2, DuplicateTerms: 1}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := health.StatsOf(context.Background(), data, tc.langs)
+			if err != nil {
+				t.Fatalf("StatsOf: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("StatsOf = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStatsOf_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := health.StatsOf(ctx, []byte("term;en\na;b\n"), nil); err == nil {
+		t.Fatal("expected a context error")
+	}
+
+	st, err := health.StatsOf(context.Background(), nil, nil)
+	if err != nil || st != (health.Stats{}) {
+		t.Fatalf("empty data: got %+v, %v", st, err)
+	}
+}
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		sum   validator.Summary
+		stats health.Stats
+		score int
+		grade string
+	}{
+		{"perfect", validator.Summary{Pass: 10}, health.Stats{Rows: 10, ExpectedCells: 20, TranslatedCells: 20}, 100, "A"},
+		{"empty glossary", validator.Summary{}, health.Stats{}, 100, "A"},
+		{"two warnings", validator.Summary{Warn: 2}, health.Stats{}, 95, "A"},
+		{"one failure", validator.Summary{Fail: 1}, health.Stats{}, 88, "B"},
+		{"half translated", validator.Summary{}, health.Stats{Rows: 4, ExpectedCells: 8, TranslatedCells: 4}, 83, "B"},
+		{"10% duplicates", validator.Summary{}, health.Stats{Rows: 10, DuplicateTerms: 1}, 93, "A"},
+		{"checks floor at zero", validator.Summary{Error: 3}, health.Stats{}, 50, "F"},
+		{"everything wrong", validator.Summary{Fail: 4}, health.Stats{Rows: 5, ExpectedCells: 10, DuplicateTerms: 4}, 0, "F"},
+		{"grade C", validator.Summary{Fail: 1, Warn: 1}, health.Stats{Rows: 10, ExpectedCells: 10, TranslatedCells: 7}, 75, "C"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := health.Score(tc.sum, tc.stats)
+			if r.Score != tc.score || r.Grade != tc.grade {
+				t.Fatalf("Score = %d %s (%+v), want %d %s", r.Score, r.Grade, r, tc.score, tc.grade)
+			}
+		})
+	}
+}
+
+func TestResult_Badge(t *testing.T) {
+	t.Parallel()
+
+	b := health.Score(validator.Summary{Warn: 2}, health.Stats{}).Badge()
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	want := `{"schemaVersion":1,"label":"glossary health","message":"A (95)","color":"brightgreen"}`
+	if string(data) != want {
+		t.Fatalf("badge = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,433 @@
+// Package manifest records the complete effective configuration of a validation run
+// (checks in run order with their priorities and capabilities, options, overrides and
+// settings) as a signed document, and validates strictly according to one.
+//
+// Regulated teams export a manifest with the glossary version they approve, sign it,
+// and later prove which rules were applied: Validate refuses to run when the checks
+// registered now differ from the manifest in any way.
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Version is the manifest format version written by Export.
+const Version = 1
+
+const modulePath = "github.com/bodrovis/lokalise-glossary-guard-core"
+
+var (
+	// ErrBadSignature is returned by Open when the signature is missing or does not
+	// match the public key.
+	ErrBadSignature = errors.New("manifest: signature is missing or invalid")
+
+	// ErrUnsupportedVersion is returned by Open for manifests of another format version.
+	ErrUnsupportedVersion = errors.New("manifest: unsupported manifest version")
+)
+
+// Manifest is the effective configuration of a run.
+type Manifest struct {
+	Version int `json:"version"`
+
+	// CoreVersion is the version of this module that exported the manifest, when the
+	// binary carries build information ("(devel)" for local builds).
+	CoreVersion string `json:"core_version,omitempty"`
+
+	// Langs are the declared languages.
+	Langs []string `json:"langs,omitempty"`
+
+	Options Options `json:"options"`
+
+	// Checks lists every check of the run in execution order.
+	Checks []Check `json:"checks"`
+}
+
+// Check is one check as it runs.
+type Check struct {
+	Name        string `json:"name"`
+	Priority    int    `json:"priority"` // effective priority, overrides applied
+	FailFast    bool   `json:"fail_fast,omitempty"`
+	OptIn       bool   `json:"opt_in,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	Fix         bool   `json:"fix,omitempty"`
+	Destructive bool   `json:"destructive,omitempty"`
+	Lossy       bool   `json:"lossy,omitempty"`
+}
+
+// Options mirrors checks.RunOptions. FindingsSidecar, SourceStrings and VerifySummary
+// are not recorded: they are run-time hooks, not rules.
+type Options struct {
+	FixMode            string                       `json:"fix_mode"`
+	RerunAfterFix      bool                         `json:"rerun_after_fix,omitempty"`
+	HardFailOnErr      bool                         `json:"hard_fail_on_error,omitempty"`
+	AllowDestructive   bool                         `json:"allow_destructive,omitempty"`
+	PreviewFixes       bool                         `json:"preview_fixes,omitempty"`
+	MaxFindings        int                          `json:"max_findings,omitempty"`
+	MaxFailures        int                          `json:"max_failures,omitempty"`
+	CheckSet           string                       `json:"check_set,omitempty"`
+	Only               []string                     `json:"only,omitempty"`
+	Skip               []string                     `json:"skip,omitempty"`
+	TieBreak           string                       `json:"tie_break"`
+	PriorityOverrides  map[string]int               `json:"priority_overrides,omitempty"`
+	SeverityOverrides  map[string]checks.Status     `json:"severity_overrides,omitempty"`
+	PreserveHeaderCase bool                         `json:"preserve_header_case,omitempty"`
+	CommentPrefix      string                       `json:"comment_prefix,omitempty"`
+	SafeFixes          bool                         `json:"safe_fixes,omitempty"`
+	RecheckAfterFixes  bool                         `json:"recheck_after_fixes,omitempty"`
+	Settings           map[string]map[string]string `json:"settings,omitempty"`
+}
+
+var (
+	fixModes  = []string{"none", "if-failed", "if-not-pass", "always"} // indexed by checks.FixMode
+	tieBreaks = []string{"name", "registration"}                       // indexed by checks.TieBreak
+)
+
+// Export describes the run validator.Validate would perform with langs and opts on the
+// checks registered now. It fails like validator.Validate does on an unknown check set
+// or unknown check names in settings and overrides.
+func Export(langs []string, opts checks.RunOptions) (Manifest, error) {
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	overrides := make(map[string]int, len(opts.PriorityOverrides))
+	for name, p := range opts.PriorityOverrides {
+		overrides[strings.ToLower(strings.TrimSpace(name))] = p
+	}
+
+	m := Manifest{
+		Version:     Version,
+		CoreVersion: coreVersion(),
+		Langs:       slices.Clone(langs),
+		Options:     optionsOf(opts),
+		Checks:      make([]Check, 0, len(units)),
+	}
+
+	for _, u := range units {
+		c := Check{Name: u.Name(), Priority: u.Priority(), FailFast: u.FailFast()}
+		if p, ok := overrides[strings.ToLower(c.Name)]; ok {
+			c.Priority = p
+		}
+		if o, ok := u.(interface{ OptIn() bool }); ok {
+			c.OptIn = o.OptIn()
+		}
+		if caps := checks.CapabilitiesOf(u); caps.Declared {
+			c.Scope = string(caps.Scope)
+			c.Fix = caps.SupportsFix
+			c.Destructive = caps.Destructive
+			c.Lossy = caps.Lossy
+		}
+
+		m.Checks = append(m.Checks, c)
+	}
+
+	return m, nil
+}
+
+func optionsOf(opts checks.RunOptions) Options {
+	o := Options{
+		FixMode:            enumName(fixModes, int(opts.FixMode)),
+		RerunAfterFix:      opts.RerunAfterFix,
+		HardFailOnErr:      opts.HardFailOnErr,
+		AllowDestructive:   opts.AllowDestructive,
+		PreviewFixes:       opts.PreviewFixes,
+		MaxFindings:        opts.MaxFindings,
+		MaxFailures:        opts.MaxFailures,
+		CheckSet:           opts.CheckSet,
+		Only:               slices.Clone(opts.Only),
+		Skip:               slices.Clone(opts.Skip),
+		TieBreak:           enumName(tieBreaks, int(opts.TieBreak)),
+		PriorityOverrides:  maps.Clone(opts.PriorityOverrides),
+		SeverityOverrides:  maps.Clone(opts.SeverityOverrides),
+		PreserveHeaderCase: opts.PreserveHeaderCase,
+		CommentPrefix:      opts.CommentPrefix,
+		SafeFixes:          opts.SafeFixes,
+		RecheckAfterFixes:  opts.RecheckAfterFixes,
+	}
+
+	if len(opts.Settings) > 0 {
+		o.Settings = make(map[string]map[string]string, len(opts.Settings))
+		for name, set := range opts.Settings {
+			o.Settings[name] = maps.Clone(set)
+		}
+	}
+
+	return o
+}
+
+func enumName(names []string, v int) string {
+	if v >= 0 && v < len(names) {
+		return names[v]
+	}
+
+	return strconv.Itoa(v)
+}
+
+func enumValue(names []string, name string) (int, error) {
+	if i := slices.Index(names, name); i >= 0 {
+		return i, nil
+	}
+
+	return 0, fmt.Errorf("unknown value %q (expected %s)", name, strings.Join(names, ", "))
+}
+
+// RunOptions converts the manifest options back. Unknown fix modes or tie breaks are an error.
+func (m Manifest) RunOptions() (checks.RunOptions, error) {
+	o := m.Options
+
+	fixMode, err := enumValue(fixModes, o.FixMode)
+	if err != nil {
+		return checks.RunOptions{}, fmt.Errorf("manifest: fix_mode: %w", err)
+	}
+	tieBreak, err := enumValue(tieBreaks, o.TieBreak)
+	if err != nil {
+		return checks.RunOptions{}, fmt.Errorf("manifest: tie_break: %w", err)
+	}
+
+	opts := checks.RunOptions{
+		FixMode:            checks.FixMode(fixMode),
+		RerunAfterFix:      o.RerunAfterFix,
+		HardFailOnErr:      o.HardFailOnErr,
+		AllowDestructive:   o.AllowDestructive,
+		PreviewFixes:       o.PreviewFixes,
+		MaxFindings:        o.MaxFindings,
+		MaxFailures:        o.MaxFailures,
+		CheckSet:           o.CheckSet,
+		Only:               slices.Clone(o.Only),
+		Skip:               slices.Clone(o.Skip),
+		TieBreak:           checks.TieBreak(tieBreak),
+		PriorityOverrides:  maps.Clone(o.PriorityOverrides),
+		SeverityOverrides:  maps.Clone(o.SeverityOverrides),
+		PreserveHeaderCase: o.PreserveHeaderCase,
+		CommentPrefix:      o.CommentPrefix,
+		SafeFixes:          o.SafeFixes,
+		RecheckAfterFixes:  o.RecheckAfterFixes,
+	}
+
+	if len(o.Settings) > 0 {
+		opts.Settings = make(map[string]checks.CheckSettings, len(o.Settings))
+		for name, set := range o.Settings {
+			opts.Settings[name] = checks.CheckSettings(maps.Clone(set))
+		}
+	}
+
+	return opts, nil
+}
+
+// coreVersion is the version of this module in the running binary, if known.
+func coreVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+
+	return ""
+}
+
+// MismatchError lists how the run configured now differs from a manifest.
+type MismatchError struct {
+	Differences []string
+}
+
+func (e *MismatchError) Error() string {
+	return "manifest: run does not match the manifest: " + strings.Join(e.Differences, "; ")
+}
+
+// Verify compares the manifest with the run it describes as the registry stands now:
+// the same checks, in the same order, with the same priorities and capabilities, built
+// by the same core version when both versions are known. Differences, including options
+// the registry cannot resolve (a check set or check names it does not know), are reported
+// as a *MismatchError; invalid fix_mode or tie_break values are a plain error.
+func (m Manifest) Verify() error {
+	opts, err := m.RunOptions()
+	if err != nil {
+		return err
+	}
+
+	now, err := Export(m.Langs, opts)
+	if err != nil {
+		// the registry cannot even resolve the recorded options (unknown set or check names)
+		return &MismatchError{Differences: []string{err.Error()}}
+	}
+
+	var diffs []string
+	if m.CoreVersion != "" && now.CoreVersion != "" && m.CoreVersion != now.CoreVersion {
+		diffs = append(diffs, "core version "+now.CoreVersion+", manifest "+m.CoreVersion)
+	}
+
+	want := make(map[string]Check, len(m.Checks))
+	for _, c := range m.Checks {
+		want[c.Name] = c
+	}
+	have := make(map[string]bool, len(now.Checks))
+	for _, c := range now.Checks {
+		have[c.Name] = true
+
+		w, ok := want[c.Name]
+		switch {
+		case !ok:
+			diffs = append(diffs, "check "+c.Name+" is not in the manifest")
+		case w != c:
+			diffs = append(diffs, "check "+c.Name+" differs: "+describe(c)+", manifest "+describe(w))
+		}
+	}
+	for _, c := range m.Checks {
+		if !have[c.Name] {
+			diffs = append(diffs, "check "+c.Name+" does not run")
+		}
+	}
+
+	if len(diffs) == 0 && !slices.Equal(names(m.Checks), names(now.Checks)) {
+		diffs = append(diffs, "checks run in another order: "+strings.Join(names(now.Checks), ", "))
+	}
+
+	if len(diffs) > 0 {
+		return &MismatchError{Differences: diffs}
+	}
+
+	return nil
+}
+
+func describe(c Check) string {
+	parts := []string{"priority " + strconv.Itoa(c.Priority)}
+	flags := []struct {
+		on   bool
+		name string
+	}{
+		{c.FailFast, "fail-fast"},
+		{c.OptIn, "opt-in"},
+		{c.Fix, "fix"},
+		{c.Destructive, "destructive"},
+		{c.Lossy, "lossy"},
+	}
+	for _, f := range flags {
+		if f.on {
+			parts = append(parts, f.name)
+		}
+	}
+	if c.Scope != "" {
+		parts = append(parts, "scope "+c.Scope)
+	}
+
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func names(cs []Check) []string {
+	out := make([]string, len(cs))
+	for i, c := range cs {
+		out[i] = c.Name
+	}
+
+	return out
+}
+
+// Validate runs validator.Validate strictly according to m: it verifies m first
+// (see Verify) and uses the manifest's languages and options. The summary's Order must
+// be the manifest's check list; a registry changed during the run is a *MismatchError.
+func Validate(ctx context.Context, m Manifest, filePath string, data []byte) (validator.Summary, error) {
+	if err := m.Verify(); err != nil {
+		return validator.Summary{FilePath: filePath, FinalData: data, FinalLangs: m.Langs}, err
+	}
+
+	opts, err := m.RunOptions()
+	if err != nil {
+		return validator.Summary{FilePath: filePath, FinalData: data, FinalLangs: m.Langs}, err
+	}
+
+	sum, err := validator.Validate(ctx, filePath, data, m.Langs, opts)
+	if err == nil && !slices.Equal(sum.Order, names(m.Checks)) {
+		err = &MismatchError{Differences: []string{"checks run in another order: " + strings.Join(sum.Order, ", ")}}
+	}
+
+	return sum, err
+}
+
+// envelope is the signed form: the manifest bytes as written, and their signature.
+type envelope struct {
+	Manifest  json.RawMessage `json:"manifest"`
+	Signature string          `json:"signature"`
+}
+
+// Sign encodes m and signs the encoding with key. The result is a JSON document
+// {"manifest": ..., "signature": "ed25519=<hex>"} that Open verifies.
+func Sign(m Manifest, key ed25519.PrivateKey) ([]byte, error) {
+	body, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(envelope{
+		Manifest:  body,
+		Signature: "ed25519=" + hex.EncodeToString(ed25519.Sign(key, body)),
+	}, "", "  ")
+}
+
+// Open verifies a document written by Sign with pub and decodes its manifest.
+// Unknown fields are an error, so a manifest is never half-applied.
+func Open(data []byte, pub ed25519.PublicKey) (Manifest, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Manifest{}, fmt.Errorf("manifest: parse: %w", err)
+	}
+
+	sig, ok := strings.CutPrefix(env.Signature, "ed25519=")
+	if !ok || len(pub) != ed25519.PublicKeySize {
+		return Manifest{}, ErrBadSignature
+	}
+	raw, err := hex.DecodeString(sig)
+	if err != nil || !ed25519.Verify(pub, compact(env.Manifest), raw) {
+		return Manifest{}, ErrBadSignature
+	}
+
+	var m Manifest
+	dec := json.NewDecoder(bytes.NewReader(env.Manifest))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return Manifest{}, fmt.Errorf("manifest: parse: %w", err)
+	}
+	if err := dec.Decode(new(json.RawMessage)); !errors.Is(err, io.EOF) {
+		return Manifest{}, errors.New("manifest: parse: trailing data after the manifest")
+	}
+	if m.Version != Version {
+		return Manifest{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, m.Version)
+	}
+
+	return m, nil
+}
+
+// compact undoes the indentation MarshalIndent applied to the signed bytes.
+func compact(raw []byte) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return raw
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,205 @@
+package manifest_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/manifest"
+)
+
+func register(t *testing.T, name string, opts ...checks.Option) {
+	t.Helper()
+
+	u, err := checks.NewCheckAdapter(name, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+	if _, err := checks.Register(u); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+}
+
+func setup(t *testing.T) {
+	t.Helper()
+
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	register(t, "alpha", checks.WithPriority(110), checks.WithFailFast(), checks.WithScope(checks.ScopeFile))
+	register(t, "beta", checks.WithPriority(210), checks.WithLossyFix(), checks.WithScope(checks.ScopeRows))
+	register(t, "gamma", checks.WithPriority(300), checks.WithOptIn())
+}
+
+var runOpts = checks.RunOptions{
+	FixMode:           checks.FixIfFailed,
+	RerunAfterFix:     true,
+	TieBreak:          checks.TieBreakRegistration,
+	PriorityOverrides: map[string]int{"Gamma": 100},
+	SeverityOverrides: map[string]checks.Status{"beta": checks.Fail},
+	Settings:          map[string]checks.CheckSettings{"gamma": {"enabled": "true", "note": "<a&b>"}},
+}
+
+func TestExport(t *testing.T) {
+	setup(t)
+
+	m, err := manifest.Export([]string{"en", "fr"}, runOpts)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	want := []manifest.Check{
+		{Name: "gamma", Priority: 100, OptIn: true},
+		{Name: "alpha", Priority: 110, FailFast: true, Scope: "file"},
+		{Name: "beta", Priority: 210, Scope: "rows", Fix: true, Lossy: true},
+	}
+	if m.Version != manifest.Version || !reflect.DeepEqual(m.Checks, want) {
+		t.Fatalf("Export = %+v, want checks %+v", m, want)
+	}
+	if m.Options.FixMode != "if-failed" || m.Options.TieBreak != "registration" || m.Options.Settings["gamma"]["enabled"] != "true" {
+		t.Fatalf("unexpected options: %+v", m.Options)
+	}
+
+	opts, err := m.RunOptions()
+	if err != nil {
+		t.Fatalf("RunOptions: %v", err)
+	}
+	if opts.FixMode != runOpts.FixMode || opts.TieBreak != runOpts.TieBreak || !opts.RerunAfterFix ||
+		!reflect.DeepEqual(opts.Settings, runOpts.Settings) || !reflect.DeepEqual(opts.PriorityOverrides, runOpts.PriorityOverrides) ||
+		!reflect.DeepEqual(opts.SeverityOverrides, runOpts.SeverityOverrides) {
+		t.Fatalf("RunOptions = %+v, want %+v", opts, runOpts)
+	}
+
+	m, err = manifest.Export(nil, checks.RunOptions{Skip: []string{"Beta"}})
+	if err != nil {
+		t.Fatalf("Export with Skip: %v", err)
+	}
+	if len(m.Checks) != 2 || !reflect.DeepEqual(m.Options.Skip, []string{"Beta"}) {
+		t.Fatalf("skipped checks must be left out and recorded: %+v", m)
+	}
+	if opts, _ := m.RunOptions(); !reflect.DeepEqual(opts.Skip, []string{"Beta"}) {
+		t.Fatalf("RunOptions must restore Skip, got %v", opts.Skip)
+	}
+
+	if _, err := manifest.Export(nil, checks.RunOptions{CheckSet: "missing"}); !errors.Is(err, checks.ErrUnknownCheckSet) {
+		t.Fatalf("expected ErrUnknownCheckSet, got %v", err)
+	}
+}
+
+func TestSignOpen(t *testing.T) {
+	setup(t)
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	m, err := manifest.Export([]string{"en"}, runOpts)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	signed, err := manifest.Sign(m, priv)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	got, err := manifest.Open(signed, pub)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if !reflect.DeepEqual(got, m) {
+		t.Fatalf("Open = %+v, want %+v", got, m)
+	}
+
+	otherPub, _, _ := ed25519.GenerateKey(nil)
+	if _, err := manifest.Open(signed, otherPub); !errors.Is(err, manifest.ErrBadSignature) {
+		t.Fatalf("expected ErrBadSignature for another key, got %v", err)
+	}
+
+	tampered := bytes.Replace(signed, []byte(`"priority": 210`), []byte(`"priority": 211`), 1)
+	if bytes.Equal(tampered, signed) {
+		t.Fatalf("test setup: priority not found in %s", signed)
+	}
+	if _, err := manifest.Open(tampered, pub); !errors.Is(err, manifest.ErrBadSignature) {
+		t.Fatalf("expected ErrBadSignature for a tampered manifest, got %v", err)
+	}
+
+	m.Version = 2
+	future, _ := manifest.Sign(m, priv)
+	if _, err := manifest.Open(future, pub); !errors.Is(err, manifest.ErrUnsupportedVersion) {
+		t.Fatalf("expected ErrUnsupportedVersion, got %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	setup(t)
+
+	m, err := manifest.Export(nil, runOpts)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if err := m.Verify(); err != nil {
+		t.Fatalf("Verify right after Export: %v", err)
+	}
+
+	register(t, "delta", checks.WithPriority(220))
+	m.Checks[2].Priority = 205
+	m.Checks = append(m.Checks, manifest.Check{Name: "removed", Priority: 400})
+	m.CoreVersion = "v0.0.1-old"
+
+	var me *manifest.MismatchError
+	if err := m.Verify(); !errors.As(err, &me) {
+		t.Fatalf("expected *MismatchError, got %v", err)
+	}
+
+	want := []string{
+		"check beta differs: (priority 210, fix, lossy, scope rows), manifest (priority 205, fix, lossy, scope rows)",
+		"check delta is not in the manifest",
+		"check removed does not run",
+	}
+	got := me.Differences
+	if len(got) > 0 && strings.HasPrefix(got[0], "core version ") {
+		got = got[1:] // only when the test binary carries a module version
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Differences = %q, want %q", got, want)
+	}
+
+	m.Options.FixMode = "sometimes"
+	if err := m.Verify(); err == nil || !strings.Contains(err.Error(), "fix_mode") {
+		t.Fatalf("expected a fix_mode error, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	setup(t)
+
+	m, err := manifest.Export([]string{"en"}, runOpts)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	sum, err := manifest.Validate(context.Background(), m, "terms.csv", []byte("term;en\na;b\n"))
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if sum.Pass != 3 || !reflect.DeepEqual(sum.Order, []string{"gamma", "alpha", "beta"}) {
+		t.Fatalf("unexpected summary: %+v", sum)
+	}
+
+	checks.Reset()
+	register(t, "alpha", checks.WithPriority(110))
+
+	var me *manifest.MismatchError
+	if _, err := manifest.Validate(context.Background(), m, "terms.csv", nil); !errors.As(err, &me) {
+		t.Fatalf("expected *MismatchError after the registry changed, got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+// Package notify posts a compact JSON summary of a validation run to a webhook,
+// for chat bots and pipeline observability.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/audit"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/gate"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of the request body as "sha256=<hex>"
+// when Webhook.Secret is set.
+const SignatureHeader = "X-Glossary-Guard-Signature"
+
+// Gate values reported in Payload.Gate.
+const (
+	GatePassed = "passed"
+	GateFailed = "failed"
+)
+
+// Payload is the JSON body posted to the webhook.
+type Payload struct {
+	Time       time.Time `json:"time"`
+	RunID      string    `json:"run_id,omitempty"`
+	File       string    `json:"file,omitempty"`
+	Pass       int       `json:"pass"`
+	Warn       int       `json:"warn"`
+	Fail       int       `json:"fail"`
+	Error      int       `json:"error"`
+	Info       int       `json:"info"`
+	Skipped    int       `json:"skipped"`
+	Gate       string    `json:"gate"`
+	GateReason string    `json:"gate_reason,omitempty"`
+	EarlyExit  string    `json:"early_exit_check,omitempty"`
+	Truncated  bool      `json:"truncated,omitempty"`
+	Fixed      bool      `json:"fixes_applied"`
+	ReportURL  string    `json:"report_url,omitempty"`
+}
+
+// NewPayload builds the payload for sum under the given gate policy.
+func NewPayload(runID string, sum validator.Summary, policy gate.Policy, reportURL string) Payload {
+	d := gate.Decide(sum, policy)
+
+	p := Payload{
+		Time:       time.Now().UTC(),
+		RunID:      runID,
+		File:       sum.FilePath,
+		Pass:       sum.Pass,
+		Warn:       sum.Warn,
+		Fail:       sum.Fail,
+		Error:      sum.Error,
+		Info:       sum.Info,
+		Skipped:    sum.Skipped,
+		Gate:       GatePassed,
+		GateReason: d.Reason,
+		Truncated:  sum.Truncated,
+		Fixed:      sum.AppliedFixes,
+		ReportURL:  reportURL,
+	}
+	if !d.Passed {
+		p.Gate = GateFailed
+	}
+	if sum.EarlyExit {
+		p.EarlyExit = sum.EarlyCheck
+	}
+
+	return p
+}
+
+// Webhook posts payloads to URL.
+type Webhook struct {
+	URL string
+
+	// Secret, when set, signs every body with HMAC-SHA256 (see SignatureHeader).
+	Secret []byte
+
+	// Policy decides the reported gate value.
+	Policy gate.Policy
+
+	// Header holds extra request headers (e.g. an auth token).
+	Header http.Header
+
+	// Client sends the requests; nil uses a client with a 10s timeout.
+	Client *http.Client
+}
+
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+// Notify posts the summary of one run. The run id is taken from ctx (see audit.WithRunID).
+// A non-2xx response is an error.
+func (w *Webhook) Notify(ctx context.Context, sum validator.Summary, reportURL string) error {
+	runID, _ := audit.RunIDFrom(ctx)
+
+	return w.Send(ctx, NewPayload(runID, sum, w.Policy, reportURL))
+}
+
+// Send posts a prepared payload.
+func (w *Webhook) Send(ctx context.Context, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("notify: build request: %w", err)
+	}
+
+	for k, vs := range w.Header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.Secret) > 0 {
+		req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	client := w.Client
+	if client == nil {
+		client = defaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notify: post: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notify: webhook responded %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Sign returns the signature header value for body: "sha256=" + hex(HMAC-SHA256(secret, body)).
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature matches body; receivers can use it to authenticate requests.
+func Verify(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,118 @@
+package notify_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/audit"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/gate"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/notify"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestNewPayload(t *testing.T) {
+	t.Parallel()
+
+	sum := validator.Summary{
+		FilePath:     "gloss.csv",
+		Pass:         3,
+		Warn:         1,
+		Fail:         1,
+		EarlyExit:    true,
+		EarlyCheck:   "ensure-at-least-two-lines",
+		AppliedFixes: true,
+	}
+
+	p := notify.NewPayload("run-1", sum, gate.Policy{}, "https://ci/report/1")
+	if p.Gate != notify.GateFailed || p.GateReason != "1 FAIL" {
+		t.Fatalf("unexpected gate: %q (%q)", p.Gate, p.GateReason)
+	}
+	if p.RunID != "run-1" || p.File != "gloss.csv" || p.Pass != 3 || p.Warn != 1 || p.Fail != 1 {
+		t.Fatalf("unexpected payload: %+v", p)
+	}
+	if p.EarlyExit != "ensure-at-least-two-lines" || !p.Fixed || p.ReportURL != "https://ci/report/1" {
+		t.Fatalf("unexpected payload: %+v", p)
+	}
+
+	p = notify.NewPayload("", validator.Summary{Warn: 2}, gate.Policy{FailOn: checks.Error}, "")
+	if p.Gate != notify.GatePassed {
+		t.Fatalf("expected passed gate, got %+v", p)
+	}
+}
+
+func TestWebhook_NotifySignsAndPosts(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("s3cr3t")
+
+	var (
+		got       notify.Payload
+		gotAuth   string
+		signature string
+		validSig  bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signature = r.Header.Get(notify.SignatureHeader)
+		validSig = notify.Verify(secret, body, signature)
+		gotAuth = r.Header.Get("Authorization")
+		_ = json.Unmarshal(body, &got)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	hook := &notify.Webhook{
+		URL:    srv.URL,
+		Secret: secret,
+		Header: http.Header{"Authorization": []string{"Bearer token"}},
+		Client: srv.Client(),
+	}
+
+	ctx := audit.WithRunID(context.Background(), "run-42")
+	if err := hook.Notify(ctx, validator.Summary{FilePath: "a.csv", Pass: 2}, ""); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if !strings.HasPrefix(signature, "sha256=") || !validSig {
+		t.Fatalf("bad signature %q", signature)
+	}
+	if gotAuth != "Bearer token" {
+		t.Fatalf("extra header not sent: %q", gotAuth)
+	}
+	if got.RunID != "run-42" || got.Gate != notify.GatePassed || got.Pass != 2 {
+		t.Fatalf("unexpected payload: %+v", got)
+	}
+}
+
+func TestWebhook_Non2xxIsError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(srv.Close)
+
+	hook := &notify.Webhook{URL: srv.URL, Client: srv.Client()}
+	err := hook.Notify(context.Background(), validator.Summary{}, "")
+	if err == nil || !strings.Contains(err.Error(), "502") {
+		t.Fatalf("expected 502 error, got %v", err)
+	}
+}
+
+func TestVerify_RejectsTamperedBody(t *testing.T) {
+	t.Parallel()
+
+	sig := notify.Sign([]byte("k"), []byte(`{"pass":1}`))
+	if notify.Verify([]byte("k"), []byte(`{"pass":2}`), sig) {
+		t.Fatalf("tampered body must not verify")
+	}
+	if notify.Verify([]byte("other"), []byte(`{"pass":1}`), sig) {
+		t.Fatalf("wrong secret must not verify")
+	}
+}
@@ -0,0 +1,340 @@
+// Package options builds checks.RunOptions fluently and rejects combinations that
+// would silently do nothing or contradict each other:
+//
+//	opts, err := options.New().
+//		FixIfFailed().Rerun().
+//		Only("warn-duplicate-term-values", "no-invalid-flags").
+//		Timeout(30 * time.Second).
+//		Build()
+//
+// Check names are not looked up here: the validator rejects unknown ones when the run starts.
+package options
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrInvalid is matched by every problem Build reports.
+var ErrInvalid = errors.New("invalid run options")
+
+// Builder accumulates RunOptions. The zero value is not usable; call New.
+// Methods return the builder, so calls chain; Build may be called more than once.
+type Builder struct {
+	opts checks.RunOptions
+}
+
+// New returns a builder for the zero RunOptions: no fixes, every registered check.
+func New() *Builder {
+	return &Builder{}
+}
+
+// NoFix never attempts fixes (the default).
+func (b *Builder) NoFix() *Builder {
+	b.opts.FixMode = checks.FixNone
+
+	return b
+}
+
+// FixIfFailed fixes checks that report FAIL or ERROR.
+func (b *Builder) FixIfFailed() *Builder {
+	b.opts.FixMode = checks.FixIfFailed
+
+	return b
+}
+
+// FixIfNotPass fixes checks that report anything but PASS.
+func (b *Builder) FixIfNotPass() *Builder {
+	b.opts.FixMode = checks.FixIfNotPass
+
+	return b
+}
+
+// FixAlways runs every fixer.
+func (b *Builder) FixAlways() *Builder {
+	b.opts.FixMode = checks.FixAlways
+
+	return b
+}
+
+// Rerun re-validates after a successful fix. It needs a fix mode.
+func (b *Builder) Rerun() *Builder {
+	b.opts.RerunAfterFix = true
+
+	return b
+}
+
+// AllowDestructive permits fixers that restructure the file.
+func (b *Builder) AllowDestructive() *Builder {
+	b.opts.AllowDestructive = true
+
+	return b
+}
+
+// PreviewFixes attaches fix previews to findings of checks that fail without fixing.
+func (b *Builder) PreviewFixes() *Builder {
+	b.opts.PreviewFixes = true
+
+	return b
+}
+
+// SafeFixes rejects fixes that lose non-empty cells without declaring it. It needs a fix mode.
+func (b *Builder) SafeFixes() *Builder {
+	b.opts.SafeFixes = true
+
+	return b
+}
+
+// RecheckAfterFixes reports checks that a later fix broke. It needs a fix mode.
+func (b *Builder) RecheckAfterFixes() *Builder {
+	b.opts.RecheckAfterFixes = true
+
+	return b
+}
+
+// HardFailOnErr ends the run with an error on any ERROR result.
+func (b *Builder) HardFailOnErr() *Builder {
+	b.opts.HardFailOnErr = true
+
+	return b
+}
+
+// VerifySummary checks the finished summary for internal consistency.
+func (b *Builder) VerifySummary() *Builder {
+	b.opts.VerifySummary = true
+
+	return b
+}
+
+// PreserveHeaderCase keeps locale header labels as spelled when header fixers rewrite them.
+func (b *Builder) PreserveHeaderCase() *Builder {
+	b.opts.PreserveHeaderCase = true
+
+	return b
+}
+
+// MaxFindings caps the findings each check keeps (negative: no cap).
+func (b *Builder) MaxFindings(n int) *Builder {
+	b.opts.MaxFindings = n
+
+	return b
+}
+
+// MaxFailures ends the run after n FAIL findings (0: no limit).
+func (b *Builder) MaxFailures(n int) *Builder {
+	b.opts.MaxFailures = n
+
+	return b
+}
+
+// CheckSet runs the registered set called name instead of every check.
+func (b *Builder) CheckSet(name string) *Builder {
+	b.opts.CheckSet = name
+
+	return b
+}
+
+// Only adds checks to run; when any are given, the others are left out.
+func (b *Builder) Only(names ...string) *Builder {
+	b.opts.Only = append(b.opts.Only, names...)
+
+	return b
+}
+
+// Skip adds checks to leave out.
+func (b *Builder) Skip(names ...string) *Builder {
+	b.opts.Skip = append(b.opts.Skip, names...)
+
+	return b
+}
+
+// TieBreak orders checks that share a priority.
+func (b *Builder) TieBreak(tb checks.TieBreak) *Builder {
+	b.opts.TieBreak = tb
+
+	return b
+}
+
+// Priority runs the named check at priority p in this run. Names match
+// case-insensitively, so a later call for "Rows" replaces one for "rows".
+func (b *Builder) Priority(name string, p int) *Builder {
+	if b.opts.PriorityOverrides == nil {
+		b.opts.PriorityOverrides = make(map[string]int)
+	}
+	delete(b.opts.PriorityOverrides, foldKey(b.opts.PriorityOverrides, name))
+	b.opts.PriorityOverrides[name] = p
+
+	return b
+}
+
+// Severity reports the named check's problems as st (INFO, WARN or FAIL). Names match
+// case-insensitively, so a later call for "Rows" replaces one for "rows".
+func (b *Builder) Severity(name string, st checks.Status) *Builder {
+	if b.opts.SeverityOverrides == nil {
+		b.opts.SeverityOverrides = make(map[string]checks.Status)
+	}
+	delete(b.opts.SeverityOverrides, foldKey(b.opts.SeverityOverrides, name))
+	b.opts.SeverityOverrides[name] = st
+
+	return b
+}
+
+// Timeout bounds the run time of each check (RunOptions.CheckTimeout).
+func (b *Builder) Timeout(d time.Duration) *Builder {
+	b.opts.CheckTimeout = d
+
+	return b
+}
+
+// TimeoutFor bounds the run time of the named check; zero removes its limit. Names match
+// case-insensitively, so a later call for "Rows" replaces one for "rows".
+func (b *Builder) TimeoutFor(name string, d time.Duration) *Builder {
+	if b.opts.CheckTimeouts == nil {
+		b.opts.CheckTimeouts = make(map[string]time.Duration)
+	}
+	delete(b.opts.CheckTimeouts, foldKey(b.opts.CheckTimeouts, name))
+	b.opts.CheckTimeouts[name] = d
+
+	return b
+}
+
+// CommentPrefix hides lines starting with prefix from the checks.
+func (b *Builder) CommentPrefix(prefix string) *Builder {
+	b.opts.CommentPrefix = prefix
+
+	return b
+}
+
+// FindingsSidecar stores every finding in s.
+func (b *Builder) FindingsSidecar(s *checks.FindingsSidecar) *Builder {
+	b.opts.FindingsSidecar = s
+
+	return b
+}
+
+// SourceStrings gives checks that compare the glossary with real content the project's strings.
+func (b *Builder) SourceStrings(seq iter.Seq[string]) *Builder {
+	b.opts.SourceStrings = seq
+
+	return b
+}
+
+// Setting sets one knob of the named check. Check names and keys match case-insensitively,
+// so setting "keep" replaces an earlier "Keep".
+func (b *Builder) Setting(check, key, value string) *Builder {
+	if b.opts.Settings == nil {
+		b.opts.Settings = make(map[string]checks.CheckSettings)
+	}
+	check = foldKey(b.opts.Settings, check)
+	if b.opts.Settings[check] == nil {
+		b.opts.Settings[check] = make(checks.CheckSettings)
+	}
+	delete(b.opts.Settings[check], foldKey(b.opts.Settings[check], key))
+	b.opts.Settings[check][key] = value
+
+	return b
+}
+
+// Build returns the options, or every problem found joined in one error (each matches ErrInvalid).
+// The options do not share maps or slices with the builder.
+func (b *Builder) Build() (checks.RunOptions, error) {
+	if err := errors.Join(b.problems()...); err != nil {
+		return checks.RunOptions{}, err
+	}
+
+	opts := b.opts
+	opts.Only = slices.Clone(opts.Only)
+	opts.Skip = slices.Clone(opts.Skip)
+	opts.PriorityOverrides = maps.Clone(opts.PriorityOverrides)
+	opts.SeverityOverrides = maps.Clone(opts.SeverityOverrides)
+	opts.CheckTimeouts = maps.Clone(opts.CheckTimeouts)
+	if opts.Settings != nil {
+		settings := make(map[string]checks.CheckSettings, len(opts.Settings))
+		for name, s := range opts.Settings {
+			settings[name] = maps.Clone(s)
+		}
+		opts.Settings = settings
+	}
+
+	return opts, nil
+}
+
+// foldKey returns the key of m equal to key ignoring case and surrounding spaces, or key.
+func foldKey[V any](m map[string]V, key string) string {
+	if _, ok := m[key]; ok {
+		return key
+	}
+	for k := range m {
+		if strings.EqualFold(strings.TrimSpace(k), strings.TrimSpace(key)) {
+			return k
+		}
+	}
+
+	return key
+}
+
+func (b *Builder) problems() []error {
+	o := b.opts
+	var out []error
+	add := func(format string, args ...any) {
+		out = append(out, fmt.Errorf("%w: "+format, append([]any{ErrInvalid}, args...)...))
+	}
+
+	if o.FixMode == checks.FixNone {
+		needFix := map[string]bool{"Rerun": o.RerunAfterFix, "SafeFixes": o.SafeFixes, "RecheckAfterFixes": o.RecheckAfterFixes}
+		for _, name := range slices.Sorted(maps.Keys(needFix)) {
+			if needFix[name] {
+				add("%s has no effect without a fix mode (call FixIfFailed, FixIfNotPass or FixAlways)", name)
+			}
+		}
+	}
+
+	if o.MaxFailures < 0 {
+		add("MaxFailures must not be negative, got %d (0 means no limit)", o.MaxFailures)
+	}
+	if o.CheckTimeout < 0 {
+		add("Timeout must not be negative, got %s (0 means no limit)", o.CheckTimeout)
+	}
+
+	if len(o.Only) > 0 {
+		skipped := make(map[string]bool, len(o.Skip))
+		for _, name := range o.Skip {
+			skipped[normalize(name)] = true
+		}
+		for _, name := range o.Only {
+			if skipped[normalize(name)] {
+				add("check %q is passed to both Only and Skip", strings.TrimSpace(name))
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(o.SeverityOverrides)) {
+		switch st := o.SeverityOverrides[name]; st {
+		case checks.Info, checks.Warn, checks.Fail:
+		default:
+			add("Severity of %q must be INFO, WARN or FAIL, got %q", name, st)
+		}
+	}
+
+	names := slices.Concat(o.Only, o.Skip,
+		slices.Collect(maps.Keys(o.PriorityOverrides)),
+		slices.Collect(maps.Keys(o.SeverityOverrides)),
+		slices.Collect(maps.Keys(o.CheckTimeouts)),
+		slices.Collect(maps.Keys(o.Settings)))
+	if slices.ContainsFunc(names, func(n string) bool { return strings.TrimSpace(n) == "" }) {
+		add("empty check name")
+	}
+
+	return out
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
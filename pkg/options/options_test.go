@@ -0,0 +1,113 @@
+package options_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/options"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	b := options.New().
+		FixIfFailed().Rerun().SafeFixes().
+		Only("warn-duplicate-term-values", "no-invalid-flags").
+		Skip("warn-unused-terms").
+		Severity("no-invalid-flags", checks.Warn).
+		Priority("warn-duplicate-term-values", 150).
+		Timeout(30*time.Second).
+		TimeoutFor("warn-near-duplicate-terms", time.Minute).
+		Setting("warn-duplicate-term-values", "keep", "last").
+		MaxFailures(20)
+
+	opts, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if opts.FixMode != checks.FixIfFailed || !opts.RerunAfterFix || !opts.SafeFixes || opts.MaxFailures != 20 {
+		t.Fatalf("unexpected options: %+v", opts)
+	}
+	if len(opts.Only) != 2 || opts.Skip[0] != "warn-unused-terms" || opts.CheckTimeout != 30*time.Second {
+		t.Fatalf("unexpected selection or timeout: %+v", opts)
+	}
+	if opts.SeverityOverrides["no-invalid-flags"] != checks.Warn || opts.PriorityOverrides["warn-duplicate-term-values"] != 150 {
+		t.Fatalf("unexpected overrides: %+v", opts)
+	}
+	if v, _ := opts.Setting("warn-duplicate-term-values", "keep"); v != "last" {
+		t.Fatalf("unexpected settings: %v", opts.Settings)
+	}
+
+	b.Setting("warn-duplicate-term-values", "keep", "first")
+	if v, _ := opts.Setting("warn-duplicate-term-values", "keep"); v != "last" {
+		t.Fatalf("built options must not share maps with the builder")
+	}
+}
+
+func TestBuild_LaterCaseVariantReplaces(t *testing.T) {
+	t.Parallel()
+
+	opts, err := options.New().
+		Severity("No-Invalid-Flags", checks.Warn).
+		Severity("no-invalid-flags", checks.Fail).
+		Priority("Warn-Duplicate-Term-Values", 50).
+		Priority("warn-duplicate-term-values", 900).
+		TimeoutFor("Warn-Near-Duplicate-Terms", time.Second).
+		TimeoutFor("warn-near-duplicate-terms", time.Minute).
+		Setting("Warn-Duplicate-Term-Values", "Keep", "last").
+		Setting("warn-duplicate-term-values", "keep", "first").
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	if len(opts.SeverityOverrides) != 1 || opts.SeverityOverrides["no-invalid-flags"] != checks.Fail {
+		t.Fatalf("SeverityOverrides = %v", opts.SeverityOverrides)
+	}
+	if len(opts.PriorityOverrides) != 1 || opts.PriorityOverrides["warn-duplicate-term-values"] != 900 {
+		t.Fatalf("PriorityOverrides = %v", opts.PriorityOverrides)
+	}
+	if len(opts.CheckTimeouts) != 1 || opts.CheckTimeoutFor("warn-near-duplicate-terms") != time.Minute {
+		t.Fatalf("CheckTimeouts = %v", opts.CheckTimeouts)
+	}
+	if set := opts.Settings["Warn-Duplicate-Term-Values"]; len(opts.Settings) != 1 || len(set) != 1 || set["keep"] != "first" {
+		t.Fatalf("Settings = %v", opts.Settings)
+	}
+}
+
+func TestBuild_RejectsIncompatibleCombinations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		b    *options.Builder
+		want []string
+	}{
+		{"rerun without fix", options.New().Rerun(), []string{"Rerun has no effect without a fix mode"}},
+		{"safe and recheck without fix", options.New().SafeFixes().RecheckAfterFixes(), []string{"RecheckAfterFixes has no effect", "SafeFixes has no effect"}},
+		{"only and skip", options.New().Only("a", "B").Skip(" b "), []string{`check "B" is passed to both Only and Skip`}},
+		{"negative limits", options.New().MaxFailures(-1).Timeout(-time.Second), []string{"MaxFailures must not be negative", "Timeout must not be negative"}},
+		{"bad severity", options.New().Severity("a", checks.Error), []string{`Severity of "a" must be INFO, WARN or FAIL, got "ERROR"`}},
+		{"empty name", options.New().Setting(" ", "k", "v"), []string{"empty check name"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tc.b.Build()
+			if !errors.Is(err, options.ErrInvalid) {
+				t.Fatalf("expected ErrInvalid, got %v", err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("error %q does not mention %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Package report renders validation summaries for people and tools outside the validator.
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// CSVHeader is the first record written by WriteFindingsCSV.
+var CSVHeader = []string{"check", "code", "severity", "row", "column", "value", "message"}
+
+// CSVOptions tunes WriteFindingsCSV. The zero value writes plain comma-separated UTF-8.
+type CSVOptions struct {
+	// Comma is the field delimiter; 0 means ','. Use ';' for Excel in locales with decimal commas.
+	Comma rune
+
+	// BOM prefixes the output with a UTF-8 byte order mark so Excel detects the encoding.
+	BOM bool
+}
+
+// WriteFindingsCSV writes one record per finding of sum (nested outcomes included), in execution order:
+//
+//   - check: name of the check that reported the finding
+//   - code: the finding's Code, or the check name when the check sets none
+//   - severity: the finding's Severity, by default the status of the check result
+//   - row: 1-based CSV record number, empty when the problem is not tied to a row
+//   - column, value, message: as reported by the check
+//
+// Non-passing results without findings (file-level problems) get a single record with the
+// result message. Cells starting with '=', '+', '-', '@' or a control character are prefixed
+// with "'" so spreadsheets do not evaluate them as formulas.
+func WriteFindingsCSV(w io.Writer, sum validator.Summary, opts CSVOptions) error {
+	if opts.BOM {
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return err
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+
+	if err := cw.Write(CSVHeader); err != nil {
+		return err
+	}
+
+	for _, rec := range FindingRecords(sum) {
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// FindingRecords returns the records WriteFindingsCSV writes after the header,
+// for callers that feed another table format.
+func FindingRecords(sum validator.Summary) [][]string {
+	var out [][]string
+	for _, o := range sum.Outcomes {
+		out = appendOutcomeRecords(out, o)
+	}
+
+	return out
+}
+
+func appendOutcomeRecords(out [][]string, o checks.CheckOutcome) [][]string {
+	res := o.Result
+
+	switch {
+	case len(res.Findings) > 0:
+		for _, f := range res.Findings {
+			check := f.Check
+			if check == "" {
+				check = res.Name
+			}
+			code := f.Code
+			if code == "" {
+				code = check
+			}
+			severity := f.Severity
+			if severity == "" {
+				severity = res.Status
+			}
+			out = append(out, findingRecord(check, code, severity, f.Row, f.Column, f.Value, f.Message))
+		}
+	case res.Status != checks.Pass && res.Status != checks.Skipped && len(o.Children) == 0:
+		out = append(out, findingRecord(res.Name, res.Name, res.Status, 0, "", "", res.Message))
+	}
+
+	for _, child := range o.Children {
+		out = appendOutcomeRecords(out, child)
+	}
+
+	return out
+}
+
+func findingRecord(check, code string, st checks.Status, row int, column, value, message string) []string {
+	rowCell := ""
+	if row > 0 {
+		rowCell = strconv.Itoa(row)
+	}
+
+	return []string{
+		safeCell(check),
+		safeCell(code),
+		string(st),
+		rowCell,
+		safeCell(column),
+		safeCell(value),
+		safeCell(message),
+	}
+}
+
+// safeCell neutralizes spreadsheet formula injection.
+func safeCell(s string) string {
+	if s == "" {
+		return s
+	}
+
+	if strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+
+	return s
+}
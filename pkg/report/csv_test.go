@@ -0,0 +1,97 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/report"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func sampleSummary() validator.Summary {
+	return validator.Summary{
+		FilePath: "terms.csv",
+		Outcomes: []checks.CheckOutcome{
+			{Result: checks.CheckResult{Name: "ensure-valid-extension", Status: checks.Pass, Message: "ok"}},
+			{Result: checks.CheckResult{
+				Name:   "ensure-no-empty-term-values",
+				Status: checks.Fail,
+				Findings: []checks.Finding{
+					{Check: "ensure-no-empty-term-values", Code: "empty-term", Row: 3, Column: "term", Message: "empty term"},
+				},
+			}},
+			{Result: checks.CheckResult{Name: "ensure-at-least-two-lines", Status: checks.Fail, Message: "expected at least two non-empty lines"}},
+			{
+				Result: checks.CheckResult{Name: "nested", Status: checks.Warn, Message: "1 warning"},
+				Children: []checks.CheckOutcome{
+					{Result: checks.CheckResult{
+						Name:     "warn-trailing-term-punctuation",
+						Status:   checks.Warn,
+						Findings: []checks.Finding{{Row: 5, Column: "term", Value: "=SUM(A1)", Message: "trailing punctuation", Severity: checks.Info}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteFindingsCSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := report.WriteFindingsCSV(&buf, sampleSummary(), report.CSVOptions{}); err != nil {
+		t.Fatalf("WriteFindingsCSV: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+
+	want := [][]string{
+		report.CSVHeader,
+		{"ensure-no-empty-term-values", "empty-term", "FAIL", "3", "term", "", "empty term"},
+		{"ensure-at-least-two-lines", "ensure-at-least-two-lines", "FAIL", "", "", "", "expected at least two non-empty lines"},
+		{"warn-trailing-term-punctuation", "warn-trailing-term-punctuation", "INFO", "5", "term", "'=SUM(A1)", "trailing punctuation"},
+	}
+
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d: %q", len(records), len(want), records)
+	}
+	for i := range want {
+		if strings.Join(records[i], "|") != strings.Join(want[i], "|") {
+			t.Fatalf("record %d = %q, want %q", i, records[i], want[i])
+		}
+	}
+}
+
+func TestWriteFindingsCSV_ExcelOptions(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := report.WriteFindingsCSV(&buf, sampleSummary(), report.CSVOptions{Comma: ';', BOM: true}); err != nil {
+		t.Fatalf("WriteFindingsCSV: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "\xEF\xBB\xBFcheck;code;severity;row;column;value;message\n") {
+		t.Fatalf("unexpected header: %q", out[:min(len(out), 60)])
+	}
+}
+
+func TestWriteFindingsCSV_CleanRun(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	sum := validator.Summary{Outcomes: []checks.CheckOutcome{{Result: checks.CheckResult{Name: "x", Status: checks.Pass}}}}
+	if err := report.WriteFindingsCSV(&buf, sum, report.CSVOptions{}); err != nil {
+		t.Fatalf("WriteFindingsCSV: %v", err)
+	}
+
+	if got := buf.String(); got != "check,code,severity,row,column,value,message\n" {
+		t.Fatalf("expected header only, got %q", got)
+	}
+}
@@ -0,0 +1,121 @@
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// DiffFinding is a finding with the fingerprint CompareSummaries matched it by.
+// Check, Code and Severity are always filled in.
+type DiffFinding struct {
+	checks.Finding
+	Fingerprint string
+}
+
+// SummaryDiff sorts the findings of two runs of the same file into buckets,
+// each in execution order.
+type SummaryDiff struct {
+	New      []DiffFinding // in curr only
+	Resolved []DiffFinding // in prev only, as prev reported them
+	Still    []DiffFinding // in both, as curr reports them
+}
+
+// Changed reports whether any finding appeared or went away.
+func (d SummaryDiff) Changed() bool {
+	return len(d.New) > 0 || len(d.Resolved) > 0
+}
+
+// CompareSummaries matches the findings of prev and curr by Fingerprint, so a PR bot can
+// comment on what a change introduced or fixed instead of repeating the full report.
+// Non-passing results without findings count as one finding of their check, like in
+// WriteFindingsCSV. Equal fingerprints are matched pairwise in order, so two empty terms
+// before and three after are two still present and one new.
+func CompareSummaries(prev, curr validator.Summary) SummaryDiff {
+	before := diffFindings(prev)
+	after := diffFindings(curr)
+
+	pending := make(map[string][]int, len(before))
+	for i, f := range before {
+		pending[f.Fingerprint] = append(pending[f.Fingerprint], i)
+	}
+
+	matched := make([]bool, len(before))
+	var d SummaryDiff
+
+	for _, f := range after {
+		queue := pending[f.Fingerprint]
+		if len(queue) == 0 {
+			d.New = append(d.New, f)
+			continue
+		}
+
+		matched[queue[0]] = true
+		pending[f.Fingerprint] = queue[1:]
+		d.Still = append(d.Still, f)
+	}
+
+	for i, f := range before {
+		if !matched[i] {
+			d.Resolved = append(d.Resolved, f)
+		}
+	}
+
+	return d
+}
+
+// Fingerprint identifies a finding across runs: a hash of its check, code, column, value
+// and message. The row is left out, so rows inserted above a problem do not make it new.
+// Check and Code must be filled in (Code defaults to the check name).
+func Fingerprint(f checks.Finding) string {
+	h := sha256.New()
+	for _, part := range []string{f.Check, f.Code, f.Column, f.Value, f.Message} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func diffFindings(sum validator.Summary) []DiffFinding {
+	var out []DiffFinding
+	for _, o := range sum.Outcomes {
+		out = appendDiffFindings(out, o)
+	}
+
+	return out
+}
+
+func appendDiffFindings(out []DiffFinding, o checks.CheckOutcome) []DiffFinding {
+	res := o.Result
+
+	switch {
+	case len(res.Findings) > 0:
+		for _, f := range res.Findings {
+			if f.Check == "" {
+				f.Check = res.Name
+			}
+			if f.Code == "" {
+				f.Code = f.Check
+			}
+			if f.Severity == "" {
+				f.Severity = res.Status
+			}
+			out = append(out, DiffFinding{Finding: f, Fingerprint: Fingerprint(f)})
+		}
+	case res.Status != checks.Pass && res.Status != checks.Skipped && len(o.Children) == 0:
+		// The message of a file-level result often carries counts; the check alone identifies it.
+		f := checks.Finding{Check: res.Name, Code: res.Name, Severity: res.Status}
+		fp := Fingerprint(f)
+		f.Message = res.Message
+		out = append(out, DiffFinding{Finding: f, Fingerprint: fp})
+	}
+
+	for _, child := range o.Children {
+		out = appendDiffFindings(out, child)
+	}
+
+	return out
+}
@@ -0,0 +1,76 @@
+package report_test
+
+import (
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/report"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func emptyTerms(rows ...int) checks.CheckOutcome {
+	o := checks.CheckOutcome{Result: checks.CheckResult{Name: "no-empty-term-values", Status: checks.Fail}}
+	for _, row := range rows {
+		o.Result.Findings = append(o.Result.Findings, checks.Finding{Code: "empty-term", Row: row, Column: "term", Message: "empty term"})
+	}
+
+	return o
+}
+
+func TestCompareSummaries(t *testing.T) {
+	t.Parallel()
+
+	prev := validator.Summary{Outcomes: []checks.CheckOutcome{
+		emptyTerms(3, 7),
+		{Result: checks.CheckResult{Name: "warn-final-newline", Status: checks.Warn, Message: "file does not end with a line break"}},
+		{Result: checks.CheckResult{Name: "warn-duplicate-tags", Status: checks.Warn, Findings: []checks.Finding{
+			{Code: "duplicate-tag", Row: 4, Column: "tags", Value: "ui,ui", Message: "repeated tags: ui"},
+		}}},
+	}}
+	curr := validator.Summary{Outcomes: []checks.CheckOutcome{
+		// a row was inserted above: same problems, shifted, plus a new one
+		emptyTerms(4, 8, 9),
+		{Result: checks.CheckResult{Name: "warn-final-newline", Status: checks.Pass}},
+		{Result: checks.CheckResult{Name: "warn-duplicate-tags", Status: checks.Warn, Findings: []checks.Finding{
+			{Code: "duplicate-tag", Row: 5, Column: "tags", Value: "ui,ui", Message: "repeated tags: ui", Severity: checks.Fail},
+		}}},
+	}}
+
+	d := report.CompareSummaries(prev, curr)
+
+	if !d.Changed() {
+		t.Fatalf("expected changes")
+	}
+	if len(d.New) != 1 || d.New[0].Row != 9 || d.New[0].Check != "no-empty-term-values" || d.New[0].Severity != checks.Fail {
+		t.Fatalf("New = %+v", d.New)
+	}
+	if len(d.Resolved) != 1 || d.Resolved[0].Check != "warn-final-newline" || d.Resolved[0].Message != "file does not end with a line break" {
+		t.Fatalf("Resolved = %+v", d.Resolved)
+	}
+	if len(d.Still) != 3 || d.Still[0].Row != 4 || d.Still[1].Row != 8 || d.Still[2].Severity != checks.Fail {
+		t.Fatalf("Still = %+v", d.Still)
+	}
+	if d.Still[0].Fingerprint != d.New[0].Fingerprint {
+		t.Fatalf("equal findings must share a fingerprint")
+	}
+
+	if same := report.CompareSummaries(curr, curr); same.Changed() || len(same.Still) != 4 {
+		t.Fatalf("comparing a summary with itself = %+v", same)
+	}
+}
+
+func TestFingerprint_IgnoresRowAndSeverity(t *testing.T) {
+	t.Parallel()
+
+	a := checks.Finding{Check: "c", Code: "x", Row: 2, Column: "term", Value: "v", Severity: checks.Warn}
+	b := a
+	b.Row, b.Severity = 10, checks.Fail
+	if report.Fingerprint(a) != report.Fingerprint(b) {
+		t.Fatalf("row and severity must not change the fingerprint")
+	}
+
+	b.Value = "w"
+	if report.Fingerprint(a) == report.Fingerprint(b) {
+		t.Fatalf("another value must change the fingerprint")
+	}
+}
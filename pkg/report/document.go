@@ -0,0 +1,299 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Defaults of DocumentOptions.
+const (
+	DefaultTitle            = "Glossary validation report"
+	DefaultMaxDiffLines     = 20
+	DefaultFindingsPerCheck = 10
+)
+
+// DocumentOptions tune WriteMarkdown and WriteHTML. The zero value uses the defaults above.
+type DocumentOptions struct {
+	// Title heads the report.
+	Title string
+
+	// Original is the validated input. When set and fixes changed the data, the report
+	// ends with a before/after excerpt of the changed lines.
+	Original []byte
+
+	// MaxDiffLines caps the changed lines shown in the excerpt (0: DefaultMaxDiffLines).
+	MaxDiffLines int
+
+	// FindingsPerCheck caps the findings listed under each check (0: DefaultFindingsPerCheck,
+	// negative: none).
+	FindingsPerCheck int
+}
+
+func (o DocumentOptions) withDefaults() DocumentOptions {
+	if o.Title == "" {
+		o.Title = DefaultTitle
+	}
+	if o.MaxDiffLines <= 0 {
+		o.MaxDiffLines = DefaultMaxDiffLines
+	}
+	if o.FindingsPerCheck == 0 {
+		o.FindingsPerCheck = DefaultFindingsPerCheck
+	}
+
+	return o
+}
+
+// document is what both renderers show.
+type document struct {
+	Title   string
+	File    string
+	Totals  string   // e.g. "1 FAIL, 2 WARN, 12 PASS"
+	Notes   []string // early exit, truncation, applied fixes
+	Checks  []checkRow
+	Diff    []diffLine
+	DiffCut int  // changed lines left out of Diff
+	DiffNil bool // data changed, but no line differs (line breaks, BOM)
+}
+
+type checkRow struct {
+	Status   checks.Status
+	Icon     string
+	Name     string
+	Message  string
+	Fix      string
+	Findings []string
+	Total    int // findings reported, shown or not
+	More     int // findings left out
+}
+
+type diffLine struct {
+	Kind byte // '@' hunk header, '-' removed, '+' added
+	Text string
+}
+
+func buildDocument(sum validator.Summary, opts DocumentOptions) document {
+	opts = opts.withDefaults()
+
+	doc := document{
+		Title:  opts.Title,
+		File:   sum.FilePath,
+		Totals: totals(sum),
+	}
+
+	if sum.EarlyExit {
+		doc.Notes = append(doc.Notes, "Stopped early at "+sum.EarlyCheck+" ("+string(sum.EarlyStatus)+").")
+	}
+	if sum.Truncated {
+		doc.Notes = append(doc.Notes, "Results are partial: the failure budget was spent.")
+	}
+	if sum.AppliedFixes {
+		note := "Fixes were applied"
+		if sum.FinalPath != "" && sum.FinalPath != sum.FilePath {
+			note += "; the file is now " + sum.FinalPath
+		}
+		doc.Notes = append(doc.Notes, note+".")
+	}
+
+	for _, o := range sum.Outcomes {
+		doc.Checks = appendCheckRows(doc.Checks, o, "", opts.FindingsPerCheck)
+	}
+
+	if sum.AppliedFixes && opts.Original != nil && !bytes.Equal(opts.Original, sum.FinalData) {
+		doc.Diff, doc.DiffCut = diffExcerpt(opts.Original, sum.FinalData, opts.MaxDiffLines)
+		doc.DiffNil = len(doc.Diff) == 0
+	}
+
+	return doc
+}
+
+func totals(sum validator.Summary) string {
+	counts := []struct {
+		status checks.Status
+		n      int
+	}{
+		{checks.Error, sum.Error},
+		{checks.Fail, sum.Fail},
+		{checks.Warn, sum.Warn},
+		{checks.Info, sum.Info},
+		{checks.Pass, sum.Pass},
+		{checks.Skipped, sum.Skipped},
+	}
+
+	var parts []string
+	for _, c := range counts {
+		if c.n > 0 {
+			parts = append(parts, strconv.Itoa(c.n)+" "+string(c.status))
+		}
+	}
+	if len(parts) == 0 {
+		return "no checks ran"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func appendCheckRows(out []checkRow, o checks.CheckOutcome, parent string, perCheck int) []checkRow {
+	res := o.Result
+
+	name := res.Name
+	if parent != "" {
+		name = parent + " / " + name
+	}
+
+	row := checkRow{
+		Status:  res.Status,
+		Icon:    statusIcon(res.Status),
+		Name:    name,
+		Message: res.Message,
+		Total:   len(res.Findings),
+	}
+	if o.Final.DidChange {
+		row.Fix = o.Final.Note
+		if row.Fix == "" {
+			row.Fix = "fixed"
+		}
+	}
+
+	if perCheck > 0 {
+		for i, f := range res.Findings {
+			if i == perCheck {
+				row.More = len(res.Findings) - perCheck
+				break
+			}
+			row.Findings = append(row.Findings, findingText(f))
+		}
+	}
+
+	out = append(out, row)
+	for _, child := range o.Children {
+		out = appendCheckRows(out, child, name, perCheck)
+	}
+
+	return out
+}
+
+func statusIcon(st checks.Status) string {
+	switch st {
+	case checks.Pass:
+		return "✅"
+	case checks.Warn:
+		return "⚠️"
+	case checks.Fail:
+		return "❌"
+	case checks.Error:
+		return "💥"
+	case checks.Info:
+		return "ℹ️"
+	default:
+		return "⏭️"
+	}
+}
+
+// findingText renders a finding as "row 3, term "x": message".
+func findingText(f checks.Finding) string {
+	var parts []string
+	if f.Row > 0 {
+		parts = append(parts, "row "+strconv.Itoa(f.Row))
+	}
+	if f.Column != "" {
+		parts = append(parts, f.Column)
+	}
+
+	where := strings.Join(parts, ", ")
+	if f.Value != "" {
+		where = strings.TrimSpace(where + " " + strconv.Quote(f.Value))
+	}
+
+	text := f.Message
+	if f.Suggestion != "" {
+		text += " (suggested: " + f.Suggestion + ")"
+	}
+	if where == "" {
+		return text
+	}
+
+	return where + ": " + text
+}
+
+// diffExcerpt lists the changed lines of before and after as hunks. Equal line counts
+// are compared line by line; otherwise the region between the common leading and
+// trailing lines is one hunk. At most limit changed lines are returned; cut counts the rest.
+func diffExcerpt(before, after []byte, limit int) (lines []diffLine, cut int) {
+	b, a := docLines(before), docLines(after)
+
+	type hunk struct{ start, oldN, newN int } // start is 0-based in both sides
+
+	var hunks []hunk
+	if len(b) == len(a) {
+		for i := 0; i < len(b); i++ {
+			if b[i] == a[i] {
+				continue
+			}
+			j := i
+			for j < len(b) && b[j] != a[j] {
+				j++
+			}
+			hunks = append(hunks, hunk{start: i, oldN: j - i, newN: j - i})
+			i = j
+		}
+	} else {
+		prefix := 0
+		for prefix < len(b) && prefix < len(a) && b[prefix] == a[prefix] {
+			prefix++
+		}
+		suffix := 0
+		for suffix < len(b)-prefix && suffix < len(a)-prefix && b[len(b)-1-suffix] == a[len(a)-1-suffix] {
+			suffix++
+		}
+		hunks = append(hunks, hunk{start: prefix, oldN: len(b) - prefix - suffix, newN: len(a) - prefix - suffix})
+	}
+
+	shown := 0
+	for _, h := range hunks {
+		changed := h.oldN + h.newN
+		if shown >= limit {
+			cut += changed
+			continue
+		}
+
+		lines = append(lines, diffLine{Kind: '@', Text: fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.start+1, h.oldN, h.start+1, h.newN)})
+		for _, l := range b[h.start : h.start+h.oldN] {
+			if shown == limit {
+				cut++
+				continue
+			}
+			lines = append(lines, diffLine{Kind: '-', Text: l})
+			shown++
+		}
+		for _, l := range a[h.start : h.start+h.newN] {
+			if shown == limit {
+				cut++
+				continue
+			}
+			lines = append(lines, diffLine{Kind: '+', Text: l})
+			shown++
+		}
+	}
+
+	return lines, cut
+}
+
+func docLines(data []byte) []string {
+	data = checks.StripUTF8BOM(data)
+	if len(data) == 0 {
+		return nil
+	}
+
+	s := strings.TrimSuffix(string(data), "\n")
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSuffix(l, "\r")
+	}
+
+	return lines
+}
@@ -0,0 +1,126 @@
+package report_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/report"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func fixedSummary() validator.Summary {
+	sum := sampleSummary()
+	sum.Fail, sum.Warn, sum.Pass = 2, 1, 1
+	sum.AppliedFixes = true
+	sum.FinalData = []byte("term;description\ncloud;a | b\nsync;x\n")
+	sum.Outcomes = append(sum.Outcomes, checks.CheckOutcome{
+		Result: checks.CheckResult{Name: "warn-double-spaces", Status: checks.Warn, Message: "double <spaces>"},
+		Final:  checks.FixResult{DidChange: true, Note: "collapsed 1 double space"},
+	})
+	return sum
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := report.WriteMarkdown(&buf, fixedSummary(), report.DocumentOptions{
+		Original: []byte("term;description\r\ncloud;a  | b\r\nsync;x\r\n"),
+	})
+	if err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		"## Glossary validation report\n",
+		"**File:** `terms.csv`  \n**Result:** 2 FAIL, 1 WARN, 1 PASS\n",
+		"> Fixes were applied.\n",
+		"| ✅ PASS | `ensure-valid-extension` | ok |  |\n",
+		"| ❌ FAIL | `ensure-no-empty-term-values` |  |  |\n",
+		"| ⚠️ WARN | `nested / warn-trailing-term-punctuation` |  |  |\n",
+		"| ⚠️ WARN | `warn-double-spaces` | double &lt;spaces> | collapsed 1 double space |\n",
+		"<details><summary>ensure-no-empty-term-values: 1 finding</summary>\n\n- row 3, term: empty term\n",
+		"- row 5, term \"=SUM(A1)\": trailing punctuation\n",
+		"### Changes\n\n```diff\n@@ -2,1 +2,1 @@\n-cloud;a  | b\n+cloud;a | b\n```\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("report misses %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestWriteMarkdown_DiffLimitAndLineBreaksOnly(t *testing.T) {
+	t.Parallel()
+
+	sum := validator.Summary{AppliedFixes: true, FinalData: []byte("a\nB\nC\nD\ne\n")}
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf, sum, report.DocumentOptions{Original: []byte("a\nb\nc\nd\ne\n"), MaxDiffLines: 2}); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "@@ -2,3 +2,3 @@\n-b\n-c\n```\n\n… 4 more changed lines\n") {
+		t.Fatalf("unexpected excerpt:\n%s", got)
+	}
+
+	buf.Reset()
+	sum.FinalData = []byte("a\r\nb\r\n")
+	if err := report.WriteMarkdown(&buf, sum, report.DocumentOptions{Original: []byte("\ufeffa\nb")}); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "Only line breaks, the byte order mark or the final newline changed.") {
+		t.Fatalf("expected a line-break-only note:\n%s", got)
+	}
+	if !strings.Contains(buf.String(), "**Result:** no checks ran") {
+		t.Fatalf("unexpected totals:\n%s", buf.String())
+	}
+}
+
+func TestWriteMarkdown_FindingsCap(t *testing.T) {
+	t.Parallel()
+
+	findings := make([]checks.Finding, 12)
+	for i := range findings {
+		findings[i] = checks.Finding{Row: i + 2, Message: "bad"}
+	}
+	sum := validator.Summary{Fail: 1, Outcomes: []checks.CheckOutcome{{Result: checks.CheckResult{Name: "c", Status: checks.Fail, Findings: findings}}}}
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf, sum, report.DocumentOptions{}); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	got := buf.String()
+	if !strings.Contains(got, "c: 12 findings") || !strings.Contains(got, "- row 11: bad\n- … and 2 more\n") || strings.Contains(got, "row 12") {
+		t.Fatalf("unexpected findings list:\n%s", got)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := report.WriteHTML(&buf, fixedSummary(), report.DocumentOptions{
+		Title:    "Report <1>",
+		Original: []byte("term;description\ncloud;a  | b\nsync;x\n"),
+	})
+	if err != nil {
+		t.Fatalf("WriteHTML: %v", err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		"<title>Report &lt;1&gt;</title>",
+		"<p><strong>Result:</strong> 2 FAIL, 1 WARN, 1 PASS</p>",
+		`<tr class="WARN"><td>⚠️ WARN</td><td><code>warn-double-spaces</code></td><td>double &lt;spaces&gt;</td><td>collapsed 1 double space</td></tr>`,
+		"<summary>ensure-no-empty-term-values: 1 finding</summary>",
+		"<li>row 5, term &#34;=SUM(A1)&#34;: trailing punctuation</li>",
+		`<span class="del">-cloud;a  | b</span>`,
+		`<span class="add">+cloud;a | b</span>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("report misses %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"html/template"
+	"io"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// WriteHTML writes the report WriteMarkdown does as a self-contained HTML page
+// (inline styles, no scripts). Every value from the summary is escaped.
+func WriteHTML(w io.Writer, sum validator.Summary, opts DocumentOptions) error {
+	return htmlTemplate.Execute(w, buildDocument(sum, opts))
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"plural": plural,
+	"kind":   func(b byte) string { return string(b) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+.FAIL, .ERROR { color: #b00020; }
+.WARN { color: #9a6700; }
+pre .del { background: #ffebe9; }
+pre .add { background: #e6ffec; }
+pre .hunk { color: #6e7781; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- if .File}}
+<p><strong>File:</strong> <code>{{.File}}</code></p>
+{{- end}}
+<p><strong>Result:</strong> {{.Totals}}</p>
+{{- range .Notes}}
+<blockquote>{{.}}</blockquote>
+{{- end}}
+{{- if .Checks}}
+<table>
+<thead><tr><th>Status</th><th>Check</th><th>Message</th><th>Fix</th></tr></thead>
+<tbody>
+{{- range .Checks}}
+<tr class="{{.Status}}"><td>{{.Icon}} {{.Status}}</td><td><code>{{.Name}}</code></td><td>{{.Message}}</td><td>{{.Fix}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- range .Checks}}{{if .Findings}}
+<details><summary>{{.Name}}: {{plural .Total "finding"}}</summary>
+<ul>
+{{- range .Findings}}
+<li>{{.}}</li>
+{{- end}}
+{{- if .More}}
+<li>… and {{.More}} more</li>
+{{- end}}
+</ul>
+</details>
+{{- end}}{{end}}
+{{- end}}
+{{- if .DiffNil}}
+<h2>Changes</h2>
+<p>Only line breaks, the byte order mark or the final newline changed.</p>
+{{- else if .Diff}}
+<h2>Changes</h2>
+<pre>
+{{- range .Diff}}
+{{if eq (kind .Kind) "@"}}<span class="hunk">{{.Text}}</span>{{else if eq (kind .Kind) "-"}}<span class="del">-{{.Text}}</span>{{else}}<span class="add">+{{.Text}}</span>{{end}}
+{{- end}}
+</pre>
+{{- if .DiffCut}}
+<p>… {{plural .DiffCut "more changed line"}}</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,116 @@
+package report
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// WriteMarkdown writes a GitHub-flavored Markdown report of sum, suitable for a pull
+// request comment: totals, a table with every check's status, message and fix note,
+// collapsible findings per check, and a diff excerpt of what fixes changed
+// (see DocumentOptions.Original).
+func WriteMarkdown(w io.Writer, sum validator.Summary, opts DocumentOptions) error {
+	doc := buildDocument(sum, opts)
+
+	var b strings.Builder
+
+	b.WriteString("## " + mdInline(doc.Title) + "\n\n")
+	if doc.File != "" {
+		b.WriteString("**File:** " + mdCode(doc.File) + "  \n")
+	}
+	b.WriteString("**Result:** " + doc.Totals + "\n")
+	for _, n := range doc.Notes {
+		b.WriteString("\n> " + mdInline(n) + "\n")
+	}
+
+	if len(doc.Checks) > 0 {
+		b.WriteString("\n| Status | Check | Message | Fix |\n|---|---|---|---|\n")
+		for _, c := range doc.Checks {
+			b.WriteString("| " + c.Icon + " " + string(c.Status) + " | " + mdCode(c.Name) + " | " + mdCell(c.Message) + " | " + mdCell(c.Fix) + " |\n")
+		}
+
+		for _, c := range doc.Checks {
+			if len(c.Findings) == 0 {
+				continue
+			}
+
+			b.WriteString("\n<details><summary>" + mdInline(c.Name) + ": " + plural(c.Total, "finding") + "</summary>\n\n")
+			for _, f := range c.Findings {
+				b.WriteString("- " + mdInline(f) + "\n")
+			}
+			if c.More > 0 {
+				b.WriteString("- … and " + strconv.Itoa(c.More) + " more\n")
+			}
+			b.WriteString("\n</details>\n")
+		}
+	}
+
+	switch {
+	case doc.DiffNil:
+		b.WriteString("\n### Changes\n\nOnly line breaks, the byte order mark or the final newline changed.\n")
+	case len(doc.Diff) > 0:
+		texts := make([]string, len(doc.Diff))
+		for i, l := range doc.Diff {
+			if l.Kind == '@' {
+				texts[i] = l.Text
+			} else {
+				texts[i] = string(l.Kind) + l.Text
+			}
+		}
+		body := strings.Join(texts, "\n")
+
+		fence := "```"
+		for strings.Contains(body, fence) {
+			fence += "`"
+		}
+
+		b.WriteString("\n### Changes\n\n" + fence + "diff\n" + body + "\n" + fence + "\n")
+		if doc.DiffCut > 0 {
+			b.WriteString("\n… " + plural(doc.DiffCut, "more changed line") + "\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+
+	return strconv.Itoa(n) + " " + noun + "s"
+}
+
+// mdInline keeps text on one line and stops it from being read as HTML.
+func mdInline(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	s = strings.ReplaceAll(s, "\r\n", " ")
+
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// mdCell is mdInline for a table cell, where "|" ends the cell.
+func mdCell(s string) string {
+	return strings.ReplaceAll(mdInline(s), "|", `\|`)
+}
+
+// mdCode wraps s in a code span long enough for the backticks it contains.
+// Pipes are escaped: GFM tables split cells on them even inside code spans.
+func mdCode(s string) string {
+	s = strings.NewReplacer("\r\n", " ", "\n", " ", "|", `\|`).Replace(s)
+
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		return fence + " " + s + " " + fence
+	}
+
+	return fence + s + fence
+}
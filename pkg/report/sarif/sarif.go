@@ -0,0 +1,388 @@
+// Package sarif converts validation summaries to SARIF 2.1.0, the format GitHub code
+// scanning and most IDEs import, so glossary findings show up next to code findings.
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// Version and Schema identify the SARIF format written by Convert.
+const (
+	Version = "2.1.0"
+	Schema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// Default tool identity reported as the run's driver.
+const (
+	DefaultToolName = "lokalise-glossary-guard"
+	toolURI         = "https://github.com/bodrovis/lokalise-glossary-guard-core"
+)
+
+// SARIF levels.
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+	LevelNone    = "none"
+)
+
+// Log is a SARIF log with a single run.
+type Log struct {
+	Version string `json:"version"`
+	Schema  string `json:"$schema"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run is the output of one tool invocation.
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+// Tool describes the analysis tool.
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver is the tool component that ran the checks, with one rule per check.
+type Driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version,omitempty"`
+	InformationURI string `json:"informationUri"`
+	Rules          []Rule `json:"rules"`
+}
+
+// Rule describes one check.
+type Rule struct {
+	ID                   string        `json:"id"`
+	Name                 string        `json:"name"`
+	ShortDescription     Message       `json:"shortDescription"`
+	Help                 Message       `json:"help"`
+	DefaultConfiguration Configuration `json:"defaultConfiguration"`
+}
+
+// Configuration holds the level a rule reports at.
+type Configuration struct {
+	Level string `json:"level"`
+}
+
+// Message is a plain-text SARIF message.
+type Message struct {
+	Text string `json:"text"`
+}
+
+// Result is one finding.
+type Result struct {
+	RuleID     string         `json:"ruleId"`
+	RuleIndex  int            `json:"ruleIndex"`
+	Level      string         `json:"level"`
+	Message    Message        `json:"message"`
+	Locations  []Location     `json:"locations"`
+	Properties map[string]any `json:"properties,omitempty"`
+}
+
+// Location points at the glossary file and, for row findings, a line in it.
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+// PhysicalLocation is a file and an optional region.
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           *Region          `json:"region,omitempty"`
+}
+
+// ArtifactLocation is the URI of the file, relative to the repository root for GitHub.
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// Region is the 1-based line a finding starts on.
+type Region struct {
+	StartLine int `json:"startLine"`
+}
+
+// Options tune Convert. The zero value reports findings against Summary.FilePath.
+type Options struct {
+	// URI is the file path results point at; empty uses Summary.FilePath.
+	// Backslashes are turned into slashes.
+	URI string
+
+	// Source is the validated input. When set, row numbers of findings are mapped to
+	// the physical lines their records start on (quoted cells may span lines);
+	// otherwise the row number is used as the line.
+	Source []byte
+
+	// ToolName and ToolVersion identify the driver; ToolName defaults to DefaultToolName.
+	ToolName    string
+	ToolVersion string
+}
+
+// Convert builds the SARIF log of sum. Every check that ran (nested outcomes included)
+// becomes a rule, in execution order: its level is the worst status it reported and its
+// help tells whether a fix exists. Every WARN, FAIL, ERROR and INFO finding becomes a
+// result; a non-passing check without findings becomes one file-level result.
+func Convert(sum validator.Summary, opts Options) Log {
+	c := converter{
+		uri:   opts.URI,
+		rules: make(map[string]int),
+	}
+	if c.uri == "" {
+		c.uri = sum.FilePath
+	}
+	c.uri = strings.ReplaceAll(c.uri, `\`, "/")
+	if opts.Source != nil {
+		c.lines = recordLines(opts.Source)
+	}
+
+	driver := Driver{
+		Name:           opts.ToolName,
+		Version:        opts.ToolVersion,
+		InformationURI: toolURI,
+	}
+	if driver.Name == "" {
+		driver.Name = DefaultToolName
+	}
+
+	for _, o := range sum.Outcomes {
+		c.addOutcome(o)
+	}
+
+	driver.Rules = c.ruleList
+	if driver.Rules == nil {
+		driver.Rules = []Rule{}
+	}
+	results := c.results
+	if results == nil {
+		results = []Result{}
+	}
+
+	return Log{
+		Version: Version,
+		Schema:  Schema,
+		Runs:    []Run{{Tool: Tool{Driver: driver}, Results: results}},
+	}
+}
+
+// Write writes the SARIF log of sum as indented JSON.
+func Write(w io.Writer, sum validator.Summary, opts Options) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(Convert(sum, opts))
+}
+
+type converter struct {
+	uri      string
+	lines    []int // lines[i] is the physical line of record i+1
+	rules    map[string]int
+	ruleList []Rule
+	results  []Result
+}
+
+func (c *converter) addOutcome(o checks.CheckOutcome) {
+	res := o.Result
+	idx := c.rule(o)
+
+	switch {
+	case len(res.Findings) > 0:
+		for _, f := range res.Findings {
+			c.addFinding(res, idx, f)
+		}
+	case reported(res.Status) && len(o.Children) == 0:
+		c.results = append(c.results, Result{
+			RuleID:    res.Name,
+			RuleIndex: idx,
+			Level:     level(res.Status),
+			Message:   Message{Text: res.Message},
+			Locations: []Location{c.location(0)},
+		})
+	}
+
+	for _, child := range o.Children {
+		c.addOutcome(child)
+	}
+}
+
+func (c *converter) addFinding(res checks.CheckResult, parent int, f checks.Finding) {
+	ruleID, idx := res.Name, parent
+	if f.Check != "" && f.Check != res.Name {
+		ruleID, idx = f.Check, c.ruleNamed(f.Check, res.Status)
+	}
+
+	severity := f.Severity
+	if severity == "" {
+		severity = res.Status
+	}
+
+	text := f.Message
+	if text == "" {
+		text = res.Message
+	}
+
+	props := make(map[string]any)
+	if f.Code != "" {
+		props["code"] = f.Code
+	}
+	if f.Row > 0 {
+		props["row"] = f.Row
+	}
+	if f.Field > 0 {
+		props["field"] = f.Field
+	}
+	if f.Column != "" {
+		props["column"] = f.Column
+	}
+	if f.Value != "" {
+		props["value"] = f.Value
+	}
+	if f.Suggestion != "" {
+		props["suggestion"] = f.Suggestion
+	}
+	if len(props) == 0 {
+		props = nil
+	}
+
+	c.results = append(c.results, Result{
+		RuleID:     ruleID,
+		RuleIndex:  idx,
+		Level:      level(severity),
+		Message:    Message{Text: text},
+		Locations:  []Location{c.location(f.Row)},
+		Properties: props,
+	})
+}
+
+// rule returns the index of the rule for o, adding it on first sight. A rule seen again
+// keeps the worst level it reported.
+func (c *converter) rule(o checks.CheckOutcome) int {
+	idx := c.ruleNamed(o.Result.Name, o.Result.Status)
+	if o.Final.DidChange && o.Final.Note != "" {
+		c.ruleList[idx].Help.Text = "Fixed automatically: " + o.Final.Note + "."
+	}
+
+	return idx
+}
+
+func (c *converter) ruleNamed(name string, st checks.Status) int {
+	if idx, ok := c.rules[name]; ok {
+		if rank(level(st)) > rank(c.ruleList[idx].DefaultConfiguration.Level) {
+			c.ruleList[idx].DefaultConfiguration.Level = level(st)
+		}
+		return idx
+	}
+
+	idx := len(c.ruleList)
+	c.rules[name] = idx
+	c.ruleList = append(c.ruleList, Rule{
+		ID:                   name,
+		Name:                 ruleName(name),
+		ShortDescription:     Message{Text: "Glossary check " + name},
+		Help:                 Message{Text: fixNote(name)},
+		DefaultConfiguration: Configuration{Level: level(st)},
+	})
+
+	return idx
+}
+
+// fixNote tells whether the registered check name has an auto-fix.
+func fixNote(name string) string {
+	u, ok := checks.Lookup(name)
+	if !ok {
+		return "Unknown check; no fix information."
+	}
+
+	caps := checks.CapabilitiesOf(u)
+	switch {
+	case caps.Destructive:
+		return "Auto-fix available; it restructures the file and only runs when destructive fixes are allowed."
+	case caps.Lossy:
+		return "Auto-fix available; it removes cell contents (e.g. duplicates) by design."
+	case caps.SupportsFix:
+		return "Auto-fix available."
+	case caps.Declared:
+		return "No auto-fix; edit the file by hand."
+	default:
+		return "No fix information."
+	}
+}
+
+func (c *converter) location(row int) Location {
+	loc := Location{PhysicalLocation: PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: c.uri}}}
+	if row <= 0 {
+		return loc
+	}
+
+	line := row
+	if row <= len(c.lines) {
+		line = c.lines[row-1]
+	}
+	loc.PhysicalLocation.Region = &Region{StartLine: line}
+
+	return loc
+}
+
+// recordLines maps CSV record numbers of data to physical lines. Empty lines are not
+// records to the CSV reader, so record numbers are counted the way checks count them.
+func recordLines(data []byte) []int {
+	r := checks.NewSemicolonCSVReader(checks.StripUTF8BOM(data))
+
+	var lines []int
+	for {
+		if _, err := r.Read(); err != nil {
+			return lines
+		}
+		line, _ := r.FieldPos(0)
+		lines = append(lines, line)
+	}
+}
+
+func reported(st checks.Status) bool {
+	return st != checks.Pass && st != checks.Skipped
+}
+
+func level(st checks.Status) string {
+	switch st {
+	case checks.Fail, checks.Error:
+		return LevelError
+	case checks.Warn:
+		return LevelWarning
+	case checks.Info:
+		return LevelNote
+	default:
+		return LevelNone
+	}
+}
+
+func rank(level string) int {
+	switch level {
+	case LevelError:
+		return 3
+	case LevelWarning:
+		return 2
+	case LevelNote:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ruleName turns "warn-duplicate-term-values" into the PascalCase name SARIF viewers
+// expect ("WarnDuplicateTermValues").
+func ruleName(id string) string {
+	var b strings.Builder
+	for part := range strings.FieldsFuncSeq(id, func(r rune) bool { return r == '-' || r == '_' || r == ' ' }) {
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	if b.Len() == 0 {
+		return "Check"
+	}
+
+	return b.String()
+}
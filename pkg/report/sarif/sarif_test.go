@@ -0,0 +1,173 @@
+package sarif_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/report/sarif"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func register(t *testing.T, name string, opts ...checks.Option) {
+	t.Helper()
+
+	u, err := checks.NewCheckAdapter(name, func(context.Context, checks.Artifact, checks.RunOptions) checks.CheckOutcome {
+		return checks.CheckOutcome{}
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+	if _, err := checks.Register(u); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+}
+
+func sampleSummary() validator.Summary {
+	return validator.Summary{
+		FilePath: `glossary\terms.csv`,
+		Outcomes: []checks.CheckOutcome{
+			{Result: checks.CheckResult{Name: "ensure-valid-extension", Status: checks.Pass, Message: "ok"}},
+			{
+				Result: checks.CheckResult{
+					Name:   "no-empty-term-values",
+					Status: checks.Fail,
+					Findings: []checks.Finding{
+						{Check: "no-empty-term-values", Code: "empty-term", Row: 3, Field: 1, Column: "term", Message: "empty term"},
+					},
+				},
+			},
+			{Result: checks.CheckResult{Name: "ensure-at-least-two-lines", Status: checks.Error, Message: "expected at least two non-empty lines"}},
+			{
+				Result: checks.CheckResult{Name: "nested", Status: checks.Warn, Message: "1 warning"},
+				Children: []checks.CheckOutcome{
+					{Result: checks.CheckResult{
+						Name:     "warn-trailing-term-punctuation",
+						Status:   checks.Warn,
+						Findings: []checks.Finding{{Row: 4, Value: "cloud.", Message: "trailing punctuation", Severity: checks.Info}},
+					}},
+				},
+			},
+			{
+				Result: checks.CheckResult{Name: "ensure-lf-line-breaks", Status: checks.Warn, Message: "converted"},
+				Final:  checks.FixResult{DidChange: true, Note: "CRLF converted to LF"},
+			},
+		},
+	}
+}
+
+func TestConvert(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	register(t, "no-empty-term-values", checks.WithScope(checks.ScopeRows))
+	register(t, "ensure-at-least-two-lines", checks.WithLossyFix())
+
+	source := []byte("term;description\nmulti;\"line\ndescription\"\n;empty\ncloud.;x\n")
+	log := sarif.Convert(sampleSummary(), sarif.Options{Source: source, ToolVersion: "1.2.3"})
+
+	if log.Version != sarif.Version || len(log.Runs) != 1 {
+		t.Fatalf("unexpected log header: %+v", log)
+	}
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != sarif.DefaultToolName || run.Tool.Driver.Version != "1.2.3" {
+		t.Fatalf("unexpected driver: %+v", run.Tool.Driver)
+	}
+
+	wantRules := []struct{ id, name, level, help string }{
+		{"ensure-valid-extension", "EnsureValidExtension", sarif.LevelNone, "Unknown check; no fix information."},
+		{"no-empty-term-values", "NoEmptyTermValues", sarif.LevelError, "No auto-fix; edit the file by hand."},
+		{"ensure-at-least-two-lines", "EnsureAtLeastTwoLines", sarif.LevelError, "Auto-fix available; it removes cell contents (e.g. duplicates) by design."},
+		{"nested", "Nested", sarif.LevelWarning, "Unknown check; no fix information."},
+		{"warn-trailing-term-punctuation", "WarnTrailingTermPunctuation", sarif.LevelWarning, "Unknown check; no fix information."},
+		{"ensure-lf-line-breaks", "EnsureLfLineBreaks", sarif.LevelWarning, "Fixed automatically: CRLF converted to LF."},
+	}
+	if len(run.Tool.Driver.Rules) != len(wantRules) {
+		t.Fatalf("got %d rules, want %d: %+v", len(run.Tool.Driver.Rules), len(wantRules), run.Tool.Driver.Rules)
+	}
+	for i, want := range wantRules {
+		r := run.Tool.Driver.Rules[i]
+		if r.ID != want.id || r.Name != want.name || r.DefaultConfiguration.Level != want.level || r.Help.Text != want.help {
+			t.Fatalf("rule %d = %+v, want %+v", i, r, want)
+		}
+	}
+
+	wantResults := []struct {
+		rule  string
+		index int
+		level string
+		text  string
+		line  int
+	}{
+		{"no-empty-term-values", 1, sarif.LevelError, "empty term", 4},
+		{"ensure-at-least-two-lines", 2, sarif.LevelError, "expected at least two non-empty lines", 0},
+		{"warn-trailing-term-punctuation", 4, sarif.LevelNote, "trailing punctuation", 5},
+		{"ensure-lf-line-breaks", 5, sarif.LevelWarning, "converted", 0},
+	}
+	if len(run.Results) != len(wantResults) {
+		t.Fatalf("got %d results, want %d: %+v", len(run.Results), len(wantResults), run.Results)
+	}
+	for i, want := range wantResults {
+		r := run.Results[i]
+		if r.RuleID != want.rule || r.RuleIndex != want.index || r.Level != want.level || r.Message.Text != want.text {
+			t.Fatalf("result %d = %+v, want %+v", i, r, want)
+		}
+
+		loc := r.Locations[0].PhysicalLocation
+		if loc.ArtifactLocation.URI != "glossary/terms.csv" {
+			t.Fatalf("result %d uri = %q", i, loc.ArtifactLocation.URI)
+		}
+		line := 0
+		if loc.Region != nil {
+			line = loc.Region.StartLine
+		}
+		if line != want.line {
+			t.Fatalf("result %d line = %d, want %d", i, line, want.line)
+		}
+	}
+
+	props := run.Results[0].Properties
+	if props["code"] != "empty-term" || props["row"] != 3 || props["field"] != 1 || props["column"] != "term" {
+		t.Fatalf("unexpected properties: %+v", props)
+	}
+}
+
+func TestConvert_RowsAsLinesWithoutSource(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	log := sarif.Convert(sampleSummary(), sarif.Options{URI: "terms.csv", ToolName: "guard"})
+	run := log.Runs[0]
+
+	if run.Tool.Driver.Name != "guard" {
+		t.Fatalf("driver name = %q", run.Tool.Driver.Name)
+	}
+	if got := run.Results[0].Locations[0].PhysicalLocation; got.ArtifactLocation.URI != "terms.csv" || got.Region.StartLine != 3 {
+		t.Fatalf("unexpected location: %+v", got)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	var buf bytes.Buffer
+	if err := sarif.Write(&buf, validator.Summary{FilePath: "terms.csv"}, sarif.Options{}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not JSON: %v", err)
+	}
+	if doc["version"] != "2.1.0" || doc["$schema"] != sarif.Schema {
+		t.Fatalf("unexpected header: %v", doc)
+	}
+
+	run := doc["runs"].([]any)[0].(map[string]any)
+	if results, ok := run["results"].([]any); !ok || len(results) != 0 {
+		t.Fatalf("a clean run must have an empty results array, got %v", run["results"])
+	}
+}
@@ -0,0 +1,205 @@
+// Package storage reads glossary files from object storage URLs (s3://, gs://, ...)
+// and writes fixed data back, without pulling any cloud SDK into the core.
+//
+// Callers wrap their SDK client in the two-method ObjectStore interface
+// (or Funcs) and register it for a URL scheme.
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// ObjectStore is the minimal object storage surface the adapters need.
+type ObjectStore interface {
+	Get(ctx context.Context, bucket, key string) ([]byte, error)
+	Put(ctx context.Context, bucket, key string, data []byte) error
+}
+
+// Funcs adapts a pair of functions to ObjectStore.
+type Funcs struct {
+	GetFunc func(ctx context.Context, bucket, key string) ([]byte, error)
+	PutFunc func(ctx context.Context, bucket, key string, data []byte) error
+}
+
+// Get calls GetFunc.
+func (f Funcs) Get(ctx context.Context, bucket, key string) ([]byte, error) {
+	if f.GetFunc == nil {
+		return nil, errors.ErrUnsupported
+	}
+
+	return f.GetFunc(ctx, bucket, key)
+}
+
+// Put calls PutFunc.
+func (f Funcs) Put(ctx context.Context, bucket, key string, data []byte) error {
+	if f.PutFunc == nil {
+		return errors.ErrUnsupported
+	}
+
+	return f.PutFunc(ctx, bucket, key, data)
+}
+
+// ErrUnknownScheme is returned for URLs whose scheme has no registered store.
+var ErrUnknownScheme = errors.New("storage: no store registered for scheme")
+
+// Location is a parsed object URL: scheme://bucket/key.
+type Location struct {
+	Scheme string
+	Bucket string
+	Key    string
+}
+
+// String formats the location back into a URL.
+func (l Location) String() string {
+	return l.Scheme + "://" + l.Bucket + "/" + l.Key
+}
+
+// ParseURL splits an object URL into scheme (lowercased), bucket and key.
+// Both bucket and key are required.
+func ParseURL(raw string) (Location, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return Location{}, fmt.Errorf("storage: parse %q: %w", raw, err)
+	}
+
+	loc := Location{
+		Scheme: strings.ToLower(u.Scheme),
+		Bucket: u.Host,
+		Key:    strings.TrimPrefix(u.Path, "/"),
+	}
+	if loc.Scheme == "" || loc.Bucket == "" || loc.Key == "" {
+		return Location{}, fmt.Errorf("storage: %q is not a scheme://bucket/key URL", raw)
+	}
+
+	return loc, nil
+}
+
+// Stores maps URL schemes to object stores. The zero value is ready to use
+// and safe for concurrent use.
+type Stores struct {
+	mu     sync.RWMutex
+	stores map[string]ObjectStore
+}
+
+// Register makes s serve URLs with the given scheme ("s3", "gs"), replacing any previous store.
+func (s *Stores) Register(scheme string, store ObjectStore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stores == nil {
+		s.stores = make(map[string]ObjectStore)
+	}
+	s.stores[strings.ToLower(scheme)] = store
+}
+
+func (s *Stores) lookup(loc Location) (ObjectStore, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	store, ok := s.stores[loc.Scheme]
+	if !ok || store == nil {
+		return nil, fmt.Errorf("%w %q", ErrUnknownScheme, loc.Scheme)
+	}
+
+	return store, nil
+}
+
+// Load reads the object at rawURL into an artifact. Artifact.Path is the object key,
+// so extension-based checks see the real file name.
+func (s *Stores) Load(ctx context.Context, rawURL string, langs []string) (checks.Artifact, error) {
+	loc, err := ParseURL(rawURL)
+	if err != nil {
+		return checks.Artifact{}, err
+	}
+
+	store, err := s.lookup(loc)
+	if err != nil {
+		return checks.Artifact{}, err
+	}
+
+	data, err := store.Get(ctx, loc.Bucket, loc.Key)
+	if err != nil {
+		return checks.Artifact{}, fmt.Errorf("storage: get %s: %w", loc, err)
+	}
+
+	return checks.Artifact{
+		Data:  data,
+		Path:  loc.Key,
+		Langs: langs,
+	}, nil
+}
+
+// Store writes sum.FinalData next to rawURL: to the same bucket, under sum.FinalPath
+// (the key, possibly renamed by a fix) or the original key when FinalPath is empty.
+// The original object is never deleted. It returns the URL that was written.
+func (s *Stores) Store(ctx context.Context, rawURL string, sum validator.Summary) (string, error) {
+	loc, err := ParseURL(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	store, err := s.lookup(loc)
+	if err != nil {
+		return "", err
+	}
+
+	if sum.FinalPath != "" {
+		loc.Key = strings.TrimPrefix(sum.FinalPath, "/")
+	}
+
+	if err := store.Put(ctx, loc.Bucket, loc.Key, sum.FinalData); err != nil {
+		return "", fmt.Errorf("storage: put %s: %w", loc, err)
+	}
+
+	return loc.String(), nil
+}
+
+// Result is the outcome of Validate.
+type Result struct {
+	Summary validator.Summary
+
+	// WrittenURL is the object written with fixed data; empty when nothing was written.
+	WrittenURL string
+}
+
+// Validate loads rawURL, runs validator.Validate on it and, when fixes changed the data
+// or the key and the run finished without error, writes FinalData back with Store.
+// The run error (a *validator.RunError) is returned as-is along with the summary.
+func (s *Stores) Validate(
+	ctx context.Context,
+	rawURL string,
+	langs []string,
+	opts checks.RunOptions,
+) (Result, error) {
+	a, err := s.Load(ctx, rawURL, langs)
+	if err != nil {
+		return Result{}, err
+	}
+
+	sum, err := validator.Validate(ctx, a.Path, a.Data, a.Langs, opts)
+	res := Result{Summary: sum}
+	if err != nil {
+		return res, err
+	}
+
+	if !sum.AppliedFixes || (sum.FinalPath == a.Path && bytes.Equal(sum.FinalData, a.Data)) {
+		return res, nil
+	}
+
+	written, err := s.Store(ctx, rawURL, sum)
+	if err != nil {
+		return res, err
+	}
+	res.WrittenURL = written
+
+	return res, nil
+}
@@ -0,0 +1,166 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/storage"
+)
+
+type memStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	puts    int
+}
+
+func newMemStore() *memStore {
+	return &memStore{objects: make(map[string][]byte)}
+}
+
+func (m *memStore) Get(_ context.Context, bucket, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.objects[bucket+"/"+key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return data, nil
+}
+
+func (m *memStore) Put(_ context.Context, bucket, key string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.objects[bucket+"/"+key] = data
+	m.puts++
+	return nil
+}
+
+func TestParseURL(t *testing.T) {
+	t.Parallel()
+
+	loc, err := storage.ParseURL("S3://my-bucket/path/to/gloss.csv")
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if loc.Scheme != "s3" || loc.Bucket != "my-bucket" || loc.Key != "path/to/gloss.csv" {
+		t.Fatalf("unexpected location: %+v", loc)
+	}
+	if loc.String() != "s3://my-bucket/path/to/gloss.csv" {
+		t.Fatalf("String() = %q", loc.String())
+	}
+
+	for _, raw := range []string{"", "gloss.csv", "s3://bucket", "s3:///key", "://x/y"} {
+		if _, err := storage.ParseURL(raw); err == nil {
+			t.Fatalf("expected error for %q", raw)
+		}
+	}
+}
+
+func TestStores_LoadAndUnknownScheme(t *testing.T) {
+	t.Parallel()
+
+	mem := newMemStore()
+	mem.objects["b/dir/gloss.csv"] = []byte("term\nx\n")
+
+	var s storage.Stores
+	s.Register("GS", mem)
+
+	a, err := s.Load(context.Background(), "gs://b/dir/gloss.csv", []string{"en"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if string(a.Data) != "term\nx\n" || a.Path != "dir/gloss.csv" || len(a.Langs) != 1 {
+		t.Fatalf("unexpected artifact: %+v", a)
+	}
+
+	if _, err := s.Load(context.Background(), "s3://b/dir/gloss.csv", nil); !errors.Is(err, storage.ErrUnknownScheme) {
+		t.Fatalf("expected ErrUnknownScheme, got %v", err)
+	}
+	if _, err := s.Load(context.Background(), "gs://b/missing.csv", nil); err == nil {
+		t.Fatalf("expected error for a missing object")
+	}
+}
+
+func TestFuncs_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	var f storage.Funcs
+	if _, err := f.Get(context.Background(), "b", "k"); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+	if err := f.Put(context.Background(), "b", "k", nil); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+}
+
+func registerReplaceCheck(t *testing.T) {
+	t.Helper()
+
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	ch, err := checks.NewCheckAdapter("replace-bad", func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+		return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+			Name: "replace-bad",
+			Validate: func(_ context.Context, a checks.Artifact) checks.ValidationResult {
+				if bytes.Contains(a.Data, []byte("bad")) {
+					return checks.ValidationResult{OK: false, Msg: "contains bad"}
+				}
+				return checks.ValidationResult{OK: true, Msg: "ok"}
+			},
+			Fix: func(_ context.Context, a checks.Artifact) (checks.FixResult, error) {
+				return checks.FixResult{
+					Data:      bytes.ReplaceAll(a.Data, []byte("bad"), []byte("good")),
+					DidChange: true,
+				}, nil
+			},
+			PassMsg:          "ok",
+			FixedMsg:         "fixed",
+			StatusAfterFixed: checks.Pass,
+		})
+	}, checks.WithPriority(1))
+	if err != nil {
+		t.Fatalf("new check: %v", err)
+	}
+	if _, err := checks.Register(ch); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+}
+
+func TestStores_ValidateWritesBackFixedData(t *testing.T) {
+	registerReplaceCheck(t)
+
+	mem := newMemStore()
+	mem.objects["b/gloss.csv"] = []byte("term\nbad\n")
+	mem.objects["b/clean.csv"] = []byte("term\nfine\n")
+
+	var s storage.Stores
+	s.Register("s3", storage.Funcs{GetFunc: mem.Get, PutFunc: mem.Put})
+
+	opts := checks.RunOptions{FixMode: checks.FixIfFailed, RerunAfterFix: true}
+
+	res, err := s.Validate(context.Background(), "s3://b/gloss.csv", nil, opts)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if res.WrittenURL != "s3://b/gloss.csv" {
+		t.Fatalf("WrittenURL = %q", res.WrittenURL)
+	}
+	if got := string(mem.objects["b/gloss.csv"]); got != "term\ngood\n" {
+		t.Fatalf("object not updated: %q", got)
+	}
+
+	res, err = s.Validate(context.Background(), "s3://b/clean.csv", nil, opts)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if res.WrittenURL != "" || mem.puts != 1 {
+		t.Fatalf("clean object must not be written: %+v puts=%d", res, mem.puts)
+	}
+}
@@ -0,0 +1,318 @@
+// Package termgraph links glossary terms that share tags or translations, for
+// terminology reviews of large glossaries: clusters of linked terms are often one
+// concept entered several times, or a family of terms that should be kept consistent.
+// Graphs export as JSON or Graphviz DOT.
+package termgraph
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrNoTermColumn means the glossary header has no "term" column.
+var ErrNoTermColumn = errors.New("termgraph: no term column in header")
+
+// DefaultMaxGroup is the group size Build uses when Options.MaxGroup is zero.
+const DefaultMaxGroup = 50
+
+const ctxCheckEvery = 1 << 10
+
+// EdgeKind says why two terms are linked.
+type EdgeKind string
+
+const (
+	KindTag         EdgeKind = "tag"         // both rows carry the same tag
+	KindTranslation EdgeKind = "translation" // both rows have the same value in a locale column
+)
+
+// Node is one glossary term.
+type Node struct {
+	ID   int      `json:"id"` // 1-based CSV record number of the term (header included)
+	Term string   `json:"term"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Edge links two terms by one kind of relationship. From < To.
+type Edge struct {
+	From int      `json:"from"`
+	To   int      `json:"to"`
+	Kind EdgeKind `json:"kind"`
+	// Labels are what the terms share: tag names, or "locale:value" for translations.
+	Labels []string `json:"labels"`
+}
+
+// Graph is the term graph of one glossary. Nodes are in glossary order;
+// edges are sorted by From, To and Kind.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// Options tune Build. The zero value links by tags and translations.
+type Options struct {
+	// SkipTags and SkipTranslations leave out the corresponding edges.
+	SkipTags         bool
+	SkipTranslations bool
+
+	// MaxGroup skips tags or translations shared by more terms than this: a tag on
+	// every term links everything and says nothing. Zero uses DefaultMaxGroup;
+	// a negative value keeps every group.
+	MaxGroup int
+}
+
+func (o Options) maxGroup() int {
+	if o.MaxGroup == 0 {
+		return DefaultMaxGroup
+	}
+
+	return o.MaxGroup
+}
+
+// Build reads the glossary in data (semicolon-separated, with a header) and links terms
+// sharing a tag (comma-separated "tags" column, compared exactly) or a non-blank value in
+// the same locale column (compared after trimming). Description columns are ignored.
+// Rows with a blank term are not part of the graph.
+func Build(ctx context.Context, data []byte, opts Options) (*Graph, error) {
+	g := &Graph{Nodes: []Node{}, Edges: []Edge{}}
+
+	r := checks.NewSemicolonCSVReader(checks.StripUTF8BOM(data))
+
+	var (
+		header           *checks.LocaleIndex
+		termCol, tagsCol = -1, -1
+		groups           = make(map[groupKey][]int)
+		order            []groupKey
+		rowNum           int
+	)
+
+	addToGroup := func(k groupKey, id int) {
+		ids, seen := groups[k]
+		if !seen {
+			order = append(order, k)
+		}
+		if len(ids) > 0 && ids[len(ids)-1] == id {
+			return
+		}
+		groups[k] = append(ids, id)
+	}
+
+	for {
+		if rowNum%ctxCheckEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rowNum++
+
+		if header == nil {
+			if isBlankRecord(rec) {
+				continue
+			}
+			header = checks.BuildLocaleIndex(rec)
+			termCol, tagsCol = column(rec, "term"), column(rec, "tags")
+			if termCol < 0 {
+				return nil, ErrNoTermColumn
+			}
+			continue
+		}
+
+		term := cell(rec, termCol)
+		if term == "" {
+			continue
+		}
+
+		node := Node{ID: rowNum, Term: term, Tags: checks.SplitTags(cell(rec, tagsCol))}
+		g.Nodes = append(g.Nodes, node)
+
+		if !opts.SkipTags {
+			for _, tag := range node.Tags {
+				addToGroup(groupKey{kind: KindTag, label: tag}, node.ID)
+			}
+		}
+		if !opts.SkipTranslations {
+			for _, col := range header.Columns {
+				if col.Description {
+					continue
+				}
+				if v := cell(rec, col.Pos); v != "" {
+					addToGroup(groupKey{kind: KindTranslation, label: col.Label + ":" + v}, node.ID)
+				}
+			}
+		}
+	}
+
+	g.Edges = linkGroups(groups, order, opts.maxGroup())
+
+	return g, nil
+}
+
+type groupKey struct {
+	kind  EdgeKind
+	label string
+}
+
+type edgeKey struct {
+	from, to int
+	kind     EdgeKind
+}
+
+// linkGroups turns every group of 2..maxGroup terms into pairwise edges,
+// merging the labels of pairs linked by several groups of the same kind.
+func linkGroups(groups map[groupKey][]int, order []groupKey, maxGroup int) []Edge {
+	index := make(map[edgeKey]int)
+	edges := []Edge{}
+
+	for _, k := range order {
+		ids := groups[k]
+		if len(ids) < 2 || (maxGroup > 0 && len(ids) > maxGroup) {
+			continue
+		}
+
+		for i, from := range ids {
+			for _, to := range ids[i+1:] {
+				ek := edgeKey{from: from, to: to, kind: k.kind}
+				if at, ok := index[ek]; ok {
+					edges[at].Labels = append(edges[at].Labels, k.label)
+					continue
+				}
+				index[ek] = len(edges)
+				edges = append(edges, Edge{From: from, To: to, Kind: k.kind, Labels: []string{k.label}})
+			}
+		}
+	}
+
+	slices.SortFunc(edges, func(a, b Edge) int {
+		return cmp.Or(cmp.Compare(a.From, b.From), cmp.Compare(a.To, b.To), cmp.Compare(a.Kind, b.Kind))
+	})
+
+	return edges
+}
+
+// Clusters returns the connected groups of linked terms as node IDs, largest first
+// (ties in glossary order). Terms without edges are left out.
+func (g *Graph) Clusters() [][]int {
+	parent := make(map[int]int, len(g.Nodes))
+	var find func(int) int
+	find = func(id int) int {
+		p, ok := parent[id]
+		if !ok || p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+
+	for _, e := range g.Edges {
+		a, b := find(e.From), find(e.To)
+		if a == b {
+			continue
+		}
+		parent[max(a, b)] = min(a, b)
+		parent[min(a, b)] = min(a, b)
+	}
+
+	members := make(map[int][]int)
+	var roots []int
+	for _, n := range g.Nodes {
+		if _, linked := parent[n.ID]; !linked {
+			continue
+		}
+		root := find(n.ID)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], n.ID)
+	}
+
+	out := make([][]int, 0, len(roots))
+	for _, root := range roots {
+		out = append(out, members[root])
+	}
+	slices.SortStableFunc(out, func(a, b []int) int {
+		return cmp.Compare(len(b), len(a))
+	})
+
+	return out
+}
+
+// WriteJSON writes the graph as one JSON object with "nodes" and "edges".
+func (g *Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(g)
+}
+
+// WriteDOT writes the graph in Graphviz DOT as an undirected graph. Nodes are named
+// "n<ID>" and labeled with their term; tag edges are solid, translation edges dashed.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("graph glossary {\n")
+	for _, n := range g.Nodes {
+		b.WriteString("  n" + strconv.Itoa(n.ID) + " [label=" + dotQuote(n.Term) + "];\n")
+	}
+	for _, e := range g.Edges {
+		style := "solid"
+		if e.Kind == KindTranslation {
+			style = "dashed"
+		}
+		b.WriteString("  n" + strconv.Itoa(e.From) + " -- n" + strconv.Itoa(e.To) +
+			" [label=" + dotQuote(string(e.Kind)+": "+strings.Join(e.Labels, ", ")) + ", style=" + style + "];\n")
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote quotes s as a DOT string: only double quotes and backslashes need escaping,
+// and line breaks become "\n" so labels stay on one source line.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(s) + `"`
+}
+
+func column(header []string, name string) int {
+	for i, h := range header {
+		if strings.EqualFold(strings.TrimSpace(h), name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func cell(rec []string, pos int) string {
+	if pos < 0 || pos >= len(rec) {
+		return ""
+	}
+
+	return strings.TrimSpace(rec[pos])
+}
+
+func isBlankRecord(rec []string) bool {
+	for _, f := range rec {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+
+	return true
+}
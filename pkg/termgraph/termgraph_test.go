@@ -0,0 +1,106 @@
+package termgraph_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/termgraph"
+)
+
+const glossary = "term;description;tags;en;fr;fr_description\n" +
+	"cloud;;infra, storage;cloud;nuage;x\n" +
+	"cloud storage;;storage;cloud storage;stockage;x\n" +
+	";blank term;infra;;;\n" +
+	"nimbus;;;nimbus;nuage;\n" +
+	"bucket;;storage,infra;bucket;seau;\n" +
+	"lonely;;;lonely;seul;x\n"
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	g, err := termgraph.Build(context.Background(), []byte(glossary), termgraph.Options{})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if len(g.Nodes) != 5 || g.Nodes[0].ID != 2 || !reflect.DeepEqual(g.Nodes[0].Tags, []string{"infra", "storage"}) {
+		t.Fatalf("unexpected nodes: %+v", g.Nodes)
+	}
+
+	want := []termgraph.Edge{
+		{From: 2, To: 3, Kind: termgraph.KindTag, Labels: []string{"storage"}},
+		{From: 2, To: 5, Kind: termgraph.KindTranslation, Labels: []string{"fr:nuage"}},
+		{From: 2, To: 6, Kind: termgraph.KindTag, Labels: []string{"infra", "storage"}},
+		{From: 3, To: 6, Kind: termgraph.KindTag, Labels: []string{"storage"}},
+	}
+	if !reflect.DeepEqual(g.Edges, want) {
+		t.Fatalf("edges = %+v\nwant %+v", g.Edges, want)
+	}
+
+	if got := g.Clusters(); !reflect.DeepEqual(got, [][]int{{2, 3, 5, 6}}) {
+		t.Fatalf("clusters = %v", got)
+	}
+}
+
+func TestBuild_Options(t *testing.T) {
+	t.Parallel()
+
+	g, err := termgraph.Build(context.Background(), []byte(glossary), termgraph.Options{SkipTranslations: true, MaxGroup: 2})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	// "storage" is shared by three terms, above MaxGroup; "infra" by two.
+	want := []termgraph.Edge{{From: 2, To: 6, Kind: termgraph.KindTag, Labels: []string{"infra"}}}
+	if !reflect.DeepEqual(g.Edges, want) {
+		t.Fatalf("edges = %+v, want %+v", g.Edges, want)
+	}
+}
+
+func TestBuild_NoTermColumn(t *testing.T) {
+	t.Parallel()
+
+	_, err := termgraph.Build(context.Background(), []byte("description;en\nx;y\n"), termgraph.Options{})
+	if !errors.Is(err, termgraph.ErrNoTermColumn) {
+		t.Fatalf("err = %v, want ErrNoTermColumn", err)
+	}
+}
+
+func TestGraph_Export(t *testing.T) {
+	t.Parallel()
+
+	g, err := termgraph.Build(context.Background(), []byte("term;tags\nsay \"hi\";a\nhello;a\n"), termgraph.Options{})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+	wantDOT := "graph glossary {\n" +
+		"  n2 [label=\"say \\\"hi\\\"\"];\n" +
+		"  n3 [label=\"hello\"];\n" +
+		"  n2 -- n3 [label=\"tag: a\", style=solid];\n" +
+		"}\n"
+	if dot.String() != wantDOT {
+		t.Fatalf("DOT:\n%s\nwant:\n%s", dot.String(), wantDOT)
+	}
+
+	var js bytes.Buffer
+	if err := g.WriteJSON(&js); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var back termgraph.Graph
+	if err := json.Unmarshal(js.Bytes(), &back); err != nil {
+		t.Fatalf("invalid JSON %q: %v", js.String(), err)
+	}
+	if !reflect.DeepEqual(&back, g) || !strings.Contains(js.String(), `"kind": "tag"`) {
+		t.Fatalf("JSON round trip mismatch: %s", js.String())
+	}
+}
@@ -0,0 +1,210 @@
+// Package usage counts how often glossary terms occur in a project's source strings,
+// for glossary hygiene reviews: terms that never occur may be dead, and frequent
+// terms are the ones worth translating carefully.
+package usage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrNoTermColumn means the glossary header has no "term" column.
+var ErrNoTermColumn = errors.New("usage: no term column in header")
+
+const ctxCheckEvery = 1 << 10
+
+// Term is how often one glossary term occurs in the source strings.
+type Term struct {
+	Row           int    // 1-based CSV record number of the term (header included)
+	Term          string // term as written, trimmed
+	CaseSensitive bool   // matched case-sensitively (casesensitive=yes)
+	Occurrences   int    // total matches across all source strings
+	Strings       int    // number of source strings with at least one match
+}
+
+// Count reads the glossary in data (semicolon-separated, with a header) and counts
+// every term in sources. A term matches as a whole word: a letter or digit right
+// before or after it is a different word ("cloud" does not match "clouds"), except
+// in scripts written without spaces (Han, kana, Thai, ...). Terms are matched
+// case-insensitively unless their casesensitive column says "yes".
+// Blank terms are skipped; sources is iterated once.
+func Count(ctx context.Context, data []byte, sources iter.Seq[string]) ([]Term, error) {
+	terms, err := readTerms(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(terms) == 0 || sources == nil {
+		return terms, nil
+	}
+
+	folded := make([]string, len(terms))
+	for i, t := range terms {
+		if !t.CaseSensitive {
+			folded[i] = strings.ToLower(t.Term)
+		}
+	}
+
+	n := 0
+	for src := range sources {
+		if n%ctxCheckEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		n++
+
+		var lower string
+		for i := range terms {
+			text, term := src, terms[i].Term
+			if !terms[i].CaseSensitive {
+				if lower == "" && src != "" {
+					lower = strings.ToLower(src)
+				}
+				text, term = lower, folded[i]
+			}
+
+			if c := countWords(text, term); c > 0 {
+				terms[i].Occurrences += c
+				terms[i].Strings++
+			}
+		}
+	}
+
+	return terms, nil
+}
+
+// Unused returns the terms of counted that occur in no source string, in glossary order.
+func Unused(counted []Term) []Term {
+	var out []Term
+	for _, t := range counted {
+		if t.Occurrences == 0 {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
+
+func readTerms(ctx context.Context, data []byte) ([]Term, error) {
+	r := checks.NewSemicolonCSVReader(checks.StripUTF8BOM(data))
+
+	termCol, caseCol, rowNum := -1, -1, 0
+	var out []Term
+
+	for {
+		if rowNum%ctxCheckEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		rowNum++
+
+		if termCol < 0 {
+			if isBlankRecord(rec) {
+				continue
+			}
+			for i, h := range rec {
+				switch strings.ToLower(strings.TrimSpace(h)) {
+				case "term":
+					if termCol < 0 {
+						termCol = i
+					}
+				case "casesensitive":
+					if caseCol < 0 {
+						caseCol = i
+					}
+				}
+			}
+			if termCol < 0 {
+				return nil, ErrNoTermColumn
+			}
+			continue
+		}
+
+		if termCol >= len(rec) {
+			continue
+		}
+		term := strings.TrimSpace(rec[termCol])
+		if term == "" {
+			continue
+		}
+
+		cs := caseCol >= 0 && caseCol < len(rec) && strings.EqualFold(strings.TrimSpace(rec[caseCol]), "yes")
+		out = append(out, Term{Row: rowNum, Term: term, CaseSensitive: cs})
+	}
+
+	return out, nil
+}
+
+func isBlankRecord(rec []string) bool {
+	for _, f := range rec {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// countWords counts non-overlapping whole-word matches of term in text.
+func countWords(text, term string) int {
+	if term == "" || len(term) > len(text) {
+		return 0
+	}
+
+	first, _ := utf8.DecodeRuneInString(term)
+	last, _ := utf8.DecodeLastRuneInString(term)
+
+	n := 0
+	for pos := 0; pos <= len(text)-len(term); {
+		i := strings.Index(text[pos:], term)
+		if i < 0 {
+			break
+		}
+		start := pos + i
+		end := start + len(term)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if (start == 0 || !joins(before, first)) && (end == len(text) || !joins(last, after)) {
+			n++
+			pos = end
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		pos = start + size
+	}
+
+	return n
+}
+
+// joins reports whether a and b, written next to each other, belong to one word.
+func joins(a, b rune) bool {
+	return isWordRune(a) && isWordRune(b) && !unspaced(a) && !unspaced(b)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+// unspaced reports runes of scripts written without spaces between words.
+func unspaced(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana,
+		unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar)
+}
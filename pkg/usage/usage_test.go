@@ -0,0 +1,90 @@
+package usage_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/usage"
+)
+
+const glossary = "term;description;casesensitive\n" +
+	"cloud;;no\n" +
+	"API;;yes\n" +
+	";blank term;no\n" +
+	"東京;;no\n" +
+	"unused;;no\n"
+
+func TestCount(t *testing.T) {
+	t.Parallel()
+
+	sources := []string{
+		"Cloud storage in the cloud",
+		"Clouds are not a cloud-native API",
+		"api docs",
+		"東京タワー",
+	}
+
+	got, err := usage.Count(context.Background(), []byte(glossary), slices.Values(sources))
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	want := []usage.Term{
+		{Row: 2, Term: "cloud", Occurrences: 3, Strings: 2},
+		{Row: 3, Term: "API", CaseSensitive: true, Occurrences: 1, Strings: 1},
+		{Row: 5, Term: "東京", Occurrences: 1, Strings: 1},
+		{Row: 6, Term: "unused"},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Count mismatch:\n got:  %+v\n want: %+v", got, want)
+	}
+
+	if u := usage.Unused(got); len(u) != 1 || u[0].Term != "unused" {
+		t.Fatalf("Unused = %+v", u)
+	}
+}
+
+func TestCount_Callback(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	sources := func(yield func(string) bool) {
+		for _, s := range []string{"cloud", "cloud"} {
+			calls++
+			if !yield(s) {
+				return
+			}
+		}
+	}
+
+	got, err := usage.Count(context.Background(), []byte("term\ncloud\n"), sources)
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if calls != 2 || len(got) != 1 || got[0].Occurrences != 2 {
+		t.Fatalf("unexpected result: calls=%d %+v", calls, got)
+	}
+}
+
+func TestCount_NoTermColumn(t *testing.T) {
+	t.Parallel()
+
+	_, err := usage.Count(context.Background(), []byte("name;en\nx;y\n"), slices.Values([]string{"x"}))
+	if !errors.Is(err, usage.ErrNoTermColumn) {
+		t.Fatalf("expected ErrNoTermColumn, got %v", err)
+	}
+}
+
+func TestCount_Cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := usage.Count(ctx, []byte(glossary), slices.Values([]string{"x"}))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ArtifactInput is one glossary passed to ValidateAll.
+type ArtifactInput struct {
+	Path  string
+	Data  []byte
+	Langs []string
+
+	// Load, when set, is called by the worker that validates the input to read its Data,
+	// so a large batch never holds every file in memory. An error is reported in
+	// FileSummary.Err and the input is not validated.
+	Load func() ([]byte, error)
+}
+
+// BatchOptions tune ValidateAll.
+type BatchOptions struct {
+	// Run is used for every input.
+	Run checks.RunOptions
+
+	// Workers is the number of inputs validated concurrently (0: GOMAXPROCS).
+	Workers int
+
+	// Done, when set, is called by the worker right after input i was validated, with the
+	// input as validated (Data loaded). It may set f.Err, e.g. when storing the result failed.
+	// It runs concurrently for different inputs.
+	Done func(i int, in ArtifactInput, f *FileSummary)
+}
+
+// FileSummary is the outcome of one ValidateAll input.
+type FileSummary struct {
+	Path    string
+	Summary Summary
+
+	// Err is the *RunError Validate would have returned for this input, the error of
+	// ArtifactInput.Load, or the error BatchOptions.Done set.
+	Err error
+}
+
+// BatchSummary aggregates the per-input outcomes of ValidateAll.
+type BatchSummary struct {
+	// Files holds one entry per input, in input order.
+	Files []FileSummary
+
+	// Pass, Warn, Fail, Error, Info and Skipped sum the check counters of every file.
+	Pass    int
+	Warn    int
+	Fail    int
+	Error   int
+	Info    int
+	Skipped int
+
+	// Fixed counts files whose run applied fixes.
+	Fixed int
+
+	// Failed counts files with a non-nil Err.
+	Failed int
+}
+
+// ValidateAll validates every input like Validate, on a pool of at most opts.Workers
+// goroutines, and returns one FileSummary per input plus their totals.
+// The checks to run are resolved once for the whole batch.
+//
+// Per-input errors are kept in FileSummary.Err (see BatchSummary.Errors). The returned
+// error is reserved for options that fail to resolve (a *RunError, no input is validated)
+// and a cancelled ctx; inputs reached after cancellation report the cancellation in Err.
+func ValidateAll(ctx context.Context, inputs []ArtifactInput, opts BatchOptions) (BatchSummary, error) {
+	units, err := checks.ResolveRun(opts.Run)
+	if err != nil {
+		return BatchSummary{}, configRunError(err)
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(inputs))
+
+	files := make([]FileSummary, len(inputs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for i := range jobs {
+				files[i] = validateInput(ctx, units, i, inputs[i], opts)
+			}
+		})
+	}
+	for i := range inputs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	res := aggregateFiles(files)
+	if err := ctx.Err(); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// validateInput loads (unless ctx is already done) and validates one input, then hands
+// it to opts.Done.
+func validateInput(ctx context.Context, units []checks.CheckUnit, i int, in ArtifactInput, opts BatchOptions) FileSummary {
+	f := FileSummary{Path: in.Path}
+
+	if in.Load != nil && ctx.Err() == nil {
+		data, err := in.Load()
+		if err != nil {
+			f.Summary = newSummary(in.Path, nil, in.Langs)
+			f.Err = err
+			return f
+		}
+		in.Data = data
+	}
+
+	f.Summary, f.Err = run(ctx, units, in.Path, in.Data, in.Langs, opts.Run)
+	if opts.Done != nil {
+		opts.Done(i, in, &f)
+	}
+
+	return f
+}
+
+func aggregateFiles(files []FileSummary) BatchSummary {
+	res := BatchSummary{Files: files}
+
+	for _, f := range files {
+		res.Pass += f.Summary.Pass
+		res.Warn += f.Summary.Warn
+		res.Fail += f.Summary.Fail
+		res.Error += f.Summary.Error
+		res.Info += f.Summary.Info
+		res.Skipped += f.Summary.Skipped
+
+		if f.Summary.AppliedFixes {
+			res.Fixed++
+		}
+		if f.Err != nil {
+			res.Failed++
+		}
+	}
+
+	return res
+}
+
+// Errors returns the per-file errors, each prefixed with its path, joined with errors.Join.
+// Nil when every input was validated without error.
+func (s BatchSummary) Errors() error {
+	var errs []error
+	for _, f := range s.Files {
+		if f.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", f.Path, f.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,179 @@
+package validator_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestValidateAll_PerFileSummariesAndTotals(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "has-bad", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			if bytes.Contains(a.Data, []byte("bad")) {
+				return checks.OutcomeKeep(checks.Fail, "has-bad", "bad term", a, "")
+			}
+			return checks.OutcomeKeep(checks.Pass, "has-bad", "ok", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "always-warn", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Warn, "always-warn", "meh", a, "")
+		},
+	))
+
+	inputs := []validator.ArtifactInput{
+		{Path: "a.csv", Data: []byte("term\ngood\n")},
+		{Path: "b.csv", Data: []byte("term\nbad\n"), Langs: []string{"en"}},
+		{Path: "c.csv", Data: []byte("term\nfine\n")},
+	}
+
+	res, err := validator.ValidateAll(context.Background(), inputs, validator.BatchOptions{Workers: 2})
+	if err != nil {
+		t.Fatalf("ValidateAll: %v", err)
+	}
+	if len(res.Files) != len(inputs) {
+		t.Fatalf("got %d files, want %d", len(res.Files), len(inputs))
+	}
+	for i, f := range res.Files {
+		if f.Path != inputs[i].Path || f.Summary.FilePath != inputs[i].Path || f.Err != nil {
+			t.Fatalf("Files[%d] = %+v, want a clean run of %s", i, f, inputs[i].Path)
+		}
+	}
+	if res.Files[1].Summary.Fail != 1 || res.Files[1].Summary.FinalLangs[0] != "en" {
+		t.Fatalf("b.csv summary = %+v", res.Files[1].Summary)
+	}
+	if res.Pass != 2 || res.Fail != 1 || res.Warn != 3 || res.Failed != 0 || res.Fixed != 0 {
+		t.Fatalf("totals = %+v", res)
+	}
+	if res.Errors() != nil {
+		t.Fatalf("Errors() = %v, want nil", res.Errors())
+	}
+}
+
+func TestValidateAll_BoundsWorkers(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	var running, peak atomic.Int32
+	_, _ = checks.Register(mkCheck(t, "slow", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return checks.OutcomeKeep(checks.Pass, "slow", "ok", a, "")
+		},
+	))
+
+	inputs := make([]validator.ArtifactInput, 8)
+	for i := range inputs {
+		inputs[i] = validator.ArtifactInput{Path: "f.csv", Data: []byte("term\nx\n")}
+	}
+
+	res, err := validator.ValidateAll(context.Background(), inputs, validator.BatchOptions{Workers: 2})
+	if err != nil {
+		t.Fatalf("ValidateAll: %v", err)
+	}
+	if res.Pass != len(inputs) {
+		t.Fatalf("Pass = %d, want %d", res.Pass, len(inputs))
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("%d inputs ran at once, want at most 2", p)
+	}
+}
+
+func TestValidateAll_UnknownCheckFailsBeforeAnyInput(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	inputs := []validator.ArtifactInput{{Path: "a.csv", Data: []byte("term\n")}}
+	res, err := validator.ValidateAll(context.Background(), inputs, validator.BatchOptions{
+		Run: checks.RunOptions{Only: []string{"nope"}},
+	})
+
+	var re *validator.RunError
+	if !errors.As(err, &re) || !errors.Is(err, checks.ErrUnknownCheck) {
+		t.Fatalf("err = %v, want a *RunError matching ErrUnknownCheck", err)
+	}
+	if len(res.Files) != 0 {
+		t.Fatalf("no input may be validated, got %d files", len(res.Files))
+	}
+}
+
+func TestValidateAll_Cancelled(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "c1", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "c1", "ok", a, "")
+		},
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	inputs := []validator.ArtifactInput{{Path: "a.csv"}, {Path: "b.csv"}}
+	res, err := validator.ValidateAll(ctx, inputs, validator.BatchOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if res.Failed != len(inputs) || !errors.Is(res.Errors(), context.Canceled) {
+		t.Fatalf("every input must report the cancellation, got %+v", res)
+	}
+}
+
+func TestValidateAll_LoadAndDone(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "c1", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "c1", "ok", a, "")
+		},
+	))
+
+	errRead := errors.New("read failed")
+	errStore := errors.New("store failed")
+	inputs := []validator.ArtifactInput{
+		{Path: "a.csv", Load: func() ([]byte, error) { return []byte("term\na\n"), nil }},
+		{Path: "b.csv", Load: func() ([]byte, error) { return nil, errRead }},
+	}
+
+	var done atomic.Int32
+	res, err := validator.ValidateAll(context.Background(), inputs, validator.BatchOptions{
+		Done: func(i int, in validator.ArtifactInput, f *validator.FileSummary) {
+			done.Add(1)
+			if i != 0 || string(in.Data) != "term\na\n" || f.Err != nil {
+				t.Errorf("Done(%d, %q, %+v): want the loaded first input", i, in.Data, f)
+			}
+			f.Err = errStore
+		},
+	})
+	if err != nil {
+		t.Fatalf("ValidateAll: %v", err)
+	}
+	if done.Load() != 1 {
+		t.Fatalf("Done called %d times, want once (not for a failed load)", done.Load())
+	}
+	if !errors.Is(res.Files[0].Err, errStore) || !errors.Is(res.Files[1].Err, errRead) || res.Failed != 2 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	if res.Pass != 1 {
+		t.Fatalf("Pass = %d, want 1", res.Pass)
+	}
+}
@@ -7,17 +7,93 @@ import (
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
 )
 
+// RunError is the error returned by Validate. It aggregates every ERROR-status outcome
+// recorded so far and the context error (if the run was cancelled), so callers can
+// inspect causes with errors.Is / errors.As.
+type RunError struct {
+	// Checks holds one entry per ERROR-status outcome, in execution order.
+	Checks []*CheckError
+	// Context is the context error that stopped the run, if any.
+	Context error
+	// Cause is a configuration error that prevented the run (e.g. unknown check set).
+	Cause error
+
+	msg string
+}
+
+// Error returns the headline message (context error, fail-fast reason, or first ERROR message).
+func (e *RunError) Error() string { return e.msg }
+
+// Unwrap exposes the configuration and context errors followed by all check errors.
+func (e *RunError) Unwrap() []error {
+	out := make([]error, 0, len(e.Checks)+2)
+	if e.Cause != nil {
+		out = append(out, e.Cause)
+	}
+	if e.Context != nil {
+		out = append(out, e.Context)
+	}
+	for _, ce := range e.Checks {
+		out = append(out, ce)
+	}
+	return out
+}
+
+// CheckError is a single check outcome reported with ERROR status.
+type CheckError struct {
+	Check   string
+	Message string
+	Err     error // underlying error from the check (e.g. *csv.ParseError), if any
+}
+
+// Unwrap exposes the underlying check error.
+func (e *CheckError) Unwrap() error { return e.Err }
+
+func (e *CheckError) Error() string {
+	if e.Message == "" {
+		return e.Check + ": returned ERROR"
+	}
+	return e.Check + ": " + e.Message
+}
+
+func newRunError(msg string, summary Summary, ctxErr error) *RunError {
+	re := &RunError{Context: ctxErr, msg: msg}
+
+	for _, outcome := range summary.Outcomes {
+		if outcome.Result.Status != checks.Error {
+			continue
+		}
+		re.Checks = append(re.Checks, &CheckError{
+			Check:   outcome.Result.Name,
+			Message: outcome.Result.Message,
+			Err:     outcome.Result.Err,
+		})
+	}
+
+	return re
+}
+
+func configRunError(err error) error {
+	return &RunError{Cause: err, msg: err.Error()}
+}
+
+func contextRunError(summary Summary, ctxErr error) error {
+	return newRunError(ctxErr.Error(), summary, ctxErr)
+}
+
 func failFastError(
 	unit checks.CheckUnit,
 	outcome checks.CheckOutcome,
+	summary Summary,
 	opts checks.RunOptions,
 ) error {
 	if outcome.Result.Status == checks.Error && opts.HardFailOnErr {
-		return fmt.Errorf(
+		msg := fmt.Sprintf(
 			"fail-fast on ERROR at %q: %s",
 			unit.Name(),
 			outcome.Result.Message,
 		)
+		return newRunError(msg, summary, nil)
 	}
 
 	return nil
@@ -33,7 +109,7 @@ func hardFailError(summary Summary, opts checks.RunOptions) error {
 		msg = "one or more checks returned ERROR"
 	}
 
-	return errors.New(msg)
+	return newRunError(msg, summary, nil)
 }
 
 func firstErrorMessage(summary Summary) string {
@@ -45,3 +121,19 @@ func firstErrorMessage(summary Summary) string {
 
 	return ""
 }
+
+// verifiedRunError adds Verify violations to err as its Cause.
+func verifiedRunError(summary Summary, err error) error {
+	verr := Verify(summary)
+	if verr == nil {
+		return err
+	}
+
+	var re *RunError
+	if errors.As(err, &re) {
+		re.Cause = errors.Join(re.Cause, verr)
+		return re
+	}
+
+	return &RunError{Cause: verr, msg: verr.Error()}
+}
@@ -0,0 +1,156 @@
+package validator_test
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestValidate_RunErrorAggregatesAllErrors(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "bad-one", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Error, "bad-one", "first error", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "fine", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Fail, "fine", "just a failure", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "bad-two", 3, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Error, "bad-two", "second error", a, "")
+		},
+	))
+
+	_, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{
+		HardFailOnErr: true,
+	})
+
+	var re *validator.RunError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected *RunError, got %T (%v)", err, err)
+	}
+	if re.Error() != "first error" {
+		t.Fatalf("Error() = %q, want %q", re.Error(), "first error")
+	}
+	if re.Context != nil {
+		t.Fatalf("Context = %v, want nil", re.Context)
+	}
+	if len(re.Checks) != 2 {
+		t.Fatalf("got %d check errors, want 2", len(re.Checks))
+	}
+	if re.Checks[0].Check != "bad-one" || re.Checks[1].Check != "bad-two" {
+		t.Fatalf("unexpected check errors: %v, %v", re.Checks[0], re.Checks[1])
+	}
+
+	var ce *validator.CheckError
+	if !errors.As(err, &ce) || ce.Error() != "bad-one: first error" {
+		t.Fatalf("errors.As CheckError = %v", ce)
+	}
+	if !errors.Is(err, re.Checks[1]) {
+		t.Fatalf("errors.Is must find the second check error")
+	}
+}
+
+func TestValidate_RunErrorWrapsContextAndPriorErrors(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	_, _ = checks.Register(mkCheck(t, "errs-then-cancels", 1, false,
+		func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			cancel()
+			return checks.OutcomeKeep(checks.Error, "errs-then-cancels", "broken", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "never", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			t.Fatalf("check must not run after cancellation")
+			return checks.CheckOutcome{}
+		},
+	))
+
+	_, err := validator.Validate(ctx, "file.csv", []byte("x"), nil, checks.RunOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	var re *validator.RunError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected *RunError, got %T", err)
+	}
+	if len(re.Checks) != 1 || re.Checks[0].Message != "broken" {
+		t.Fatalf("unexpected check errors: %+v", re.Checks)
+	}
+	if got := len(re.Unwrap()); got != 2 {
+		t.Fatalf("Unwrap() len = %d, want 2", got)
+	}
+}
+
+func TestValidate_FailFastRunError(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "boom", 1, true,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Error, "boom", "kaboom", a, "")
+		},
+	))
+
+	_, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{
+		HardFailOnErr: true,
+	})
+
+	var re *validator.RunError
+	if !errors.As(err, &re) {
+		t.Fatalf("expected *RunError, got %T", err)
+	}
+	if re.Error() != `fail-fast on ERROR at "boom": kaboom` {
+		t.Fatalf("Error() = %q", re.Error())
+	}
+	if len(re.Checks) != 1 || re.Checks[0].Check != "boom" {
+		t.Fatalf("unexpected check errors: %+v", re.Checks)
+	}
+}
+
+func TestValidate_RunErrorExposesParseError(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	pe := &csv.ParseError{StartLine: 5, Line: 5, Column: 3, Err: csv.ErrQuote}
+
+	unit, err := checks.NewCheckAdapter("parser", func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+		return checks.RunWithFix(ctx, a, opts, checks.RunRecipe{
+			Name: "parser",
+			Validate: func(context.Context, checks.Artifact) checks.ValidationResult {
+				return checks.ValidationResult{Msg: "cannot parse CSV", Err: pe}
+			},
+		})
+	})
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+	_, _ = checks.Register(unit)
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{
+		HardFailOnErr: true,
+	})
+
+	var got *csv.ParseError
+	if !errors.As(err, &got) || got != pe {
+		t.Fatalf("errors.As(*csv.ParseError) = %v, want original", got)
+	}
+	if d, ok := sum.Outcomes[0].Result.ParseError(); !ok || d.Line != 5 || d.Column != 3 {
+		t.Fatalf("outcome ParseError() = %+v, %v", d, ok)
+	}
+}
@@ -27,3 +27,18 @@ func shouldStop(unit checks.CheckUnit, outcome checks.CheckOutcome) bool {
 		return false
 	}
 }
+
+// failureBudgetSpent reports whether RunOptions.MaxFailures FAIL findings were already reported.
+func failureBudgetSpent(opts checks.RunOptions, failures int) bool {
+	return opts.MaxFailures > 0 && failures >= opts.MaxFailures
+}
+
+// withRemainingFailures hands the next check what is left of the failure budget,
+// so its row scan stops where the run would.
+func withRemainingFailures(opts checks.RunOptions, failures int) checks.RunOptions {
+	if opts.MaxFailures > 0 {
+		opts.MaxFailures -= failures
+	}
+
+	return opts
+}
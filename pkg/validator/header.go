@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrIncompleteHeader is the Cause of the *RunError ValidateHeader returns when
+// firstChunk ends before the header line does; read more of the file and retry.
+var ErrIncompleteHeader = errors.New("validator: first chunk does not contain a complete header line")
+
+// ValidateHeader gives instant feedback on the header of a file being uploaded, from
+// just its first bytes. It cuts firstChunk after the first non-blank line and runs only
+// the registered header-scoped checks (checks.ScopeHeader) on that line, without fixing.
+// The summary has Partial set: row and file checks did not run, and a header that
+// passes here can still fail a full Validate.
+// A non-nil error is always a *RunError.
+func ValidateHeader(ctx context.Context, firstChunk []byte, langs []string) (Summary, error) {
+	line, ok := headerLine(firstChunk, "")
+	if !ok {
+		sum := newSummary("", firstChunk, langs)
+		sum.Partial = true
+		return sum, configRunError(ErrIncompleteHeader)
+	}
+
+	opts := checks.RunOptions{FixMode: checks.FixNone}
+
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		sum := newSummary("", line, langs)
+		sum.Partial = true
+		return sum, configRunError(err)
+	}
+
+	headerUnits := units[:0]
+	for _, u := range units {
+		if checks.CapabilitiesOf(u).Scope == checks.ScopeHeader {
+			headerUnits = append(headerUnits, u)
+		}
+	}
+
+	sum, err := run(ctx, headerUnits, "", line, langs, opts)
+	sum.Partial = true
+
+	return sum, err
+}
+
+// headerLine returns data up to and including the line break that ends the first
+// non-blank line (blank lines and a BOM before it are kept). Lines starting with
+// commentPrefix before the header are left out, as a full run masks them; the result
+// is then a copy. ok is false when the header line is not terminated within data.
+func headerLine(data []byte, commentPrefix string) ([]byte, bool) {
+	var out []byte // nil until a comment line is dropped
+	pos := 0
+	for pos < len(data) {
+		line, _, found := bytes.Cut(data[pos:], []byte("\n"))
+		if !found {
+			return nil, false
+		}
+
+		end := pos + len(line) + 1
+		text := checks.StripUTF8BOM(bytes.TrimSuffix(line, []byte("\r")))
+		switch {
+		case commentPrefix != "" && bytes.HasPrefix(text, []byte(commentPrefix)):
+			if out == nil {
+				out = slices.Clone(data[:pos])
+				if pos == 0 {
+					_, bom := checks.SplitUTF8BOM(data)
+					out = append(out, bom...)
+				}
+			}
+		case !checks.IsBlankUnicode(text):
+			if out == nil {
+				return data[:end], true
+			}
+			return append(out, data[pos:end]...), true
+		case out != nil:
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+
+	return nil, false
+}
@@ -0,0 +1,58 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestValidateHeader_RunsHeaderChecksOnFirstLine(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	var seen []string
+	register := func(name string, scope checks.Scope) {
+		ch, err := checks.NewCheckAdapter(name,
+			func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+				seen = append(seen, name+":"+string(a.Data))
+				return checks.OutcomeKeep(checks.Warn, name, "header looks odd", a, "")
+			},
+			checks.WithScope(scope),
+		)
+		if err != nil {
+			t.Fatalf("NewCheckAdapter(%s): %v", name, err)
+		}
+		_, _ = checks.Register(ch)
+	}
+	register("header-check", checks.ScopeHeader)
+	register("rows-check", checks.ScopeRows)
+
+	sum, err := validator.ValidateHeader(context.Background(), []byte("\r\nTerm;en\r\ncloud;clo"), []string{"en"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sum.Partial || sum.Warn != 1 || !reflect.DeepEqual(sum.Order, []string{"header-check"}) {
+		t.Fatalf("unexpected summary: %+v", sum)
+	}
+	if !reflect.DeepEqual(seen, []string{"header-check:\r\nTerm;en\r\n"}) {
+		t.Fatalf("checks saw %q", seen)
+	}
+}
+
+func TestValidateHeader_IncompleteHeader(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	sum, err := validator.ValidateHeader(context.Background(), []byte("\nterm;descr"), nil)
+	if !errors.Is(err, validator.ErrIncompleteHeader) {
+		t.Fatalf("expected ErrIncompleteHeader, got %v", err)
+	}
+	var re *validator.RunError
+	if !errors.As(err, &re) || !sum.Partial {
+		t.Fatalf("expected *RunError and a partial summary, got %T %+v", err, sum)
+	}
+}
@@ -0,0 +1,232 @@
+package validator
+
+import (
+	"encoding/json"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// SchemaVersion is the version of the JSON document a Summary marshals to.
+// It changes only when a field is removed or changes meaning; fields may be added
+// within a version, so consumers should ignore the ones they do not know.
+const SchemaVersion = 1
+
+// SummaryDocument is the JSON form of a Summary (see Summary.MarshalJSON).
+// FinalData and Rows are left out; write FinalData to a file of its own.
+type SummaryDocument struct {
+	SchemaVersion int    `json:"schema_version"`
+	File          string `json:"file,omitempty"`
+
+	Pass    int `json:"pass"`
+	Warn    int `json:"warn"`
+	Fail    int `json:"fail"`
+	Error   int `json:"error"`
+	Info    int `json:"info"`
+	Skipped int `json:"skipped"`
+
+	Order    []string          `json:"order,omitempty"`
+	Outcomes []OutcomeDocument `json:"outcomes"`
+
+	EarlyExit   bool          `json:"early_exit,omitempty"`
+	EarlyCheck  string        `json:"early_check,omitempty"`
+	EarlyStatus checks.Status `json:"early_status,omitempty"`
+	Truncated   bool          `json:"truncated,omitempty"`
+	Partial     bool          `json:"partial,omitempty"`
+
+	FixesApplied bool     `json:"fixes_applied"`
+	FinalPath    string   `json:"final_path,omitempty"`
+	FinalLangs   []string `json:"final_langs,omitempty"`
+
+	Detection   *DetectionDocument   `json:"detection,omitempty"`
+	Languages   LanguagesDocument    `json:"languages"`
+	Regressions []RegressionDocument `json:"regressions,omitempty"`
+}
+
+// OutcomeDocument is the JSON form of one check outcome.
+type OutcomeDocument struct {
+	Check     string            `json:"check"`
+	Status    checks.Status     `json:"status"`
+	Message   string            `json:"message,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	Truncated bool              `json:"truncated,omitempty"`
+	Findings  []FindingDocument `json:"findings,omitempty"`
+
+	// PreFixMessage and PreFixFindings keep the diagnosis of the input when a fix was
+	// applied but the check still fails; Message and Findings describe the fixed data.
+	PreFixMessage  string            `json:"pre_fix_message,omitempty"`
+	PreFixFindings []FindingDocument `json:"pre_fix_findings,omitempty"`
+
+	// Changed is set when the check's fix changed the file; FixNote says what it did.
+	Changed bool   `json:"changed,omitempty"`
+	FixNote string `json:"fix_note,omitempty"`
+
+	Children []OutcomeDocument `json:"children,omitempty"`
+}
+
+// FindingDocument is the JSON form of a checks.Finding.
+type FindingDocument struct {
+	Check      string        `json:"check,omitempty"`
+	Code       string        `json:"code,omitempty"`
+	Severity   checks.Status `json:"severity,omitempty"`
+	Row        int           `json:"row,omitempty"`
+	Field      int           `json:"field,omitempty"`
+	Column     string        `json:"column,omitempty"`
+	Value      string        `json:"value,omitempty"`
+	Message    string        `json:"message,omitempty"`
+	Suggestion string        `json:"suggestion,omitempty"`
+
+	SuggestedFix *SuggestedFixDocument `json:"suggested_fix,omitempty"`
+}
+
+// SuggestedFixDocument is the JSON form of a checks.SuggestedFix.
+type SuggestedFixDocument struct {
+	Before  string `json:"before,omitempty"`
+	After   string `json:"after,omitempty"`
+	Removed bool   `json:"removed,omitempty"`
+	Note    string `json:"note,omitempty"`
+}
+
+// DetectionDocument is the JSON form of checks.Detection.
+type DetectionDocument struct {
+	Delimiter       string            `json:"delimiter,omitempty"`
+	Confidence      checks.Confidence `json:"confidence,omitempty"`
+	DelimiterReason string            `json:"delimiter_reason,omitempty"`
+	Encoding        string            `json:"encoding,omitempty"`
+	EncodingPath    string            `json:"encoding_path,omitempty"`
+	BOM             string            `json:"bom,omitempty"`
+	LineEndings     string            `json:"line_endings,omitempty"`
+}
+
+// LanguagesDocument is the JSON form of Languages.
+type LanguagesDocument struct {
+	Declared   []string `json:"declared,omitempty"`
+	Detected   []string `json:"detected,omitempty"`
+	Missing    []string `json:"missing,omitempty"`
+	Undeclared []string `json:"undeclared,omitempty"`
+}
+
+// RegressionDocument is the JSON form of a Regression.
+type RegressionDocument struct {
+	Check   string          `json:"check"`
+	FixedBy []string        `json:"fixed_by"`
+	Outcome OutcomeDocument `json:"outcome"`
+}
+
+// Document converts s to its JSON form.
+func (s Summary) Document() SummaryDocument {
+	doc := SummaryDocument{
+		SchemaVersion: SchemaVersion,
+		File:          s.FilePath,
+		Pass:          s.Pass,
+		Warn:          s.Warn,
+		Fail:          s.Fail,
+		Error:         s.Error,
+		Info:          s.Info,
+		Skipped:       s.Skipped,
+		Order:         s.Order,
+		Outcomes:      outcomeDocuments(s.Outcomes),
+		EarlyExit:     s.EarlyExit,
+		EarlyCheck:    s.EarlyCheck,
+		EarlyStatus:   s.EarlyStatus,
+		Truncated:     s.Truncated,
+		Partial:       s.Partial,
+		FixesApplied:  s.AppliedFixes,
+		FinalPath:     s.FinalPath,
+		FinalLangs:    s.FinalLangs,
+		Languages: LanguagesDocument{
+			Declared:   s.Languages.Declared,
+			Detected:   s.Languages.Detected,
+			Missing:    s.Languages.Missing,
+			Undeclared: s.Languages.Undeclared,
+		},
+	}
+
+	if !s.Detection.IsZero() {
+		d := s.Detection
+		doc.Detection = &DetectionDocument{
+			Delimiter:       d.Delimiter,
+			Confidence:      d.Confidence,
+			DelimiterReason: d.DelimiterReason,
+			Encoding:        d.Encoding,
+			EncodingPath:    d.EncodingPath,
+			BOM:             d.BOM,
+			LineEndings:     d.LineEndings,
+		}
+	}
+
+	for _, r := range s.Regressions {
+		doc.Regressions = append(doc.Regressions, RegressionDocument{
+			Check:   r.Check,
+			FixedBy: r.FixedBy,
+			Outcome: outcomeDocument(r.Outcome),
+		})
+	}
+
+	return doc
+}
+
+// MarshalJSON encodes s as its SummaryDocument.
+func (s Summary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Document())
+}
+
+func outcomeDocuments(outcomes []checks.CheckOutcome) []OutcomeDocument {
+	out := make([]OutcomeDocument, 0, len(outcomes))
+	for _, o := range outcomes {
+		out = append(out, outcomeDocument(o))
+	}
+
+	return out
+}
+
+func outcomeDocument(o checks.CheckOutcome) OutcomeDocument {
+	doc := OutcomeDocument{
+		Check:     o.Result.Name,
+		Status:    o.Result.Status,
+		Message:   o.Result.Message,
+		Truncated: o.Result.Truncated,
+		Changed:   o.Final.DidChange,
+		FixNote:   o.Final.Note,
+	}
+	if o.Result.Err != nil {
+		doc.Error = o.Result.Err.Error()
+	}
+
+	doc.Findings = findingDocuments(o.Result.Findings)
+	doc.PreFixMessage = o.Result.PreFixMessage
+	doc.PreFixFindings = findingDocuments(o.Result.PreFixFindings)
+
+	if len(o.Children) > 0 {
+		doc.Children = outcomeDocuments(o.Children)
+	}
+
+	return doc
+}
+
+func findingDocuments(findings []checks.Finding) []FindingDocument {
+	var out []FindingDocument
+	for _, f := range findings {
+		doc := FindingDocument{
+			Check:      f.Check,
+			Code:       f.Code,
+			Severity:   f.Severity,
+			Row:        f.Row,
+			Field:      f.Field,
+			Column:     f.Column,
+			Value:      f.Value,
+			Message:    f.Message,
+			Suggestion: f.Suggestion,
+		}
+		if fix := f.SuggestedFix; fix != nil {
+			doc.SuggestedFix = &SuggestedFixDocument{
+				Before:  fix.Before,
+				After:   fix.After,
+				Removed: fix.Removed,
+				Note:    fix.Note,
+			}
+		}
+		out = append(out, doc)
+	}
+
+	return out
+}
@@ -0,0 +1,125 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestSummaryMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	sum := validator.Summary{
+		FilePath: "terms.csv",
+		Pass:     1,
+		Fail:     1,
+		Warn:     2,
+		Order:    []string{"ext", "empty", "dupes", "nested"},
+		Outcomes: []checks.CheckOutcome{
+			{Result: checks.CheckResult{Name: "ext", Status: checks.Pass, Message: "ok"}},
+			{
+				Result: checks.CheckResult{
+					Name:    "empty",
+					Status:  checks.Fail,
+					Message: "empty term in rows: 3",
+					Err:     errors.New("boom"),
+					Findings: []checks.Finding{
+						{Check: "empty", Code: "empty-term", Severity: checks.Fail, Row: 3, Field: 1, Column: "term", Message: "empty term"},
+					},
+				},
+			},
+			{
+				Result: checks.CheckResult{
+					Name:    "dupes",
+					Status:  checks.Warn,
+					Message: "still 1 duplicate",
+					Findings: []checks.Finding{{Row: 4, SuggestedFix: &checks.SuggestedFix{
+						Before: "a;x", Removed: true, Note: "removed duplicate term rows",
+					}}},
+					PreFixMessage:  "2 duplicates",
+					PreFixFindings: []checks.Finding{{Row: 2}, {Row: 4}},
+				},
+			},
+			{
+				Result:   checks.CheckResult{Name: "nested", Status: checks.Warn, Message: "1 nested"},
+				Final:    checks.FixResult{DidChange: true, Note: "trimmed"},
+				Children: []checks.CheckOutcome{{Result: checks.CheckResult{Name: "inner", Status: checks.Warn}}},
+			},
+		},
+		AppliedFixes: true,
+		FinalData:    []byte("never serialized"),
+		FinalPath:    "terms-fixed.csv",
+		Detection:    checks.Detection{Delimiter: ";", Confidence: checks.ConfidenceHigh},
+		Languages:    validator.Languages{Declared: []string{"en"}, Missing: []string{"en"}},
+	}
+
+	data, err := json.Marshal(sum)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if strings.Contains(string(data), "never serialized") {
+		t.Fatalf("FinalData must not be serialized: %s", data)
+	}
+
+	var doc validator.SummaryDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if doc.SchemaVersion != validator.SchemaVersion || doc.File != "terms.csv" || doc.FinalPath != "terms-fixed.csv" || !doc.FixesApplied {
+		t.Fatalf("unexpected document header: %+v", doc)
+	}
+	if doc.Pass != 1 || doc.Warn != 2 || doc.Fail != 1 || doc.Error != 0 {
+		t.Fatalf("counters = %d/%d/%d/%d", doc.Pass, doc.Warn, doc.Fail, doc.Error)
+	}
+	if len(doc.Outcomes) != 4 {
+		t.Fatalf("got %d outcomes, want 4", len(doc.Outcomes))
+	}
+
+	wantFinding := validator.FindingDocument{
+		Check: "empty", Code: "empty-term", Severity: checks.Fail, Row: 3, Field: 1, Column: "term", Message: "empty term",
+	}
+	if got := doc.Outcomes[1]; got.Error != "boom" || len(got.Findings) != 1 || got.Findings[0] != wantFinding {
+		t.Fatalf("outcome = %+v", got)
+	}
+
+	dupes := doc.Outcomes[2]
+	wantFix := validator.SuggestedFixDocument{Before: "a;x", Removed: true, Note: "removed duplicate term rows"}
+	if len(dupes.Findings) != 1 || dupes.Findings[0].SuggestedFix == nil || *dupes.Findings[0].SuggestedFix != wantFix {
+		t.Fatalf("suggested fix = %+v", dupes.Findings)
+	}
+	if dupes.PreFixMessage != "2 duplicates" || len(dupes.PreFixFindings) != 2 || dupes.PreFixFindings[1].Row != 4 {
+		t.Fatalf("pre-fix diagnosis = %q %+v", dupes.PreFixMessage, dupes.PreFixFindings)
+	}
+
+	nested := doc.Outcomes[3]
+	if !nested.Changed || nested.FixNote != "trimmed" || len(nested.Children) != 1 || nested.Children[0].Check != "inner" {
+		t.Fatalf("nested outcome = %+v", nested)
+	}
+
+	if doc.Detection == nil || doc.Detection.Delimiter != ";" || doc.Detection.Confidence != checks.ConfidenceHigh {
+		t.Fatalf("detection = %+v", doc.Detection)
+	}
+	if !reflect.DeepEqual(doc.Languages, validator.LanguagesDocument{Declared: []string{"en"}, Missing: []string{"en"}}) {
+		t.Fatalf("languages = %+v", doc.Languages)
+	}
+}
+
+func TestSummaryMarshalJSON_StableKeys(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(validator.Summary{})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	want := `{"schema_version":1,"pass":0,"warn":0,"fail":0,"error":0,"info":0,"skipped":0,"outcomes":[],"fixes_applied":false,"languages":{}}`
+	if string(data) != want {
+		t.Fatalf("json = %s\nwant   %s", data, want)
+	}
+}
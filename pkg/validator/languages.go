@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Languages compares the declared languages with the locale columns of the final header.
+// Entries keep their spelling; they are matched case-insensitively with "-" and "_" equal.
+type Languages struct {
+	// Declared is the declared language list after checks normalized it (Summary.FinalLangs),
+	// without blanks and repeats.
+	Declared []string
+
+	// Detected lists the languages with a value or description column, in header order.
+	Detected []string
+
+	// Missing lists declared languages without a value column.
+	Missing []string
+
+	// Undeclared lists detected languages that are not declared.
+	// Empty when nothing was declared: there is nothing to compare with.
+	Undeclared []string
+}
+
+// languagesOf builds the Languages section from the header of data. A file without
+// a readable header has no detected languages.
+func languagesOf(ctx context.Context, data []byte, declared []string) Languages {
+	var langs Languages
+
+	declaredKeys := make(map[string]bool, len(declared))
+	for _, lang := range declared {
+		lang = strings.TrimSpace(lang)
+		key := langKey(lang)
+		if key == "" || declaredKeys[key] {
+			continue
+		}
+		declaredKeys[key] = true
+		langs.Declared = append(langs.Declared, lang)
+	}
+
+	ix, err := checks.LocaleIndexOf(ctx, checks.Artifact{Data: data})
+	if err != nil {
+		langs.Missing = slices.Clone(langs.Declared)
+		return langs
+	}
+
+	detected := make(map[string]bool)
+	values := make(map[string]bool)
+	for _, col := range ix.Columns {
+		if !col.LangLike {
+			continue
+		}
+		if !col.Description {
+			values[col.Key] = true
+		}
+		if detected[col.Key] {
+			continue
+		}
+		detected[col.Key] = true
+		langs.Detected = append(langs.Detected, col.Base)
+
+		if len(declaredKeys) > 0 && !declaredKeys[col.Key] {
+			langs.Undeclared = append(langs.Undeclared, col.Base)
+		}
+	}
+
+	for _, lang := range langs.Declared {
+		if !values[langKey(lang)] {
+			langs.Missing = append(langs.Missing, lang)
+		}
+	}
+
+	return langs
+}
+
+func langKey(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "-", "_"))
+}
@@ -0,0 +1,96 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestSummaryLanguages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     string
+		declared []string
+		want     validator.Languages
+	}{
+		{
+			name:     "declared vs detected",
+			data:     "term;description;en;fr_description;pt-BR;notes\nx;y;z;w;v;n\n",
+			declared: []string{"en", " pt_br ", "de", "EN", ""},
+			want: validator.Languages{
+				Declared:   []string{"en", "pt_br", "de"},
+				Detected:   []string{"en", "fr", "pt-BR"},
+				Missing:    []string{"de"},
+				Undeclared: []string{"fr"},
+			},
+		},
+		{
+			name:     "description only is missing",
+			data:     "term;description;de_description\n",
+			declared: []string{"de"},
+			want: validator.Languages{
+				Declared: []string{"de"},
+				Detected: []string{"de"},
+				Missing:  []string{"de"},
+			},
+		},
+		{
+			name: "nothing declared",
+			data: "\ufeff\nterm;description;en;fr\n",
+			want: validator.Languages{Detected: []string{"en", "fr"}},
+		},
+		{
+			name:     "no header",
+			data:     " \n",
+			declared: []string{"en"},
+			want:     validator.Languages{Declared: []string{"en"}, Missing: []string{"en"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sum, err := validator.NewPipeline(checks.RunOptions{}).Validate(context.Background(), "file.csv", []byte(tc.data), tc.declared)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(sum.Languages, tc.want) {
+				t.Fatalf("Languages = %+v, want %+v", sum.Languages, tc.want)
+			}
+		})
+	}
+}
+
+func TestSummaryLanguages_FollowFixes(t *testing.T) {
+	t.Parallel()
+
+	addDe, err := checks.NewCheckAdapter("add-de", func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{Name: "add-de", Status: checks.Warn, Message: "added de"},
+			Final: checks.FixResult{
+				Data:      []byte("term;description;en;de\n"),
+				DidChange: true,
+				Langs:     []string{"en", "de"},
+			},
+		}
+	})
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	sum, err := validator.NewPipeline(checks.RunOptions{}, addDe).Validate(context.Background(), "file.csv", []byte("term;description;en\n"), []string{"en"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := validator.Languages{Declared: []string{"en", "de"}, Detected: []string{"en", "de"}}
+	if !reflect.DeepEqual(sum.Languages, want) {
+		t.Fatalf("Languages = %+v, want %+v", sum.Languages, want)
+	}
+}
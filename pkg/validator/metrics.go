@@ -0,0 +1,137 @@
+package validator
+
+import (
+	"errors"
+	"io"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// CategoryOther groups outcomes of checks that are no longer registered or whose
+// priority is outside every band.
+const CategoryOther = "other"
+
+// Metrics are derived from a Summary for dashboards that trend glossary quality
+// over time instead of a binary pass/fail.
+type Metrics struct {
+	// Rows is the number of data records in FinalData (header excluded).
+	Rows int
+
+	// WarnFindings and FailFindings count findings of WARN and FAIL outcomes;
+	// an outcome without findings counts as one.
+	WarnFindings int
+	FailFindings int
+
+	// WarningsPer1000Rows and FailuresPer1000Rows normalize the counts above by Rows;
+	// both are 0 for a file without data rows.
+	WarningsPer1000Rows float64
+	FailuresPer1000Rows float64
+
+	// ByCategory breaks the outcomes down by priority band of the check
+	// ("structural", "content", "semantic", or CategoryOther).
+	ByCategory map[string]CategoryMetrics
+}
+
+// CategoryMetrics counts the outcomes of one check category.
+type CategoryMetrics struct {
+	Checks       int
+	Warn         int
+	Fail         int
+	Error        int
+	WarnFindings int
+	FailFindings int
+}
+
+// Metrics computes the derived metrics of s. Categories come from the priorities
+// of the checks registered now (overrides of the run are not reflected).
+func (s Summary) Metrics() Metrics {
+	m := Metrics{
+		Rows:       dataRows(s.FinalData),
+		ByCategory: make(map[string]CategoryMetrics),
+	}
+
+	for _, o := range s.Outcomes {
+		cat := categoryOf(o.Result.Name)
+		c := m.ByCategory[cat]
+		c.Checks++
+
+		n := max(len(o.Result.Findings), 1)
+		switch o.Result.Status {
+		case checks.Warn:
+			c.Warn++
+			c.WarnFindings += n
+			m.WarnFindings += n
+		case checks.Fail:
+			c.Fail++
+			c.FailFindings += n
+			m.FailFindings += n
+		case checks.Error:
+			c.Error++
+		}
+
+		m.ByCategory[cat] = c
+	}
+
+	if m.Rows > 0 {
+		m.WarningsPer1000Rows = float64(m.WarnFindings) * 1000 / float64(m.Rows)
+		m.FailuresPer1000Rows = float64(m.FailFindings) * 1000 / float64(m.Rows)
+	}
+
+	return m
+}
+
+func categoryOf(check string) string {
+	u, ok := checks.Lookup(check)
+	if !ok {
+		return CategoryOther
+	}
+
+	if band := checks.PriorityBand(u.Priority()); band != "" {
+		return band
+	}
+
+	return CategoryOther
+}
+
+// dataRows counts the non-blank CSV records after the header. Data that does not
+// parse as CSV is counted by non-blank lines instead.
+func dataRows(data []byte) int {
+	r := checks.NewSemicolonCSVReader(checks.StripUTF8BOM(data))
+
+	records := 0
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return dataLines(data)
+		}
+		if !blankRecord(rec) {
+			records++
+		}
+	}
+
+	return max(records-1, 0)
+}
+
+func dataLines(data []byte) int {
+	lines := 0
+	for _, line := range checks.Lines(checks.StripUTF8BOM(data)) {
+		if !checks.IsBlankUnicode(line) {
+			lines++
+		}
+	}
+
+	return max(lines-1, 0)
+}
+
+func blankRecord(rec []string) bool {
+	for _, f := range rec {
+		if !checks.IsBlankUnicode([]byte(f)) {
+			return false
+		}
+	}
+
+	return true
+}
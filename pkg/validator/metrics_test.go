@@ -0,0 +1,106 @@
+package validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestSummaryMetrics_RatesAndCategories(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	findings := func(n int) []checks.Finding {
+		out := make([]checks.Finding, n)
+		for i := range out {
+			out[i] = checks.Finding{Row: i + 2, Message: "bad"}
+		}
+		return out
+	}
+
+	_, _ = checks.Register(mkCheck(t, "struct-fail", checks.PrioStructural, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Fail, "struct-fail", "broken", a, "")
+			out.Result.Findings = findings(2)
+			return out
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "content-warn", checks.PrioContent, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Warn, "content-warn", "odd", a, "")
+			out.Result.Findings = findings(3)
+			return out
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "content-warn-bare", checks.PrioContent+1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Warn, "content-warn-bare", "odd", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "semantic-pass", checks.PrioSemantic, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "semantic-pass", "ok", a, "")
+		},
+	))
+
+	data := []byte("\ufeffterm;description\na;x\n\nb;\"multi\nline\"\nc;z\n")
+
+	sum, err := validator.Validate(context.Background(), "file.csv", data, nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m := sum.Metrics()
+	if m.Rows != 3 {
+		t.Fatalf("Rows = %d, want 3", m.Rows)
+	}
+	if m.WarnFindings != 4 || m.FailFindings != 2 {
+		t.Fatalf("findings = %d warn / %d fail, want 4 / 2", m.WarnFindings, m.FailFindings)
+	}
+	if got, want := m.FailuresPer1000Rows, 2000.0/3; got != want {
+		t.Fatalf("FailuresPer1000Rows = %v, want %v", got, want)
+	}
+	if got, want := m.WarningsPer1000Rows, 4000.0/3; got != want {
+		t.Fatalf("WarningsPer1000Rows = %v, want %v", got, want)
+	}
+
+	want := map[string]validator.CategoryMetrics{
+		"structural": {Checks: 1, Fail: 1, FailFindings: 2},
+		"content":    {Checks: 2, Warn: 2, WarnFindings: 4},
+		"semantic":   {Checks: 1},
+	}
+	if len(m.ByCategory) != len(want) {
+		t.Fatalf("ByCategory = %+v, want %+v", m.ByCategory, want)
+	}
+	for cat, w := range want {
+		if got := m.ByCategory[cat]; got != w {
+			t.Fatalf("ByCategory[%q] = %+v, want %+v", cat, got, w)
+		}
+	}
+}
+
+func TestSummaryMetrics_NoRowsAndUnknownChecks(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	sum := validator.Summary{
+		FinalData: []byte("term;description\n"),
+		Outcomes: []checks.CheckOutcome{
+			{Result: checks.CheckResult{Name: "gone", Status: checks.Error}},
+			{Result: checks.CheckResult{Name: "gone-too", Status: checks.Warn}},
+		},
+	}
+
+	m := sum.Metrics()
+	if m.Rows != 0 {
+		t.Fatalf("Rows = %d, want 0", m.Rows)
+	}
+	if m.WarningsPer1000Rows != 0 || m.FailuresPer1000Rows != 0 {
+		t.Fatalf("rates = %v / %v, want 0 without rows", m.WarningsPer1000Rows, m.FailuresPer1000Rows)
+	}
+	if got, want := m.ByCategory[validator.CategoryOther], (validator.CategoryMetrics{Checks: 2, Warn: 1, Error: 1, WarnFindings: 1}); got != want {
+		t.Fatalf("ByCategory[other] = %+v, want %+v", got, want)
+	}
+}
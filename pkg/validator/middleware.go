@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Step executes a single check against the current artifact state.
+type Step func(
+	ctx context.Context,
+	unit checks.CheckUnit,
+	a checks.Artifact,
+	opts checks.RunOptions,
+) checks.CheckOutcome
+
+// Middleware wraps a Step to add cross-cutting behavior (timing, tracing, snapshots, access checks).
+// It must call next to actually run the check, or return its own outcome to short-circuit it.
+type Middleware func(next Step) Step
+
+var (
+	mwMu        sync.RWMutex
+	middlewares []Middleware
+)
+
+// Use appends middleware to the global chain used by Validate.
+// The first registered middleware is the outermost one. Nil values are ignored.
+func Use(mw ...Middleware) {
+	mwMu.Lock()
+	defer mwMu.Unlock()
+
+	for _, m := range mw {
+		if m != nil {
+			middlewares = append(middlewares, m)
+		}
+	}
+}
+
+// ResetMiddleware removes all registered middleware. It is intended for tests.
+func ResetMiddleware() {
+	mwMu.Lock()
+	middlewares = nil
+	mwMu.Unlock()
+}
+
+// buildStep composes the registered middleware around the plain unit.Run call.
+// The chain is built once per Validate call so registrations mid-run do not affect it.
+func buildStep() Step {
+	mwMu.RLock()
+	chain := make([]Middleware, len(middlewares))
+	copy(chain, middlewares)
+	mwMu.RUnlock()
+
+	step := runUnit
+	for i := len(chain) - 1; i >= 0; i-- {
+		if wrapped := chain[i](step); wrapped != nil {
+			step = wrapped
+		}
+	}
+
+	return step
+}
+
+func runUnit(
+	ctx context.Context,
+	unit checks.CheckUnit,
+	a checks.Artifact,
+	opts checks.RunOptions,
+) checks.CheckOutcome {
+	return unit.Run(ctx, a, opts)
+}
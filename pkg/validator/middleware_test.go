@@ -0,0 +1,125 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestUse_WrapsEachCheckInRegistrationOrder(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	_, _ = checks.Register(mkCheck(t, "first", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "first", "ok", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "second", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "second", "ok", a, "")
+		},
+	))
+
+	var trace []string
+	tracer := func(label string) validator.Middleware {
+		return func(next validator.Step) validator.Step {
+			return func(ctx context.Context, unit checks.CheckUnit, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+				trace = append(trace, label+">"+unit.Name())
+				out := next(ctx, unit, a, opts)
+				trace = append(trace, label+"<"+unit.Name())
+				return out
+			}
+		}
+	}
+
+	validator.Use(tracer("outer"), nil, tracer("inner"))
+
+	_, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"outer>first", "inner>first", "inner<first", "outer<first",
+		"outer>second", "inner>second", "inner<second", "outer<second",
+	}
+	if !reflect.DeepEqual(trace, want) {
+		t.Fatalf("trace mismatch:\n got: %v\nwant: %v", trace, want)
+	}
+}
+
+func TestUse_MiddlewareCanShortCircuitAndRewriteArtifact(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	_, _ = checks.Register(mkCheck(t, "blocked", 1, false,
+		func(ctx context.Context, _ checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			t.Fatalf("blocked check must not run")
+			return checks.CheckOutcome{}
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "observe", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			if string(a.Data) != "snapshot" {
+				t.Fatalf("observe got Data=%q, want snapshot", string(a.Data))
+			}
+			return checks.OutcomeKeep(checks.Pass, "observe", "ok", a, "")
+		},
+	))
+
+	validator.Use(func(next validator.Step) validator.Step {
+		return func(ctx context.Context, unit checks.CheckUnit, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			if unit.Name() == "blocked" {
+				final := checks.FixResult{Data: []byte("snapshot"), DidChange: true}
+				return checks.OutcomeWithFinal(checks.Warn, unit.Name(), "access denied", final)
+			}
+			return next(ctx, unit, a, opts)
+		}
+	})
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Warn != 1 || sum.Pass != 1 {
+		t.Fatalf("counters mismatch: PASS=%d WARN=%d", sum.Pass, sum.Warn)
+	}
+	if string(sum.FinalData) != "snapshot" {
+		t.Fatalf("FinalData=%q, want snapshot", string(sum.FinalData))
+	}
+}
+
+func TestResetMiddleware_RemovesChain(t *testing.T) {
+	checks.Reset()
+	validator.ResetMiddleware()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(validator.ResetMiddleware)
+
+	called := false
+	validator.Use(func(next validator.Step) validator.Step {
+		called = true
+		return next
+	})
+	validator.ResetMiddleware()
+
+	_, _ = checks.Register(mkCheck(t, "only", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "only", "ok", a, "")
+		},
+	))
+
+	if _, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if called {
+		t.Fatalf("middleware was applied after ResetMiddleware")
+	}
+}
@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Pipeline is a self-contained validator with its own checks and options.
+// It does not read the global registry, so a vendor-provided base pipeline can be
+// run on its own or nested inside another run with AsCheck.
+type Pipeline struct {
+	units []checks.CheckUnit
+	opts  checks.RunOptions
+}
+
+// NewPipeline builds a pipeline from units, ordered by priority (ties by opts.TieBreak,
+// where TieBreakRegistration keeps the order given here). Nil units are ignored.
+func NewPipeline(opts checks.RunOptions, units ...checks.CheckUnit) *Pipeline {
+	p := &Pipeline{opts: opts}
+	for _, u := range units {
+		if u != nil {
+			p.units = append(p.units, u)
+		}
+	}
+	checks.SortUnits(p.units, opts.TieBreak)
+
+	return p
+}
+
+// Validate runs the pipeline's checks with the pipeline's options.
+func (p *Pipeline) Validate(
+	ctx context.Context,
+	filePath string,
+	data []byte,
+	langs []string,
+) (Summary, error) {
+	return run(ctx, p.units, filePath, data, langs, p.opts)
+}
+
+// AsCheck wraps the pipeline as a single check named name.
+// The nested outcomes are exposed as Children, the status is the worst nested status,
+// and the nested final artifact state and detection are propagated to the outer run.
+// The outer RunOptions are ignored: the pipeline always runs with its own.
+func (p *Pipeline) AsCheck(name string, opts ...checks.Option) (*checks.CheckAdapter, error) {
+	return checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		sum, err := p.Validate(ctx, a.Path, a.Data, a.Langs)
+
+		var detection *checks.Detection
+		if !sum.Detection.IsZero() {
+			detection = &sum.Detection
+		}
+
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{
+				Name:    name,
+				Status:  pipelineStatus(sum, err),
+				Message: pipelineMessage(sum, err),
+			},
+			Final: checks.FixResult{
+				Data:      sum.FinalData,
+				Path:      sum.FinalPath,
+				DidChange: sum.AppliedFixes,
+				Langs:     sum.FinalLangs,
+			},
+			Children:  sum.Outcomes,
+			Detection: detection,
+		}
+	}, opts...)
+}
+
+// pipelineStatus folds a nested summary into one status: ERROR > FAIL > WARN > INFO > PASS.
+// A pipeline whose nested checks were all skipped is SKIPPED.
+func pipelineStatus(sum Summary, err error) checks.Status {
+	var re *RunError
+	switch {
+	case sum.Error > 0:
+		return checks.Error
+	case err != nil && (!errors.As(err, &re) || re.Cause != nil || re.Context != nil):
+		return checks.Error
+	case sum.Fail > 0:
+		return checks.Fail
+	case sum.Warn > 0:
+		return checks.Warn
+	case sum.Info > 0:
+		return checks.Info
+	case sum.Skipped > 0 && sum.Skipped == len(sum.Outcomes):
+		return checks.Skipped
+	default:
+		return checks.Pass
+	}
+}
+
+func pipelineMessage(sum Summary, err error) string {
+	var b strings.Builder
+
+	b.WriteString(strconv.Itoa(len(sum.Outcomes)))
+	b.WriteString(" nested checks: ")
+	b.WriteString(strconv.Itoa(sum.Pass))
+	b.WriteString(" PASS, ")
+	b.WriteString(strconv.Itoa(sum.Warn))
+	b.WriteString(" WARN, ")
+	b.WriteString(strconv.Itoa(sum.Fail))
+	b.WriteString(" FAIL, ")
+	b.WriteString(strconv.Itoa(sum.Error))
+	b.WriteString(" ERROR")
+	if sum.Info > 0 {
+		b.WriteString(", ")
+		b.WriteString(strconv.Itoa(sum.Info))
+		b.WriteString(" INFO")
+	}
+	if sum.Skipped > 0 {
+		b.WriteString(", ")
+		b.WriteString(strconv.Itoa(sum.Skipped))
+		b.WriteString(" SKIPPED")
+	}
+
+	if sum.EarlyExit {
+		b.WriteString("; stopped early at ")
+		b.WriteString(strconv.Quote(sum.EarlyCheck))
+	}
+
+	if err != nil {
+		b.WriteString("; ")
+		b.WriteString(err.Error())
+	}
+
+	return b.String()
+}
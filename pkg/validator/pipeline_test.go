@@ -0,0 +1,172 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestPipeline_ValidateIgnoresGlobalRegistry(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "global", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			t.Fatalf("global check must not run inside a pipeline")
+			return checks.CheckOutcome{}
+		},
+	))
+
+	p := validator.NewPipeline(checks.RunOptions{TieBreak: checks.TieBreakRegistration},
+		mkCheck(t, "second", 1, false, passRun("second")),
+		nil,
+		mkCheck(t, "first", 0, false, passRun("first")),
+		mkCheck(t, "third", 1, false, passRun("third")),
+	)
+
+	sum, err := p.Validate(context.Background(), "file.csv", []byte("x"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"first", "second", "third"}; !reflect.DeepEqual(sum.Order, want) {
+		t.Fatalf("Order = %v, want %v", sum.Order, want)
+	}
+}
+
+func TestPipeline_AsCheckGroupsNestedOutcomes(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	base := validator.NewPipeline(checks.RunOptions{FixMode: checks.FixAlways},
+		mkCheck(t, "base-ok", 1, false, passRun("base-ok")),
+		mkCheck(t, "base-fix", 2, false,
+			func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+				if opts.FixMode != checks.FixAlways {
+					t.Fatalf("nested check must receive the pipeline options")
+				}
+				final := checks.FixResult{Data: []byte("fixed"), DidChange: true}
+				return checks.OutcomeWithFinal(checks.Warn, "base-fix", "rewrote", final)
+			},
+		),
+	)
+
+	unit, err := base.AsCheck("vendor-base", checks.WithPriority(1))
+	if err != nil {
+		t.Fatalf("AsCheck: %v", err)
+	}
+	_, _ = checks.Register(unit)
+	_, _ = checks.Register(mkCheck(t, "custom", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			if string(a.Data) != "fixed" {
+				t.Fatalf("outer check must see nested final data, got %q", string(a.Data))
+			}
+			return checks.OutcomeKeep(checks.Pass, "custom", "ok", a, "")
+		},
+	))
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Warn != 1 || sum.Pass != 1 {
+		t.Fatalf("outer counters: PASS=%d WARN=%d", sum.Pass, sum.Warn)
+	}
+
+	group := sum.Outcomes[0]
+	if group.Result.Name != "vendor-base" || group.Result.Status != checks.Warn {
+		t.Fatalf("group result = %+v", group.Result)
+	}
+	if !strings.Contains(group.Result.Message, "2 nested checks: 1 PASS, 1 WARN, 0 FAIL, 0 ERROR") {
+		t.Fatalf("group message = %q", group.Result.Message)
+	}
+	if len(group.Children) != 2 || group.Children[1].Result.Name != "base-fix" {
+		t.Fatalf("children = %+v", group.Children)
+	}
+	if !group.Final.DidChange || !sum.AppliedFixes {
+		t.Fatalf("nested fix must propagate as a change")
+	}
+}
+
+func TestPipeline_AsCheckFoldsWorstStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []checks.Status
+		hardFail bool
+		want     checks.Status
+	}{
+		{"all pass", []checks.Status{checks.Pass, checks.Pass}, false, checks.Pass},
+		{"fail beats warn", []checks.Status{checks.Warn, checks.Fail}, false, checks.Fail},
+		{"error beats fail", []checks.Status{checks.Fail, checks.Error}, false, checks.Error},
+		{"hard fail still error", []checks.Status{checks.Error, checks.Pass}, true, checks.Error},
+		{"info beats pass", []checks.Status{checks.Pass, checks.Info, checks.Skipped}, false, checks.Info},
+		{"warn beats info", []checks.Status{checks.Info, checks.Warn}, false, checks.Warn},
+		{"skipped with pass is pass", []checks.Status{checks.Skipped, checks.Pass}, false, checks.Pass},
+		{"all skipped", []checks.Status{checks.Skipped, checks.Skipped}, false, checks.Skipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var units []checks.CheckUnit
+			for i, st := range tt.statuses {
+				name := "n" + string(rune('a'+i))
+				units = append(units, mkCheck(t, name, i, false,
+					func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+						return checks.OutcomeKeep(st, name, "m", a, "")
+					},
+				))
+			}
+
+			unit, err := validator.NewPipeline(checks.RunOptions{HardFailOnErr: tt.hardFail}, units...).AsCheck("group")
+			if err != nil {
+				t.Fatalf("AsCheck: %v", err)
+			}
+
+			out := unit.Run(context.Background(), checks.Artifact{Data: []byte("x")}, checks.RunOptions{})
+			if out.Result.Status != tt.want {
+				t.Fatalf("Status = %s, want %s (%s)", out.Result.Status, tt.want, out.Result.Message)
+			}
+		})
+	}
+}
+
+func passRun(name string) func(context.Context, checks.Artifact, checks.RunOptions) checks.CheckOutcome {
+	return func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+	}
+}
+
+func TestPipeline_FixedLangsReachLaterChecks(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	var seen []string
+	p := validator.NewPipeline(checks.RunOptions{},
+		mkCheck(t, "clean-langs", 1, false,
+			func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+				final := checks.FixResult{Data: a.Data, Langs: []string{"en"}}
+				return checks.OutcomeWithFinal(checks.Pass, "clean-langs", "ok", final)
+			},
+		),
+		mkCheck(t, "reader", 2, false,
+			func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+				seen = a.Langs
+				return checks.OutcomeKeep(checks.Pass, "reader", "ok", a, "")
+			},
+		),
+	)
+
+	sum, err := p.Validate(context.Background(), "file.csv", []byte("x"), []string{"en", "EN"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"en"}; !reflect.DeepEqual(seen, want) || !reflect.DeepEqual(sum.FinalLangs, want) {
+		t.Fatalf("later check saw %v, FinalLangs %v; want %v", seen, sum.FinalLangs, want)
+	}
+	if sum.AppliedFixes {
+		t.Fatalf("langs-only change must not count as an applied fix")
+	}
+}
@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrUnknownProfile is returned by WithProfile for names that match no built-in profile.
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// Built-in profile names (see WithProfile).
+const (
+	ProfileStrict         = "strict"
+	ProfileLenient        = "lenient"
+	ProfileLokaliseImport = "lokalise-import"
+)
+
+// advisoryChecks report INFO on purpose; strict leaves their severity alone.
+var advisoryChecks = []string{"info-semicolon-cells", "warn-unused-terms"}
+
+// profiles builds the options of each built-in profile.
+var profiles = map[string]func() checks.RunOptions{
+	ProfileStrict: func() checks.RunOptions {
+		overrides := make(map[string]checks.Status)
+		for _, unit := range checks.List() {
+			name := unit.Name()
+			if !slices.Contains(advisoryChecks, name) {
+				overrides[name] = checks.Fail
+			}
+		}
+
+		return checks.RunOptions{
+			FixMode:           checks.FixNone,
+			HardFailOnErr:     true,
+			SeverityOverrides: overrides,
+		}
+	},
+	ProfileLenient: func() checks.RunOptions {
+		return checks.RunOptions{
+			FixMode:           checks.FixIfNotPass,
+			RerunAfterFix:     true,
+			SafeFixes:         true,
+			RecheckAfterFixes: true,
+			Skip: []string{
+				"info-semicolon-cells",
+				"warn-near-duplicate-terms",
+				"warn-trivial-terms",
+				"warn-unused-terms",
+			},
+			SeverityOverrides: map[string]checks.Status{
+				"ensure-tags-policy":                  checks.Warn,
+				"no-forbidden-non-translatable-terms": checks.Warn,
+				"no-invalid-flags":                    checks.Warn,
+			},
+		}
+	},
+	ProfileLokaliseImport: func() checks.RunOptions {
+		return checks.RunOptions{
+			FixMode:           checks.FixIfNotPass,
+			RerunAfterFix:     true,
+			AllowDestructive:  true,
+			SafeFixes:         true,
+			RecheckAfterFixes: true,
+			HardFailOnErr:     true,
+			Skip: []string{
+				"info-semicolon-cells",
+				"warn-double-spaces",
+				"warn-file-naming",
+				"warn-language-mismatch",
+				"warn-near-duplicate-terms",
+				"warn-nonstandard-hyphens",
+				"warn-term-overlaps",
+				"warn-trailing-term-punctuation",
+				"warn-translation-casing",
+				"warn-trivial-terms",
+				"warn-unused-terms",
+			},
+			SeverityOverrides: map[string]checks.Status{
+				"ensure-allowed-columns-header": checks.Fail,
+				"warn-duplicate-header-cells":   checks.Fail,
+				"warn-replacement-characters":   checks.Fail,
+			},
+		}
+	},
+}
+
+// Profiles returns the names of the built-in profiles, sorted.
+func Profiles() []string {
+	return slices.Sorted(maps.Keys(profiles))
+}
+
+// WithProfile returns the run options of a built-in profile (case-insensitive):
+//
+//   - "strict" validates without fixing, stops on the first ERROR and turns every
+//     WARN-level check into FAIL;
+//   - "lenient" applies safe fixes, skips the noisiest heuristics and downgrades
+//     policy checks (tags, flags, forbidden terms) to WARN;
+//   - "lokalise-import" fixes everything Lokalise would reject, including destructive
+//     fixes, fails on header problems and skips purely stylistic checks.
+//
+// Check names are resolved against the registry when WithProfile is called: checks that
+// are not registered yet are left out, so import the check packages first. The result
+// is a fresh value the caller may tune further, e.g. to set a CommentPrefix.
+func WithProfile(name string) (checks.RunOptions, error) {
+	build, ok := profiles[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return checks.RunOptions{}, fmt.Errorf("%w %q (known: %s)", ErrUnknownProfile, name, strings.Join(Profiles(), ", "))
+	}
+
+	opts := build()
+	opts.Skip = registeredOnly(opts.Skip)
+	maps.DeleteFunc(opts.SeverityOverrides, func(name string, _ checks.Status) bool {
+		_, ok := checks.Lookup(name)
+		return !ok
+	})
+
+	return opts, nil
+}
+
+func registeredOnly(names []string) []string {
+	return slices.DeleteFunc(names, func(name string) bool {
+		_, ok := checks.Lookup(name)
+		return !ok
+	})
+}
@@ -0,0 +1,106 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func registerProfileChecks(t *testing.T) {
+	t.Helper()
+
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	for i, name := range []string{"warn-double-spaces", "info-semicolon-cells", "no-invalid-flags"} {
+		_, _ = checks.Register(mkCheck(t, name, i+1, false,
+			func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+				return checks.OutcomeKeep(checks.Warn, name, "problem", a, "")
+			},
+		))
+	}
+}
+
+func TestWithProfile_Strict(t *testing.T) {
+	registerProfileChecks(t)
+
+	opts, err := validator.WithProfile(" Strict ")
+	if err != nil {
+		t.Fatalf("WithProfile: %v", err)
+	}
+	if opts.FixMode != checks.FixNone || !opts.HardFailOnErr {
+		t.Fatalf("strict must validate without fixing and stop on ERROR, got %+v", opts)
+	}
+
+	want := map[string]checks.Status{"warn-double-spaces": checks.Fail, "no-invalid-flags": checks.Fail}
+	if len(opts.SeverityOverrides) != len(want) {
+		t.Fatalf("SeverityOverrides = %v, want %v", opts.SeverityOverrides, want)
+	}
+	for name, st := range want {
+		if opts.SeverityOverrides[name] != st {
+			t.Fatalf("SeverityOverrides = %v, want %v", opts.SeverityOverrides, want)
+		}
+	}
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("term\na\n"), nil, opts)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if got := sum.Outcomes[0].Result.Status; got != checks.Fail {
+		t.Fatalf("first outcome = %s, want FAIL", got)
+	}
+}
+
+func TestWithProfile_LeavesOutUnregisteredChecks(t *testing.T) {
+	registerProfileChecks(t)
+
+	opts, err := validator.WithProfile(validator.ProfileLenient)
+	if err != nil {
+		t.Fatalf("WithProfile: %v", err)
+	}
+	if !slices.Equal(opts.Skip, []string{"info-semicolon-cells"}) {
+		t.Fatalf("Skip = %v, want only registered checks", opts.Skip)
+	}
+	if len(opts.SeverityOverrides) != 1 || opts.SeverityOverrides["no-invalid-flags"] != checks.Warn {
+		t.Fatalf("SeverityOverrides = %v, want only no-invalid-flags", opts.SeverityOverrides)
+	}
+
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		t.Fatalf("ResolveRun: %v", err)
+	}
+	if len(units) != 2 {
+		t.Fatalf("ResolveRun returned %d checks, want 2", len(units))
+	}
+}
+
+func TestWithProfile_ReturnsFreshOptions(t *testing.T) {
+	registerProfileChecks(t)
+
+	first, _ := validator.WithProfile(validator.ProfileLokaliseImport)
+	first.SeverityOverrides["no-invalid-flags"] = checks.Info
+
+	second, _ := validator.WithProfile(validator.ProfileLokaliseImport)
+	if _, ok := second.SeverityOverrides["no-invalid-flags"]; ok {
+		t.Fatal("changing returned options must not change the profile")
+	}
+	if !second.AllowDestructive || second.FixMode != checks.FixIfNotPass {
+		t.Fatalf("lokalise-import must fix, destructively included, got %+v", second)
+	}
+}
+
+func TestWithProfile_Unknown(t *testing.T) {
+	t.Parallel()
+
+	_, err := validator.WithProfile("paranoid")
+	if !errors.Is(err, validator.ErrUnknownProfile) {
+		t.Fatalf("err = %v, want ErrUnknownProfile", err)
+	}
+	if want := []string{"lenient", "lokalise-import", "strict"}; !slices.Equal(validator.Profiles(), want) {
+		t.Fatalf("Profiles() = %v, want %v", validator.Profiles(), want)
+	}
+}
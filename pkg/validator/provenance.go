@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// RowFate tells what became of one input CSV record through the fixes of a run.
+type RowFate struct {
+	Original int // 1-based record number in the input data (header included)
+	Final    int // 1-based record number in Summary.FinalData; 0 when the row did not survive
+
+	// DroppedBy names the check whose fix removed the row. A fix that rebuilds the whole
+	// table (e.g. transposition) ends the provenance of every row and is reported here too.
+	DroppedBy string
+
+	// ModifiedBy lists the checks whose fixes changed the row's cells, in run order.
+	ModifiedBy []string
+}
+
+// rowTracker follows input records through the data changes of a run.
+// Records are compared after parsing, so quoting and line ending changes do not count as edits.
+type rowTracker struct {
+	fates   []RowFate
+	live    []int // live[i] = index into fates of current record i; -1 when the record has no input origin
+	records [][]string
+}
+
+func newRowTracker(data []byte) *rowTracker {
+	records := parseRecords(data)
+
+	t := &rowTracker{
+		fates:   make([]RowFate, len(records)),
+		live:    make([]int, len(records)),
+		records: records,
+	}
+	for i := range records {
+		t.fates[i] = RowFate{Original: i + 1, Final: i + 1}
+		t.live[i] = i
+	}
+
+	return t
+}
+
+// apply records the change made by check when the data became next.
+//
+// Fixes do not report which rows they touched, so the mapping is inferred:
+//   - same record count: records keep their positions; differing records were modified;
+//   - fewer records: records that are gone were dropped, provided every remaining
+//     record survives unchanged and in order;
+//   - anything else: the table was rebuilt and no input row can be followed further.
+func (t *rowTracker) apply(check string, next []byte) {
+	records := parseRecords(next)
+
+	switch {
+	case len(records) == len(t.records):
+		for i, rec := range records {
+			if f := t.live[i]; f >= 0 && !slices.Equal(rec, t.records[i]) {
+				t.fates[f].ModifiedBy = append(t.fates[f].ModifiedBy, check)
+			}
+		}
+	case len(records) < len(t.records) && t.applyDrops(check, records):
+	default:
+		t.rebuild(check, len(records))
+	}
+
+	t.records = records
+
+	for i, f := range t.live {
+		if f >= 0 {
+			t.fates[f].Final = i + 1
+		}
+	}
+}
+
+// applyDrops matches records to the current ones in order and marks the unmatched ones dropped.
+// It changes nothing and returns false when some record cannot be matched.
+func (t *rowTracker) applyDrops(check string, records [][]string) bool {
+	keep := make([]bool, len(t.records))
+
+	j := 0
+	for i, rec := range t.records {
+		if j < len(records) && slices.Equal(rec, records[j]) {
+			keep[i] = true
+			j++
+		}
+	}
+	if j != len(records) {
+		return false
+	}
+
+	live := make([]int, 0, len(records))
+	for i, f := range t.live {
+		if keep[i] {
+			live = append(live, f)
+			continue
+		}
+		if f >= 0 {
+			t.dropFate(f, check)
+		}
+	}
+	t.live = live
+
+	return true
+}
+
+func (t *rowTracker) rebuild(check string, n int) {
+	for _, f := range t.live {
+		if f >= 0 {
+			t.dropFate(f, check)
+		}
+	}
+
+	t.live = make([]int, n)
+	for i := range t.live {
+		t.live[i] = -1
+	}
+}
+
+func (t *rowTracker) dropFate(f int, check string) {
+	t.fates[f].Final = 0
+	t.fates[f].DroppedBy = check
+}
+
+// parseRecords splits data into semicolon CSV records. Data that does not parse as CSV
+// (wrong encoding or delimiter before the fix that repairs it) falls back to its non-blank lines.
+func parseRecords(data []byte) [][]string {
+	data = checks.StripUTF8BOM(data)
+
+	records, err := checks.NewSemicolonCSVReader(data).ReadAll()
+	if err == nil {
+		return records
+	}
+
+	records = records[:0]
+	for _, line := range checks.Lines(data) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		records = append(records, []string{string(line)})
+	}
+
+	return records
+}
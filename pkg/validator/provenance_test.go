@@ -0,0 +1,95 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// mkRewrite registers a check whose fix replaces the data with out.
+func mkRewrite(t *testing.T, name string, prio int, out string) {
+	t.Helper()
+
+	_, _ = checks.Register(mkCheck(t, name, prio, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeWithFinal(checks.Pass, name, "fixed", checks.FixResult{
+				Data:      []byte(out),
+				Path:      a.Path,
+				DidChange: true,
+			})
+		},
+	))
+}
+
+func TestValidate_RowsTrackDropsAndEdits(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	in := "term;en\ncat;Cat\ndog;Dog\ncat;Cat\nbird;Bird\n"
+
+	// quoting and line endings alone are not edits
+	mkRewrite(t, "requote", 1, "term;en\r\n\"cat\";Cat\r\ndog;Dog\r\ncat;Cat\r\nbird;Bird\r\n")
+	mkRewrite(t, "lowercase", 2, "term;en\ncat;cat\ndog;Dog\ncat;cat\nbird;Bird\n")
+	mkRewrite(t, "dedupe", 3, "term;en\ncat;cat\ndog;Dog\nbird;Bird\n")
+	mkRewrite(t, "tag", 4, "term;en\ncat;cat\ndog;Dog\nbird;Bird!\n")
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte(in), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []validator.RowFate{
+		{Original: 1, Final: 1},
+		{Original: 2, Final: 2, ModifiedBy: []string{"lowercase"}},
+		{Original: 3, Final: 3},
+		{Original: 4, Final: 0, DroppedBy: "dedupe", ModifiedBy: []string{"lowercase"}},
+		{Original: 5, Final: 4, ModifiedBy: []string{"tag"}},
+	}
+	if !reflect.DeepEqual(sum.Rows, want) {
+		t.Fatalf("Rows mismatch:\n got %+v\nwant %+v", sum.Rows, want)
+	}
+}
+
+func TestValidate_RowsRebuiltTable(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	mkRewrite(t, "transpose", 1, "term;a;b;c\nen;A;B;C\n")
+	mkRewrite(t, "edit", 2, "term;a;b;c\nen;x;B;C\n")
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("term;en\na;A\nb;B\nc;C\n"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sum.Rows) != 4 {
+		t.Fatalf("expected one fate per input record, got %+v", sum.Rows)
+	}
+	for _, f := range sum.Rows {
+		if f.Final != 0 || f.DroppedBy != "transpose" || len(f.ModifiedBy) != 0 {
+			t.Fatalf("expected every row to end at the rebuild, got %+v", f)
+		}
+	}
+}
+
+func TestValidate_RowsNilWithoutChanges(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "keep", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "keep", "ok", a, "")
+		},
+	))
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("term\na\n"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Rows != nil {
+		t.Fatalf("expected nil Rows, got %+v", sum.Rows)
+	}
+}
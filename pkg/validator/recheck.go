@@ -0,0 +1,134 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// Regression is a check that passed during the run but no longer passes on FinalData
+// because a later fix changed the part of the file it inspects (see RunOptions.RecheckAfterFixes).
+type Regression struct {
+	// Check is the name of the regressed check.
+	Check string
+
+	// FixedBy lists the checks whose fixes touched its scope after it passed, in run order.
+	FixedBy []string
+
+	// Outcome is the re-run on the final artifact; fixes are never applied during it.
+	Outcome checks.CheckOutcome
+}
+
+// scopeChange tells which parts of the artifact one fix touched.
+type scopeChange struct {
+	file   bool // any change to the data or the path
+	header bool // the header record or the declared languages
+	rows   bool // anything after the header record
+}
+
+func (c scopeChange) touches(scope checks.Scope) bool {
+	switch scope {
+	case checks.ScopeHeader:
+		return c.header
+	case checks.ScopeRows:
+		return c.rows
+	default:
+		return c.file // file-scoped checks, and undeclared ones to be safe
+	}
+}
+
+func changeBetween(prev, next checks.Artifact) scopeChange {
+	var c scopeChange
+
+	if !bytes.Equal(prev.Data, next.Data) {
+		c.file = true
+
+		prevHeader, prevRows := splitHeader(prev.Data)
+		nextHeader, nextRows := splitHeader(next.Data)
+		c.header = !bytes.Equal(prevHeader, nextHeader)
+		c.rows = !bytes.Equal(prevRows, nextRows)
+	}
+	if prev.Path != next.Path {
+		c.file = true
+	}
+	if !slices.Equal(prev.Langs, next.Langs) {
+		c.header = true
+	}
+
+	return c
+}
+
+// splitHeader returns the first line (without BOM and line break) and everything after it.
+func splitHeader(data []byte) ([]byte, []byte) {
+	data = checks.StripUTF8BOM(data)
+
+	header, rows, _ := bytes.Cut(data, []byte("\n"))
+
+	return bytes.TrimSuffix(header, []byte("\r")), rows
+}
+
+type passedCheck struct {
+	unit checks.CheckUnit
+	pos  int // index of its outcome in Summary.Outcomes
+}
+
+type fixMark struct {
+	check  string
+	pos    int
+	change scopeChange
+}
+
+// touchedBy lists the fixes after p that changed its scope.
+func (s *runState) touchedBy(p passedCheck) []string {
+	scope := checks.CapabilitiesOf(p.unit).Scope
+
+	var out []string
+	for _, f := range s.fixes {
+		if f.pos > p.pos && f.change.touches(scope) {
+			out = append(out, f.check)
+		}
+	}
+
+	return out
+}
+
+// recheck re-runs every check that passed before a later fix touched its scope,
+// without fixing, and records those that no longer pass in Summary.Regressions.
+func (s *runState) recheck(ctx context.Context, step Step, opts checks.RunOptions) error {
+	if len(s.fixes) == 0 {
+		return nil
+	}
+
+	opts.FixMode = checks.FixNone
+	opts.RerunAfterFix = false
+	opts.PreviewFixes = false
+	opts.FindingsSidecar = nil
+	opts.MaxFailures = 0
+
+	for _, p := range s.passed {
+		fixedBy := s.touchedBy(p)
+		if len(fixedBy) == 0 {
+			continue
+		}
+
+		if err := contextError(ctx); err != nil {
+			s.markContextEarlyExit()
+			return err
+		}
+
+		outcome := overrideSeverity(p.unit, opts, runTimed(ctx, step, p.unit, s.artifact, opts))
+
+		switch outcome.Result.Status {
+		case checks.Warn, checks.Fail, checks.Error:
+			s.summary.Regressions = append(s.summary.Regressions, Regression{
+				Check:   p.unit.Name(),
+				FixedBy: fixedBy,
+				Outcome: outcome,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package validator_test
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// registerRecheckChecks registers:
+//   - "header-ok": header-scoped, fails when the header contains "bad";
+//   - "rows-ok": rows-scoped, fails when the data rows contain "bad";
+//   - "breaker": a later rows fix that writes "bad" into the rows (or the header, with breakHeader).
+func registerRecheckChecks(t *testing.T, breakHeader bool) map[string]int {
+	t.Helper()
+
+	runs := map[string]int{}
+	scoped := func(name string, prio int, scope checks.Scope, part func([]byte) []byte) {
+		u, err := checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			runs[name]++
+			if bytes.Contains(part(a.Data), []byte("bad")) {
+				return checks.OutcomeKeep(checks.Fail, name, "bad", a, "")
+			}
+			return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+		}, checks.WithPriority(prio), checks.WithScope(scope))
+		if err != nil {
+			t.Fatalf("NewCheckAdapter(%s): %v", name, err)
+		}
+		_, _ = checks.Register(u)
+	}
+
+	header := func(b []byte) []byte { h, _, _ := bytes.Cut(b, []byte("\n")); return h }
+	rows := func(b []byte) []byte { _, r, _ := bytes.Cut(b, []byte("\n")); return r }
+	scoped("header-ok", 1, checks.ScopeHeader, header)
+	scoped("rows-ok", 2, checks.ScopeRows, rows)
+
+	breaker, err := checks.NewCheckAdapter("breaker", func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+		runs["breaker"]++
+		if opts.FixMode == checks.FixNone || bytes.Contains(a.Data, []byte("bad")) {
+			return checks.OutcomeKeep(checks.Pass, "breaker", "ok", a, "")
+		}
+
+		data := append(bytes.Clone(a.Data), "bad;x\n"...)
+		if breakHeader {
+			data = append([]byte("bad;"), a.Data...)
+		}
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{Name: "breaker", Status: checks.Warn, Message: "rewritten"},
+			Final:  checks.FixResult{Data: data, Path: a.Path, DidChange: true},
+		}
+	}, checks.WithPriority(3), checks.WithScope(checks.ScopeRows), checks.WithFix())
+	if err != nil {
+		t.Fatalf("NewCheckAdapter(breaker): %v", err)
+	}
+	_, _ = checks.Register(breaker)
+
+	return runs
+}
+
+func TestValidate_RecheckAfterFixesReportsRegressions(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	runs := registerRecheckChecks(t, false)
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("term;description\na;b\n"), nil, checks.RunOptions{
+		FixMode:           checks.FixIfFailed,
+		RecheckAfterFixes: true,
+		VerifySummary:     true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sum.Pass != 2 || sum.Warn != 1 {
+		t.Fatalf("counters = %d PASS / %d WARN, want the first results 2 / 1", sum.Pass, sum.Warn)
+	}
+	if len(sum.Regressions) != 1 {
+		t.Fatalf("Regressions = %+v, want one", sum.Regressions)
+	}
+
+	r := sum.Regressions[0]
+	if r.Check != "rows-ok" || !reflect.DeepEqual(r.FixedBy, []string{"breaker"}) || r.Outcome.Result.Status != checks.Fail {
+		t.Fatalf("regression = %+v, want rows-ok FAIL fixed by breaker", r)
+	}
+
+	// The header was not touched, so header-ok is not re-run.
+	if runs["header-ok"] != 1 || runs["rows-ok"] != 2 {
+		t.Fatalf("runs = %v, want header-ok once and rows-ok twice", runs)
+	}
+}
+
+func TestValidate_RecheckAfterFixesFollowsTouchedScope(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	runs := registerRecheckChecks(t, true)
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("term;description\na;b\n"), nil, checks.RunOptions{
+		FixMode:           checks.FixIfFailed,
+		RecheckAfterFixes: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sum.Regressions) != 1 || sum.Regressions[0].Check != "header-ok" {
+		t.Fatalf("Regressions = %+v, want header-ok only", sum.Regressions)
+	}
+	if runs["rows-ok"] != 1 {
+		t.Fatalf("rows-ok ran %d times, want once (its scope was not touched)", runs["rows-ok"])
+	}
+}
+
+func TestValidate_RecheckIsOptIn(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	runs := registerRecheckChecks(t, false)
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("term;description\na;b\n"), nil, checks.RunOptions{
+		FixMode: checks.FixIfFailed,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sum.Regressions != nil {
+		t.Fatalf("Regressions = %+v, want nil without RecheckAfterFixes", sum.Regressions)
+	}
+	if runs["rows-ok"] != 1 {
+		t.Fatalf("rows-ok ran %d times, want once", runs["rows-ok"])
+	}
+}
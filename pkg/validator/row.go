@@ -0,0 +1,156 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// HeaderState is what ValidateRow reuses between calls: the header line of the file,
+// the declared languages and the row-scoped checks to run. Build it once per header
+// with NewHeaderState and again when the header changes. Safe for concurrent use.
+type HeaderState struct {
+	header    []byte // data up to and including the header line break
+	headerRec int    // CSV record number of the header
+	eol       []byte // line break of the header line
+	langs     []string
+	opts      checks.RunOptions
+	units     []checks.CheckUnit
+}
+
+// NewHeaderState prepares row validation for a file starting with data (the whole file,
+// or at least its header line). Checks are resolved now with opts (CheckSet, TieBreak,
+// PriorityOverrides): the registered checks scoped to rows (checks.ScopeRows).
+// Comment lines (opts.CommentPrefix) before the header are skipped.
+// A non-nil error is always a *RunError; ErrIncompleteHeader means data ends before
+// the header line does.
+func NewHeaderState(ctx context.Context, data []byte, langs []string, opts checks.RunOptions) (*HeaderState, error) {
+	line, ok := headerLine(data, opts.CommentPrefix)
+	if !ok {
+		return nil, configRunError(ErrIncompleteHeader)
+	}
+
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		return nil, configRunError(err)
+	}
+
+	rowUnits := units[:0]
+	for _, u := range units {
+		if checks.CapabilitiesOf(u).Scope == checks.ScopeRows {
+			rowUnits = append(rowUnits, u)
+		}
+	}
+
+	table, err := checks.TableOf(ctx, checks.Artifact{Data: line})
+	if err != nil {
+		return nil, configRunError(err)
+	}
+
+	eol := []byte("\n")
+	if bytes.HasSuffix(line, []byte("\r\n")) {
+		eol = []byte("\r\n")
+	}
+
+	return &HeaderState{
+		header:    line,
+		headerRec: len(table.Records),
+		eol:       eol,
+		langs:     slices.Clone(langs),
+		opts:      rowRunOptions(opts),
+		units:     rowUnits,
+	}, nil
+}
+
+// rowRunOptions keeps the knobs that change what row checks report and drops the ones
+// that only make sense for a whole run.
+func rowRunOptions(opts checks.RunOptions) checks.RunOptions {
+	return checks.RunOptions{
+		FixMode:            checks.FixNone,
+		HardFailOnErr:      opts.HardFailOnErr,
+		AllowDestructive:   opts.AllowDestructive,
+		PreviewFixes:       opts.PreviewFixes,
+		MaxFindings:        opts.MaxFindings,
+		PreserveHeaderCase: opts.PreserveHeaderCase,
+		CommentPrefix:      opts.CommentPrefix,
+		SourceStrings:      opts.SourceStrings,
+		Settings:           opts.Settings,
+		SeverityOverrides:  opts.SeverityOverrides,
+		CheckTimeout:       opts.CheckTimeout,
+		CheckTimeouts:      opts.CheckTimeouts,
+	}
+}
+
+// Checks lists the names of the checks ValidateRow runs, in execution order.
+func (hs *HeaderState) Checks() []string {
+	names := make([]string, len(hs.units))
+	for i, u := range hs.units {
+		names[i] = u.Name()
+	}
+
+	return names
+}
+
+// ValidateRow runs the row-scoped checks on one edited record, for editors and watchers
+// that cannot afford a full Validate per keystroke. row is the raw record (a missing line
+// break is added) and rowIndex its 1-based CSV record number in the file, header included.
+// The checks see the header line followed by row, never fixing, so checks that compare
+// rows with each other (duplicates) find nothing: run Validate for those.
+//
+// It returns the findings of that row with Row set to rowIndex, and Check and Severity
+// filled in when the check left them empty. A failing check without
+// findings contributes one finding with its message. A fail-fast check that fails stops
+// the remaining checks, as in Validate. A non-nil error is always a *RunError.
+func ValidateRow(ctx context.Context, hs *HeaderState, row []byte, rowIndex int) ([]checks.Finding, error) {
+	data := make([]byte, 0, len(hs.header)+len(row)+len(hs.eol))
+	data = append(data, hs.header...)
+	data = append(data, row...)
+	if !bytes.HasSuffix(row, []byte("\n")) {
+		data = append(data, hs.eol...)
+	}
+
+	sum, err := runUnits(ctx, hs.units, "", data, hs.langs, hs.opts)
+
+	var out []checks.Finding
+	for _, o := range sum.Outcomes {
+		out = hs.appendRowFindings(out, o, rowIndex)
+	}
+
+	return out, err
+}
+
+func (hs *HeaderState) appendRowFindings(out []checks.Finding, o checks.CheckOutcome, rowIndex int) []checks.Finding {
+	res := o.Result
+
+	switch {
+	case len(res.Findings) > 0:
+		for _, f := range res.Findings {
+			if f.Row <= hs.headerRec {
+				continue
+			}
+			f.Row = rowIndex + f.Row - hs.headerRec - 1
+			if f.Check == "" {
+				f.Check = res.Name
+			}
+			if f.Severity == "" {
+				f.Severity = res.Status
+			}
+			out = append(out, f)
+		}
+	case res.Status != checks.Pass && res.Status != checks.Skipped && len(o.Children) == 0:
+		out = append(out, checks.Finding{
+			Check:    res.Name,
+			Row:      rowIndex,
+			Message:  res.Message,
+			Severity: res.Status,
+		})
+	}
+
+	for _, child := range o.Children {
+		out = hs.appendRowFindings(out, child, rowIndex)
+	}
+
+	return out
+}
@@ -0,0 +1,162 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// registerRowChecks registers a row check that flags cells equal to "bad", a row check
+// that fails without findings on "oops" anywhere, and a header check that always warns.
+func registerRowChecks(t *testing.T) *[]string {
+	t.Helper()
+
+	var seen []string
+	register := func(name string, scope checks.Scope, run checks.CheckFunc) {
+		ch, err := checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			seen = append(seen, name+":"+string(a.Data))
+			return run(ctx, a, opts)
+		}, checks.WithScope(scope))
+		if err != nil {
+			t.Fatalf("NewCheckAdapter(%s): %v", name, err)
+		}
+		_, _ = checks.Register(ch)
+	}
+
+	register("bad-cells", checks.ScopeRows, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		table, _ := checks.TableOf(ctx, a)
+
+		var findings []checks.Finding
+		for i, rec := range table.Records {
+			for j, cell := range rec {
+				if cell == "bad" {
+					findings = append(findings, checks.Finding{Check: "bad-cells", Row: i + 1, Field: j + 1, Value: cell, Message: "bad cell"})
+				}
+			}
+		}
+		if len(findings) == 0 {
+			return checks.OutcomeKeep(checks.Pass, "bad-cells", "ok", a, "")
+		}
+
+		out := checks.OutcomeKeep(checks.Fail, "bad-cells", "bad cells", a, "")
+		out.Result.Findings = findings
+		return out
+	})
+	register("no-oops", checks.ScopeRows, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		if strings.Contains(string(a.Data), "oops") {
+			return checks.OutcomeKeep(checks.Warn, "no-oops", "oops found", a, "")
+		}
+		return checks.OutcomeKeep(checks.Pass, "no-oops", "ok", a, "")
+	})
+	register("header-check", checks.ScopeHeader, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.OutcomeKeep(checks.Warn, "header-check", "odd header", a, "")
+	})
+
+	return &seen
+}
+
+func TestValidateRow_ReportsFindingsOfTheRow(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+	seen := registerRowChecks(t)
+
+	hs, err := validator.NewHeaderState(context.Background(), []byte("\r\nterm;en\r\ncloud;clo\r\n"), []string{"en"}, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("NewHeaderState: %v", err)
+	}
+	if got := hs.Checks(); !reflect.DeepEqual(got, []string{"bad-cells", "no-oops"}) {
+		t.Fatalf("Checks() = %v", got)
+	}
+
+	findings, err := validator.ValidateRow(context.Background(), hs, []byte("bad;oops"), 7)
+	if err != nil {
+		t.Fatalf("ValidateRow: %v", err)
+	}
+
+	want := []checks.Finding{
+		{Check: "bad-cells", Row: 7, Field: 1, Value: "bad", Message: "bad cell", Severity: checks.Fail},
+		{Check: "no-oops", Row: 7, Message: "oops found", Severity: checks.Warn},
+	}
+	if !reflect.DeepEqual(findings, want) {
+		t.Fatalf("findings = %+v, want %+v", findings, want)
+	}
+
+	wantSeen := []string{"bad-cells:\r\nterm;en\r\nbad;oops\r\n", "no-oops:\r\nterm;en\r\nbad;oops\r\n"}
+	if !reflect.DeepEqual(*seen, wantSeen) {
+		t.Fatalf("checks saw %q, want %q", *seen, wantSeen)
+	}
+}
+
+func TestValidateRow_CleanRowAndHeaderFindings(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+	registerRowChecks(t)
+
+	// a header cell equal to "bad" is not part of the row
+	hs, err := validator.NewHeaderState(context.Background(), []byte("term;bad\n"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("NewHeaderState: %v", err)
+	}
+
+	findings, err := validator.ValidateRow(context.Background(), hs, []byte("cloud;nuage\n"), 2)
+	if err != nil || findings != nil {
+		t.Fatalf("expected no findings, got %+v, %v", findings, err)
+	}
+}
+
+func TestValidateRow_SkipsCommentLinesBeforeHeader(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+	seen := registerRowChecks(t)
+
+	comments, err := checks.NewCheckAdapter("comment-rows", func(_ context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+		if len(checks.FindCommentLines(a.Data, opts.CommentPrefix)) > 0 {
+			return checks.OutcomeKeep(checks.Warn, "comment-rows", "comment lines found", a, "")
+		}
+		return checks.OutcomeKeep(checks.Pass, "comment-rows", "ok", a, "")
+	}, checks.WithScope(checks.ScopeRows), checks.WithComments())
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+	_, _ = checks.Register(comments)
+
+	opts := checks.RunOptions{CommentPrefix: "#"}
+	hs, err := validator.NewHeaderState(context.Background(), []byte("\xEF\xBB\xBF# exported\n\n# by tool\nterm;en\n"), nil, opts)
+	if err != nil {
+		t.Fatalf("NewHeaderState: %v", err)
+	}
+
+	findings, err := validator.ValidateRow(context.Background(), hs, []byte("bad;x"), 5)
+	if err != nil {
+		t.Fatalf("ValidateRow: %v", err)
+	}
+
+	want := []checks.Finding{{Check: "bad-cells", Row: 5, Field: 1, Value: "bad", Message: "bad cell", Severity: checks.Fail}}
+	if !reflect.DeepEqual(findings, want) {
+		t.Fatalf("findings = %+v, want %+v", findings, want)
+	}
+	if got := (*seen)[0]; got != "bad-cells:\xEF\xBB\xBF\nterm;en\nbad;x\n" {
+		t.Fatalf("checks saw %q, want the header without comment lines", got)
+	}
+}
+
+func TestNewHeaderState_Errors(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, err := validator.NewHeaderState(context.Background(), []byte("term;en"), nil, checks.RunOptions{})
+	var re *validator.RunError
+	if !errors.As(err, &re) || !errors.Is(err, validator.ErrIncompleteHeader) {
+		t.Fatalf("expected ErrIncompleteHeader, got %v", err)
+	}
+
+	_, err = validator.NewHeaderState(context.Background(), []byte("term;en\n"), nil, checks.RunOptions{CheckSet: "missing"})
+	if !errors.Is(err, checks.ErrUnknownCheckSet) {
+		t.Fatalf("expected ErrUnknownCheckSet, got %v", err)
+	}
+}
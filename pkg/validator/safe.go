@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrFixLostCells is the Err of an outcome RunOptions.SafeFixes rejected: the fix
+// removed non-empty cells although the check does not declare a lossy or destructive fix.
+var ErrFixLostCells = errors.New("fix removed non-empty cells")
+
+// guardFix rejects outcome when its fix lost non-empty cells of prev without declaring
+// it (checks.Capabilities Destructive or Lossy). The rejected outcome is an ERROR that
+// keeps prev; the original diagnosis moves to PreFixMessage and PreFixFindings.
+// prev that is not valid UTF-8 is not compared: its cells cannot be counted before it is
+// transcoded, which is what the fix (ensure-utf8-encoding) does.
+func guardFix(ctx context.Context, unit checks.CheckUnit, prev checks.Artifact, outcome checks.CheckOutcome) checks.CheckOutcome {
+	final := outcome.Final
+	if !final.DidChange || final.Data == nil {
+		return outcome
+	}
+	if caps := checks.CapabilitiesOf(unit); caps.Destructive || caps.Lossy {
+		return outcome
+	}
+	if !utf8.Valid(prev.Data) {
+		return outcome
+	}
+
+	before, err := nonEmptyCells(ctx, prev)
+	if err != nil {
+		return outcome
+	}
+	after, err := nonEmptyCells(ctx, checks.Artifact{Data: final.Data, Cache: prev.Cache})
+	if err != nil || after >= before {
+		return outcome
+	}
+
+	res := outcome.Result
+	return checks.CheckOutcome{
+		Result: checks.CheckResult{
+			Name:   res.Name,
+			Status: checks.Error,
+			Message: fmt.Sprintf("fix rejected: it removed %d non-empty cells (%d before, %d after); original data kept",
+				before-after, before, after),
+			Err:            ErrFixLostCells,
+			PreFixMessage:  res.Message,
+			PreFixFindings: res.Findings,
+		},
+		Final:     checks.FixResult{Data: prev.Data, Path: prev.Path},
+		Children:  outcome.Children,
+		Detection: outcome.Detection,
+	}
+}
+
+// nonEmptyCells counts the cells of a's table that are not blank (see checks.IsBlankUnicode).
+func nonEmptyCells(ctx context.Context, a checks.Artifact) (int, error) {
+	table, err := checks.TableOf(ctx, a)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, rec := range table.Records {
+		for _, cell := range rec {
+			if !checks.IsBlankUnicode([]byte(cell)) {
+				n++
+			}
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,125 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// dropLastRow fixes by cutting the last data row, losing its cells.
+func dropLastRow(t *testing.T, opts ...checks.Option) checks.CheckUnit {
+	t.Helper()
+
+	u, err := checks.NewCheckAdapter("drop-last-row", func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{Name: "drop-last-row", Status: checks.Warn, Message: "dropped a row"},
+			Final:  checks.FixResult{Data: []byte("term;description\na;b\n"), DidChange: true, Note: "dropped"},
+		}
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	return u
+}
+
+const safeInput = "term;description\na;b\nc;d\n"
+
+func TestSafeFixes_RejectsUndeclaredCellLoss(t *testing.T) {
+	t.Parallel()
+
+	p := validator.NewPipeline(checks.RunOptions{FixMode: checks.FixIfFailed, SafeFixes: true, VerifySummary: true}, dropLastRow(t, checks.WithFix()))
+
+	sum, err := p.Validate(context.Background(), "file.csv", []byte(safeInput), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	o := sum.Outcomes[0]
+	if o.Result.Status != checks.Error || !errors.Is(o.Result.Err, validator.ErrFixLostCells) {
+		t.Fatalf("outcome = %+v, want ERROR with ErrFixLostCells", o.Result)
+	}
+	if want := "fix rejected: it removed 2 non-empty cells (6 before, 4 after); original data kept"; o.Result.Message != want {
+		t.Fatalf("Message = %q, want %q", o.Result.Message, want)
+	}
+	if o.Result.PreFixMessage != "dropped a row" {
+		t.Fatalf("PreFixMessage = %q, want the original message", o.Result.PreFixMessage)
+	}
+	if sum.AppliedFixes || string(sum.FinalData) != safeInput {
+		t.Fatalf("original data must be kept, got AppliedFixes=%v data=%q", sum.AppliedFixes, sum.FinalData)
+	}
+}
+
+func TestSafeFixes_AllowsDeclaredLoss(t *testing.T) {
+	t.Parallel()
+
+	for name, opt := range map[string]checks.Option{
+		"lossy":       checks.WithLossyFix(),
+		"destructive": checks.WithDestructiveFix(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := validator.NewPipeline(checks.RunOptions{FixMode: checks.FixIfFailed, SafeFixes: true}, dropLastRow(t, opt))
+
+			sum, err := p.Validate(context.Background(), "file.csv", []byte(safeInput), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sum.Outcomes[0].Result.Status != checks.Warn || !sum.AppliedFixes {
+				t.Fatalf("declared loss must pass through, got %+v", sum.Outcomes[0].Result)
+			}
+		})
+	}
+}
+
+func TestSafeFixes_OffByDefault(t *testing.T) {
+	t.Parallel()
+
+	p := validator.NewPipeline(checks.RunOptions{FixMode: checks.FixIfFailed}, dropLastRow(t, checks.WithFix()))
+
+	sum, err := p.Validate(context.Background(), "file.csv", []byte(safeInput), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Outcomes[0].Result.Status != checks.Warn || string(sum.FinalData) != "term;description\na;b\n" {
+		t.Fatalf("without SafeFixes the fix applies, got %+v", sum.Outcomes[0].Result)
+	}
+}
+
+func TestSafeFixes_AllowsTranscodingFromUTF16(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{0xFF, 0xFE}
+	for _, u := range utf16.Encode([]rune(safeInput)) {
+		data = append(data, byte(u), byte(u>>8))
+	}
+
+	u, err := checks.NewCheckAdapter("to-utf8", func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		units := make([]uint16, 0, len(a.Data)/2)
+		for i := 2; i+1 < len(a.Data); i += 2 {
+			units = append(units, uint16(a.Data[i])|uint16(a.Data[i+1])<<8)
+		}
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{Name: "to-utf8", Status: checks.Warn, Message: "re-encoded"},
+			Final:  checks.FixResult{Data: []byte(string(utf16.Decode(units))), DidChange: true, Note: "re-encoded"},
+		}
+	}, checks.WithFix())
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	p := validator.NewPipeline(checks.RunOptions{FixMode: checks.FixIfFailed, SafeFixes: true}, u)
+
+	sum, err := p.Validate(context.Background(), "file.csv", data, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Outcomes[0].Result.Status != checks.Warn || !sum.AppliedFixes || string(sum.FinalData) != safeInput {
+		t.Fatalf("transcoding must pass through, got %+v data=%q", sum.Outcomes[0].Result, sum.FinalData)
+	}
+}
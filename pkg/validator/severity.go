@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// overrideSeverity applies RunOptions.SeverityOverrides to the outcome of unit.
+// Only INFO, WARN and FAIL results are changed; findings that carried the old status
+// explicitly get the new one, and the message records the change.
+func overrideSeverity(unit checks.CheckUnit, opts checks.RunOptions, outcome checks.CheckOutcome) checks.CheckOutcome {
+	to, ok := opts.SeverityOverride(unit.Name())
+	if !ok {
+		return outcome
+	}
+
+	res := outcome.Result
+	from := res.Status
+	switch from {
+	case checks.Info, checks.Warn, checks.Fail:
+	default:
+		return outcome
+	}
+	if from == to {
+		return outcome
+	}
+
+	res.Status = to
+	res.Message += " (severity " + string(from) + " overridden to " + string(to) + ")"
+	if len(res.Findings) > 0 {
+		findings := make([]checks.Finding, len(res.Findings))
+		for i, f := range res.Findings {
+			if f.Severity == from {
+				f.Severity = to
+			}
+			findings[i] = f
+		}
+		res.Findings = findings
+	}
+
+	outcome.Result = res
+
+	return outcome
+}
@@ -0,0 +1,106 @@
+package validator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// reportAs returns a check that always reports st with one finding carrying st explicitly
+// and one leaving its severity to the result.
+func reportAs(t *testing.T, name string, st checks.Status, opts ...checks.Option) checks.CheckUnit {
+	t.Helper()
+
+	u, err := checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{
+				Name:    name,
+				Status:  st,
+				Message: "problem",
+				Findings: []checks.Finding{
+					{Row: 2, Severity: st},
+					{Row: 3},
+				},
+			},
+		}
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	return u
+}
+
+func TestSeverityOverrides_ChangeStatusAndFindings(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		SeverityOverrides: map[string]checks.Status{"Dupes": checks.Fail, "flags": checks.Warn},
+		VerifySummary:     true,
+	}
+	p := validator.NewPipeline(opts,
+		reportAs(t, "dupes", checks.Warn, checks.WithPriority(1)),
+		reportAs(t, "flags", checks.Fail, checks.WithPriority(2)),
+		reportAs(t, "other", checks.Warn, checks.WithPriority(3)),
+	)
+
+	sum, err := p.Validate(context.Background(), "file.csv", []byte("term\na\nb\n"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sum.Fail != 1 || sum.Warn != 2 {
+		t.Fatalf("counts = %d FAIL, %d WARN; want 1, 2", sum.Fail, sum.Warn)
+	}
+
+	dupes := sum.Outcomes[0].Result
+	if dupes.Status != checks.Fail || dupes.Message != "problem (severity WARN overridden to FAIL)" {
+		t.Fatalf("dupes = %+v", dupes)
+	}
+	if dupes.Findings[0].Severity != checks.Fail || dupes.Findings[1].Severity != "" {
+		t.Fatalf("findings = %+v", dupes.Findings)
+	}
+
+	if flags := sum.Outcomes[1].Result; flags.Status != checks.Warn {
+		t.Fatalf("flags status = %s, want WARN", flags.Status)
+	}
+	if other := sum.Outcomes[2].Result; other.Status != checks.Warn || other.Message != "problem" {
+		t.Fatalf("other = %+v", other)
+	}
+}
+
+func TestSeverityOverrides_KeepPassAndError(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{SeverityOverrides: map[string]checks.Status{"ok": checks.Fail, "broken": checks.Warn}}
+	p := validator.NewPipeline(opts,
+		reportAs(t, "ok", checks.Pass, checks.WithPriority(1)),
+		reportAs(t, "broken", checks.Error, checks.WithPriority(2)),
+	)
+
+	sum, _ := p.Validate(context.Background(), "file.csv", []byte("term\na\n"), nil)
+
+	if sum.Outcomes[0].Result.Status != checks.Pass || sum.Outcomes[1].Result.Status != checks.Error {
+		t.Fatalf("statuses = %s, %s; want PASS, ERROR", sum.Outcomes[0].Result.Status, sum.Outcomes[1].Result.Status)
+	}
+}
+
+func TestSeverityOverrides_UpgradeStopsFailFast(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{SeverityOverrides: map[string]checks.Status{"first": checks.Fail}}
+	p := validator.NewPipeline(opts,
+		reportAs(t, "first", checks.Warn, checks.WithPriority(1), checks.WithFailFast()),
+		reportAs(t, "second", checks.Warn, checks.WithPriority(2)),
+	)
+
+	sum, err := p.Validate(context.Background(), "file.csv", []byte("term\na\n"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sum.EarlyExit || sum.EarlyCheck != "first" || len(sum.Outcomes) != 1 {
+		t.Fatalf("expected the run to stop at first, got %+v", sum)
+	}
+}
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
@@ -9,32 +10,72 @@ import (
 type runState struct {
 	summary  Summary
 	artifact checks.Artifact
+	failures int         // FAIL findings so far, for RunOptions.MaxFailures
+	rows     *rowTracker // started by the first data change
+
+	// For RunOptions.RecheckAfterFixes: passing checks and the fixes that followed them.
+	passed []passedCheck
+	fixes  []fixMark
 }
 
 func newRunState(filePath string, data []byte, langs []string) runState {
+	summary := newSummary(filePath, data, langs)
+
 	return runState{
-		summary: newSummary(filePath, data),
+		summary: summary,
 		artifact: checks.Artifact{
-			Data:  data,
+			Data:  summary.FinalData,
 			Path:  filePath,
 			Langs: langs,
+			Cache: checks.NewParseCache(),
 		},
 	}
 }
 
+func (s *runState) setOrder(units []checks.CheckUnit) {
+	s.summary.Order = make([]string, len(units))
+	for i, unit := range units {
+		s.summary.Order[i] = unit.Name()
+	}
+}
+
 func (s *runState) runCheck(
 	ctx context.Context,
+	step Step,
 	unit checks.CheckUnit,
 	opts checks.RunOptions,
 ) checks.CheckOutcome {
-	outcome := unit.Run(ctx, s.artifact, opts)
+	prev := s.artifact
+
+	outcome := runTimed(ctx, step, unit, s.artifact, opts)
+	if opts.SafeFixes {
+		outcome = guardFix(ctx, unit, prev, outcome)
+	}
+	outcome = overrideSeverity(unit, opts, outcome)
+	outcome = opts.FindingsSidecar.Record(outcome)
 
 	s.recordOutcome(outcome)
 	s.applyFinal(outcome)
 
+	if opts.RecheckAfterFixes {
+		s.markForRecheck(unit, outcome, prev)
+	}
+
 	return outcome
 }
 
+func (s *runState) markForRecheck(unit checks.CheckUnit, outcome checks.CheckOutcome, prev checks.Artifact) {
+	pos := len(s.summary.Outcomes) - 1
+
+	if outcome.Result.Status == checks.Pass {
+		s.passed = append(s.passed, passedCheck{unit: unit, pos: pos})
+	}
+
+	if change := changeBetween(prev, s.artifact); change != (scopeChange{}) {
+		s.fixes = append(s.fixes, fixMark{check: unit.Name(), pos: pos, change: change})
+	}
+}
+
 func (s *runState) recordOutcome(outcome checks.CheckOutcome) {
 	switch outcome.Result.Status {
 	case checks.Pass:
@@ -45,9 +86,38 @@ func (s *runState) recordOutcome(outcome checks.CheckOutcome) {
 		s.summary.Fail++
 	case checks.Error:
 		s.summary.Error++
+	case checks.Info:
+		s.summary.Info++
+	case checks.Skipped:
+		s.summary.Skipped++
 	}
 
+	if outcome.Result.Status == checks.Fail {
+		s.failures += max(len(outcome.Result.Findings), 1)
+	}
+	if outcome.Result.Truncated {
+		s.summary.Truncated = true
+	}
+
+	s.summary.Detection.Merge(outcome.Detection)
 	s.summary.Outcomes = append(s.summary.Outcomes, outcome)
+	s.groupFindings(outcome)
+}
+
+func (s *runState) groupFindings(outcome checks.CheckOutcome) {
+	for _, f := range outcome.Result.Findings {
+		if f.Row <= 0 {
+			continue
+		}
+		if s.summary.FindingsByRow == nil {
+			s.summary.FindingsByRow = make(map[int][]checks.Finding)
+		}
+		s.summary.FindingsByRow[f.Row] = append(s.summary.FindingsByRow[f.Row], f)
+	}
+
+	for _, child := range outcome.Children {
+		s.groupFindings(child)
+	}
 }
 
 func (s *runState) applyFinal(outcome checks.CheckOutcome) {
@@ -58,6 +128,7 @@ func (s *runState) applyFinal(outcome checks.CheckOutcome) {
 	}
 
 	if final.Data != nil {
+		s.trackRows(outcome.Result.Name, final.Data)
 		s.artifact.Data = final.Data
 	}
 	s.summary.FinalData = s.artifact.Data
@@ -66,6 +137,24 @@ func (s *runState) applyFinal(outcome checks.CheckOutcome) {
 		s.artifact.Path = final.Path
 	}
 	s.summary.FinalPath = s.artifact.Path
+
+	if final.Langs != nil {
+		s.artifact.Langs = final.Langs
+	}
+	s.summary.FinalLangs = s.artifact.Langs
+}
+
+// trackRows updates Summary.Rows when the check replaced the data with different content.
+func (s *runState) trackRows(check string, next []byte) {
+	if bytes.Equal(next, s.artifact.Data) {
+		return
+	}
+
+	if s.rows == nil {
+		s.rows = newRowTracker(s.artifact.Data)
+	}
+	s.rows.apply(check, next)
+	s.summary.Rows = s.rows.fates
 }
 
 func (s *runState) markEarlyExit(unit checks.CheckUnit, outcome checks.CheckOutcome) {
@@ -74,6 +163,13 @@ func (s *runState) markEarlyExit(unit checks.CheckUnit, outcome checks.CheckOutc
 	s.summary.EarlyStatus = outcome.Result.Status
 }
 
+func (s *runState) markFailureBudgetExit() {
+	s.summary.EarlyExit = true
+	s.summary.EarlyCheck = "max failures reached"
+	s.summary.EarlyStatus = checks.Fail
+	s.summary.Truncated = true
+}
+
 func (s *runState) markContextEarlyExit() {
 	s.summary.EarlyExit = true
 	s.summary.EarlyCheck = "context canceled"
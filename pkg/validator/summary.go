@@ -11,27 +11,72 @@ type Summary struct {
 	Warn     int
 	Fail     int
 	Error    int
+	Info     int
+	Skipped  int
+
+	// Order lists every check name in the order the run planned to execute them.
+	// It is deterministic for a given registry and TieBreak; on early exit only a prefix ran.
+	Order []string
+
+	// Detection gathers what the encoding and delimiter checks concluded about the input.
+	// Fields stay empty when the check that fills them did not run.
+	Detection checks.Detection
 
 	// Per-check combined outcomes in execution order.
 	Outcomes []checks.CheckOutcome
 
+	// FindingsByRow groups every row-level finding (nested outcomes included) by CSV
+	// record number, in execution order, so a UI can show all problems of a row at once.
+	// Findings not tied to a row are left out. Nil when no check reported any.
+	FindingsByRow map[int][]checks.Finding
+
 	// Early-exit info when a fail-fast check stops the pipeline.
 	EarlyExit   bool
 	EarlyCheck  string
 	EarlyStatus checks.Status
 
+	// Truncated is set when results are partial because of RunOptions.MaxFailures:
+	// a check stopped scanning early, or the run ended once the budget was spent.
+	Truncated bool
+
+	// Partial is set by ValidateHeader: only the header was available, and only
+	// header-scoped checks ran.
+	Partial bool
+
 	// Fix pipeline outcome (always populated):
 	// - when fixes are applied: final state after sequential fix pipeline
 	// - when not: echoes original input
 	AppliedFixes bool
 	FinalData    []byte
 	FinalPath    string
+
+	// Rows maps every input CSV record to its record in FinalData, or to the check whose
+	// fix dropped it, with the checks that modified it on the way. Indexed by input record
+	// number minus one. Nil when no check changed the data.
+	Rows []RowFate
+
+	// Regressions lists checks that passed during the run but fail (or warn) on FinalData
+	// after later fixes changed what they inspect. Only filled with RunOptions.RecheckAfterFixes;
+	// the counters above keep the first results.
+	Regressions []Regression
+
+	// FinalLangs is the declared language list after checks normalized it
+	// (echoes the input when nothing changed it).
+	FinalLangs []string
+
+	// Languages compares FinalLangs with the locale columns of FinalData's header.
+	Languages Languages
 }
 
-func newSummary(filePath string, data []byte) Summary {
+func newSummary(filePath string, data []byte, langs []string) Summary {
+	if data == nil {
+		data = []byte{} // FinalData is never nil (see Verify)
+	}
+
 	return Summary{
-		FilePath:  filePath,
-		FinalData: data,
-		FinalPath: filePath,
+		FilePath:   filePath,
+		FinalData:  data,
+		FinalPath:  filePath,
+		FinalLangs: langs,
 	}
 }
@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrCheckTimeout is the Err of an outcome whose check ran past its time budget
+// (RunOptions.CheckTimeout or CheckTimeouts). It also matches context.DeadlineExceeded.
+var ErrCheckTimeout = errors.New("check timed out")
+
+// runTimed runs unit through step under its time budget. When the budget, not ctx,
+// ended a check that reported ERROR, the outcome is replaced by a timeout ERROR that
+// keeps a, so a fix cut off halfway never reaches the next check.
+func runTimed(
+	ctx context.Context,
+	step Step,
+	unit checks.CheckUnit,
+	a checks.Artifact,
+	opts checks.RunOptions,
+) checks.CheckOutcome {
+	budget := opts.CheckTimeoutFor(unit.Name())
+	if budget <= 0 {
+		return step(ctx, unit, a, opts)
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	outcome := step(cctx, unit, a, opts)
+	if outcome.Result.Status != checks.Error || cctx.Err() == nil || ctx.Err() != nil {
+		return outcome
+	}
+
+	res := outcome.Result
+	msg := fmt.Sprintf("check timed out after %s", budget)
+	if res.Message != "" {
+		msg += ": " + res.Message
+	}
+
+	return checks.CheckOutcome{
+		Result: checks.CheckResult{
+			Name:    res.Name,
+			Status:  checks.Error,
+			Message: msg,
+			Err:     fmt.Errorf("%w after %s: %w", ErrCheckTimeout, budget, context.DeadlineExceeded),
+		},
+		Final:     checks.FixResult{Data: a.Data, Path: a.Path},
+		Children:  outcome.Children,
+		Detection: outcome.Detection,
+	}
+}
@@ -0,0 +1,89 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+// waitForContext blocks until its context is done and reports the cancellation
+// the way built-in checks do; it never returns on its own.
+func waitForContext(t *testing.T, name string, opts ...checks.Option) checks.CheckUnit {
+	t.Helper()
+
+	u, err := checks.NewCheckAdapter(name, func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		<-ctx.Done()
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{Name: name, Status: checks.Error, Message: "validation cancelled", Err: ctx.Err()},
+			Final:  checks.FixResult{Data: []byte("half-fixed"), DidChange: true},
+		}
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewCheckAdapter: %v", err)
+	}
+
+	return u
+}
+
+func TestCheckTimeout_SlowCheckReportsErrorAndRunContinues(t *testing.T) {
+	t.Parallel()
+
+	p := validator.NewPipeline(checks.RunOptions{CheckTimeout: 20 * time.Millisecond, VerifySummary: true},
+		waitForContext(t, "slow", checks.WithPriority(1)),
+		reportAs(t, "next", checks.Warn, checks.WithPriority(2)),
+	)
+
+	const input = "term\na\n"
+	sum, err := p.Validate(context.Background(), "file.csv", []byte(input), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	slow := sum.Outcomes[0].Result
+	if slow.Status != checks.Error || !errors.Is(slow.Err, validator.ErrCheckTimeout) || !errors.Is(slow.Err, context.DeadlineExceeded) {
+		t.Fatalf("slow = %+v, want ERROR with ErrCheckTimeout", slow)
+	}
+	if want := "check timed out after 20ms: validation cancelled"; slow.Message != want {
+		t.Fatalf("Message = %q, want %q", slow.Message, want)
+	}
+	if len(sum.Outcomes) != 2 || sum.Outcomes[1].Result.Status != checks.Warn {
+		t.Fatalf("the run must go on after a timeout, got %d outcomes", len(sum.Outcomes))
+	}
+	if sum.AppliedFixes || string(sum.FinalData) != input {
+		t.Fatalf("a timed-out check must not change the data, got %q", sum.FinalData)
+	}
+}
+
+func TestCheckTimeouts_OverrideBudget(t *testing.T) {
+	t.Parallel()
+
+	opts := checks.RunOptions{
+		CheckTimeout:  time.Hour,
+		CheckTimeouts: map[string]time.Duration{"SLOW": 10 * time.Millisecond},
+	}
+	p := validator.NewPipeline(opts, waitForContext(t, "slow"))
+
+	sum, _ := p.Validate(context.Background(), "file.csv", []byte("term\na\n"), nil)
+	if res := sum.Outcomes[0].Result; !strings.HasPrefix(res.Message, "check timed out after 10ms") {
+		t.Fatalf("Message = %q, want the per-check budget", res.Message)
+	}
+}
+
+func TestCheckTimeout_RunCancellationIsNotATimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	p := validator.NewPipeline(checks.RunOptions{CheckTimeout: time.Hour}, waitForContext(t, "slow"))
+
+	sum, _ := p.Validate(ctx, "file.csv", []byte("term\na\n"), nil)
+	if res := sum.Outcomes[0].Result; errors.Is(res.Err, validator.ErrCheckTimeout) {
+		t.Fatalf("the run's own deadline must not be reported as a check timeout: %+v", res)
+	}
+}
@@ -6,27 +6,78 @@ import (
 	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
 )
 
-// Validate runs all registered checks in sorted order and returns a summary.
+// Validate runs all registered checks (or the set named by opts.CheckSet) in priority order,
+// ties resolved by opts.TieBreak, and returns a summary. The planned order is recorded in Summary.Order.
+// Each check runs through the middleware chain registered with Use.
+// A non-nil error is always a *RunError.
 func Validate(
 	ctx context.Context,
 	filePath string,
 	data []byte,
 	langs []string,
 	opts checks.RunOptions,
+) (Summary, error) {
+	units, err := checks.ResolveRun(opts)
+	if err != nil {
+		return newSummary(filePath, data, langs), configRunError(err)
+	}
+
+	return run(ctx, units, filePath, data, langs, opts)
+}
+
+// run executes units in the given order through the middleware chain.
+// With opts.VerifySummary the finished summary is checked with Verify.
+func run(
+	ctx context.Context,
+	units []checks.CheckUnit,
+	filePath string,
+	data []byte,
+	langs []string,
+	opts checks.RunOptions,
+) (Summary, error) {
+	sum, err := runUnits(ctx, units, filePath, data, langs, opts)
+	sum.Languages = languagesOf(context.WithoutCancel(ctx), sum.FinalData, sum.FinalLangs)
+	if !opts.VerifySummary {
+		return sum, err
+	}
+
+	return sum, verifiedRunError(sum, err)
+}
+
+func runUnits(
+	ctx context.Context,
+	units []checks.CheckUnit,
+	filePath string,
+	data []byte,
+	langs []string,
+	opts checks.RunOptions,
 ) (Summary, error) {
 	state := newRunState(filePath, data, langs)
+	step := buildStep()
+	state.setOrder(units)
 
-	for _, unit := range checks.ListSorted() {
+	for _, unit := range units {
 		if err := contextError(ctx); err != nil {
 			state.markContextEarlyExit()
-			return state.summary, err
+			return state.summary, contextRunError(state.summary, err)
+		}
+
+		if failureBudgetSpent(opts, state.failures) {
+			state.markFailureBudgetExit()
+			return state.summary, nil
 		}
 
-		outcome := state.runCheck(ctx, unit, opts)
+		outcome := state.runCheck(ctx, step, unit, withRemainingFailures(opts, state.failures))
 
 		if shouldStop(unit, outcome) {
 			state.markEarlyExit(unit, outcome)
-			return state.summary, failFastError(unit, outcome, opts)
+			return state.summary, failFastError(unit, outcome, state.summary, opts)
+		}
+	}
+
+	if opts.RecheckAfterFixes {
+		if err := state.recheck(ctx, step, opts); err != nil {
+			return state.summary, contextRunError(state.summary, err)
 		}
 	}
 
@@ -1,8 +1,11 @@
 package validator_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +38,18 @@ func TestValidate_OrderAndCounters(t *testing.T) {
 		},
 	))
 
+	// c4 (prio 4): INFO, c5 (prio 5): SKIPPED
+	_, _ = checks.Register(mkCheck(t, "c4", 4, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Info, "c4", "fyi", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "c5", 5, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Skipped, "c5", "not applicable", a, "")
+		},
+	))
+
 	sum, err := validator.Validate(context.Background(), "file.csv", []byte("data"), nil, checks.RunOptions{
 		FixMode:       checks.FixNone,
 		RerunAfterFix: false,
@@ -57,8 +72,12 @@ func TestValidate_OrderAndCounters(t *testing.T) {
 		}
 	}
 
-	if sum.Pass != 2 || sum.Warn != 1 || sum.Fail != 0 || sum.Error != 0 {
-		t.Fatalf("counters mismatch: PASS=%d WARN=%d FAIL=%d ERROR=%d", sum.Pass, sum.Warn, sum.Fail, sum.Error)
+	if sum.Pass != 2 || sum.Warn != 1 || sum.Fail != 0 || sum.Error != 0 || sum.Info != 1 || sum.Skipped != 1 {
+		t.Fatalf("counters mismatch: PASS=%d WARN=%d FAIL=%d ERROR=%d INFO=%d SKIPPED=%d",
+			sum.Pass, sum.Warn, sum.Fail, sum.Error, sum.Info, sum.Skipped)
+	}
+	if err := validator.Verify(sum); err != nil {
+		t.Fatalf("Verify: %v", err)
 	}
 	if sum.AppliedFixes {
 		t.Fatalf("AppliedFixes=true, want false")
@@ -535,3 +554,295 @@ func mkCheck(t *testing.T, name string, prio int, failfast bool, run func(ctx co
 	}
 	return ch
 }
+
+func TestValidate_OrderIsDeterministicAndRecorded(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	var ran []string
+	for _, name := range []string{"delta", "bravo", "charlie", "alpha"} {
+		_, _ = checks.Register(mkCheck(t, name, 1, false,
+			func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+				ran = append(ran, name)
+				return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+			},
+		))
+	}
+
+	tests := []struct {
+		tb   checks.TieBreak
+		want []string
+	}{
+		{checks.TieBreakName, []string{"alpha", "bravo", "charlie", "delta"}},
+		{checks.TieBreakRegistration, []string{"delta", "bravo", "charlie", "alpha"}},
+	}
+
+	for _, tt := range tests {
+		for range 20 {
+			ran = nil
+
+			sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{
+				TieBreak: tt.tb,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(sum.Order, tt.want) {
+				t.Fatalf("Order (tb=%d) = %v, want %v", tt.tb, sum.Order, tt.want)
+			}
+			if !reflect.DeepEqual(ran, tt.want) {
+				t.Fatalf("execution (tb=%d) = %v, want %v", tt.tb, ran, tt.want)
+			}
+		}
+	}
+}
+
+func TestValidate_OrderListsPlanOnEarlyExit(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "stop", 1, true,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Fail, "stop", "nope", a, "")
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "skipped", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "skipped", "ok", a, "")
+		},
+	))
+
+	sum, _ := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{})
+	if !reflect.DeepEqual(sum.Order, []string{"stop", "skipped"}) {
+		t.Fatalf("Order = %v", sum.Order)
+	}
+	if len(sum.Outcomes) != 1 {
+		t.Fatalf("expected 1 outcome, got %d", len(sum.Outcomes))
+	}
+}
+
+func TestValidate_CheckSetSelectsChecks(t *testing.T) {
+	checks.Reset()
+	checks.ResetSets()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(checks.ResetSets)
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, _ = checks.Register(mkCheck(t, name, 1, false,
+			func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+				return checks.OutcomeKeep(checks.Pass, name, "ok", a, "")
+			},
+		))
+	}
+	_, _ = checks.RegisterSet(checks.NewSet("tenant-a", "c", "a"))
+	_, _ = checks.RegisterSet(checks.NewSet("tenant-b", "b"))
+
+	sumA, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{CheckSet: "tenant-a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(sumA.Order, []string{"a", "c"}) || sumA.Pass != 2 {
+		t.Fatalf("tenant-a: Order=%v Pass=%d", sumA.Order, sumA.Pass)
+	}
+
+	sumB, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{CheckSet: "tenant-b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(sumB.Order, []string{"b"}) {
+		t.Fatalf("tenant-b: Order=%v", sumB.Order)
+	}
+}
+
+func TestValidate_UnknownCheckSet(t *testing.T) {
+	checks.Reset()
+	checks.ResetSets()
+	t.Cleanup(checks.Reset)
+	t.Cleanup(checks.ResetSets)
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{CheckSet: "ghost"})
+	if !errors.Is(err, checks.ErrUnknownCheckSet) {
+		t.Fatalf("expected ErrUnknownCheckSet, got %v", err)
+	}
+
+	var re *validator.RunError
+	if !errors.As(err, &re) || re.Cause == nil {
+		t.Fatalf("expected *RunError with Cause, got %T %v", err, err)
+	}
+	if len(sum.Outcomes) != 0 || string(sum.FinalData) != "x" {
+		t.Fatalf("nothing must run for an unknown set: %+v", sum)
+	}
+}
+
+func TestValidate_UnknownCheckInSettings(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	ran := false
+	_, _ = checks.Register(mkCheck(t, "warn-tags", 1, false, func(_ context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+		ran = true
+		return checks.OutcomeKeep(checks.Pass, "warn-tags", "ok", a, "")
+	}))
+
+	opts := checks.RunOptions{Settings: map[string]checks.CheckSettings{"warn-tag": {"enabled": "true"}}}
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, opts)
+
+	var uce *checks.UnknownChecksError
+	if !errors.As(err, &uce) || uce.Suggestions["warn-tag"] != "warn-tags" {
+		t.Fatalf("expected *UnknownChecksError suggesting warn-tags, got %v", err)
+	}
+	var re *validator.RunError
+	if !errors.As(err, &re) || re.Cause == nil {
+		t.Fatalf("expected *RunError with Cause, got %T %v", err, err)
+	}
+	if ran || len(sum.Outcomes) != 0 {
+		t.Fatalf("nothing must run with a misconfigured check name")
+	}
+}
+
+func TestValidate_MergesDetection(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "encoding", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Pass, "encoding", "ok", a, "")
+			out.Detection = &checks.Detection{Encoding: "UTF-8", EncodingPath: "valid UTF-8"}
+			return out
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "delimiter", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Pass, "delimiter", "ok", a, "")
+			out.Detection = &checks.Detection{Delimiter: ";", Confidence: checks.ConfidenceHigh}
+			return out
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "plain", 3, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Pass, "plain", "ok", a, "")
+		},
+	))
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("data"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := checks.Detection{
+		Delimiter:    ";",
+		Confidence:   checks.ConfidenceHigh,
+		Encoding:     "UTF-8",
+		EncodingPath: "valid UTF-8",
+	}
+	if sum.Detection != want {
+		t.Fatalf("Detection = %+v, want %+v", sum.Detection, want)
+	}
+}
+
+func TestValidate_GroupsFindingsByRow(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "flags", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Fail, "flags", "bad flags", a, "")
+			out.Result.Findings = []checks.Finding{
+				{Check: "flags", Row: 3, Column: "forbidden", Value: "maybe"},
+				{Check: "flags", Row: 0, Message: "file-level"},
+			}
+			return out
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "terms", 2, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Warn, "terms", "empty terms", a, "")
+			out.Result.Findings = []checks.Finding{
+				{Check: "terms", Row: 3, Column: "term"},
+				{Check: "terms", Row: 5, Column: "term"},
+			}
+			return out
+		},
+	))
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sum.FindingsByRow) != 2 {
+		t.Fatalf("FindingsByRow = %+v, want rows 3 and 5", sum.FindingsByRow)
+	}
+	row3 := sum.FindingsByRow[3]
+	if len(row3) != 2 || row3[0].Check != "flags" || row3[1].Check != "terms" {
+		t.Fatalf("row 3 = %+v, want flags then terms", row3)
+	}
+	if len(sum.FindingsByRow[5]) != 1 {
+		t.Fatalf("row 5 = %+v", sum.FindingsByRow[5])
+	}
+}
+
+func TestValidate_MaxFailuresEndsRunAndPassesRemainingBudget(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	var budgets []int
+	failing := func(name string, n int) func(context.Context, checks.Artifact, checks.RunOptions) checks.CheckOutcome {
+		return func(ctx context.Context, a checks.Artifact, opts checks.RunOptions) checks.CheckOutcome {
+			budgets = append(budgets, opts.MaxFailures)
+			out := checks.OutcomeKeep(checks.Fail, name, "bad rows", a, "")
+			for i := range n {
+				out.Result.Findings = append(out.Result.Findings, checks.Finding{Check: name, Row: i + 2})
+			}
+			return out
+		}
+	}
+
+	_, _ = checks.Register(mkCheck(t, "first", 1, false, failing("first", 3)))
+	_, _ = checks.Register(mkCheck(t, "second", 2, false, failing("second", 2)))
+	_, _ = checks.Register(mkCheck(t, "third", 3, false, failing("third", 1)))
+
+	sum, err := validator.Validate(context.Background(), "file.csv", []byte("x"), nil, checks.RunOptions{MaxFailures: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(budgets, []int{5, 2}) {
+		t.Fatalf("budgets = %v, want [5 2]", budgets)
+	}
+	if len(sum.Outcomes) != 2 || !sum.EarlyExit || !sum.Truncated {
+		t.Fatalf("expected run to stop after 2 checks, got %+v", sum)
+	}
+	if sum.EarlyCheck != "max failures reached" || sum.EarlyStatus != checks.Fail {
+		t.Fatalf("EarlyCheck=%q EarlyStatus=%s", sum.EarlyCheck, sum.EarlyStatus)
+	}
+}
+
+func TestValidate_WritesFindingsSidecar(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "many", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			out := checks.OutcomeKeep(checks.Fail, "many", "bad rows: 2, 3, ... (total 11 rows)", a, "")
+			for row := 2; row <= 12; row++ {
+				out.Result.Findings = append(out.Result.Findings, checks.Finding{Check: "many", Row: row})
+			}
+			return out
+		},
+	))
+
+	var buf bytes.Buffer
+	sc := &checks.FindingsSidecar{Name: "out.jsonl", W: &buf}
+
+	sum, err := validator.Validate(context.Background(), "f.csv", []byte("x"), nil, checks.RunOptions{FindingsSidecar: sc})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+	if got := sum.Outcomes[0].Result.Message; !strings.HasSuffix(got, "(all 11 findings in out.jsonl)") {
+		t.Fatalf("message not pointing at the sidecar: %q", got)
+	}
+	if strings.Count(buf.String(), "\n") != 11 {
+		t.Fatalf("sidecar lines = %d, want 11", strings.Count(buf.String(), "\n"))
+	}
+}
@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+)
+
+// ErrInvariant wraps every violation reported by Verify.
+var ErrInvariant = errors.New("summary invariant violated")
+
+// Verify checks the invariants every Summary produced by a run must hold:
+// the status counters match the outcomes, FinalData is non-nil, and AppliedFixes
+// is set exactly when some outcome changed the artifact. It is meant for tests and
+// for runs with RunOptions.VerifySummary; a violation means a runner bug, not bad input.
+// All violations are returned together, each wrapping ErrInvariant.
+func Verify(sum Summary) error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%w: "+format, append([]any{ErrInvariant}, args...)...))
+	}
+
+	var pass, warn, failed, errored, info, skipped int
+	changed := false
+
+	for i, o := range sum.Outcomes {
+		switch o.Result.Status {
+		case checks.Pass:
+			pass++
+		case checks.Warn:
+			warn++
+		case checks.Fail:
+			failed++
+		case checks.Error:
+			errored++
+		case checks.Info:
+			info++
+		case checks.Skipped:
+			skipped++
+		default:
+			fail("outcome %d (%s) has unknown status %q", i, o.Result.Name, o.Result.Status)
+		}
+
+		if o.Final.DidChange {
+			changed = true
+		}
+	}
+
+	if pass != sum.Pass || warn != sum.Warn || failed != sum.Fail || errored != sum.Error ||
+		info != sum.Info || skipped != sum.Skipped {
+		fail("counters %d/%d/%d/%d/%d/%d (PASS/WARN/FAIL/ERROR/INFO/SKIPPED) do not match outcomes %d/%d/%d/%d/%d/%d",
+			sum.Pass, sum.Warn, sum.Fail, sum.Error, sum.Info, sum.Skipped,
+			pass, warn, failed, errored, info, skipped)
+	}
+
+	if sum.FinalData == nil {
+		fail("FinalData is nil")
+	}
+
+	if sum.AppliedFixes && !changed {
+		fail("AppliedFixes is set but no outcome changed the artifact")
+	}
+	if changed && !sum.AppliedFixes {
+		fail("an outcome changed the artifact but AppliedFixes is not set")
+	}
+
+	if len(sum.Order) > 0 && len(sum.Outcomes) > len(sum.Order) {
+		fail("%d outcomes for %d planned checks", len(sum.Outcomes), len(sum.Order))
+	}
+
+	if sum.EarlyExit && sum.EarlyCheck == "" {
+		fail("EarlyExit is set without EarlyCheck")
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,136 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/checks"
+	"github.com/bodrovis/lokalise-glossary-guard-core/pkg/validator"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	outcome := func(st checks.Status, changed bool) checks.CheckOutcome {
+		return checks.CheckOutcome{
+			Result: checks.CheckResult{Name: "c", Status: st},
+			Final:  checks.FixResult{DidChange: changed},
+		}
+	}
+
+	cases := []struct {
+		name string
+		sum  validator.Summary
+		want []string
+	}{
+		{
+			name: "consistent",
+			sum: validator.Summary{
+				Pass: 1, Warn: 1, AppliedFixes: true, FinalData: []byte("x"),
+				Order:    []string{"a", "b"},
+				Outcomes: []checks.CheckOutcome{outcome(checks.Pass, false), outcome(checks.Warn, true)},
+			},
+		},
+		{
+			name: "empty run",
+			sum:  validator.Summary{FinalData: []byte{}},
+		},
+		{
+			name: "counters",
+			sum: validator.Summary{
+				Pass: 2, FinalData: []byte{},
+				Outcomes: []checks.CheckOutcome{outcome(checks.Pass, false), outcome(checks.Fail, false)},
+			},
+			want: []string{"counters 2/0/0/0/0/0 (PASS/WARN/FAIL/ERROR/INFO/SKIPPED) do not match outcomes 1/0/1/0/0/0"},
+		},
+		{
+			name: "nil data and fix flags",
+			sum:  validator.Summary{AppliedFixes: true, EarlyExit: true},
+			want: []string{
+				"FinalData is nil",
+				"AppliedFixes is set but no outcome changed the artifact",
+				"EarlyExit is set without EarlyCheck",
+			},
+		},
+		{
+			name: "missed fix",
+			sum: validator.Summary{
+				Pass: 1, FinalData: []byte{},
+				Outcomes: []checks.CheckOutcome{outcome(checks.Pass, true)},
+			},
+			want: []string{"an outcome changed the artifact but AppliedFixes is not set"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validator.Verify(tc.sum)
+			if len(tc.want) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected err: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, validator.ErrInvariant) {
+				t.Fatalf("expected ErrInvariant, got %v", err)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(err.Error(), w) {
+					t.Fatalf("missing %q in %v", w, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_VerifySummary(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	// An outcome with a status the runner does not count breaks the counter invariant.
+	_, _ = checks.Register(mkCheck(t, "odd", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Status("MAYBE"), "odd", "?", a, "")
+		},
+	))
+
+	if _, err := validator.Validate(context.Background(), "f.csv", nil, nil, checks.RunOptions{}); err != nil {
+		t.Fatalf("without VerifySummary the run must not fail: %v", err)
+	}
+
+	sum, err := validator.Validate(context.Background(), "f.csv", nil, nil, checks.RunOptions{VerifySummary: true})
+	var re *validator.RunError
+	if !errors.As(err, &re) || !errors.Is(err, validator.ErrInvariant) {
+		t.Fatalf("expected a RunError wrapping ErrInvariant, got %v", err)
+	}
+	if sum.FinalData == nil {
+		t.Fatalf("FinalData must not be nil even for nil input")
+	}
+}
+
+func TestValidate_VerifySummaryPassesOnHealthyRun(t *testing.T) {
+	checks.Reset()
+	t.Cleanup(checks.Reset)
+
+	_, _ = checks.Register(mkCheck(t, "fixer", 1, false,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			final := checks.FixResult{Data: []byte("fixed"), DidChange: true}
+			return checks.OutcomeWithFinal(checks.Pass, "fixer", "fixed", final)
+		},
+	))
+	_, _ = checks.Register(mkCheck(t, "stop", 2, true,
+		func(ctx context.Context, a checks.Artifact, _ checks.RunOptions) checks.CheckOutcome {
+			return checks.OutcomeKeep(checks.Fail, "stop", "bad", a, "")
+		},
+	))
+
+	_, err := validator.Validate(context.Background(), "f.csv", []byte("raw"), nil, checks.RunOptions{VerifySummary: true})
+	if errors.Is(err, validator.ErrInvariant) {
+		t.Fatalf("healthy run reported invariant violations: %v", err)
+	}
+}